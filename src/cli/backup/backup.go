@@ -178,72 +178,50 @@ func genericCreateCommand(
 	ins idname.Cacher,
 ) error {
 	var (
-		bIDs []string
-		errs = []error{}
+		bIDs    []string
+		errs    = []error{}
+		results = make([]backupResult, len(selectorSet))
 	)
 
-	for _, discSel := range selectorSet {
-		discSel.Configure(defaultSelectorConfig)
-
-		var (
-			owner = discSel.DiscreteOwner
-			ictx  = clues.Add(ctx, "resource_owner_selected", owner)
-		)
-
-		logger.Ctx(ictx).Infof("setting up backup")
-
-		bo, err := r.NewBackupWithLookup(ictx, discSel, ins)
-		if err != nil {
-			cerr := clues.WrapWC(ictx, err, owner)
-			errs = append(errs, cerr)
-
-			Errf(
-				ictx,
-				"%s\nCause: %s",
-				"Unable to initiate backup",
-				err.Error())
-
-			continue
-		}
-
-		ictx = clues.Add(
-			ictx,
-			"resource_owner_id", bo.ResourceOwner.ID(),
-			"resource_owner_name", clues.Hide(bo.ResourceOwner.Name()))
-
-		logger.Ctx(ictx).Infof("running backup")
-
-		err = bo.Run(ictx)
-		if err != nil {
-			if errors.Is(err, core.ErrServiceNotEnabled) {
-				logger.Ctx(ictx).Infow("service not enabled",
-					"resource_owner_id", bo.ResourceOwner.ID(),
-					"service", serviceName)
-
-				continue
+	runInOrder(
+		len(selectorSet),
+		flags.ResourceParallelismFV,
+		func(i int) {
+			results[i] = runBackup(ctx, r, serviceName, selectorSet[i], ins)
+		},
+		func(i int) {
+			var (
+				res   = results[i]
+				owner = selectorSet[i].DiscreteOwner
+				ictx  = clues.Add(ctx, "resource_owner_selected", owner)
+			)
+
+			if res.err != nil {
+				errs = append(errs, clues.WrapWC(ictx, res.err, owner))
+
+				Errf(
+					ictx,
+					"%s\nCause: %s",
+					res.failMsg,
+					res.err.Error())
+
+				return
 			}
 
-			cerr := clues.Wrap(err, owner)
-			errs = append(errs, cerr)
-
-			Errf(
-				ictx,
-				"%s\nCause: %s",
-				"Unable to complete backup",
-				err.Error())
-
-			continue
-		}
+			// the service isn't enabled for the resource.
+			if len(res.backupID) == 0 {
+				return
+			}
 
-		bIDs = append(bIDs, string(bo.Results.BackupID))
+			bIDs = append(bIDs, res.backupID)
 
-		if !DisplayJSONFormat() {
-			Infof(ictx, fmt.Sprintf("Backup complete %s %s", observe.Bullet, color.BlueOutput(bo.Results.BackupID)))
-			printBackupStats(ictx, r, string(bo.Results.BackupID))
-		} else {
-			Infof(ictx, "Backup complete - ID: %v\n", bo.Results.BackupID)
-		}
-	}
+			if !DisplayJSONFormat() {
+				Infof(ictx, fmt.Sprintf("Backup complete %s %s", observe.Bullet, color.BlueOutput(res.backupID)))
+				printBackupStats(ictx, r, res.backupID)
+			} else {
+				Infof(ictx, "Backup complete - ID: %v\n", res.backupID)
+			}
+		})
 
 	bups, berrs := r.Backups(ctx, bIDs)
 	if berrs.Failure() != nil {
@@ -269,6 +247,101 @@ func genericCreateCommand(
 	return nil
 }
 
+// backupResult is the outcome of the backup of a single protected resource.
+// Results without an error or a backup ID belong to resources which don't
+// have the service enabled.
+type backupResult struct {
+	backupID string
+	err      error
+	// failMsg describes the stage of the backup which failed.
+	failMsg string
+}
+
+// runBackup runs the backup of a single protected resource.
+func runBackup(
+	ctx context.Context,
+	r repository.Repositoryer,
+	serviceName string,
+	discSel selectors.Selector,
+	ins idname.Cacher,
+) backupResult {
+	discSel.Configure(defaultSelectorConfig)
+
+	ictx := clues.Add(ctx, "resource_owner_selected", discSel.DiscreteOwner)
+
+	logger.Ctx(ictx).Infof("setting up backup")
+
+	bo, err := r.NewBackupWithLookup(ictx, discSel, ins)
+	if err != nil {
+		return backupResult{err: err, failMsg: "Unable to initiate backup"}
+	}
+
+	ictx = clues.Add(
+		ictx,
+		"resource_owner_id", bo.ResourceOwner.ID(),
+		"resource_owner_name", clues.Hide(bo.ResourceOwner.Name()))
+
+	logger.Ctx(ictx).Infof("running backup")
+
+	if err := bo.Run(ictx); err != nil {
+		if errors.Is(err, core.ErrServiceNotEnabled) {
+			logger.Ctx(ictx).Infow("service not enabled",
+				"resource_owner_id", bo.ResourceOwner.ID(),
+				"service", serviceName)
+
+			return backupResult{}
+		}
+
+		return backupResult{err: err, failMsg: "Unable to complete backup"}
+	}
+
+	return backupResult{backupID: string(bo.Results.BackupID)}
+}
+
+// runInOrder calls run for each index in [0, n), with up to parallelism
+// calls running at once.  Report gets called with each index in ascending
+// order, once the run for that index completes, so that the output stays
+// in order no matter which runs complete first.
+func runInOrder(n, parallelism int, run, report func(i int)) {
+	if parallelism <= 1 {
+		for i := 0; i < n; i++ {
+			run(i)
+			report(i)
+		}
+
+		return
+	}
+
+	var (
+		done      = make([]chan struct{}, n)
+		semaphore = make(chan struct{}, parallelism)
+	)
+
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	go func() {
+		for i := 0; i < n; i++ {
+			semaphore <- struct{}{}
+
+			go func(i int) {
+				defer func() {
+					<-semaphore
+					close(done[i])
+				}()
+
+				run(i)
+			}(i)
+		}
+	}()
+
+	for i := 0; i < n; i++ {
+		<-done[i]
+		report(i)
+	}
+}
+
 // genericDeleteCommand is a helper function that all services can use
 // for the removal of an entry from the repository
 func genericDeleteCommand(
@@ -1,7 +1,9 @@
 package backup
 
 import (
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/alcionai/clues"
 	"github.com/stretchr/testify/assert"
@@ -95,3 +97,68 @@ func (suite *BackupUnitSuite) TestGenericDetailsCore_empty() {
 	require.Error(t, err, "has error")
 	assert.ErrorIs(t, err, ErrEmptyBackup, clues.ToCore(err))
 }
+
+func (suite *BackupUnitSuite) TestRunInOrder() {
+	const n = 7
+
+	table := []struct {
+		name        string
+		parallelism int
+		expectMax   int
+	}{
+		{
+			name:        "unset",
+			parallelism: 0,
+			expectMax:   1,
+		},
+		{
+			name:        "sequential",
+			parallelism: 1,
+			expectMax:   1,
+		},
+		{
+			name:        "parallel",
+			parallelism: 3,
+			expectMax:   3,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			var (
+				running  atomic.Int64
+				maxSeen  atomic.Int64
+				finished = make([]atomic.Bool, n)
+				reported = []int{}
+			)
+
+			run := func(i int) {
+				curr := running.Add(1)
+				defer running.Add(-1)
+
+				for {
+					m := maxSeen.Load()
+					if curr <= m || maxSeen.CompareAndSwap(m, curr) {
+						break
+					}
+				}
+
+				// later runs complete sooner, to shuffle the completion order.
+				time.Sleep(time.Duration(n-i) * 5 * time.Millisecond)
+
+				finished[i].Store(true)
+			}
+
+			report := func(i int) {
+				assert.True(t, finished[i].Load(), "reported before the run completed")
+				reported = append(reported, i)
+			}
+
+			runInOrder(n, test.parallelism, run, report)
+
+			assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, reported)
+			assert.LessOrEqual(t, maxSeen.Load(), int64(test.expectMax))
+		})
+	}
+}
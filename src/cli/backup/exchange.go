@@ -1,14 +1,19 @@
 package backup
 
 import (
+	"context"
+
 	"github.com/alcionai/clues"
 	"github.com/spf13/cobra"
 
 	"github.com/alcionai/corso/src/cli/flags"
 	. "github.com/alcionai/corso/src/cli/print"
 	"github.com/alcionai/corso/src/cli/utils"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/control"
 	"github.com/alcionai/corso/src/pkg/fault"
 	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/repository"
 	"github.com/alcionai/corso/src/pkg/selectors"
 )
 
@@ -20,6 +25,9 @@ const (
 	dataContacts = "contacts"
 	dataEmail    = "email"
 	dataEvents   = "events"
+	dataTasks    = "tasks"
+
+	dataMailboxSettings = "mailboxsettings"
 )
 
 const (
@@ -36,6 +44,9 @@ corso backup create exchange --mailbox alice@example.com
 # Backup only Exchange contacts for Alice and Bob
 corso backup create exchange --mailbox alice@example.com,bob@example.com --data contacts
 
+# Backup Alice's inbox rules, automatic replies, and other mailbox settings
+corso backup create exchange --mailbox alice@example.com --data mailboxsettings
+
 # Backup all Exchange data for all M365 users 
 corso backup create exchange --mailbox '*'`
 
@@ -56,7 +67,11 @@ corso backup details exchange --backup 1234abcd-12ab-cd34-56de-1234abcd \
 
 # Explore contacts named Andy
 corso backup details exchange --backup 1234abcd-12ab-cd34-56de-1234abcd \
-    --contact-name Andy`
+    --contact-name Andy
+
+# Show the inbox rules and mailbox settings that changed between two backups
+corso backup details exchange --backup 1234abcd-12ab-cd34-56de-1234abcd \
+    --diff-backup 1234abcd-12ab-cd34-56de-1234abce`
 )
 
 // called by backup.go to map subcommands to provider-specific handling.
@@ -73,7 +88,7 @@ func addExchangeCommands(cmd *cobra.Command) *cobra.Command {
 		// Flags addition ordering should follow the order we want them to appear in help and docs:
 		// More generic (ex: --user) and more frequently used flags take precedence.
 		flags.AddMailBoxFlag(c)
-		flags.AddDataFlag(c, []string{dataEmail, dataContacts, dataEvents}, false)
+		flags.AddDataFlag(c, []string{dataEmail, dataContacts, dataEvents, dataTasks, dataMailboxSettings}, false)
 		flags.AddFetchParallelismFlag(c)
 		flags.AddDisableDeltaFlag(c)
 		flags.AddEnableImmutableIDFlag(c)
@@ -98,6 +113,7 @@ func addExchangeCommands(cmd *cobra.Command) *cobra.Command {
 		// Flags addition ordering should follow the order we want them to appear in help and docs:
 		// More generic (ex: --user) and more frequently used flags take precedence.
 		flags.AddBackupIDFlag(c, true)
+		flags.AddDiffBackupFlag(c)
 		flags.AddExchangeDetailsAndRestoreFlags(c, false)
 
 	case deleteCommand:
@@ -196,6 +212,10 @@ func exchangeBackupCreateSelectors(userIDs, cats []string) *selectors.ExchangeBa
 			sel.Include(sel.MailFolders(selectors.Any()))
 		case dataEvents:
 			sel.Include(sel.EventCalendars(selectors.Any()))
+		case dataTasks:
+			sel.Include(sel.TaskLists(selectors.Any()))
+		case dataMailboxSettings:
+			sel.Include(sel.MailboxSettings(selectors.Any(), selectors.Any()))
 		}
 	}
 
@@ -208,9 +228,11 @@ func validateExchangeBackupCreateFlags(userIDs, cats []string) error {
 	}
 
 	for _, d := range cats {
-		if d != dataContacts && d != dataEmail && d != dataEvents {
+		if d != dataContacts && d != dataEmail && d != dataEvents && d != dataTasks && d != dataMailboxSettings {
 			return clues.New(
-				d + " is an unrecognized data type; must be one of " + dataContacts + ", " + dataEmail + ", or " + dataEvents)
+				d + " is an unrecognized data type; must be one of " +
+					dataContacts + ", " + dataEmail + ", " + dataEvents + ", " + dataTasks +
+					", or " + dataMailboxSettings)
 		}
 	}
 
@@ -272,6 +294,10 @@ func runDetailsExchangeCmd(cmd *cobra.Command) error {
 	sel.Configure(selectors.Config{OnlyMatchItemNames: true})
 	utils.FilterExchangeRestoreInfoSelectors(sel, opts)
 
+	if len(flags.DiffBackupFV) > 0 {
+		return runDiffExchangeCmd(cmd, sel.Selector)
+	}
+
 	ds, err := genericDetailsCommand(cmd, flags.BackupIDFV, sel.Selector)
 	if err != nil {
 		return Only(ctx, err)
@@ -286,6 +312,67 @@ func runDetailsExchangeCmd(cmd *cobra.Command) error {
 	return nil
 }
 
+// runDiffExchangeCmd prints the inbox rules and mailbox settings that were
+// added, removed, or modified between the --diff-backup and --backup backups.
+func runDiffExchangeCmd(cmd *cobra.Command, sel selectors.Selector) error {
+	ctx := cmd.Context()
+
+	r, rdao, err := utils.GetAccountAndConnect(ctx, cmd, path.ExchangeService)
+	if err != nil {
+		return Only(ctx, err)
+	}
+
+	defer utils.CloseRepo(ctx, r)
+
+	changes, err := diffExchangeSettings(
+		ctx,
+		r,
+		flags.DiffBackupFV,
+		flags.BackupIDFV,
+		sel,
+		rdao.Opts)
+	if err != nil {
+		return Only(ctx, err)
+	}
+
+	if len(changes) == 0 {
+		Info(ctx, "No mailbox settings changed between the backups")
+		return nil
+	}
+
+	ps := make([]Printable, 0, len(changes))
+
+	for _, ec := range changes {
+		ps = append(ps, ec)
+	}
+
+	All(ctx, ps...)
+
+	return nil
+}
+
+// diffExchangeSettings compares the mailbox settings in the selected
+// entries of two backups.
+func diffExchangeSettings(
+	ctx context.Context,
+	bg repository.BackupGetter,
+	olderID, newerID string,
+	sel selectors.Selector,
+	opts control.Options,
+) ([]details.EntryChange, error) {
+	older, err := genericDetailsCore(ctx, bg, olderID, sel, opts)
+	if err != nil {
+		return nil, clues.Wrap(err, "getting details of the older backup")
+	}
+
+	newer, err := genericDetailsCore(ctx, bg, newerID, sel, opts)
+	if err != nil {
+		return nil, clues.Wrap(err, "getting details of the newer backup")
+	}
+
+	return details.DiffMailboxSettings(older.Entries, newer.Entries), nil
+}
+
 // ------------------------------------------------------------------------------------------------
 // backup delete
 // ------------------------------------------------------------------------------------------------
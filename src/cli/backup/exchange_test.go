@@ -1,6 +1,7 @@
 package backup
 
 import (
+	"context"
 	"strconv"
 	"testing"
 
@@ -14,8 +15,13 @@ import (
 	flagsTD "github.com/alcionai/corso/src/cli/flags/testdata"
 	cliTD "github.com/alcionai/corso/src/cli/testdata"
 	"github.com/alcionai/corso/src/cli/utils"
+	utilsTD "github.com/alcionai/corso/src/cli/utils/testdata"
+	"github.com/alcionai/corso/src/internal/data"
 	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/backup"
+	"github.com/alcionai/corso/src/pkg/backup/details"
 	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/fault"
 )
 
 type ExchangeUnitSuite struct {
@@ -188,6 +194,7 @@ func (suite *ExchangeUnitSuite) TestBackupDetailsFlags() {
 			[]string{
 				"--" + flags.RunModeFN, flags.RunModeFlagTest,
 				"--" + flags.BackupFN, flagsTD.BackupInput,
+				"--" + flags.DiffBackupFN, "older-backup",
 				"--" + flags.SkipReduceFN,
 			},
 			flagsTD.PreparedProviderFlags(),
@@ -196,6 +203,7 @@ func (suite *ExchangeUnitSuite) TestBackupDetailsFlags() {
 	co := utils.Control()
 
 	assert.Equal(t, flagsTD.BackupInput, flags.BackupIDFV)
+	assert.Equal(t, "older-backup", flags.DiffBackupFV)
 	assert.True(t, co.SkipReduce)
 	flagsTD.AssertProviderFlags(t, cmd)
 	flagsTD.AssertStorageFlags(t, cmd)
@@ -247,6 +255,18 @@ func (suite *ExchangeUnitSuite) TestValidateBackupCreateFlags() {
 			data:   []string{"smurfs"},
 			expect: assert.Error,
 		},
+		{
+			name:   "users and tasks",
+			user:   []string{"fnord"},
+			data:   []string{dataTasks},
+			expect: assert.NoError,
+		},
+		{
+			name:   "users and mailbox settings",
+			user:   []string{"fnord"},
+			data:   []string{dataMailboxSettings},
+			expect: assert.NoError,
+		},
 		{
 			name:   "only users no data",
 			user:   []string{"fnord"},
@@ -319,6 +339,18 @@ func (suite *ExchangeUnitSuite) TestExchangeBackupCreateSelectors() {
 			data:             []string{dataEvents},
 			expectIncludeLen: 1,
 		},
+		{
+			name:             "single user, tasks",
+			user:             []string{"u1"},
+			data:             []string{dataTasks},
+			expectIncludeLen: 1,
+		},
+		{
+			name:             "single user, mailbox settings",
+			user:             []string{"u1"},
+			data:             []string{dataMailboxSettings},
+			expectIncludeLen: 1,
+		},
 		{
 			name:             "any users, contacts + email",
 			user:             []string{flags.Wildcard},
@@ -377,3 +409,92 @@ func (suite *ExchangeUnitSuite) TestExchangeBackupCreateSelectors() {
 		})
 	}
 }
+
+type diffBackupGetter struct {
+	*utilsTD.MockBackupGetter
+	details map[string]*details.Details
+}
+
+func (bg diffBackupGetter) GetBackupDetails(
+	_ context.Context,
+	backupID string,
+) (*details.Details, *backup.Backup, *fault.Bus) {
+	d, ok := bg.details[backupID]
+	if !ok {
+		return nil, nil, fault.New(true).Fail(data.ErrNotFound)
+	}
+
+	return d, nil, fault.New(true)
+}
+
+func settingDetails(entries ...details.Entry) *details.Details {
+	return &details.Details{
+		DetailsModel: details.DetailsModel{Entries: entries},
+	}
+}
+
+func (suite *ExchangeUnitSuite) TestDiffExchangeSettings() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	rule := func(name, hash string) details.Entry {
+		return details.Entry{
+			RepoRef:     "tid/exchange/uid/mailboxSettings/InboxRules/" + name,
+			ShortRef:    name,
+			ParentRef:   "InboxRules",
+			LocationRef: "InboxRules",
+			ItemRef:     name,
+			ItemInfo: details.ItemInfo{
+				Exchange: &details.ExchangeInfo{
+					ItemType:    details.ExchangeMailboxSetting,
+					SettingName: name,
+					SettingHash: hash,
+				},
+			},
+		}
+	}
+
+	bg := diffBackupGetter{
+		details: map[string]*details.Details{
+			"older": settingDetails(rule("kept", "h1"), rule("changed", "h2"), rule("removed", "h3")),
+			"newer": settingDetails(rule("kept", "h1"), rule("changed", "h2-new"), rule("added", "h4")),
+		},
+	}
+
+	sel := utils.IncludeExchangeRestoreDataSelectors(utils.ExchangeOpts{})
+
+	changes, err := diffExchangeSettings(
+		ctx,
+		bg,
+		"older",
+		"newer",
+		sel.Selector,
+		control.DefaultOptions())
+	require.NoError(t, err, clues.ToCore(err))
+
+	result := map[string]details.ChangeType{}
+
+	for _, ec := range changes {
+		result[ec.ItemRef] = ec.Change
+	}
+
+	assert.Equal(
+		t,
+		map[string]details.ChangeType{
+			"changed": details.EntryModified,
+			"removed": details.EntryRemoved,
+			"added":   details.EntryAdded,
+		},
+		result)
+
+	_, err = diffExchangeSettings(
+		ctx,
+		bg,
+		"missing",
+		"newer",
+		sel.Selector,
+		control.DefaultOptions())
+	assert.Error(t, err, clues.ToCore(err))
+}
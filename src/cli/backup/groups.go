@@ -76,6 +76,7 @@ func addGroupsCommands(cmd *cobra.Command) *cobra.Command {
 		flags.AddFetchParallelismFlag(c)
 		flags.AddDisableDeltaFlag(c)
 		flags.AddGenericBackupFlags(c)
+		flags.AddDriveItemVersionsFlags(c)
 		flags.AddDisableLazyItemReader(c)
 
 	case listCommand:
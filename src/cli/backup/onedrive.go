@@ -32,7 +32,10 @@ corso backup create onedrive --user alice@example.com
 corso backup create onedrive --user alice@example.com,bob@example.com
 
 # Backup all OneDrive data for all M365 users 
-corso backup create onedrive --user '*'`
+corso backup create onedrive --user '*'
+
+# Backup OneDrive files and OneNote notebooks for Alice
+corso backup create onedrive --user alice@example.com --data files,notebooks`
 
 	oneDriveServiceCommandDeleteExamples = `# Delete OneDrive backup with ID 1234abcd-12ab-cd34-56de-1234abcd \
 and 1234abcd-12ab-cd34-56de-1234abce
@@ -65,7 +68,9 @@ func addOneDriveCommands(cmd *cobra.Command) *cobra.Command {
 		c.Example = oneDriveServiceCommandCreateExamples
 
 		flags.AddUserFlag(c)
+		flags.AddDataFlag(c, []string{flags.DataFiles, flags.DataNotebooks}, false)
 		flags.AddGenericBackupFlags(c)
+		flags.AddDriveItemVersionsFlags(c)
 		fs.BoolVar(
 			&flags.UseOldDeltaProcessFV,
 			flags.UseOldDeltaProcessFN,
@@ -129,7 +134,7 @@ func createOneDriveCmd(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	if err := validateOneDriveBackupCreateFlags(flags.UserFV); err != nil {
+	if err := validateOneDriveBackupCreateFlags(flags.UserFV, flags.CategoryDataFV); err != nil {
 		return err
 	}
 
@@ -143,7 +148,7 @@ func createOneDriveCmd(cmd *cobra.Command, args []string) error {
 
 	defer utils.CloseRepo(ctx, r)
 
-	sel := oneDriveBackupCreateSelectors(flags.UserFV)
+	sel := oneDriveBackupCreateSelectors(flags.UserFV, flags.CategoryDataFV)
 
 	ins, err := utils.UsersMap(
 		ctx,
@@ -169,17 +174,40 @@ func createOneDriveCmd(cmd *cobra.Command, args []string) error {
 		ins)
 }
 
-func validateOneDriveBackupCreateFlags(users []string) error {
+func validateOneDriveBackupCreateFlags(users, cats []string) error {
 	if len(users) == 0 {
 		return clues.New("requires one or more --user ids or the wildcard --user *")
 	}
 
+	for _, d := range cats {
+		if d != flags.DataFiles && d != flags.DataNotebooks {
+			return clues.New(
+				d + " is an unrecognized data type; must be one of " +
+					flags.DataFiles + " or " + flags.DataNotebooks)
+		}
+	}
+
 	return nil
 }
 
-func oneDriveBackupCreateSelectors(users []string) *selectors.OneDriveBackup {
+// notebooks are only backed up when requested, since they require
+// calls to the OneNote api in addition to the drive.
+func oneDriveBackupCreateSelectors(users, cats []string) *selectors.OneDriveBackup {
 	sel := selectors.NewOneDriveBackup(users)
-	sel.Include(sel.AllData())
+
+	if len(cats) == 0 {
+		sel.Include(sel.AllData())
+		return sel
+	}
+
+	for _, d := range cats {
+		switch d {
+		case flags.DataFiles:
+			sel.Include(sel.AllData())
+		case flags.DataNotebooks:
+			sel.Include(sel.Notebooks(selectors.Any()))
+		}
+	}
 
 	return sel
 }
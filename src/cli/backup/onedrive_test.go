@@ -2,6 +2,7 @@ package backup
 
 import (
 	"testing"
+	"time"
 
 	"github.com/alcionai/clues"
 	"github.com/spf13/cobra"
@@ -15,6 +16,7 @@ import (
 	"github.com/alcionai/corso/src/cli/utils"
 	"github.com/alcionai/corso/src/internal/tester"
 	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/path"
 )
 
 type OneDriveUnitSuite struct {
@@ -101,6 +103,9 @@ func (suite *OneDriveUnitSuite) TestBackupCreateFlags() {
 			[]string{
 				"--" + flags.RunModeFN, flags.RunModeFlagTest,
 				"--" + flags.UserFN, flagsTD.FlgInputs(flagsTD.UsersInput),
+				"--" + flags.CategoryDataFN, flagsTD.FlgInputs(flagsTD.OneDriveCategoryDataInput),
+				"--" + flags.VersionsCountFN, "5",
+				"--" + flags.VersionsMaxAgeFN, "720h",
 			},
 			flagsTD.PreparedGenericBackupFlags(),
 			flagsTD.PreparedProviderFlags(),
@@ -120,8 +125,11 @@ func (suite *OneDriveUnitSuite) TestBackupCreateFlags() {
 	assert.Equal(t, control.FailFast, co.FailureHandling)
 	assert.True(t, co.ToggleFeatures.DisableIncrementals)
 	assert.True(t, co.ToggleFeatures.ForceItemDataDownload)
+	assert.Equal(t, 5, co.DriveItemVersions.MaxCount)
+	assert.Equal(t, 720*time.Hour, co.DriveItemVersions.MaxAge)
 
 	assert.ElementsMatch(t, flagsTD.UsersInput, opts.Users)
+	assert.ElementsMatch(t, flagsTD.OneDriveCategoryDataInput, flags.CategoryDataFV)
 	flagsTD.AssertGenericBackupFlags(t, cmd)
 	flagsTD.AssertProviderFlags(t, cmd)
 	flagsTD.AssertStorageFlags(t, cmd)
@@ -212,6 +220,7 @@ func (suite *OneDriveUnitSuite) TestValidateOneDriveBackupCreateFlags() {
 	table := []struct {
 		name   string
 		user   []string
+		data   []string
 		expect assert.ErrorAssertionFunc
 	}{
 		{
@@ -223,11 +232,65 @@ func (suite *OneDriveUnitSuite) TestValidateOneDriveBackupCreateFlags() {
 			user:   []string{"fnord"},
 			expect: assert.NoError,
 		},
+		{
+			name:   "files and notebooks",
+			user:   []string{"fnord"},
+			data:   []string{flags.DataFiles, flags.DataNotebooks},
+			expect: assert.NoError,
+		},
+		{
+			name:   "unknown data type",
+			user:   []string{"fnord"},
+			data:   []string{"smurfs"},
+			expect: assert.Error,
+		},
 	}
 	for _, test := range table {
 		suite.Run(test.name, func() {
-			err := validateOneDriveBackupCreateFlags(test.user)
+			err := validateOneDriveBackupCreateFlags(test.user, test.data)
 			test.expect(suite.T(), err, clues.ToCore(err))
 		})
 	}
 }
+
+func (suite *OneDriveUnitSuite) TestOneDriveBackupCreateSelectors() {
+	table := []struct {
+		name           string
+		data           []string
+		expectCategory []path.CategoryType
+	}{
+		{
+			name:           "no data",
+			expectCategory: []path.CategoryType{path.FilesCategory},
+		},
+		{
+			name:           "files",
+			data:           []string{flags.DataFiles},
+			expectCategory: []path.CategoryType{path.FilesCategory},
+		},
+		{
+			name:           "notebooks",
+			data:           []string{flags.DataNotebooks},
+			expectCategory: []path.CategoryType{path.NotebooksCategory},
+		},
+		{
+			name:           "files and notebooks",
+			data:           []string{flags.DataFiles, flags.DataNotebooks},
+			expectCategory: []path.CategoryType{path.FilesCategory, path.NotebooksCategory},
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			sel := oneDriveBackupCreateSelectors([]string{"u1"}, test.data)
+
+			cats := []path.CategoryType{}
+			for _, sc := range sel.Scopes() {
+				cats = append(cats, sc.Category().PathType())
+			}
+
+			assert.ElementsMatch(t, test.expectCategory, cats)
+		})
+	}
+}
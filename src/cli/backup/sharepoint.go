@@ -41,6 +41,9 @@ corso backup create sharepoint --site '*'
 
 # Backup all SharePoint list data for a Site
 corso backup create sharepoint --site https://example.com/hr --data lists
+
+# Backup the OneNote notebooks of a Site
+corso backup create sharepoint --site https://example.com/hr --data notebooks
 `
 
 	sharePointServiceCommandDeleteExamples = `# Delete SharePoint backup with ID 1234abcd-12ab-cd34-56de-1234abcd \
@@ -80,7 +83,11 @@ corso backup details sharepoint --backup 1234abcd-12ab-cd34-56de-1234abcd \
 
 # Explore lists modified after a given time
 corso backup details sharepoint --backup 1234abcd-12ab-cd34-56de-1234abcd \
-    --list-modified-after 2024-01-01T12:23:34`
+    --list-modified-after 2024-01-01T12:23:34
+
+# Explore OneNote pages in the "Meetings" section of the "Team Notes" notebook
+corso backup details sharepoint --backup 1234abcd-12ab-cd34-56de-1234abcd \
+    --notebook "Team Notes/Meetings"`
 )
 
 // called by backup.go to map subcommands to provider-specific handling.
@@ -100,6 +107,7 @@ func addSharePointCommands(cmd *cobra.Command) *cobra.Command {
 		// when explicit invoke is not required anymore
 		flags.AddDataFlag(c, []string{flags.DataLibraries}, true)
 		flags.AddGenericBackupFlags(c)
+		flags.AddDriveItemVersionsFlags(c)
 
 	case listCommand:
 		c, _ = utils.AddCommand(cmd, sharePointListCmd())
@@ -6,6 +6,7 @@ import (
 
 	"github.com/alcionai/corso/src/cli/flags"
 	"github.com/alcionai/corso/src/cli/utils"
+	"github.com/alcionai/corso/src/pkg/control"
 )
 
 // called by export.go to map subcommands to provider-specific handling.
@@ -18,7 +19,8 @@ func addExchangeCommands(cmd *cobra.Command) *cobra.Command {
 
 		c.Use = c.Use + " " + exchangeServiceCommandUseSuffix
 
-		flags.AddBackupIDFlag(c, true)
+		flags.AddBackupIDFlag(c, false)
+		flags.AddAsOfFlag(c)
 		flags.AddExchangeDetailsAndRestoreFlags(c, true)
 		flags.AddExportConfigFlags(c)
 		flags.AddFailFastFlag(c)
@@ -40,7 +42,11 @@ corso export exchange my-folder --backup 1234abcd-12ab-cd34-56de-1234abcd --emai
 
 # Export emails with subject containing "Hello world" in the "Inbox" to my-folder
 corso export exchange --backup 1234abcd-12ab-cd34-56de-1234abcd \
-    --email-subject "Hello world" --email-folder Inbox my-folder`
+    --email-subject "Hello world" --email-folder Inbox my-folder
+
+# Export all emails in the "Inbox" as a single mbox file within a zip archive
+corso export exchange --backup 1234abcd-12ab-cd34-56de-1234abcd \
+    --email-folder Inbox --format mbox --archive my-folder`
 
 // TODO(meain): Uncomment once support for these are added
 // 		`# Export an entire calendar to my-folder
@@ -89,6 +95,11 @@ func exportExchangeCmd(cmd *cobra.Command, args []string) error {
 	sel := utils.IncludeExchangeRestoreDataSelectors(opts)
 	utils.FilterExchangeRestoreInfoSelectors(sel, opts)
 
+	acceptedExchangeFormatTypes := []string{
+		string(control.DefaultFormat),
+		string(control.MBOXFormat),
+	}
+
 	return runExport(
 		ctx,
 		cmd,
@@ -96,6 +107,7 @@ func exportExchangeCmd(cmd *cobra.Command, args []string) error {
 		opts.ExportCfg,
 		sel.Selector,
 		flags.BackupIDFV,
+		opts.AsOf,
 		"Exchange",
-		defaultAcceptedFormatTypes)
+		acceptedExchangeFormatTypes)
 }
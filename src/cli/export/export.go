@@ -17,6 +17,7 @@ import (
 	"github.com/alcionai/corso/src/pkg/control"
 	"github.com/alcionai/corso/src/pkg/dttm"
 	"github.com/alcionai/corso/src/pkg/export"
+	"github.com/alcionai/corso/src/pkg/repository"
 	"github.com/alcionai/corso/src/pkg/selectors"
 )
 
@@ -25,6 +26,7 @@ var exportCommands = []func(cmd *cobra.Command) *cobra.Command{
 	addSharePointCommands,
 	addGroupsCommands,
 	addExchangeCommands,
+	addTeamsChatsCommands,
 }
 
 var defaultAcceptedFormatTypes = []string{string(control.DefaultFormat)}
@@ -36,8 +38,13 @@ func AddCommands(cmd *cobra.Command) {
 
 	for _, addExportTo := range exportCommands {
 		sc := addExportTo(subCommand)
+		flags.AddTargetAzureCredsFlags(sc)
 		flags.AddAllStorageFlags(sc)
 	}
+
+	// verification only reads the local export, and doesn't
+	// need a connection to the repository.
+	addVerifyCommands(subCommand)
 }
 
 const exportCommand = "export"
@@ -66,7 +73,7 @@ func runExport(
 	args []string,
 	ueco utils.ExportCfgOpts,
 	sel selectors.Selector,
-	backupID, serviceName string,
+	backupID, asOf, serviceName string,
 	acceptedFormatTypes []string,
 ) error {
 	if err := utils.ValidateExportConfigFlags(&ueco, acceptedFormatTypes); err != nil {
@@ -80,19 +87,65 @@ func runExport(
 
 	defer utils.CloseRepo(ctx, r)
 
+	if err := utils.ConnectTargetAccount(ctx, r, sel.PathService()); err != nil {
+		return Only(ctx, err)
+	}
+
 	exportLocation := args[0]
 	if len(exportLocation) == 0 {
 		// This should not be possible, but adding it just in case.
 		exportLocation = control.DefaultRestoreLocation + dttm.FormatNow(dttm.HumanReadableDriveItem)
 	}
 
-	Infof(ctx, "Exporting to folder %s", exportLocation)
+	sink, err := utils.MakeExportSink(exportLocation, ueco)
+	if err != nil {
+		return Only(ctx, err)
+	}
+
+	journal, err := utils.MakeExportJournal(ctx, exportLocation, ueco)
+	if err != nil {
+		return Only(ctx, err)
+	}
+
+	defer journal.Close()
+
+	Infof(ctx, "Exporting to %s", sink.Location())
+
+	srcs, err := utils.ResolveBackupSources(ctx, r, sel, backupID, asOf)
+	if err != nil {
+		return Only(ctx, err)
+	}
+
+	exportCfg := utils.MakeExportConfig(ctx, ueco)
+	// each backup gets its own manifest, so that exporting several of
+	// them into the same location doesn't overwrite the earlier ones.
+	exportCfg.ManifestPerBackup = len(srcs) > 1
+
+	for _, src := range srcs {
+		if err := exportFrom(ctx, r, src, exportCfg, ueco, sink, journal, serviceName); err != nil {
+			return err
+		}
+	}
 
+	return nil
+}
+
+// exportFrom runs the export of a single backup into the sink.
+func exportFrom(
+	ctx context.Context,
+	r repository.Exporter,
+	src utils.BackupSource,
+	exportCfg control.ExportConfig,
+	ueco utils.ExportCfgOpts,
+	sink export.Sink,
+	journal *export.Journal,
+	serviceName string,
+) error {
 	eo, err := r.NewExport(
 		ctx,
-		backupID,
-		sel,
-		utils.MakeExportConfig(ctx, ueco))
+		src.BackupID,
+		src.Selector,
+		exportCfg)
 	if err != nil {
 		return Only(ctx, clues.Wrap(err, "Failed to initialize "+serviceName+" export"))
 	}
@@ -100,13 +153,13 @@ func runExport(
 	collections, err := eo.Run(ctx)
 	if err != nil {
 		if errors.Is(err, data.ErrNotFound) {
-			return Only(ctx, clues.New("Backup or backup details missing for id "+backupID))
+			return Only(ctx, clues.New("Backup or backup details missing for id "+src.BackupID))
 		}
 
 		return Only(ctx, clues.Wrap(err, "Failed to run "+serviceName+" export"))
 	}
 
-	if err = showExportProgress(ctx, eo, collections, exportLocation); err != nil {
+	if err = showExportProgress(ctx, &eo, collections, sink, journal); err != nil {
 		return err
 	}
 
@@ -129,22 +182,34 @@ func runExport(
 		Infof(ctx, "%s: %d items (%s)", k.HumanString(), s.ResourceCount, humanize.Bytes(uint64(s.BytesRead)))
 	}
 
+	if ueco.Resume {
+		ic := eo.Stats().GetItemCounts()
+		Infof(ctx, "%d items written, %d items skipped as already exported", ic.Written, ic.Skipped)
+	}
+
 	return nil
 }
 
 // slim wrapper that allows us to defer the progress bar closure with the expected scope.
 func showExportProgress(
 	ctx context.Context,
-	op operations.ExportOperation,
+	op *operations.ExportOperation,
 	collections []export.Collectioner,
-	exportLocation string,
+	sink export.Sink,
+	journal *export.Journal,
 ) error {
 	// It would be better to give a progressbar than a spinner, but we
 	// have any way of knowing how many files are available as of now.
-	progressMessage := observe.MessageWithCompletion(ctx, observe.DefaultCfg(), "Writing data to disk")
+	progressMessage := observe.MessageWithCompletion(ctx, observe.DefaultCfg(), "Writing exported data")
 	defer close(progressMessage)
 
-	err := export.ConsumeExportCollections(ctx, exportLocation, collections, op.Errors)
+	err := export.ConsumeExportCollections(
+		ctx,
+		sink,
+		journal,
+		collections,
+		op.Stats(),
+		op.Errors)
 	if err != nil {
 		return Only(ctx, err)
 	}
@@ -19,12 +19,14 @@ func addGroupsCommands(cmd *cobra.Command) *cobra.Command {
 
 		c.Use = c.Use + " " + groupsServiceCommandUseSuffix
 
-		flags.AddBackupIDFlag(c, true)
+		flags.AddBackupIDFlag(c, false)
+		flags.AddAsOfFlag(c)
 		flags.AddSiteFlag(c, false)
 		flags.AddSiteIDFlag(c, false)
 		flags.AddSharePointDetailsAndRestoreFlags(c)
 		flags.AddGroupDetailsAndRestoreFlags(c)
 		flags.AddExportConfigFlags(c)
+		flags.AddItemVersionFlag(c)
 		flags.AddFailFastFlag(c)
 	}
 
@@ -56,7 +58,11 @@ corso export groups my-exports --backup 1234abcd-12ab-cd34-56de-1234abcd \
 corso export groups my-exports --backup 1234abcd-12ab-cd34-56de-1234abcd --conversation "hello world"
 
 # Export post with ID 98765abcdef from a conversation from group mailbox's last backup to /my-exports
-corso export groups my-exports --backup 1234abcd-12ab-cd34-56de-1234abcd --conversation "hello world" --post 98765abcdef`
+corso export groups my-exports --backup 1234abcd-12ab-cd34-56de-1234abcd --conversation "hello world" --post 98765abcdef
+
+# Export all messages in channel "Finance Reports" as html transcripts to /my-exports
+corso export groups my-exports --backup 1234abcd-12ab-cd34-56de-1234abcd \
+    --channel "Finance Reports" --format html`
 )
 
 // `corso export groups [<flag>...] <destination>`
@@ -101,6 +107,7 @@ func exportGroupsCmd(cmd *cobra.Command, args []string) error {
 	acceptedGroupsFormatTypes := []string{
 		string(control.DefaultFormat),
 		string(control.JSONFormat),
+		string(control.HTMLFormat),
 	}
 
 	return runExport(
@@ -110,6 +117,7 @@ func exportGroupsCmd(cmd *cobra.Command, args []string) error {
 		opts.ExportCfg,
 		sel.Selector,
 		flags.BackupIDFV,
+		opts.AsOf,
 		"Groups",
 		acceptedGroupsFormatTypes)
 }
@@ -18,9 +18,11 @@ func addOneDriveCommands(cmd *cobra.Command) *cobra.Command {
 
 		c.Use = c.Use + " " + oneDriveServiceCommandUseSuffix
 
-		flags.AddBackupIDFlag(c, true)
+		flags.AddBackupIDFlag(c, false)
+		flags.AddAsOfFlag(c)
 		flags.AddOneDriveDetailsAndRestoreFlags(c)
 		flags.AddExportConfigFlags(c)
+		flags.AddItemVersionFlag(c)
 		flags.AddFailFastFlag(c)
 	}
 
@@ -39,9 +41,23 @@ corso export onedrive my-exports --backup 1234abcd-12ab-cd34-56de-1234abcd --fil
 corso export onedrive . --backup 1234abcd-12ab-cd34-56de-1234abcd \
     --file "FY2021 Planning.xlsx" --folder "Documents/Finance Reports"
 
+# Export the "Documents/Finance Reports" folder as it was on the first of March,
+# using the newest backup of each user created before that time
+corso export onedrive . --as-of 2024-03-01T00:00:00 --folder "Documents/Finance Reports"
+
 # Export all files and folders in folder "Documents/Finance Reports" that were created before 2020 to /my-exports
 corso export onedrive my-exports --backup 1234abcd-12ab-cd34-56de-1234abcd \
-    --folder "Documents/Finance Reports" --file-created-before 2020-01-01T00:00:00`
+    --folder "Documents/Finance Reports" --file-created-before 2020-01-01T00:00:00
+
+# Export all files as a zstd compressed tar archive, split into 50GB parts, to /my-exports
+corso export onedrive my-exports --backup 1234abcd-12ab-cd34-56de-1234abcd \
+    --archive --archive-format tar.zst --archive-volume-size 50GB
+
+# Resume an interrupted export of all files to /my-exports
+corso export onedrive my-exports --backup 1234abcd-12ab-cd34-56de-1234abcd --resume
+
+# Export all files to the "exports" prefix of an s3 bucket
+corso export onedrive s3://my-bucket/exports --backup 1234abcd-12ab-cd34-56de-1234abcd`
 )
 
 // `corso export onedrive [<flag>...] <destination>`
@@ -89,6 +105,7 @@ func exportOneDriveCmd(cmd *cobra.Command, args []string) error {
 		opts.ExportCfg,
 		sel.Selector,
 		flags.BackupIDFV,
+		opts.AsOf,
 		"OneDrive",
 		defaultAcceptedFormatTypes)
 }
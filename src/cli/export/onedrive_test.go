@@ -61,6 +61,7 @@ func (suite *OneDriveUnitSuite) TestAddOneDriveCommands() {
 						"--" + flags.FileModifiedBeforeFN, flagsTD.FileModifiedBeforeInput,
 
 						"--" + flags.FormatFN, flagsTD.FormatType,
+						"--" + flags.ItemVersionFN, "2.0",
 
 						// bool flags
 						"--" + flags.ArchiveFN,
@@ -85,6 +86,7 @@ func (suite *OneDriveUnitSuite) TestAddOneDriveCommands() {
 			assert.Equal(t, flagsTD.FileCreatedBeforeInput, opts.FileCreatedBefore)
 			assert.Equal(t, flagsTD.FileModifiedAfterInput, opts.FileModifiedAfter)
 			assert.Equal(t, flagsTD.FileModifiedBeforeInput, opts.FileModifiedBefore)
+			assert.Equal(t, "2.0", opts.ExportCfg.ItemVersion)
 			assert.Equal(t, flagsTD.CorsoPassphrase, flags.PassphraseFV)
 			flagsTD.AssertStorageFlags(t, cmd)
 		})
@@ -6,6 +6,7 @@ import (
 
 	"github.com/alcionai/corso/src/cli/flags"
 	"github.com/alcionai/corso/src/cli/utils"
+	"github.com/alcionai/corso/src/pkg/control"
 )
 
 // called by export.go to map subcommands to provider-specific handling.
@@ -18,9 +19,11 @@ func addSharePointCommands(cmd *cobra.Command) *cobra.Command {
 
 		c.Use = c.Use + " " + sharePointServiceCommandUseSuffix
 
-		flags.AddBackupIDFlag(c, true)
+		flags.AddBackupIDFlag(c, false)
+		flags.AddAsOfFlag(c)
 		flags.AddSharePointDetailsAndRestoreFlags(c)
 		flags.AddExportConfigFlags(c)
+		flags.AddItemVersionFlag(c)
 		flags.AddFailFastFlag(c)
 	}
 
@@ -51,6 +54,10 @@ corso export sharepoint --backup 1234abcd-12ab-cd34-56de-1234abcd \
 corso export sharepoint --backup 1234abcd-12ab-cd34-56de-1234abcd \
     --list "list-name-1,list-name-2" .
 
+# Export lists as csv files, one row per list item
+corso export sharepoint --backup 1234abcd-12ab-cd34-56de-1234abcd \
+    --list "list-name-1" --format csv .
+
 # Export lists created after a given time
 corso export sharepoint --backup 1234abcd-12ab-cd34-56de-1234abcd \
     --list-created-after 2024-01-01T12:23:34 .
@@ -106,6 +113,11 @@ func exportSharePointCmd(cmd *cobra.Command, args []string) error {
 	sel := utils.IncludeSharePointRestoreDataSelectors(ctx, opts)
 	utils.FilterSharePointRestoreInfoSelectors(sel, opts)
 
+	acceptedSharePointFormatTypes := []string{
+		string(control.DefaultFormat),
+		string(control.CSVFormat),
+	}
+
 	return runExport(
 		ctx,
 		cmd,
@@ -113,6 +125,7 @@ func exportSharePointCmd(cmd *cobra.Command, args []string) error {
 		opts.ExportCfg,
 		sel.Selector,
 		flags.BackupIDFV,
+		opts.AsOf,
 		"SharePoint",
-		defaultAcceptedFormatTypes)
+		acceptedSharePointFormatTypes)
 }
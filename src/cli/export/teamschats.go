@@ -0,0 +1,110 @@
+package export
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/alcionai/corso/src/cli/flags"
+	"github.com/alcionai/corso/src/cli/utils"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/selectors"
+)
+
+// called by export.go to map subcommands to provider-specific handling.
+func addTeamsChatsCommands(cmd *cobra.Command) *cobra.Command {
+	var c *cobra.Command
+
+	switch cmd.Use {
+	case exportCommand:
+		c, _ = utils.AddCommand(cmd, teamschatsExportCmd(), utils.MarkPreReleaseCommand())
+
+		c.Use = c.Use + " " + teamschatsServiceCommandUseSuffix
+
+		flags.AddBackupIDFlag(c, false)
+		flags.AddAsOfFlag(c)
+		flags.AddTeamsChatsDetailsAndRestoreFlags(c)
+		flags.AddExportConfigFlags(c)
+		flags.AddFailFastFlag(c)
+	}
+
+	return c
+}
+
+const (
+	teamschatsServiceCommand          = "chats"
+	teamschatsServiceCommandUseSuffix = "<destination> --backup <backupId>"
+
+	//nolint:lll
+	teamschatsServiceCommandExportExamples = `# Export all of Bob's chats from his last backup (1234abcd...) to /my-exports
+corso export chats my-exports --backup 1234abcd-12ab-cd34-56de-1234abcd
+
+# Export the chat named "Quarterly planning" to the current directory
+corso export chats . --backup 1234abcd-12ab-cd34-56de-1234abcd --chat "Quarterly planning"
+
+# Export all chats that include alice@company.hr to /my-exports
+corso export chats my-exports --backup 1234abcd-12ab-cd34-56de-1234abcd --chat-member alice@company.hr
+
+# Export all chats created before 2020 with messages sent after 2023 to /my-exports
+corso export chats my-exports --backup 1234abcd-12ab-cd34-56de-1234abcd \
+    --chat-created-before 2020-01-01T00:00:00 --chat-last-message-after 2023-01-01T00:00:00
+
+# Export only the messages sent during 2023 from each of Bob's chats to /my-exports
+corso export chats my-exports --backup 1234abcd-12ab-cd34-56de-1234abcd \
+    --message-created-after 2023-01-01T00:00:00 --message-created-before 2024-01-01T00:00:00`
+)
+
+// `corso export chats [<flag>...] <destination>`
+func teamschatsExportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   teamschatsServiceCommand,
+		Short: "Export M365 Chats data",
+		RunE:  exportTeamsChatsCmd,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("missing export destination")
+			}
+
+			return nil
+		},
+		Example: teamschatsServiceCommandExportExamples,
+	}
+}
+
+// processes a teamschats export.
+func exportTeamsChatsCmd(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if utils.HasNoFlagsAndShownHelp(cmd) {
+		return nil
+	}
+
+	opts := utils.MakeTeamsChatsOpts(cmd)
+
+	if flags.RunModeFV == flags.RunModeFlagTest {
+		return nil
+	}
+
+	if err := utils.ValidateTeamsChatsRestoreFlags(flags.BackupIDFV, opts, false); err != nil {
+		return err
+	}
+
+	sel := utils.IncludeTeamsChatsRestoreDataSelectors(ctx, opts)
+	sel.Configure(selectors.Config{OnlyMatchItemNames: true})
+	utils.FilterTeamsChatsRestoreInfoSelectors(sel, opts)
+
+	acceptedTeamsChatsFormatTypes := []string{
+		string(control.DefaultFormat),
+		string(control.JSONFormat),
+	}
+
+	return runExport(
+		ctx,
+		cmd,
+		args,
+		opts.ExportCfg,
+		sel.Selector,
+		flags.BackupIDFV,
+		opts.AsOf,
+		"Chats",
+		acceptedTeamsChatsFormatTypes)
+}
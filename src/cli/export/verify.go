@@ -0,0 +1,91 @@
+package export
+
+import (
+	"github.com/alcionai/clues"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	. "github.com/alcionai/corso/src/cli/print"
+	"github.com/alcionai/corso/src/cli/utils"
+	"github.com/alcionai/corso/src/pkg/export"
+)
+
+// called by export.go to map subcommands to provider-specific handling.
+func addVerifyCommands(cmd *cobra.Command) *cobra.Command {
+	var c *cobra.Command
+
+	switch cmd.Use {
+	case exportCommand:
+		c, _ = utils.AddCommand(cmd, verifyExportCmd())
+
+		c.Use = c.Use + " " + verifyCommandUseSuffix
+	}
+
+	return c
+}
+
+const (
+	verifyCommand          = "verify"
+	verifyCommandUseSuffix = "<directory>"
+
+	verifyCommandExamples = `# Verify the files in /my-exports against the export manifest
+corso export verify /my-exports`
+)
+
+// `corso export verify <directory>`
+func verifyExportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   verifyCommand,
+		Short: "Verify exported data against its manifest",
+		Long: `Re-hash the files in an export directory and compare them against the
+manifests produced by the export.  Archived exports must be extracted first.`,
+		RunE: verifyExportDirCmd,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("missing export directory")
+			}
+
+			return nil
+		},
+		Example: verifyCommandExamples,
+	}
+}
+
+// verifies the files in a local export directory.
+func verifyExportDirCmd(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	results, err := export.VerifyManifest(ctx, args[0])
+	if err != nil {
+		return Only(ctx, clues.Wrap(err, "Failed to verify export"))
+	}
+
+	for _, m := range results.UnsignedManifests {
+		Errf(ctx, "Missing manifest checksum file %s", m+export.ChecksumFileSuffix)
+	}
+
+	for _, m := range results.ModifiedManifests {
+		Errf(ctx, "Manifest %s does not match its checksum", m)
+	}
+
+	for _, p := range results.Missing {
+		Errf(ctx, "Missing: %s", p)
+	}
+
+	for _, p := range results.Mismatched {
+		Errf(ctx, "Mismatched: %s", p)
+	}
+
+	Infof(
+		ctx,
+		"%d files verified, %d missing, %d mismatched",
+		results.Verified,
+		len(results.Missing),
+		len(results.Mismatched))
+
+	if !results.OK() {
+		return Only(ctx, clues.New("Export failed verification"))
+	}
+
+	return nil
+}
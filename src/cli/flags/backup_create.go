@@ -1,11 +1,53 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 )
 
+const (
+	ResourceParallelismFN = "resource-parallelism"
+	VersionsCountFN       = "versions-count"
+	VersionsMaxAgeFN      = "versions-max-age"
+)
+
+var (
+	ResourceParallelismFV int
+	VersionsCountFV       int
+	VersionsMaxAgeFV      time.Duration
+)
+
 func AddGenericBackupFlags(cmd *cobra.Command) {
 	AddFailFastFlag(cmd)
 	AddDisableIncrementalsFlag(cmd)
 	AddForceItemDataDownloadFlag(cmd)
+	AddResourceParallelismFlag(cmd)
+}
+
+// AddResourceParallelismFlag adds the flag that controls how many
+// protected resources get backed up at once.
+func AddResourceParallelismFlag(cmd *cobra.Command) {
+	fs := cmd.Flags()
+	fs.IntVar(
+		&ResourceParallelismFV,
+		ResourceParallelismFN,
+		1,
+		"Number of protected resources to back up at once")
+}
+
+// AddDriveItemVersionsFlags adds the flags that back up the previous
+// versions of drive files.
+func AddDriveItemVersionsFlags(cmd *cobra.Command) {
+	fs := cmd.Flags()
+	fs.IntVar(
+		&VersionsCountFV,
+		VersionsCountFN,
+		0,
+		"Backs up at most this many previous versions of each file")
+	fs.DurationVar(
+		&VersionsMaxAgeFV,
+		VersionsMaxAgeFN,
+		0,
+		"Backs up the previous versions of each file modified within this duration (ex: 720h)")
 }
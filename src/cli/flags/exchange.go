@@ -23,6 +23,15 @@ const (
 	EventStartsAfterFN  = "event-starts-after"
 	EventStartsBeforeFN = "event-starts-before"
 	EventSubjectFN      = "event-subject"
+
+	TaskFN      = "task"
+	TaskListFN  = "task-list"
+	TaskTitleFN = "task-title"
+
+	InboxRuleFN      = "inbox-rule"
+	MailboxSettingFN = "mailbox-setting"
+
+	FromFilesFN = "from-files"
 )
 
 // flag values (ie: FV)
@@ -45,8 +54,27 @@ var (
 	EventStartsAfterFV  string
 	EventStartsBeforeFV string
 	EventSubjectFV      string
+
+	TaskFV      []string
+	TaskListFV  []string
+	TaskTitleFV string
+
+	InboxRuleFV      []string
+	MailboxSettingFV []string
+
+	FromFilesFV string
 )
 
+// AddFromFilesFlag adds the flag for restoring a directory of
+// .eml, .ics, and .vcf files instead of a backup.
+func AddFromFilesFlag(cmd *cobra.Command) {
+	fs := cmd.Flags()
+	fs.StringVar(
+		&FromFilesFV,
+		FromFilesFN, "",
+		"Restores the .eml, .ics, and .vcf files in this directory instead of a backup; requires --"+ToResourceFN)
+}
+
 // AddExchangeDetailsAndRestoreFlags adds flags that are common to both the
 // details and restore commands.
 func AddExchangeDetailsAndRestoreFlags(cmd *cobra.Command, emailOnly bool) {
@@ -127,4 +155,29 @@ func AddExchangeDetailsAndRestoreFlags(cmd *cobra.Command, emailOnly bool) {
 		&ContactNameFV,
 		ContactNameFN, "",
 		"Select contacts whose contact name contains this value.")
+
+	// task flags
+	fs.StringSliceVar(
+		&TaskFV,
+		TaskFN, nil,
+		"Select tasks by task ID; accepts '"+Wildcard+"' to select all tasks.")
+	fs.StringSliceVar(
+		&TaskListFV,
+		TaskListFN, nil,
+		"Select tasks within a task list; accepts '"+Wildcard+"' to select all task lists.")
+	fs.StringVar(
+		&TaskTitleFV,
+		TaskTitleFN, "",
+		"Select tasks with a title containing this value.")
+
+	// mailbox settings flags
+	fs.StringSliceVar(
+		&InboxRuleFV,
+		InboxRuleFN, nil,
+		"Select inbox rules by rule name or ID; accepts '"+Wildcard+"' to select all rules.")
+	fs.StringSliceVar(
+		&MailboxSettingFV,
+		MailboxSettingFN, nil,
+		"Select mailbox settings by name (automaticReplies, workingHours, timeZone, categories); "+
+			"accepts '"+Wildcard+"' to select all settings.")
 }
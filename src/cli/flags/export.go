@@ -5,19 +5,65 @@ import (
 )
 
 const (
-	ArchiveFN = "archive"
-	FormatFN  = "format"
+	ArchiveFN           = "archive"
+	ArchiveFormatFN     = "archive-format"
+	ArchiveVolumeSizeFN = "archive-volume-size"
+	FormatFN            = "format"
+	ResumeFN            = "resume"
+
+	ExportEndpointFN               = "export-endpoint"
+	ExportDisableTLSFN             = "export-disable-tls"
+	ExportDisableTLSVerificationFN = "export-disable-tls-verification"
 )
 
 var (
-	ArchiveFV bool
-	FormatFV  string
+	ArchiveFV           bool
+	ArchiveFormatFV     string
+	ArchiveVolumeSizeFV string
+	FormatFV            string
+	ResumeFV            bool
+
+	ExportEndpointFV               string
+	ExportDisableTLSFV             bool
+	ExportDisableTLSVerificationFV bool
 )
 
 // AddExportConfigFlags adds the restore config flag set.
 func AddExportConfigFlags(cmd *cobra.Command) {
 	fs := cmd.Flags()
 	fs.BoolVar(&ArchiveFV, ArchiveFN, false, "Export data as an archive instead of individual files")
+	fs.StringVar(
+		&ArchiveFormatFV,
+		ArchiveFormatFN,
+		"",
+		"Archive format to use with --archive: zip (default), tar, or tar.zst")
+	fs.StringVar(
+		&ArchiveVolumeSizeFV,
+		ArchiveVolumeSizeFN,
+		"",
+		"Split the archive into numbered parts of at most this size (ex: 10GB)")
 	fs.StringVar(&FormatFV, FormatFN, "", "Specify the export file format")
 	cobra.CheckErr(fs.MarkHidden(FormatFN))
+	fs.BoolVar(
+		&ResumeFV,
+		ResumeFN,
+		false,
+		"Resume an interrupted export, skipping the items it already wrote to the destination")
+
+	// flags for exporting to an s3 destination (ex: s3://bucket/prefix)
+	fs.StringVar(
+		&ExportEndpointFV,
+		ExportEndpointFN,
+		"",
+		"S3 service endpoint for exports to an s3:// destination")
+	fs.BoolVar(
+		&ExportDisableTLSFV,
+		ExportDisableTLSFN,
+		false,
+		"Disable TLS (HTTPS) when exporting to an s3:// destination")
+	fs.BoolVar(
+		&ExportDisableTLSVerificationFV,
+		ExportDisableTLSVerificationFN,
+		false,
+		"Disable TLS (HTTPS) certificate verification when exporting to an s3:// destination")
 }
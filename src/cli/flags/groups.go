@@ -68,6 +68,14 @@ func AddGroupDetailsAndRestoreFlags(cmd *cobra.Command) {
 		MessageLastReplyBeforeFN, "",
 		"Select messages with replies before this datetime.")
 
+	AddGroupConversationFlags(cmd)
+}
+
+// AddGroupConversationFlags adds the flags for selecting conversations
+// and their posts.
+func AddGroupConversationFlags(cmd *cobra.Command) {
+	fs := cmd.Flags()
+
 	fs.StringSliceVar(
 		&ConversationFV,
 		ConversationFN, nil,
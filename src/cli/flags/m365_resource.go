@@ -15,6 +15,10 @@ const (
 	AzureOnBehalfOfRefreshTokenFN  = "azure-on-behalf-of-refresh-token"
 	AzureOnBehalfOfServiceIDFN     = "azure-on-behalf-of-service-id"
 	AzureOnBehalfOfServiceSecretFN = "azure-on-behalf-of-service-secret"
+
+	TargetAzureClientTenantFN = "target-azure-tenant-id"
+	TargetAzureClientIDFN     = "target-azure-client-id"
+	TargetAzureClientSecretFN = "target-azure-client-secret"
 )
 
 var (
@@ -25,6 +29,10 @@ var (
 	AzureOnBehalfOfRefreshTokenFV  string
 	AzureOnBehalfOfServiceIDFV     string
 	AzureOnBehalfOfServiceSecretFV string
+
+	TargetAzureClientTenantFV string
+	TargetAzureClientIDFV     string
+	TargetAzureClientSecretFV string
 )
 
 // AddUserFlag adds the --user flag.
@@ -63,3 +71,21 @@ func AddAzureCredsFlags(cmd *cobra.Command) {
 	fs.StringVar(&AzureOnBehalfOfServiceIDFV, AzureOnBehalfOfServiceIDFN, "", "Azure On-Behalf-Of Service ID")
 	fs.StringVar(&AzureOnBehalfOfServiceSecretFV, AzureOnBehalfOfServiceSecretFN, "", "Azure On-Behalf-Of Service Secret")
 }
+
+// AddTargetAzureCredsFlags adds the M365 cred flags of a separate target
+// tenant, which receives the data instead of the backed up tenant.
+func AddTargetAzureCredsFlags(cmd *cobra.Command) {
+	fs := cmd.Flags()
+	fs.StringVar(
+		&TargetAzureClientTenantFV,
+		TargetAzureClientTenantFN, "",
+		"Azure tenant ID of a separate tenant to restore into")
+	fs.StringVar(
+		&TargetAzureClientIDFV,
+		TargetAzureClientIDFN, "",
+		"Azure app client ID within the target tenant")
+	fs.StringVar(
+		&TargetAzureClientSecretFV,
+		TargetAzureClientSecretFN, "",
+		"Azure app client secret within the target tenant")
+}
@@ -4,6 +4,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	DataFiles = "files"
+)
+
 const (
 	FileFN   = "file"
 	FolderFN = "folder"
@@ -62,4 +66,6 @@ func AddOneDriveDetailsAndRestoreFlags(cmd *cobra.Command) {
 		&FileModifiedBeforeFV,
 		FileModifiedBeforeFN, "",
 		"Select files modified before this datetime.")
+
+	addNotebookDetailsAndRestoreFlags(cmd)
 }
@@ -0,0 +1,30 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+const (
+	NotebookFN     = "notebook"
+	NotebookPageFN = "notebook-page"
+)
+
+var (
+	NotebookFV     []string
+	NotebookPageFV []string
+)
+
+// addNotebookDetailsAndRestoreFlags adds the OneNote flags that are common
+// to the details and restore commands of OneDrive and SharePoint.
+func addNotebookDetailsAndRestoreFlags(cmd *cobra.Command) {
+	fs := cmd.Flags()
+
+	fs.StringSliceVar(
+		&NotebookFV,
+		NotebookFN, nil,
+		"Select OneNote pages by notebook, section group, and section path; accepts '"+Wildcard+"' to select all notebooks.")
+	fs.StringSliceVar(
+		&NotebookPageFV,
+		NotebookPageFN, nil,
+		"Select OneNote pages by title.")
+}
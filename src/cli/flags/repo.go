@@ -5,8 +5,10 @@ import (
 )
 
 const (
+	AsOfFN               = "as-of"
 	BackupFN             = "backup"
 	BackupIDsFN          = "backups"
+	DiffBackupFN         = "diff-backup"
 	AWSAccessKeyFN       = "aws-access-key"
 	AWSSecretAccessKeyFN = "aws-secret-access-key"
 	AWSSessionTokenFN    = "aws-session-token"
@@ -17,8 +19,10 @@ const (
 )
 
 var (
+	AsOfFV               string
 	BackupIDFV           string
 	BackupIDsFV          []string
+	DiffBackupFV         string
 	AWSAccessKeyFV       string
 	AWSSecretAccessKeyFV string
 	AWSSessionTokenFV    string
@@ -47,6 +51,22 @@ func AddBackupIDFlag(cmd *cobra.Command, require bool) {
 	}
 }
 
+// AddDiffBackupFlag adds the --diff-backup flag.
+func AddDiffBackupFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&DiffBackupFV,
+		DiffBackupFN, "",
+		"ID of an older backup to compare against, showing what changed since that backup.")
+}
+
+// AddAsOfFlag adds the --as-of flag.
+func AddAsOfFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&AsOfFV,
+		AsOfFN, "",
+		"Use the newest complete backups taken before this time, instead of a single backup.")
+}
+
 // ---------------------------------------------------------------------------
 // storage
 // ---------------------------------------------------------------------------
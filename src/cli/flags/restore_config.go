@@ -7,15 +7,35 @@ import (
 )
 
 const (
-	CollisionsFN  = "collisions"
-	DestinationFN = "destination"
-	ToResourceFN  = "to-resource"
+	AttendeeMapFN      = "attendee-map"
+	AttendeesFN        = "attendees"
+	CollisionsFN       = "collisions"
+	ConfirmMirrorFN    = "confirm-mirror"
+	DestinationFN      = "destination"
+	DryRunFN           = "dry-run"
+	ItemVersionFN      = "item-version"
+	MirrorQuarantineFN = "mirror-quarantine"
+	PrincipalMapFN     = "principal-map"
+	SuppressInvitesFN  = "suppress-invites"
+	ToResourceFN       = "to-resource"
+	ToSiteFN           = "to-site"
+	ToUserFN           = "to-user"
 )
 
 var (
-	CollisionsFV  string
-	DestinationFV string
-	ToResourceFV  string
+	AttendeeMapFV      string
+	AttendeesFV        string
+	CollisionsFV       string
+	ConfirmMirrorFV    bool
+	DestinationFV      string
+	DryRunFV           bool
+	ItemVersionFV      string
+	MirrorQuarantineFV string
+	PrincipalMapFV     string
+	SuppressInvitesFV  bool
+	ToResourceFV       string
+	ToSiteFV           string
+	ToUserFV           string
 )
 
 // AddRestoreConfigFlags adds the restore config flag set.
@@ -24,10 +44,13 @@ func AddRestoreConfigFlags(cmd *cobra.Command, canRestoreToAlternate bool) {
 	fs.StringVar(
 		&CollisionsFV, CollisionsFN, string(control.Skip),
 		//nolint:lll
-		"Sets the behavior for existing item collisions: "+string(control.Skip)+", "+string(control.Copy)+", or "+string(control.Replace))
+		"Sets the behavior for existing item collisions: "+string(control.Skip)+", "+string(control.Copy)+", "+string(control.Replace)+", or "+string(control.Mirror)+" (drive data only)")
 	fs.StringVar(
 		&DestinationFV, DestinationFN, "",
 		"Overrides the folder where items get restored; '/' places items into their original location")
+	fs.BoolVar(
+		&DryRunFV, DryRunFN, false,
+		"Reports how each item would get restored, including collisions, without writing any data")
 
 	if canRestoreToAlternate {
 		fs.StringVar(
@@ -35,3 +58,72 @@ func AddRestoreConfigFlags(cmd *cobra.Command, canRestoreToAlternate bool) {
 			"Overrides the protected resource (mailbox, site, user, etc) where data gets restored")
 	}
 }
+
+// AddPrincipalMapFlag adds the flag for restoring permissions to
+// different users and groups than the ones in the backup.
+func AddPrincipalMapFlag(cmd *cobra.Command) {
+	fs := cmd.Flags()
+	fs.StringVar(
+		&PrincipalMapFV, PrincipalMapFN, "",
+		"Path to a csv or yaml file mapping the ID or UPN of users and groups in the backup to the "+
+			"ones that receive their permissions and link shares on restore")
+}
+
+// AddEventAttendeeFlags adds the flags which control how restored
+// calendar events handle the attendees and rooms of the backed up event.
+func AddEventAttendeeFlags(cmd *cobra.Command) {
+	fs := cmd.Flags()
+	fs.StringVar(
+		&AttendeesFV, AttendeesFN, string(control.AttendeesInBody),
+		//nolint:lll
+		"Sets how restored events handle their attendees and rooms: "+string(control.AttendeesInBody)+" (listed in the event body), "+string(control.AttendeesStrip)+", or "+string(control.AttendeesRemap)+" (invited at the address in --"+AttendeeMapFN+")")
+	fs.StringVar(
+		&AttendeeMapFV, AttendeeMapFN, "",
+		"Path to a csv or yaml file mapping the email addresses of attendees and rooms in the backup to "+
+			"the addresses used by restored events")
+	fs.BoolVar(
+		&SuppressInvitesFV, SuppressInvitesFN, false,
+		"Keeps restored events from sending meeting invitations; remapped attendees get listed in the event body")
+}
+
+// AddItemVersionFlag adds the flag for picking which version of each
+// drive file gets restored or exported.
+func AddItemVersionFlag(cmd *cobra.Command) {
+	fs := cmd.Flags()
+	fs.StringVar(
+		&ItemVersionFV, ItemVersionFN, "",
+		"Uses this backed up version of each file instead of its current content, or '"+control.AllItemVersions+
+			"' to include every backed up version")
+}
+
+// AddMirrorFlags adds the flags used by the mirror collision policy.
+func AddMirrorFlags(cmd *cobra.Command) {
+	fs := cmd.Flags()
+	fs.BoolVar(
+		&ConfirmMirrorFV, ConfirmMirrorFN, false,
+		"Confirms that a mirror restore should remove the items in the restore folders "+
+			"which aren't in the backup")
+	fs.StringVar(
+		&MirrorQuarantineFV, MirrorQuarantineFN, "",
+		"Moves the items removed by a mirror restore into this folder, at the root of the drive, "+
+			"instead of deleting them")
+}
+
+// AddToSiteFlag adds the flag for restoring drive data into the
+// default document library of a site.
+func AddToSiteFlag(cmd *cobra.Command) {
+	fs := cmd.Flags()
+	fs.StringVar(
+		&ToSiteFV, ToSiteFN, "",
+		"Restores the data into the default document library of this site (ID or URL)")
+}
+
+// AddToUserFlag adds the flag for restoring drive data into the
+// OneDrive of a user.
+func AddToUserFlag(cmd *cobra.Command) {
+	fs := cmd.Flags()
+	fs.StringVar(
+		&ToUserFV, ToUserFN, "",
+		"Restores the document libraries into the OneDrive of this user (ID or UPN), "+
+			"within a folder for each library")
+}
@@ -8,6 +8,7 @@ const (
 	DataLibraries = "libraries"
 	DataPages     = "pages"
 	DataLists     = "lists"
+	DataNotebooks = "notebooks"
 )
 
 const (
@@ -112,6 +113,10 @@ func AddSharePointDetailsAndRestoreFlags(cmd *cobra.Command) {
 		PageFN, nil,
 		"Select pages by item name; accepts '"+Wildcard+"' to select all pages.")
 	cobra.CheckErr(fs.MarkHidden(PageFN))
+
+	// notebooks
+
+	addNotebookDetailsAndRestoreFlags(cmd)
 }
 
 // AddSiteIDFlag adds the --site-id flag, which accepts site ID values.
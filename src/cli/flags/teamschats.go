@@ -8,6 +8,66 @@ const (
 	DataChats = "chats"
 )
 
+const (
+	ChatFN       = "chat"
+	ChatMemberFN = "chat-member"
+
+	ChatCreatedAfterFN      = "chat-created-after"
+	ChatCreatedBeforeFN     = "chat-created-before"
+	ChatLastMessageAfterFN  = "chat-last-message-after"
+	ChatLastMessageBeforeFN = "chat-last-message-before"
+)
+
+var (
+	ChatFV       []string
+	ChatMemberFV string
+
+	ChatCreatedAfterFV      string
+	ChatCreatedBeforeFV     string
+	ChatLastMessageAfterFV  string
+	ChatLastMessageBeforeFV string
+)
+
 func AddTeamsChatsDetailsAndRestoreFlags(cmd *cobra.Command) {
-	// TODO: add details flags
+	fs := cmd.Flags()
+
+	fs.StringSliceVar(
+		&ChatFV,
+		ChatFN, nil,
+		"Select chats by name or reference; accepts '"+Wildcard+"' to select all chats.")
+
+	fs.StringVar(
+		&ChatMemberFV,
+		ChatMemberFN, "",
+		"Select chats that include this member.")
+
+	fs.StringVar(
+		&ChatCreatedAfterFV,
+		ChatCreatedAfterFN, "",
+		"Select chats created after this datetime.")
+
+	fs.StringVar(
+		&ChatCreatedBeforeFV,
+		ChatCreatedBeforeFN, "",
+		"Select chats created before this datetime.")
+
+	fs.StringVar(
+		&ChatLastMessageAfterFV,
+		ChatLastMessageAfterFN, "",
+		"Select chats with messages sent after this datetime.")
+
+	fs.StringVar(
+		&ChatLastMessageBeforeFV,
+		ChatLastMessageBeforeFN, "",
+		"Select chats whose last message was sent before this datetime.")
+
+	fs.StringVar(
+		&MessageCreatedAfterFV,
+		MessageCreatedAfterFN, "",
+		"Select chat messages created after this datetime.")
+
+	fs.StringVar(
+		&MessageCreatedBeforeFV,
+		MessageCreatedBeforeFN, "",
+		"Select chat messages created before this datetime.")
 }
@@ -19,6 +19,7 @@ var (
 	WebURLInput  = []string{"webURL1", "webURL2"}
 
 	ExchangeCategoryDataInput   = []string{"email", "events", "contacts"}
+	OneDriveCategoryDataInput   = []string{"files", "notebooks"}
 	SharepointCategoryDataInput = []string{"files", "lists", "pages"}
 	GroupsCategoryDataInput     = []string{"files", "lists", "pages", "messages"}
 	TeamsChatsCategoryDataInput = []string{"chats"}
@@ -30,6 +31,13 @@ var (
 	MessageLastReplyAfterInput  = "messageLastReplyAfter"
 	MessageLastReplyBeforeInput = "messageLastReplyBefore"
 
+	ChatInput                  = []string{"chat1", "chat2"}
+	ChatMemberInput            = "chatMember"
+	ChatCreatedAfterInput      = "chatCreatedAfter"
+	ChatCreatedBeforeInput     = "chatCreatedBefore"
+	ChatLastMessageAfterInput  = "chatLastMessageAfter"
+	ChatLastMessageBeforeInput = "chatLastMessageBefore"
+
 	ContactInput     = []string{"contact1", "contact2"}
 	ContactFldInput  = []string{"contactFld1", "contactFld2"}
 	ContactNameInput = "contactName"
@@ -91,7 +99,8 @@ var (
 
 	RestoreDestination = "test-restore-destination"
 
-	FetchParallelism = "3"
+	FetchParallelism    = "3"
+	ResourceParallelism = "3"
 
 	FailFast              = true
 	DisableIncrementals   = true
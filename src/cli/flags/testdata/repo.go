@@ -46,6 +46,7 @@ func PreparedGenericBackupFlags() []string {
 		"--" + flags.FailFastFN,
 		"--" + flags.DisableIncrementalsFN,
 		"--" + flags.ForceItemDataDownloadFN,
+		"--" + flags.ResourceParallelismFN, ResourceParallelism,
 	}
 }
 
@@ -53,4 +54,5 @@ func AssertGenericBackupFlags(t *testing.T, cmd *cobra.Command) {
 	assert.True(t, flags.FailFastFV, "fail fast flag")
 	assert.True(t, flags.DisableIncrementalsFV, "disable incrementals flag")
 	assert.True(t, flags.ForceItemDataDownloadFV, "force item data download flag")
+	assert.Equal(t, 3, flags.ResourceParallelismFV, "resource parallelism flag")
 }
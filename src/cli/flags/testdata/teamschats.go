@@ -4,22 +4,31 @@ import (
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alcionai/corso/src/cli/flags"
 )
 
 func PreparedTeamsChatsFlags() []string {
 	return []string{
-		// FIXME: populate when adding filters
-		// "--" + flags.ChatCreatedAfterFN, ChatCreatedAfterInput,
-		// "--" + flags.ChatCreatedBeforeFN, ChatCreatedBeforeInput,
-		// "--" + flags.ChatLastMessageAfterFN, ChatLastMessageAfterInput,
-		// "--" + flags.ChatLastMessageBeforeFN, ChatLastMessageBeforeInput,
+		"--" + flags.ChatFN, FlgInputs(ChatInput),
+		"--" + flags.ChatMemberFN, ChatMemberInput,
+		"--" + flags.ChatCreatedAfterFN, ChatCreatedAfterInput,
+		"--" + flags.ChatCreatedBeforeFN, ChatCreatedBeforeInput,
+		"--" + flags.ChatLastMessageAfterFN, ChatLastMessageAfterInput,
+		"--" + flags.ChatLastMessageBeforeFN, ChatLastMessageBeforeInput,
+		"--" + flags.MessageCreatedAfterFN, MessageCreatedAfterInput,
+		"--" + flags.MessageCreatedBeforeFN, MessageCreatedBeforeInput,
 	}
 }
 
 func AssertTeamsChatsFlags(t *testing.T, cmd *cobra.Command) {
-	// FIXME: populate when adding filters
-	// assert.Equal(t, ChatCreatedAfterInput, flags.ChatCreatedAfterFV)
-	// assert.Equal(t, ChatCreatedBeforeInput, flags.ChatCreatedBeforeFV)
-	// assert.Equal(t, ChatLastMessageAfterInput, flags.ChatLastMessageAfterFV)
-	// assert.Equal(t, ChatLastMessageBeforeInput, flags.ChatLastMessageBeforeFV)
+	assert.ElementsMatch(t, ChatInput, flags.ChatFV)
+	assert.Equal(t, ChatMemberInput, flags.ChatMemberFV)
+	assert.Equal(t, ChatCreatedAfterInput, flags.ChatCreatedAfterFV)
+	assert.Equal(t, ChatCreatedBeforeInput, flags.ChatCreatedBeforeFV)
+	assert.Equal(t, ChatLastMessageAfterInput, flags.ChatLastMessageAfterFV)
+	assert.Equal(t, ChatLastMessageBeforeInput, flags.ChatLastMessageBeforeFV)
+	assert.Equal(t, MessageCreatedAfterInput, flags.MessageCreatedAfterFV)
+	assert.Equal(t, MessageCreatedBeforeInput, flags.MessageCreatedBeforeFV)
 }
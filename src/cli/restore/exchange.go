@@ -5,6 +5,7 @@ import (
 
 	"github.com/alcionai/corso/src/cli/flags"
 	"github.com/alcionai/corso/src/cli/utils"
+	"github.com/alcionai/corso/src/pkg/selectors"
 )
 
 // called by restore.go to map subcommands to provider-specific handling.
@@ -17,9 +18,12 @@ func addExchangeCommands(cmd *cobra.Command) *cobra.Command {
 
 		c.Use = c.Use + " " + exchangeServiceCommandUseSuffix
 
-		flags.AddBackupIDFlag(c, true)
+		flags.AddBackupIDFlag(c, false)
+		flags.AddAsOfFlag(c)
 		flags.AddExchangeDetailsAndRestoreFlags(c, false)
 		flags.AddRestoreConfigFlags(c, true)
+		flags.AddEventAttendeeFlags(c)
+		flags.AddFromFilesFlag(c)
 		flags.AddFailFastFlag(c)
 	}
 
@@ -42,8 +46,24 @@ corso restore exchange --backup 1234abcd-12ab-cd34-56de-1234abcd \
 corso restore exchange --backup 1234abcd-12ab-cd34-56de-1234abcd \
     --event-calendar Calendar
 
+# Restore an entire calendar, rebinding its attendees to the addresses in a
+# mapping file, without sending any invitations
+corso restore exchange --backup 1234abcd-12ab-cd34-56de-1234abcd \
+    --event-calendar Calendar --attendees remap --attendee-map attendees.csv --suppress-invites
+
 # Restore the contact with ID abdef0101
-corso restore exchange --backup 1234abcd-12ab-cd34-56de-1234abcd --contact abdef0101`
+corso restore exchange --backup 1234abcd-12ab-cd34-56de-1234abcd --contact abdef0101
+
+# Reapply the inbox rule named "Forward invoices", replacing the current rule of the same name
+corso restore exchange --backup 1234abcd-12ab-cd34-56de-1234abcd \
+    --inbox-rule "Forward invoices" --collisions replace
+
+# Reapply the automatic replies and working hours from the backup
+corso restore exchange --backup 1234abcd-12ab-cd34-56de-1234abcd \
+    --mailbox-setting automaticReplies,workingHours --collisions replace
+
+# Restore a directory of exported .eml, .ics, and .vcf files into Bob's mailbox
+corso restore exchange --from-files /my-exports --to-resource bob@example.com`
 )
 
 // `corso restore exchange [<flag>...]`
@@ -78,11 +98,28 @@ func restoreExchangeCmd(cmd *cobra.Command, args []string) error {
 	sel := utils.IncludeExchangeRestoreDataSelectors(opts)
 	utils.FilterExchangeRestoreInfoSelectors(sel, opts)
 
+	// mailbox settings apply to the whole mailbox, so they only get
+	// restored when asked for by name.
+	if len(opts.InboxRule)+len(opts.MailboxSetting) == 0 {
+		sel.Exclude(sel.MailboxSettings(selectors.Any(), selectors.Any()))
+	}
+
+	if len(opts.FromFiles) > 0 {
+		return runFileRestore(
+			ctx,
+			cmd,
+			opts.RestoreCfg,
+			sel.Selector,
+			opts.FromFiles,
+			"Exchange")
+	}
+
 	return runRestore(
 		ctx,
 		cmd,
 		opts.RestoreCfg,
 		sel.Selector,
 		flags.BackupIDFV,
+		opts.AsOf,
 		"Exchange")
 }
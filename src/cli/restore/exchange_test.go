@@ -12,6 +12,7 @@ import (
 	cliTD "github.com/alcionai/corso/src/cli/testdata"
 	"github.com/alcionai/corso/src/cli/utils"
 	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/control"
 )
 
 type ExchangeUnitSuite struct {
@@ -70,7 +71,11 @@ func (suite *ExchangeUnitSuite) TestAddExchangeCommands() {
 						"--" + flags.EventSubjectFN, flagsTD.EventSubjectInput,
 						"--" + flags.CollisionsFN, flagsTD.Collisions,
 						"--" + flags.DestinationFN, flagsTD.Destination,
+						"--" + flags.DryRunFN,
 						"--" + flags.ToResourceFN, flagsTD.ToResource,
+						"--" + flags.AttendeesFN, "remap",
+						"--" + flags.AttendeeMapFN, "attendees.csv",
+						"--" + flags.SuppressInvitesFN,
 					},
 					flagsTD.PreparedProviderFlags(),
 					flagsTD.PreparedStorageFlags()))
@@ -104,7 +109,11 @@ func (suite *ExchangeUnitSuite) TestAddExchangeCommands() {
 			assert.Equal(t, flagsTD.EventSubjectInput, opts.EventSubject)
 			assert.Equal(t, flagsTD.Collisions, opts.RestoreCfg.Collisions)
 			assert.Equal(t, flagsTD.Destination, opts.RestoreCfg.Destination)
+			assert.True(t, opts.RestoreCfg.DryRun)
 			assert.Equal(t, flagsTD.ToResource, opts.RestoreCfg.ProtectedResource)
+			assert.Equal(t, string(control.AttendeesRemap), opts.RestoreCfg.Attendees)
+			assert.Equal(t, "attendees.csv", opts.RestoreCfg.AttendeeMap)
+			assert.True(t, opts.RestoreCfg.SuppressInvites)
 			flagsTD.AssertProviderFlags(t, cmd)
 			flagsTD.AssertStorageFlags(t, cmd)
 		})
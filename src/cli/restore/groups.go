@@ -6,7 +6,6 @@ import (
 	"github.com/alcionai/corso/src/cli/flags"
 	"github.com/alcionai/corso/src/cli/utils"
 	"github.com/alcionai/corso/src/pkg/dttm"
-	"github.com/alcionai/corso/src/pkg/selectors"
 )
 
 // called by restore.go to map subcommands to provider-specific handling.
@@ -19,12 +18,17 @@ func addGroupsCommands(cmd *cobra.Command) *cobra.Command {
 
 		c.Use = c.Use + " " + groupsServiceCommandUseSuffix
 
-		flags.AddBackupIDFlag(c, true)
+		flags.AddBackupIDFlag(c, false)
+		flags.AddAsOfFlag(c)
 		flags.AddSiteFlag(c, false)
 		flags.AddSiteIDFlag(c, false)
 		flags.AddNoPermissionsFlag(c)
+		flags.AddPrincipalMapFlag(c)
+		flags.AddMirrorFlags(c)
+		flags.AddItemVersionFlag(c)
 		flags.AddSharePointDetailsAndRestoreFlags(c)
-		flags.AddRestoreConfigFlags(c, false)
+		flags.AddGroupConversationFlags(c)
+		flags.AddRestoreConfigFlags(c, true)
 		flags.AddFailFastFlag(c)
 	}
 
@@ -47,7 +51,11 @@ corso restore groups --backup 1234abcd-12ab-cd34-56de-1234abcd --file "FY2021 Pl
 
 # Restore all files and folders in folder "Documents/Finance Reports" that were created before 2020
 corso restore groups --backup 1234abcd-12ab-cd34-56de-1234abcd \
-    --folder "Documents/Finance Reports" --file-created-before 2020-01-01T00:00:00`
+    --folder "Documents/Finance Reports" --file-created-before 2020-01-01T00:00:00
+
+# Restore all posts in the conversation "Quarterly Planning" into the Marketing group
+corso restore groups --backup 1234abcd-12ab-cd34-56de-1234abcd \
+    --conversation "Quarterly Planning" --to-resource marketing@example.com`
 )
 
 // `corso restore groups [<flag>...]`
@@ -84,15 +92,12 @@ func restoreGroupsCmd(cmd *cobra.Command, args []string) error {
 	sel := utils.IncludeGroupsRestoreDataSelectors(ctx, opts)
 	utils.FilterGroupsRestoreInfoSelectors(sel, opts)
 
-	// TODO(pandeyabs): Exclude conversations from restores since they are not
-	// supported yet.
-	sel.Exclude(sel.Conversation(selectors.Any()))
-
 	return runRestore(
 		ctx,
 		cmd,
 		opts.RestoreCfg,
 		sel.Selector,
 		flags.BackupIDFV,
+		opts.AsOf,
 		"Groups")
 }
@@ -65,7 +65,9 @@ func (suite *GroupsUnitSuite) TestAddGroupsCommands() {
 						"--" + flags.PageFolderFN, flagsTD.FlgInputs(flagsTD.PageFolderInput),
 						"--" + flags.CollisionsFN, flagsTD.Collisions,
 						"--" + flags.DestinationFN, flagsTD.Destination,
-						// "--" + flags.ToResourceFN, flagsTD.ToResource,
+						"--" + flags.ConversationFN, flagsTD.FlgInputs(flagsTD.ConversationInput),
+						"--" + flags.PostFN, flagsTD.FlgInputs(flagsTD.PostInput),
+						"--" + flags.ToResourceFN, flagsTD.ToResource,
 						"--" + flags.NoPermissionsFN,
 					},
 					flagsTD.PreparedProviderFlags(),
@@ -92,7 +94,9 @@ func (suite *GroupsUnitSuite) TestAddGroupsCommands() {
 			assert.Equal(t, flagsTD.Collisions, opts.RestoreCfg.Collisions)
 			assert.Equal(t, flagsTD.Destination, opts.RestoreCfg.Destination)
 			assert.ElementsMatch(t, flagsTD.ListsInput, opts.Lists)
-			// assert.Equal(t, flagsTD.ToResource, opts.RestoreCfg.ProtectedResource)
+			assert.ElementsMatch(t, flagsTD.ConversationInput, opts.Conversations)
+			assert.ElementsMatch(t, flagsTD.PostInput, opts.Posts)
+			assert.Equal(t, flagsTD.ToResource, opts.RestoreCfg.ProtectedResource)
 			assert.True(t, flags.NoPermissionsFV)
 			flagsTD.AssertProviderFlags(t, cmd)
 			flagsTD.AssertStorageFlags(t, cmd)
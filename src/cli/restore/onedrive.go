@@ -18,10 +18,15 @@ func addOneDriveCommands(cmd *cobra.Command) *cobra.Command {
 
 		c.Use = c.Use + " " + oneDriveServiceCommandUseSuffix
 
-		flags.AddBackupIDFlag(c, true)
+		flags.AddBackupIDFlag(c, false)
+		flags.AddAsOfFlag(c)
 		flags.AddOneDriveDetailsAndRestoreFlags(c)
 		flags.AddNoPermissionsFlag(c)
+		flags.AddPrincipalMapFlag(c)
+		flags.AddMirrorFlags(c)
+		flags.AddItemVersionFlag(c)
 		flags.AddRestoreConfigFlags(c, true)
+		flags.AddToSiteFlag(c)
 		flags.AddFailFastFlag(c)
 	}
 
@@ -44,7 +49,11 @@ corso restore onedrive --backup 1234abcd-12ab-cd34-56de-1234abcd \
 
 # Restore all files and folders in folder "Documents/Finance Reports" that were created before 2020
 corso restore onedrive --backup 1234abcd-12ab-cd34-56de-1234abcd \
-    --folder "Documents/Finance Reports" --file-created-before 2020-01-01T00:00:00`
+    --folder "Documents/Finance Reports" --file-created-before 2020-01-01T00:00:00
+
+# Restore all of Bob's files into the document library of the Finance site
+corso restore onedrive --backup 1234abcd-12ab-cd34-56de-1234abcd \
+    --to-site https://example.sharepoint.com/sites/finance`
 )
 
 // `corso restore onedrive [<flag>...]`
@@ -86,5 +95,6 @@ func restoreOneDriveCmd(cmd *cobra.Command, args []string) error {
 		opts.RestoreCfg,
 		sel.Selector,
 		flags.BackupIDFV,
+		opts.AsOf,
 		"OneDrive")
 }
@@ -12,6 +12,7 @@ import (
 	cliTD "github.com/alcionai/corso/src/cli/testdata"
 	"github.com/alcionai/corso/src/cli/utils"
 	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/control"
 )
 
 type OneDriveUnitSuite struct {
@@ -62,6 +63,11 @@ func (suite *OneDriveUnitSuite) TestAddOneDriveCommands() {
 						"--" + flags.DestinationFN, flagsTD.Destination,
 						"--" + flags.ToResourceFN, flagsTD.ToResource,
 						"--" + flags.NoPermissionsFN,
+						"--" + flags.PrincipalMapFN, "principal-map.csv",
+						"--" + flags.ConfirmMirrorFN,
+						"--" + flags.MirrorQuarantineFN, "quarantine",
+						"--" + flags.ToSiteFN, "site",
+						"--" + flags.ItemVersionFN, "all",
 					},
 					flagsTD.PreparedProviderFlags(),
 					flagsTD.PreparedStorageFlags()))
@@ -87,6 +93,11 @@ func (suite *OneDriveUnitSuite) TestAddOneDriveCommands() {
 			assert.Equal(t, flagsTD.Destination, opts.RestoreCfg.Destination)
 			assert.Equal(t, flagsTD.ToResource, opts.RestoreCfg.ProtectedResource)
 			assert.True(t, flags.NoPermissionsFV)
+			assert.Equal(t, "principal-map.csv", opts.RestoreCfg.PrincipalMap)
+			assert.True(t, opts.RestoreCfg.ConfirmMirror)
+			assert.Equal(t, "quarantine", opts.RestoreCfg.MirrorQuarantine)
+			assert.Equal(t, "site", opts.RestoreCfg.ToSite)
+			assert.Equal(t, control.AllItemVersions, opts.RestoreCfg.ItemVersion)
 			flagsTD.AssertProviderFlags(t, cmd)
 			flagsTD.AssertStorageFlags(t, cmd)
 		})
@@ -11,7 +11,11 @@ import (
 	. "github.com/alcionai/corso/src/cli/print"
 	"github.com/alcionai/corso/src/cli/utils"
 	"github.com/alcionai/corso/src/internal/data"
+	"github.com/alcionai/corso/src/internal/operations"
+	"github.com/alcionai/corso/src/pkg/control"
 	"github.com/alcionai/corso/src/pkg/count"
+	"github.com/alcionai/corso/src/pkg/repository"
+	"github.com/alcionai/corso/src/pkg/restoreplan"
 	"github.com/alcionai/corso/src/pkg/selectors"
 )
 
@@ -30,6 +34,7 @@ func AddCommands(cmd *cobra.Command) {
 	for _, addRestoreTo := range restoreCommands {
 		sc := addRestoreTo(subCommand)
 		flags.AddAllProviderFlags(sc)
+		flags.AddTargetAzureCredsFlags(sc)
 		flags.AddAllStorageFlags(sc)
 	}
 }
@@ -52,6 +57,12 @@ corso restore onedrive \
 	--folder '/work/corso_june_releases' \
 	--destination /recovered_june_releases
 
+# Restore a OneDrive folder as it was on the first of March, using the newest
+# backup of each user created before that time
+corso restore onedrive \
+	--as-of 2024-03-01T00:00:00 \
+	--folder '/work/corso_june_releases'
+
 # Restore a calendar event, making a copy if the event already exists.
 corso restore exchange \
 	--backup 1234abcd-12ab-cd34-56de-1234abcd \
@@ -64,7 +75,22 @@ corso restore sharepoint \
 	--backup 1234abcd-12ab-cd34-56de-1234abcd \
 	--library documents \
 	--destination '/' \
-	--collisions replace`
+	--collisions replace
+
+# Restore a user's OneDrive into the matching user of a separate tenant
+corso restore onedrive \
+	--backup 1234abcd-12ab-cd34-56de-1234abcd \
+	--to-resource 'adele@target.onmicrosoft.com' \
+	--target-azure-tenant-id 5678efab-56ef-ab78-90cd-5678efab \
+	--target-azure-client-id 9012abcd-90ab-cd12-34ef-9012abcd \
+	--target-azure-client-secret '<secret>'
+
+# Preview how a OneDrive folder would get restored, without writing any data
+corso restore onedrive \
+	--backup 1234abcd-12ab-cd34-56de-1234abcd \
+	--folder '/work/corso_june_releases' \
+	--collisions copy \
+	--dry-run`
 
 // The restore category of commands.
 // `corso restore [<subcommand>] [<flag>...]`
@@ -94,9 +120,10 @@ func runRestore(
 	cmd *cobra.Command,
 	urco utils.RestoreCfgOpts,
 	sel selectors.Selector,
-	backupID, serviceName string,
+	backupID, asOf, serviceName string,
 ) error {
-	if err := utils.ValidateRestoreConfigFlags(urco); err != nil {
+	restoreCfg, err := makeRestoreConfig(ctx, urco)
+	if err != nil {
 		return Only(ctx, err)
 	}
 
@@ -107,31 +134,175 @@ func runRestore(
 
 	defer utils.CloseRepo(ctx, r)
 
-	ro, err := r.NewRestore(ctx, backupID, sel, utils.MakeRestoreConfig(ctx, urco))
+	if err := utils.ConnectTargetAccount(ctx, r, sel.PathService()); err != nil {
+		return Only(ctx, err)
+	}
+
+	srcs, err := utils.ResolveBackupSources(ctx, r, sel, backupID, asOf)
+	if err != nil {
+		return Only(ctx, err)
+	}
+
+	for _, src := range srcs {
+		if err := restoreFrom(ctx, r, src, restoreCfg, serviceName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runFileRestore restores the files in sourceDir, instead of a backup.
+func runFileRestore(
+	ctx context.Context,
+	cmd *cobra.Command,
+	urco utils.RestoreCfgOpts,
+	sel selectors.Selector,
+	sourceDir, serviceName string,
+) error {
+	restoreCfg, err := makeRestoreConfig(ctx, urco)
+	if err != nil {
+		return Only(ctx, err)
+	}
+
+	r, _, err := utils.GetAccountAndConnect(ctx, cmd, sel.PathService())
+	if err != nil {
+		return Only(ctx, err)
+	}
+
+	defer utils.CloseRepo(ctx, r)
+
+	if err := utils.ConnectTargetAccount(ctx, r, sel.PathService()); err != nil {
+		return Only(ctx, err)
+	}
+
+	ro, err := r.NewFileRestore(ctx, sourceDir, sel, restoreCfg)
 	if err != nil {
 		return Only(ctx, clues.Wrap(err, "Failed to initialize "+serviceName+" restore"))
 	}
 
+	return runRestoreOperation(ctx, ro, serviceName)
+}
+
+// makeRestoreConfig validates the restore flags, and produces the
+// restore config along with the contents of its mapping files.
+func makeRestoreConfig(
+	ctx context.Context,
+	urco utils.RestoreCfgOpts,
+) (control.RestoreConfig, error) {
+	if err := utils.ValidateRestoreConfigFlags(urco); err != nil {
+		return control.RestoreConfig{}, err
+	}
+
+	principalMap, err := utils.ReadPrincipalMap(ctx, urco.PrincipalMap)
+	if err != nil {
+		return control.RestoreConfig{}, err
+	}
+
+	attendeeMap, err := utils.ReadAttendeeMap(ctx, urco.AttendeeMap)
+	if err != nil {
+		return control.RestoreConfig{}, err
+	}
+
+	restoreCfg := utils.MakeRestoreConfig(ctx, urco)
+	restoreCfg.PrincipalMap = principalMap
+	restoreCfg.AttendeeMap = attendeeMap
+
+	return restoreCfg, nil
+}
+
+// restoreFrom runs the restore of a single backup.
+func restoreFrom(
+	ctx context.Context,
+	r repository.Restorer,
+	src utils.BackupSource,
+	restoreCfg control.RestoreConfig,
+	serviceName string,
+) error {
+	ro, err := r.NewRestore(ctx, src.BackupID, src.Selector, restoreCfg)
+	if err != nil {
+		return Only(ctx, clues.Wrap(err, "Failed to initialize "+serviceName+" restore"))
+	}
+
+	return runRestoreOperation(ctx, ro, serviceName)
+}
+
+// runRestoreOperation runs the restore and prints its results.
+func runRestoreOperation(
+	ctx context.Context,
+	ro operations.RestoreOperation,
+	serviceName string,
+) error {
 	ds, err := ro.Run(ctx)
 	if err != nil {
+		// file restores have no backup, so report the directory instead.
+		if len(ro.SourceDir) > 0 {
+			return Only(ctx, clues.Wrap(err, "Failed to restore "+serviceName+" files from "+ro.SourceDir))
+		}
+
 		if errors.Is(err, data.ErrNotFound) {
-			return Only(ctx, clues.New("Backup or backup details missing for id "+flags.BackupIDFV))
+			return Only(ctx, clues.New("Backup or backup details missing for id "+string(ro.BackupID)))
 		}
 
 		return Only(ctx, clues.Wrap(err, "Failed to run "+serviceName+" restore"))
 	}
 
+	if ro.RestoreCfg.DryRun {
+		printRestorePlan(ctx, ro.Plan)
+		return nil
+	}
+
 	Info(ctx, "Restore Complete")
 
+	for _, alert := range ro.Errors.Alerts() {
+		Infof(ctx, "%s: %s %s", alert.String(), alert.Item.Name, alert.Item.ID)
+	}
+
 	skipped := ro.Counter.Get(count.CollisionSkip)
 	if skipped > 0 {
 		Infof(ctx, "Skipped %d items due to collision", skipped)
 	}
 
 	dis := ds.Items()
+	removed := ro.Counter.Get(count.MirrorDeleted) + ro.Counter.Get(count.MirrorQuarantined)
 
-	Outf(ctx, "Restored %d items", len(dis))
+	if removed > 0 {
+		Infof(ctx, "Removed %d items not in the backup", removed)
+	}
+
+	Outf(ctx, "Restored %d items", int64(len(dis))-removed)
 	dis.MaybePrintEntries(ctx)
 
 	return nil
 }
+
+// printRestorePlan prints the planned restore of each item, followed by
+// a count of the items planned for each action.
+func printRestorePlan(ctx context.Context, plan *restoreplan.Plan) {
+	var (
+		items  = plan.Items()
+		counts = plan.Counts()
+		ps     = make([]Printable, 0, len(items))
+	)
+
+	for _, item := range items {
+		ps = append(ps, item)
+	}
+
+	Info(ctx, "Restore Plan Complete")
+	All(ctx, ps...)
+
+	Infof(
+		ctx,
+		"Planned %d items: %d create, %d skip, %d copy-rename, %d replace",
+		len(items)-counts[restoreplan.Remove]-counts[restoreplan.Quarantine],
+		counts[restoreplan.Create],
+		counts[restoreplan.Skip],
+		counts[restoreplan.Copy],
+		counts[restoreplan.Replace])
+
+	removed := counts[restoreplan.Remove] + counts[restoreplan.Quarantine]
+	if removed > 0 {
+		Infof(ctx, "Planned to remove %d items not in the backup", removed)
+	}
+}
@@ -18,10 +18,15 @@ func addSharePointCommands(cmd *cobra.Command) *cobra.Command {
 
 		c.Use = c.Use + " " + sharePointServiceCommandUseSuffix
 
-		flags.AddBackupIDFlag(c, true)
+		flags.AddBackupIDFlag(c, false)
+		flags.AddAsOfFlag(c)
 		flags.AddSharePointDetailsAndRestoreFlags(c)
 		flags.AddNoPermissionsFlag(c)
+		flags.AddPrincipalMapFlag(c)
+		flags.AddMirrorFlags(c)
+		flags.AddItemVersionFlag(c)
 		flags.AddRestoreConfigFlags(c, true)
+		flags.AddToUserFlag(c)
 		flags.AddFailFastFlag(c)
 	}
 
@@ -70,7 +75,11 @@ corso restore sharepoint --backup 1234abcd-12ab-cd34-56de-1234abcd \
 
 # Restore lists modified after a given time
 corso restore sharepoint --backup 1234abcd-12ab-cd34-56de-1234abcd \
-    --list-modified-after 2024-01-01T12:23:34`
+    --list-modified-after 2024-01-01T12:23:34
+
+# Restore the "Documents" library into Bob's OneDrive
+corso restore sharepoint --backup 1234abcd-12ab-cd34-56de-1234abcd \
+    --library Documents --to-user bob@example.com`
 )
 
 // `corso restore sharepoint [<flag>...]`
@@ -112,5 +121,6 @@ func restoreSharePointCmd(cmd *cobra.Command, args []string) error {
 		opts.RestoreCfg,
 		sel.Selector,
 		flags.BackupIDFV,
+		opts.AsOf,
 		"SharePoint")
 }
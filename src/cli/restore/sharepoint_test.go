@@ -70,6 +70,7 @@ func (suite *SharePointUnitSuite) TestAddSharePointCommands() {
 						"--" + flags.DestinationFN, flagsTD.Destination,
 						"--" + flags.ToResourceFN, flagsTD.ToResource,
 						"--" + flags.NoPermissionsFN,
+						"--" + flags.ToUserFN, "user",
 					},
 					flagsTD.PreparedProviderFlags(),
 					flagsTD.PreparedStorageFlags()))
@@ -102,6 +103,7 @@ func (suite *SharePointUnitSuite) TestAddSharePointCommands() {
 			assert.Equal(t, flagsTD.Collisions, opts.RestoreCfg.Collisions)
 			assert.Equal(t, flagsTD.Destination, opts.RestoreCfg.Destination)
 			assert.Equal(t, flagsTD.ToResource, opts.RestoreCfg.ProtectedResource)
+			assert.Equal(t, "user", opts.RestoreCfg.ToUser)
 			assert.True(t, flags.NoPermissionsFV)
 			flagsTD.AssertProviderFlags(t, cmd)
 			flagsTD.AssertStorageFlags(t, cmd)
@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/alcionai/clues"
+
+	. "github.com/alcionai/corso/src/cli/print"
+	"github.com/alcionai/corso/src/pkg/dttm"
+	"github.com/alcionai/corso/src/pkg/repository"
+	"github.com/alcionai/corso/src/pkg/selectors"
+)
+
+// BackupSource is a backup read by a restore or export, along with
+// the selector used to read from it.
+type BackupSource struct {
+	BackupID string
+	Selector selectors.Selector
+}
+
+// ResolveBackupSources returns the backups read by a restore or export.
+// That's the backup with the provided ID or, given an as-of time, the
+// newest complete backup of each protected resource and category in the
+// selector that was created before that time.
+func ResolveBackupSources(
+	ctx context.Context,
+	bg repository.BackupGetter,
+	sel selectors.Selector,
+	backupID, asOf string,
+) ([]BackupSource, error) {
+	if len(asOf) == 0 {
+		return []BackupSource{{BackupID: backupID, Selector: sel}}, nil
+	}
+
+	t, err := dttm.ParseTime(asOf)
+	if err != nil {
+		return nil, clues.Wrap(err, "parsing as-of time")
+	}
+
+	bups, err := bg.BackupsAsOf(ctx, sel, t)
+	if err != nil {
+		return nil, clues.Wrap(err, "finding backups as of "+asOf)
+	}
+
+	srcs := make([]BackupSource, 0, len(bups))
+
+	for _, bup := range bups {
+		Infof(
+			ctx,
+			"Using backup %s of %s, created %s",
+			bup.Backup.ID,
+			bup.Selector.Name(),
+			dttm.FormatToHumanReadable(bup.Backup.CreationTime))
+
+		srcs = append(srcs, BackupSource{
+			BackupID: string(bup.Backup.ID),
+			Selector: bup.Selector,
+		})
+	}
+
+	return srcs, nil
+}
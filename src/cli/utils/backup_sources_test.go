@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alcionai/clues"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/model"
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/backup"
+	"github.com/alcionai/corso/src/pkg/dttm"
+	"github.com/alcionai/corso/src/pkg/repository"
+	"github.com/alcionai/corso/src/pkg/selectors"
+)
+
+type mockAsOfGetter struct {
+	repository.BackupGetter
+
+	asOf time.Time
+	bups []repository.BackupAsOf
+}
+
+func (mg *mockAsOfGetter) BackupsAsOf(
+	_ context.Context,
+	_ selectors.Selector,
+	asOf time.Time,
+) ([]repository.BackupAsOf, error) {
+	mg.asOf = asOf
+	return mg.bups, nil
+}
+
+type BackupSourcesUnitSuite struct {
+	tester.Suite
+}
+
+func TestBackupSourcesUnitSuite(t *testing.T) {
+	suite.Run(t, &BackupSourcesUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func (suite *BackupSourcesUnitSuite) TestResolveBackupSources() {
+	var (
+		asOf = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+		sel  = selectors.NewExchangeRestore([]string{"user"}).Selector
+		mail = selectors.NewExchangeRestore([]string{"user"})
+		cal  = selectors.NewExchangeRestore([]string{"user"})
+	)
+
+	mail.Include(mail.MailFolders(selectors.Any()))
+	cal.Include(cal.EventCalendars(selectors.Any()))
+
+	bups := []repository.BackupAsOf{
+		{
+			Backup: &backup.Backup{
+				BaseModel:    model.BaseModel{ID: "mail-bup"},
+				CreationTime: asOf.Add(-2 * time.Hour),
+			},
+			Selector: mail.Selector,
+		},
+		{
+			Backup: &backup.Backup{
+				BaseModel:    model.BaseModel{ID: "cal-bup"},
+				CreationTime: asOf.Add(-time.Hour),
+			},
+			Selector: cal.Selector,
+		},
+	}
+
+	table := []struct {
+		name      string
+		backupID  string
+		asOf      string
+		expect    []BackupSource
+		expectErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "backup id",
+			backupID: "bid",
+			expect: []BackupSource{
+				{BackupID: "bid", Selector: sel},
+			},
+			expectErr: assert.NoError,
+		},
+		{
+			name: "as of",
+			asOf: dttm.Format(asOf),
+			expect: []BackupSource{
+				{BackupID: "mail-bup", Selector: mail.Selector},
+				{BackupID: "cal-bup", Selector: cal.Selector},
+			},
+			expectErr: assert.NoError,
+		},
+		{
+			name:      "invalid as of",
+			asOf:      "fnords",
+			expectErr: assert.Error,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			mg := &mockAsOfGetter{bups: bups}
+
+			srcs, err := ResolveBackupSources(ctx, mg, sel, test.backupID, test.asOf)
+			test.expectErr(t, err, clues.ToCore(err))
+
+			if err != nil {
+				return
+			}
+
+			require.Len(t, srcs, len(test.expect))
+
+			for i, src := range srcs {
+				assert.Equal(t, test.expect[i].BackupID, src.BackupID)
+				assert.Equal(t, test.expect[i].Selector.Includes, src.Selector.Includes)
+			}
+
+			if len(test.asOf) > 0 {
+				assert.True(t, asOf.Equal(mg.asOf), "as-of time")
+			}
+		})
+	}
+}
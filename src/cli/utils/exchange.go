@@ -5,6 +5,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/alcionai/corso/src/cli/flags"
+	"github.com/alcionai/corso/src/pkg/control"
 	"github.com/alcionai/corso/src/pkg/selectors"
 )
 
@@ -30,6 +31,20 @@ type ExchangeOpts struct {
 	EventStartsBefore string
 	EventSubject      string
 
+	Task      []string
+	TaskList  []string
+	TaskTitle string
+
+	InboxRule      []string
+	MailboxSetting []string
+
+	// FromFiles is a directory of .eml, .ics, and .vcf
+	// files that gets restored instead of a backup.
+	FromFiles string
+
+	// AsOf picks the newest complete backups taken before
+	// this time, instead of a single backup.
+	AsOf       string
 	RestoreCfg RestoreCfgOpts
 	ExportCfg  ExportCfgOpts
 
@@ -60,6 +75,16 @@ func MakeExchangeOpts(cmd *cobra.Command) ExchangeOpts {
 		EventStartsBefore: flags.EventStartsBeforeFV,
 		EventSubject:      flags.EventSubjectFV,
 
+		Task:      flags.TaskFV,
+		TaskList:  flags.TaskListFV,
+		TaskTitle: flags.TaskTitleFV,
+
+		InboxRule:      flags.InboxRuleFV,
+		MailboxSetting: flags.MailboxSettingFV,
+
+		FromFiles: flags.FromFilesFV,
+
+		AsOf:       flags.AsOfFV,
 		RestoreCfg: makeRestoreCfgOpts(cmd),
 		ExportCfg:  makeExportCfgOpts(cmd),
 
@@ -115,10 +140,52 @@ func AddExchangeInfo(
 	sel.Filter(f(v))
 }
 
+// exchangeSelectionFlags are the flags which select the data
+// restored or exported from a backup.
+var exchangeSelectionFlags = []string{
+	flags.ContactFN,
+	flags.ContactFolderFN,
+	flags.ContactNameFN,
+	flags.EmailFN,
+	flags.EmailFolderFN,
+	flags.EmailReceivedAfterFN,
+	flags.EmailReceivedBeforeFN,
+	flags.EmailSenderFN,
+	flags.EmailSubjectFN,
+	flags.EventFN,
+	flags.EventCalendarFN,
+	flags.EventOrganizerFN,
+	flags.EventRecursFN,
+	flags.EventStartsAfterFN,
+	flags.EventStartsBeforeFN,
+	flags.EventSubjectFN,
+	flags.TaskFN,
+	flags.TaskListFN,
+	flags.TaskTitleFN,
+	flags.InboxRuleFN,
+	flags.MailboxSettingFN,
+}
+
 // ValidateExchangeRestoreFlags checks common flags for correctness and interdependencies
 func ValidateExchangeRestoreFlags(backupID string, opts ExchangeOpts) error {
-	if len(backupID) == 0 {
-		return clues.New("a backup ID is required")
+	if len(opts.FromFiles) > 0 {
+		if len(backupID) > 0 || len(opts.AsOf) > 0 {
+			return clues.New("--" + flags.FromFilesFN + " can't be used with a backup ID or an as-of time")
+		}
+
+		if len(opts.RestoreCfg.ProtectedResource) == 0 {
+			return clues.New("--" + flags.FromFilesFN + " requires --" + flags.ToResourceFN)
+		}
+
+		// every file in the directory gets restored, so the flags
+		// which select data from a backup don't apply.
+		for _, fn := range exchangeSelectionFlags {
+			if _, ok := opts.Populated[fn]; ok {
+				return clues.New("--" + flags.FromFilesFN + " can't be used with --" + fn)
+			}
+		}
+	} else if err := validateBackupSource(backupID, opts.AsOf); err != nil {
+		return err
 	}
 
 	if _, ok := opts.Populated[flags.EmailReceivedAfterFN]; ok && !IsValidTimeFormat(opts.EmailReceivedAfter) {
@@ -141,6 +208,10 @@ func ValidateExchangeRestoreFlags(backupID string, opts ExchangeOpts) error {
 		return clues.New("invalid format for event-recurs")
 	}
 
+	if control.CollisionPolicy(opts.RestoreCfg.Collisions) == control.Mirror {
+		return clues.New("the mirror collision policy is not supported for exchange restores")
+	}
+
 	return nil
 }
 
@@ -157,9 +228,17 @@ func IncludeExchangeRestoreDataSelectors(opts ExchangeOpts) *selectors.ExchangeR
 	lc, lcf := len(opts.Contact), len(opts.ContactFolder)
 	le, lef := len(opts.Email), len(opts.EmailFolder)
 	lev, lec := len(opts.Event), len(opts.EventCalendar)
+	lt, ltl := len(opts.Task), len(opts.TaskList)
+	lir, lms := len(opts.InboxRule), len(opts.MailboxSetting)
 	// either scope the request to a set of users
-	if lc+lcf+le+lef+lev+lec == 0 {
+	if lc+lcf+le+lef+lev+lec+lt+ltl+lir+lms == 0 {
 		sel.Include(sel.AllData())
+		// tasks and mailbox settings are opt-in at backup time, so AllData
+		// doesn't cover them.  If the backup holds any, they're still in
+		// scope here.
+		sel.Include(sel.TaskLists(selectors.Any()))
+		sel.Include(sel.MailboxSettings(selectors.Any(), selectors.Any()))
+
 		return sel
 	}
 
@@ -169,6 +248,15 @@ func IncludeExchangeRestoreDataSelectors(opts ExchangeOpts) *selectors.ExchangeR
 	AddExchangeInclude(sel, opts.ContactFolder, opts.Contact, sel.Contacts)
 	AddExchangeInclude(sel, opts.EmailFolder, opts.Email, sel.Mails)
 	AddExchangeInclude(sel, opts.EventCalendar, opts.Event, sel.Events)
+	AddExchangeInclude(sel, opts.TaskList, opts.Task, sel.Tasks)
+
+	if lir > 0 {
+		sel.Include(sel.InboxRules(opts.InboxRule))
+	}
+
+	if lms > 0 {
+		sel.Include(sel.MailboxConfig(opts.MailboxSetting))
+	}
 
 	return sel
 }
@@ -188,4 +276,5 @@ func FilterExchangeRestoreInfoSelectors(
 	AddExchangeInfo(sel, opts.EventStartsAfter, sel.EventStartsAfter)
 	AddExchangeInfo(sel, opts.EventStartsBefore, sel.EventStartsBefore)
 	AddExchangeInfo(sel, opts.EventSubject, sel.EventSubject)
+	AddExchangeInfo(sel, opts.TaskTitle, sel.TaskTitle)
 }
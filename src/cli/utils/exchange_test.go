@@ -40,6 +40,22 @@ func (suite *ExchangeUtilsSuite) TestValidateRestoreFlags() {
 			opts:   utils.ExchangeOpts{},
 			expect: assert.Error,
 		},
+		{
+			name:   "with as-of",
+			opts:   utils.ExchangeOpts{AsOf: dttm.Now()},
+			expect: assert.NoError,
+		},
+		{
+			name:     "backupid and as-of",
+			backupID: "bid",
+			opts:     utils.ExchangeOpts{AsOf: dttm.Now()},
+			expect:   assert.Error,
+		},
+		{
+			name:   "invalid as-of",
+			opts:   utils.ExchangeOpts{AsOf: "fnords"},
+			expect: assert.Error,
+		},
 		{
 			name:     "valid time",
 			backupID: "bid",
@@ -51,6 +67,48 @@ func (suite *ExchangeUtilsSuite) TestValidateRestoreFlags() {
 			opts:   utils.ExchangeOpts{EmailReceivedAfter: "fnords"},
 			expect: assert.Error,
 		},
+		{
+			name: "from files",
+			opts: utils.ExchangeOpts{
+				FromFiles:  "dir",
+				RestoreCfg: utils.RestoreCfgOpts{ProtectedResource: "rid"},
+			},
+			expect: assert.NoError,
+		},
+		{
+			name:   "from files without a protected resource",
+			opts:   utils.ExchangeOpts{FromFiles: "dir"},
+			expect: assert.Error,
+		},
+		{
+			name: "from files and a folder",
+			opts: utils.ExchangeOpts{
+				FromFiles:   "dir",
+				EmailFolder: []string{"Inbox"},
+				RestoreCfg:  utils.RestoreCfgOpts{ProtectedResource: "rid"},
+				Populated:   flags.PopulatedFlags{flags.EmailFolderFN: {}},
+			},
+			expect: assert.Error,
+		},
+		{
+			name: "from files and a filter",
+			opts: utils.ExchangeOpts{
+				FromFiles:    "dir",
+				EmailSubject: "subject",
+				RestoreCfg:   utils.RestoreCfgOpts{ProtectedResource: "rid"},
+				Populated:    flags.PopulatedFlags{flags.EmailSubjectFN: {}},
+			},
+			expect: assert.Error,
+		},
+		{
+			name:     "from files and backupid",
+			backupID: "bid",
+			opts: utils.ExchangeOpts{
+				FromFiles:  "dir",
+				RestoreCfg: utils.RestoreCfgOpts{ProtectedResource: "rid"},
+			},
+			expect: assert.Error,
+		},
 	}
 	for _, test := range table {
 		suite.Run(test.name, func() {
@@ -72,28 +130,28 @@ func (suite *ExchangeUtilsSuite) TestIncludeExchangeRestoreDataSelectors() {
 	}{
 		{
 			name:             "no selectors",
-			expectIncludeLen: 3,
+			expectIncludeLen: 5,
 		},
 		{
 			name: "any users",
 			opts: utils.ExchangeOpts{
 				Users: a,
 			},
-			expectIncludeLen: 3,
+			expectIncludeLen: 5,
 		},
 		{
 			name: "single user",
 			opts: utils.ExchangeOpts{
 				Users: stub,
 			},
-			expectIncludeLen: 3,
+			expectIncludeLen: 5,
 		},
 		{
 			name: "multiple users",
 			opts: utils.ExchangeOpts{
 				Users: many,
 			},
-			expectIncludeLen: 3,
+			expectIncludeLen: 5,
 		},
 		{
 			name: "any users, any data",
@@ -131,6 +189,32 @@ func (suite *ExchangeUtilsSuite) TestIncludeExchangeRestoreDataSelectors() {
 			},
 			expectIncludeLen: 3,
 		},
+		{
+			name: "single user, tasks",
+			opts: utils.ExchangeOpts{
+				Task:     stub,
+				TaskList: stub,
+				Users:    stub,
+			},
+			expectIncludeLen: 1,
+		},
+		{
+			name: "single user, inbox rules",
+			opts: utils.ExchangeOpts{
+				InboxRule: stub,
+				Users:     stub,
+			},
+			expectIncludeLen: 1,
+		},
+		{
+			name: "single user, inbox rules and mailbox settings",
+			opts: utils.ExchangeOpts{
+				InboxRule:      a,
+				MailboxSetting: many,
+				Users:          stub,
+			},
+			expectIncludeLen: 2,
+		},
 		{
 			name: "single user, single of each folder",
 			opts: utils.ExchangeOpts{
@@ -463,6 +547,13 @@ func (suite *ExchangeUtilsSuite) TestFilterExchangeRestoreInfoSelectors() {
 			},
 			expectFilterLen: 1,
 		},
+		{
+			name: "taskTitle",
+			opts: utils.ExchangeOpts{
+				TaskTitle: stub,
+			},
+			expectFilterLen: 1,
+		},
 		{
 			name: "one of each",
 			opts: utils.ExchangeOpts{
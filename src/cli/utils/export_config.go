@@ -5,30 +5,88 @@ import (
 	"strings"
 
 	"github.com/alcionai/clues"
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
 
 	"github.com/alcionai/corso/src/cli/flags"
 	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/credentials"
+	"github.com/alcionai/corso/src/pkg/dttm"
+	"github.com/alcionai/corso/src/pkg/export"
 	"github.com/alcionai/corso/src/pkg/filters"
+	"github.com/alcionai/corso/src/pkg/storage"
 )
 
+const s3DestinationScheme = "s3://"
+
+var acceptedArchiveFormatTypes = []string{
+	string(control.DefaultArchiveFormat),
+	string(control.ZipArchiveFormat),
+	string(control.TarArchiveFormat),
+	string(control.TarZstdArchiveFormat),
+}
+
 type ExportCfgOpts struct {
-	Archive bool
-	Format  string
+	Archive           bool
+	ArchiveFormat     string
+	ArchiveVolumeSize string
+	Format            string
+	// ItemVersion picks which version of each drive file gets exported.
+	ItemVersion string
+	// MessageCreatedAfter and MessageCreatedBefore bound the
+	// messages exported from each chat.
+	MessageCreatedAfter  string
+	MessageCreatedBefore string
+	Resume               bool
+
+	// s3 destination settings
+	Endpoint               string
+	DisableTLS             bool
+	DisableTLSVerification bool
+	// AWS holds the s3 credentials from the storage flags and env vars.
+	// Empty values fall back to the instance's IAM role.
+	AWS credentials.AWS
+
+	// archiveVolumeBytes is the parsed value of ArchiveVolumeSize,
+	// set during validation.
+	archiveVolumeBytes int64
 
 	Populated flags.PopulatedFlags
 }
 
 func makeExportCfgOpts(cmd *cobra.Command) ExportCfgOpts {
+	populated := flags.GetPopulatedFlags(cmd)
+
 	return ExportCfgOpts{
-		Archive: flags.ArchiveFV,
-		Format:  flags.FormatFV,
+		Archive:           flags.ArchiveFV,
+		ArchiveFormat:     flags.ArchiveFormatFV,
+		ArchiveVolumeSize: flags.ArchiveVolumeSizeFV,
+		Format:            flags.FormatFV,
+		ItemVersion:       flags.ItemVersionFV,
+		Resume:            flags.ResumeFV,
+
+		Endpoint:               flags.ExportEndpointFV,
+		DisableTLS:             flags.ExportDisableTLSFV,
+		DisableTLSVerification: flags.ExportDisableTLSVerificationFV,
+		AWS:                    exportAWSCreds(populated),
 
 		// populated contains the list of flags that appear in the
 		// command, according to pflags.  Use this to differentiate
 		// between an "empty" and a "missing" value.
-		Populated: flags.GetPopulatedFlags(cmd),
+		Populated: populated,
+	}
+}
+
+// exportAWSCreds produces the credentials used to write exports into s3.
+// The storage flags take precedence over the AWS env vars.
+func exportAWSCreds(populated flags.PopulatedFlags) credentials.AWS {
+	creds := credentials.GetAWSEnvs()
+
+	for k, v := range flags.PopulateS3Flags(populated) {
+		creds[k] = v
 	}
+
+	return credentials.GetAWS(creds)
 }
 
 func MakeExportConfig(
@@ -38,11 +96,69 @@ func MakeExportConfig(
 	exportCfg := control.DefaultExportConfig()
 
 	exportCfg.Archive = opts.Archive
+	exportCfg.ArchiveFormat = control.ArchiveFormatType(opts.ArchiveFormat)
+	exportCfg.ArchiveVolumeSize = opts.archiveVolumeBytes
 	exportCfg.Format = control.FormatType(opts.Format)
+	exportCfg.ItemVersion = opts.ItemVersion
+
+	if len(opts.MessageCreatedAfter) > 0 {
+		exportCfg.MessagesCreatedAfter, _ = dttm.ParseTime(opts.MessageCreatedAfter)
+	}
+
+	if len(opts.MessageCreatedBefore) > 0 {
+		exportCfg.MessagesCreatedBefore, _ = dttm.ParseTime(opts.MessageCreatedBefore)
+	}
 
 	return exportCfg
 }
 
+// MakeExportSink returns the sink to which exported data is written.
+// Destinations of the form s3://bucket/prefix are uploaded to the
+// object store, using the credentials in opts.AWS.  Anything else is
+// treated as a local directory.
+func MakeExportSink(dest string, opts ExportCfgOpts) (export.Sink, error) {
+	if !strings.HasPrefix(dest, s3DestinationScheme) {
+		return export.NewLocalSink(dest), nil
+	}
+
+	bucket, prefix, _ := strings.Cut(strings.TrimPrefix(dest, s3DestinationScheme), "/")
+
+	sink, err := export.NewS3Sink(storage.S3Config{
+		AWS:            opts.AWS,
+		Bucket:         bucket,
+		Prefix:         prefix,
+		Endpoint:       opts.Endpoint,
+		DoNotUseTLS:    opts.DisableTLS,
+		DoNotVerifyTLS: opts.DisableTLSVerification,
+	})
+
+	return sink, clues.Wrap(err, "creating s3 export destination").OrNil()
+}
+
+// MakeExportJournal opens the journal used to resume exports to a local
+// directory.  Exports to s3, and archived exports, are not journaled.
+func MakeExportJournal(
+	ctx context.Context,
+	dest string,
+	opts ExportCfgOpts,
+) (*export.Journal, error) {
+	if strings.HasPrefix(dest, s3DestinationScheme) {
+		if opts.Resume {
+			return nil, clues.New("--" + flags.ResumeFN + " can only be used with local destinations")
+		}
+
+		return nil, nil
+	}
+
+	if opts.Archive {
+		return nil, nil
+	}
+
+	j, err := export.OpenJournal(ctx, dest, opts.Resume)
+
+	return j, clues.Wrap(err, "opening export journal").OrNil()
+}
+
 // ValidateExportConfigFlags ensures all export config flags that utilize
 // enumerated values match a well-known value.
 func ValidateExportConfigFlags(opts *ExportCfgOpts, acceptedFormatTypes []string) error {
@@ -55,5 +171,35 @@ func ValidateExportConfigFlags(opts *ExportCfgOpts, acceptedFormatTypes []string
 
 	opts.Format = strings.ToLower(opts.Format)
 
+	if opts.Resume && opts.Archive {
+		return clues.New("--" + flags.ResumeFN + " cannot be used with --" + flags.ArchiveFN)
+	}
+
+	_, formatPopulated := opts.Populated[flags.ArchiveFormatFN]
+	_, sizePopulated := opts.Populated[flags.ArchiveVolumeSizeFN]
+
+	if (formatPopulated || sizePopulated) && !opts.Archive {
+		return clues.New("--" + flags.ArchiveFormatFN + " and --" + flags.ArchiveVolumeSizeFN +
+			" can only be used with --" + flags.ArchiveFN)
+	}
+
+	if !formatPopulated {
+		opts.ArchiveFormat = string(control.DefaultArchiveFormat)
+	} else if !filters.Equal(acceptedArchiveFormatTypes).Compare(opts.ArchiveFormat) {
+		return clues.New("unrecognized archive format: " + opts.ArchiveFormat)
+	}
+
+	opts.ArchiveFormat = strings.ToLower(opts.ArchiveFormat)
+	opts.archiveVolumeBytes = 0
+
+	if sizePopulated {
+		size, err := humanize.ParseBytes(opts.ArchiveVolumeSize)
+		if err != nil || size == 0 {
+			return clues.New("invalid archive volume size: " + opts.ArchiveVolumeSize)
+		}
+
+		opts.archiveVolumeBytes = int64(size)
+	}
+
 	return nil
 }
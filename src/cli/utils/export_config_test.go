@@ -10,6 +10,7 @@ import (
 	"github.com/alcionai/corso/src/cli/flags"
 	"github.com/alcionai/corso/src/internal/tester"
 	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/credentials"
 )
 
 type ExportCfgUnitSuite struct {
@@ -101,6 +102,23 @@ func (suite *ExportCfgUnitSuite) TestValidateExportConfigFlags() {
 			expectErr:    assert.NoError,
 			expectFormat: control.DefaultFormat,
 		},
+		{
+			name: "resume",
+			input: ExportCfgOpts{
+				Resume: true,
+			},
+			expectErr:    assert.NoError,
+			expectFormat: control.DefaultFormat,
+		},
+		{
+			name: "resume archive",
+			input: ExportCfgOpts{
+				Archive: true,
+				Resume:  true,
+			},
+			expectErr:    assert.Error,
+			expectFormat: control.DefaultFormat,
+		},
 	}
 	for _, test := range table {
 		suite.Run(test.name, func() {
@@ -113,3 +131,230 @@ func (suite *ExportCfgUnitSuite) TestValidateExportConfigFlags() {
 		})
 	}
 }
+
+func (suite *ExportCfgUnitSuite) TestValidateExportConfigFlags_archive() {
+	table := []struct {
+		name              string
+		input             ExportCfgOpts
+		expectErr         assert.ErrorAssertionFunc
+		expectFormat      control.ArchiveFormatType
+		expectVolumeBytes int64
+	}{
+		{
+			name:         "default",
+			input:        ExportCfgOpts{Archive: true},
+			expectErr:    assert.NoError,
+			expectFormat: control.DefaultArchiveFormat,
+		},
+		{
+			name: "tar.zst",
+			input: ExportCfgOpts{
+				Archive:       true,
+				ArchiveFormat: "TAR.ZST",
+				Populated:     flags.PopulatedFlags{flags.ArchiveFormatFN: {}},
+			},
+			expectErr:    assert.NoError,
+			expectFormat: control.TarZstdArchiveFormat,
+		},
+		{
+			name: "bad format",
+			input: ExportCfgOpts{
+				Archive:       true,
+				ArchiveFormat: "rar",
+				Populated:     flags.PopulatedFlags{flags.ArchiveFormatFN: {}},
+			},
+			expectErr: assert.Error,
+		},
+		{
+			name: "volume size",
+			input: ExportCfgOpts{
+				Archive:           true,
+				ArchiveVolumeSize: "10MB",
+				Populated:         flags.PopulatedFlags{flags.ArchiveVolumeSizeFN: {}},
+			},
+			expectErr:         assert.NoError,
+			expectFormat:      control.DefaultArchiveFormat,
+			expectVolumeBytes: 10 * 1000 * 1000,
+		},
+		{
+			name: "bad volume size",
+			input: ExportCfgOpts{
+				Archive:           true,
+				ArchiveVolumeSize: "lots",
+				Populated:         flags.PopulatedFlags{flags.ArchiveVolumeSizeFN: {}},
+			},
+			expectErr: assert.Error,
+		},
+		{
+			name: "zero volume size",
+			input: ExportCfgOpts{
+				Archive:           true,
+				ArchiveVolumeSize: "0",
+				Populated:         flags.PopulatedFlags{flags.ArchiveVolumeSizeFN: {}},
+			},
+			expectErr: assert.Error,
+		},
+		{
+			name: "archive format without archive",
+			input: ExportCfgOpts{
+				ArchiveFormat: "tar",
+				Populated:     flags.PopulatedFlags{flags.ArchiveFormatFN: {}},
+			},
+			expectErr: assert.Error,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			err := ValidateExportConfigFlags(&test.input, []string{string(control.DefaultFormat)})
+			test.expectErr(t, err, clues.ToCore(err))
+
+			if err != nil {
+				return
+			}
+
+			result := MakeExportConfig(ctx, test.input)
+			assert.Equal(t, test.expectFormat, result.ArchiveFormat)
+			assert.Equal(t, test.expectVolumeBytes, result.ArchiveVolumeSize)
+		})
+	}
+}
+
+func (suite *ExportCfgUnitSuite) TestMakeExportSink() {
+	table := []struct {
+		name      string
+		dest      string
+		expectErr assert.ErrorAssertionFunc
+		expectLoc string
+	}{
+		{
+			name:      "local directory",
+			dest:      "/tmp/export",
+			expectErr: assert.NoError,
+			expectLoc: "/tmp/export",
+		},
+		{
+			name:      "s3 bucket",
+			dest:      "s3://bucket",
+			expectErr: assert.NoError,
+			expectLoc: "s3://bucket/",
+		},
+		{
+			name:      "s3 bucket and prefix",
+			dest:      "s3://bucket/some/prefix",
+			expectErr: assert.NoError,
+			expectLoc: "s3://bucket/some/prefix/",
+		},
+		{
+			name:      "s3 missing bucket",
+			dest:      "s3:///prefix",
+			expectErr: assert.Error,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			sink, err := MakeExportSink(test.dest, ExportCfgOpts{Endpoint: "localhost:9000"})
+			test.expectErr(t, err, clues.ToCore(err))
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, test.expectLoc, sink.Location())
+		})
+	}
+}
+
+func (suite *ExportCfgUnitSuite) TestExportAWSCreds() {
+	t := suite.T()
+
+	t.Setenv(credentials.AWSAccessKeyID, "env-access")
+	t.Setenv(credentials.AWSSecretAccessKey, "env-secret")
+	t.Setenv(credentials.AWSSessionToken, "")
+
+	defer func() {
+		flags.AWSAccessKeyFV = ""
+	}()
+
+	creds := exportAWSCreds(flags.PopulatedFlags{})
+	assert.Equal(
+		t,
+		credentials.AWS{AccessKey: "env-access", SecretKey: "env-secret"},
+		creds,
+		"env vars")
+
+	flags.AWSAccessKeyFV = "flag-access"
+
+	creds = exportAWSCreds(flags.PopulatedFlags{flags.AWSAccessKeyFN: {}})
+	assert.Equal(
+		t,
+		credentials.AWS{AccessKey: "flag-access", SecretKey: "env-secret"},
+		creds,
+		"flags override env vars")
+}
+
+func (suite *ExportCfgUnitSuite) TestMakeExportJournal() {
+	table := []struct {
+		name          string
+		s3            bool
+		input         ExportCfgOpts
+		expectErr     assert.ErrorAssertionFunc
+		expectJournal assert.ValueAssertionFunc
+	}{
+		{
+			name:          "local",
+			expectErr:     assert.NoError,
+			expectJournal: assert.NotNil,
+		},
+		{
+			name:          "local resume",
+			input:         ExportCfgOpts{Resume: true},
+			expectErr:     assert.NoError,
+			expectJournal: assert.NotNil,
+		},
+		{
+			name:          "local archive",
+			input:         ExportCfgOpts{Archive: true},
+			expectErr:     assert.NoError,
+			expectJournal: assert.Nil,
+		},
+		{
+			name:          "s3",
+			s3:            true,
+			expectErr:     assert.NoError,
+			expectJournal: assert.Nil,
+		},
+		{
+			name:          "s3 resume",
+			s3:            true,
+			input:         ExportCfgOpts{Resume: true},
+			expectErr:     assert.Error,
+			expectJournal: assert.Nil,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			dest := t.TempDir()
+			if test.s3 {
+				dest = "s3://bucket/prefix"
+			}
+
+			j, err := MakeExportJournal(ctx, dest, test.input)
+			test.expectErr(t, err, clues.ToCore(err))
+			test.expectJournal(t, j)
+
+			assert.NoError(t, j.Close())
+		})
+	}
+}
@@ -26,6 +26,21 @@ func IsValidBool(in string) bool {
 	return err == nil
 }
 
+// validateBackupSource checks that exactly one of a backup ID or
+// an as-of time picks the backups to read from.
+func validateBackupSource(backupID, asOf string) error {
+	switch {
+	case len(backupID) == 0 && len(asOf) == 0:
+		return clues.New("a backup ID or an as-of time is required")
+	case len(backupID) > 0 && len(asOf) > 0:
+		return clues.New("only one of a backup ID or an as-of time can be provided")
+	case len(asOf) > 0 && !IsValidTimeFormat(asOf):
+		return clues.New("invalid time format for " + flags.AsOfFN)
+	}
+
+	return nil
+}
+
 // trimFolderSlash takes a set of folder paths and returns a set of folder paths
 // with any unescaped trailing `/` characters removed.
 func trimFolderSlash(folders []string) []string {
@@ -46,6 +46,30 @@ func (suite *FlagUnitSuite) TestAddAzureCredsFlags() {
 	require.NoError(t, err, clues.ToCore(err))
 }
 
+func (suite *FlagUnitSuite) TestAddTargetAzureCredsFlags() {
+	t := suite.T()
+
+	cmd := &cobra.Command{
+		Use: "test",
+		Run: func(cmd *cobra.Command, args []string) {
+			assert.Equal(t, "tenantID", flags.TargetAzureClientTenantFV, flags.TargetAzureClientTenantFN)
+			assert.Equal(t, "clientID", flags.TargetAzureClientIDFV, flags.TargetAzureClientIDFN)
+			assert.Equal(t, "secret", flags.TargetAzureClientSecretFV, flags.TargetAzureClientSecretFN)
+		},
+	}
+
+	flags.AddTargetAzureCredsFlags(cmd)
+	cmd.SetArgs([]string{
+		"test",
+		"--" + flags.TargetAzureClientIDFN, "clientID",
+		"--" + flags.TargetAzureClientTenantFN, "tenantID",
+		"--" + flags.TargetAzureClientSecretFN, "secret",
+	})
+
+	err := cmd.Execute()
+	require.NoError(t, err, clues.ToCore(err))
+}
+
 func (suite *FlagUnitSuite) TestAddAWSCredsFlags() {
 	t := suite.T()
 
@@ -37,6 +37,9 @@ type GroupsOpts struct {
 	PageFolder []string
 	Page       []string
 
+	// AsOf picks the newest complete backups taken before
+	// this time, instead of a single backup.
+	AsOf       string
 	RestoreCfg RestoreCfgOpts
 	ExportCfg  ExportCfgOpts
 
@@ -112,6 +115,7 @@ func MakeGroupsOpts(cmd *cobra.Command) GroupsOpts {
 		Page:       flags.PageFV,
 		PageFolder: flags.PageFolderFV,
 
+		AsOf:       flags.AsOfFV,
 		RestoreCfg: makeRestoreCfgOpts(cmd),
 		ExportCfg:  makeExportCfgOpts(cmd),
 
@@ -124,8 +128,8 @@ func MakeGroupsOpts(cmd *cobra.Command) GroupsOpts {
 
 // ValidateGroupsRestoreFlags checks common flags for correctness and interdependencies
 func ValidateGroupsRestoreFlags(backupID string, opts GroupsOpts, isRestore bool) error {
-	if len(backupID) == 0 {
-		return clues.New("a backup ID is required")
+	if err := validateBackupSource(backupID, opts.AsOf); err != nil {
+		return err
 	}
 
 	// The user has to explicitly specify which resource to restore. In
@@ -18,6 +18,12 @@ type OneDriveOpts struct {
 	FileModifiedAfter  string
 	FileModifiedBefore string
 
+	Notebook     []string
+	NotebookPage []string
+
+	// AsOf picks the newest complete backups taken before
+	// this time, instead of a single backup.
+	AsOf       string
 	RestoreCfg RestoreCfgOpts
 	ExportCfg  ExportCfgOpts
 
@@ -35,6 +41,10 @@ func MakeOneDriveOpts(cmd *cobra.Command) OneDriveOpts {
 		FileModifiedAfter:  flags.FileModifiedAfterFV,
 		FileModifiedBefore: flags.FileModifiedBeforeFV,
 
+		Notebook:     flags.NotebookFV,
+		NotebookPage: flags.NotebookPageFV,
+
+		AsOf:       flags.AsOfFV,
 		RestoreCfg: makeRestoreCfgOpts(cmd),
 		ExportCfg:  makeExportCfgOpts(cmd),
 
@@ -47,8 +57,8 @@ func MakeOneDriveOpts(cmd *cobra.Command) OneDriveOpts {
 
 // ValidateOneDriveRestoreFlags checks common flags for correctness and interdependencies
 func ValidateOneDriveRestoreFlags(backupID string, opts OneDriveOpts) error {
-	if len(backupID) == 0 {
-		return clues.New("a backup ID is required")
+	if err := validateBackupSource(backupID, opts.AsOf); err != nil {
+		return err
 	}
 
 	if _, ok := opts.Populated[flags.FileCreatedAfterFN]; ok && !IsValidTimeFormat(opts.FileCreatedAfter) {
@@ -94,12 +104,40 @@ func IncludeOneDriveRestoreDataSelectors(opts OneDriveOpts) *selectors.OneDriveR
 
 	sel := selectors.NewOneDriveRestore(users)
 
-	lp, ln := len(opts.FolderPath), len(opts.FileName)
+	var (
+		lp, ln = len(opts.FolderPath), len(opts.FileName)
+		nb, np = len(opts.Notebook), len(opts.NotebookPage)
+	)
 
 	// only use the inclusion if either a path or item name
 	// is specified
+	if lp+ln+nb+np == 0 {
+		sel.Include(sel.AllData(), sel.Notebooks(selectors.Any()))
+		return sel
+	}
+
+	if nb+np > 0 {
+		if nb == 0 {
+			opts.Notebook = selectors.Any()
+		}
+
+		if np == 0 {
+			opts.NotebookPage = selectors.Any()
+		}
+
+		opts.Notebook = trimFolderSlash(opts.Notebook)
+		containsNotebooks, prefixNotebooks := splitFoldersIntoContainsAndPrefix(opts.Notebook)
+
+		if len(containsNotebooks) > 0 {
+			sel.Include(sel.NotebookPages(containsNotebooks, opts.NotebookPage))
+		}
+
+		if len(prefixNotebooks) > 0 {
+			sel.Include(sel.NotebookPages(prefixNotebooks, opts.NotebookPage, selectors.PrefixMatch()))
+		}
+	}
+
 	if lp+ln == 0 {
-		sel.Include(sel.AllData())
 		return sel
 	}
 
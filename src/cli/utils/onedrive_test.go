@@ -42,7 +42,24 @@ func (suite *OneDriveUtilsSuite) TestIncludeOneDriveRestoreDataSelectors() {
 				FileName:   empty,
 				FolderPath: empty,
 			},
-			expectIncludeLen: 1,
+			expectIncludeLen: 2,
+		},
+		{
+			name: "notebook contains and prefix",
+			opts: utils.OneDriveOpts{
+				Users:    empty,
+				Notebook: containsAndPrefix,
+			},
+			expectIncludeLen: 2,
+		},
+		{
+			name: "notebook pages and files",
+			opts: utils.OneDriveOpts{
+				Users:        empty,
+				FileName:     single,
+				NotebookPage: single,
+			},
+			expectIncludeLen: 2,
 		},
 		{
 			name: "single inputs",
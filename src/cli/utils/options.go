@@ -30,6 +30,8 @@ func Control() control.Options {
 	opt.ToggleFeatures.ExchangeImmutableIDs = flags.EnableImmutableIDFV
 	opt.ToggleFeatures.UseOldDeltaProcess = flags.UseOldDeltaProcessFV
 	opt.Parallelism.ItemFetch = flags.FetchParallelismFV
+	opt.DriveItemVersions.MaxCount = flags.VersionsCountFV
+	opt.DriveItemVersions.MaxAge = flags.VersionsMaxAgeFV
 
 	return opt
 }
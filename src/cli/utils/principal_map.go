@@ -0,0 +1,112 @@
+package utils
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/alcionai/clues"
+	"gopkg.in/yaml.v3"
+)
+
+// ReadPrincipalMap reads the mapping of source principals to target
+// principals used when restoring permissions.  Both sides may be either
+// an ID or a UPN.
+//
+// Files with a .yaml or .yml extension hold a single mapping of source
+// to target.  All other files are read as csv, with one `source,target`
+// pair per row.  Empty rows, rows starting with `#`, and a header row of
+// `source,target` are ignored.
+//
+// Returns nil if no file is provided.
+func ReadPrincipalMap(ctx context.Context, fpath string) (map[string]string, error) {
+	return readMappingFile(ctx, fpath, "principal map")
+}
+
+// ReadAttendeeMap reads the mapping of the email addresses of event
+// attendees and rooms in the backup to the addresses used by restored
+// events.  Accepts the same file formats as ReadPrincipalMap.
+//
+// Returns nil if no file is provided.
+func ReadAttendeeMap(ctx context.Context, fpath string) (map[string]string, error) {
+	return readMappingFile(ctx, fpath, "attendee map")
+}
+
+func readMappingFile(ctx context.Context, fpath, kind string) (map[string]string, error) {
+	if len(fpath) == 0 {
+		return nil, nil
+	}
+
+	ctx = clues.Add(ctx, strings.ReplaceAll(kind, " ", "_")+"_file", clues.Hide(fpath))
+
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, clues.WrapWC(ctx, err, "opening "+kind)
+	}
+
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(fpath)) {
+	case ".yaml", ".yml":
+		return readYAMLMapping(ctx, f, kind)
+	default:
+		return readCSVMapping(ctx, f, kind)
+	}
+}
+
+func readYAMLMapping(ctx context.Context, r io.Reader, kind string) (map[string]string, error) {
+	pm := map[string]string{}
+
+	if err := yaml.NewDecoder(r).Decode(&pm); err != nil && !errors.Is(err, io.EOF) {
+		return nil, clues.WrapWC(ctx, err, "parsing yaml "+kind)
+	}
+
+	return validateMapping(ctx, pm, kind)
+}
+
+func readCSVMapping(ctx context.Context, r io.Reader, kind string) (map[string]string, error) {
+	var (
+		pm     = map[string]string{}
+		reader = csv.NewReader(r)
+	)
+
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	for first := true; ; first = false {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, clues.WrapWC(ctx, err, "parsing csv "+kind)
+		}
+
+		src, tgt := strings.TrimSpace(row[0]), strings.TrimSpace(row[1])
+
+		if first && strings.EqualFold(src, "source") && strings.EqualFold(tgt, "target") {
+			continue
+		}
+
+		pm[src] = tgt
+	}
+
+	return validateMapping(ctx, pm, kind)
+}
+
+func validateMapping(ctx context.Context, pm map[string]string, kind string) (map[string]string, error) {
+	for src, tgt := range pm {
+		if len(strings.TrimSpace(src)) == 0 || len(strings.TrimSpace(tgt)) == 0 {
+			return nil, clues.NewWC(ctx, kind+" contains an empty source or target").
+				With("source", clues.Hide(src), "target", clues.Hide(tgt))
+		}
+	}
+
+	return pm, nil
+}
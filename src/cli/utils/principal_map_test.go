@@ -0,0 +1,141 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alcionai/clues"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/tester"
+)
+
+type PrincipalMapUnitSuite struct {
+	tester.Suite
+}
+
+func TestPrincipalMapUnitSuite(t *testing.T) {
+	suite.Run(t, &PrincipalMapUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func (suite *PrincipalMapUnitSuite) TestReadPrincipalMap() {
+	expect := map[string]string{
+		"departed@example.com": "successor@example.com",
+		"old-group-id":         "new-group-id",
+	}
+
+	table := []struct {
+		name      string
+		fileName  string
+		content   string
+		expect    map[string]string
+		expectErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "csv",
+			fileName: "map.csv",
+			content: "source,target\n" +
+				"# departed users\n" +
+				"departed@example.com, successor@example.com\n" +
+				"\n" +
+				"old-group-id,new-group-id\n",
+			expect:    expect,
+			expectErr: assert.NoError,
+		},
+		{
+			name:     "csv without header",
+			fileName: "map.txt",
+			content: "departed@example.com,successor@example.com\n" +
+				"old-group-id,new-group-id\n",
+			expect:    expect,
+			expectErr: assert.NoError,
+		},
+		{
+			name:     "yaml",
+			fileName: "map.yaml",
+			content: "departed@example.com: successor@example.com\n" +
+				"old-group-id: new-group-id\n",
+			expect:    expect,
+			expectErr: assert.NoError,
+		},
+		{
+			name:      "empty yaml",
+			fileName:  "map.yml",
+			content:   "",
+			expect:    map[string]string{},
+			expectErr: assert.NoError,
+		},
+		{
+			name:      "csv with too many columns",
+			fileName:  "map.csv",
+			content:   "a,b,c\n",
+			expectErr: assert.Error,
+		},
+		{
+			name:      "csv with empty target",
+			fileName:  "map.csv",
+			content:   "a,\n",
+			expectErr: assert.Error,
+		},
+		{
+			name:      "yaml list",
+			fileName:  "map.yaml",
+			content:   "- a\n- b\n",
+			expectErr: assert.Error,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			fpath := filepath.Join(t.TempDir(), test.fileName)
+
+			err := os.WriteFile(fpath, []byte(test.content), 0o600)
+			require.NoError(t, err, clues.ToCore(err))
+
+			result, err := ReadPrincipalMap(ctx, fpath)
+			test.expectErr(t, err, clues.ToCore(err))
+			assert.Equal(t, test.expect, result)
+		})
+	}
+}
+
+func (suite *PrincipalMapUnitSuite) TestReadPrincipalMap_noFile() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	result, err := ReadPrincipalMap(ctx, "")
+	assert.NoError(t, err, clues.ToCore(err))
+	assert.Nil(t, result)
+
+	_, err = ReadPrincipalMap(ctx, filepath.Join(t.TempDir(), "missing.csv"))
+	assert.Error(t, err, clues.ToCore(err))
+}
+
+func (suite *PrincipalMapUnitSuite) TestReadAttendeeMap() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	fpath := filepath.Join(t.TempDir(), "attendees.yaml")
+
+	err := os.WriteFile(fpath, []byte("alice@contoso.com: alice@sandbox.com\n"), 0o600)
+	require.NoError(t, err, clues.ToCore(err))
+
+	result, err := ReadAttendeeMap(ctx, fpath)
+	require.NoError(t, err, clues.ToCore(err))
+	assert.Equal(t, map[string]string{"alice@contoso.com": "alice@sandbox.com"}, result)
+
+	result, err = ReadAttendeeMap(ctx, "")
+	assert.NoError(t, err, clues.ToCore(err))
+	assert.Nil(t, result)
+}
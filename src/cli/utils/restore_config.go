@@ -11,28 +11,59 @@ import (
 	. "github.com/alcionai/corso/src/cli/print"
 	"github.com/alcionai/corso/src/pkg/control"
 	"github.com/alcionai/corso/src/pkg/dttm"
+	"github.com/alcionai/corso/src/pkg/path"
 )
 
 type RestoreCfgOpts struct {
+	// AttendeeMap is the path to the file that maps the
+	// addresses of event attendees in the backup to the
+	// ones used on restore.
+	AttendeeMap string
+	Attendees   string
 	Collisions  string
-	Destination string
+	// ConfirmMirror acknowledges that the mirror collision
+	// policy removes items which aren't in the backup.
+	ConfirmMirror bool
+	Destination   string
+	DryRun        bool
+	// ItemVersion picks which version of each drive file gets restored.
+	ItemVersion string
 	// DTTMFormat is the timestamp format appended
 	// to the default folder name.  Defaults to
 	// dttm.HumanReadable.
-	DTTMFormat        dttm.TimeFormat
+	DTTMFormat       dttm.TimeFormat
+	MirrorQuarantine string
+	// PrincipalMap is the path to the file that maps the
+	// principals in the backup to the ones used on restore.
+	PrincipalMap      string
 	ProtectedResource string
 	SkipPermissions   bool
+	SuppressInvites   bool
+	// ToSite and ToUser restore drive data into a site's library,
+	// or a user's OneDrive, regardless of the backed up service.
+	ToSite string
+	ToUser string
 
 	Populated flags.PopulatedFlags
 }
 
 func makeRestoreCfgOpts(cmd *cobra.Command) RestoreCfgOpts {
 	return RestoreCfgOpts{
+		AttendeeMap:       flags.AttendeeMapFV,
+		Attendees:         flags.AttendeesFV,
 		Collisions:        flags.CollisionsFV,
+		ConfirmMirror:     flags.ConfirmMirrorFV,
 		Destination:       flags.DestinationFV,
+		DryRun:            flags.DryRunFV,
+		ItemVersion:       flags.ItemVersionFV,
 		DTTMFormat:        dttm.HumanReadable,
+		MirrorQuarantine:  flags.MirrorQuarantineFV,
+		PrincipalMap:      flags.PrincipalMapFV,
 		ProtectedResource: flags.ToResourceFV,
 		SkipPermissions:   flags.NoPermissionsFV,
+		SuppressInvites:   flags.SuppressInvitesFV,
+		ToSite:            flags.ToSiteFV,
+		ToUser:            flags.ToUserFV,
 
 		// populated contains the list of flags that appear in the
 		// command, according to pflags.  Use this to differentiate
@@ -50,6 +81,105 @@ func ValidateRestoreConfigFlags(opts RestoreCfgOpts) error {
 		return clues.New(fmt.Sprintf("invalid collision policy: %s", flags.CollisionsFN))
 	}
 
+	if len(opts.PrincipalMap) > 0 && opts.SkipPermissions {
+		return clues.New(fmt.Sprintf("--%s cannot be used with --%s", flags.PrincipalMapFN, flags.NoPermissionsFN))
+	}
+
+	if err := validateAttendeeFlags(opts); err != nil {
+		return err
+	}
+
+	if err := validateCrossServiceFlags(opts); err != nil {
+		return err
+	}
+
+	return validateMirrorFlags(opts)
+}
+
+func validateAttendeeFlags(opts RestoreCfgOpts) error {
+	_, populated := opts.Populated[flags.AttendeesFN]
+	policy := control.AttendeePolicy(opts.Attendees)
+
+	if populated && !control.IsValidAttendeePolicy(policy) {
+		return clues.New(fmt.Sprintf("invalid attendee policy: %s", flags.AttendeesFN))
+	}
+
+	remap := populated && policy == control.AttendeesRemap
+
+	if remap && len(opts.AttendeeMap) == 0 {
+		return clues.New(fmt.Sprintf(
+			"--%s %s requires --%s",
+			flags.AttendeesFN, control.AttendeesRemap, flags.AttendeeMapFN))
+	}
+
+	if !remap && len(opts.AttendeeMap) > 0 {
+		return clues.New(fmt.Sprintf(
+			"--%s requires --%s %s",
+			flags.AttendeeMapFN, flags.AttendeesFN, control.AttendeesRemap))
+	}
+
+	return nil
+}
+
+func validateCrossServiceFlags(opts RestoreCfgOpts) error {
+	var fn string
+
+	switch {
+	case len(opts.ToSite) > 0 && len(opts.ToUser) > 0:
+		return clues.New(fmt.Sprintf("--%s cannot be used with --%s", flags.ToSiteFN, flags.ToUserFN))
+	case len(opts.ToSite) > 0:
+		fn = flags.ToSiteFN
+	case len(opts.ToUser) > 0:
+		fn = flags.ToUserFN
+	default:
+		return nil
+	}
+
+	if len(opts.ProtectedResource) > 0 {
+		return clues.New(fmt.Sprintf("--%s cannot be used with --%s", fn, flags.ToResourceFN))
+	}
+
+	if control.CollisionPolicy(opts.Collisions) == control.Mirror {
+		return clues.New(fmt.Sprintf("--%s %s cannot be used with --%s", flags.CollisionsFN, control.Mirror, fn))
+	}
+
+	return nil
+}
+
+// fileFilterFNs are the flags which restore a subset of the files in
+// a folder.
+var fileFilterFNs = []string{
+	flags.FileFN,
+	flags.FileCreatedAfterFN,
+	flags.FileCreatedBeforeFN,
+	flags.FileModifiedAfterFN,
+	flags.FileModifiedBeforeFN,
+}
+
+func validateMirrorFlags(opts RestoreCfgOpts) error {
+	if control.CollisionPolicy(opts.Collisions) != control.Mirror {
+		if len(opts.MirrorQuarantine) > 0 {
+			return clues.New(fmt.Sprintf(
+				"--%s requires --%s %s",
+				flags.MirrorQuarantineFN, flags.CollisionsFN, control.Mirror))
+		}
+
+		return nil
+	}
+
+	if !opts.ConfirmMirror && !opts.DryRun {
+		return clues.New(fmt.Sprintf(
+			"--%s %s removes items which aren't in the backup; add --%s to continue",
+			flags.CollisionsFN, control.Mirror, flags.ConfirmMirrorFN))
+	}
+
+	// mirroring a subset of a folder's files would remove the rest of them.
+	for _, fn := range fileFilterFNs {
+		if _, ok := opts.Populated[fn]; ok {
+			return clues.New(fmt.Sprintf("--%s %s cannot be used with --%s", flags.CollisionsFN, control.Mirror, fn))
+		}
+	}
+
 	return nil
 }
 
@@ -72,7 +202,30 @@ func MakeRestoreConfig(
 	}
 
 	restoreCfg.ProtectedResource = opts.ProtectedResource
+
+	switch {
+	case len(opts.ToSite) > 0:
+		restoreCfg.ProtectedResource = opts.ToSite
+		restoreCfg.TargetService = path.SharePointService
+	case len(opts.ToUser) > 0:
+		restoreCfg.ProtectedResource = opts.ToUser
+		restoreCfg.TargetService = path.OneDriveService
+	}
+
 	restoreCfg.IncludePermissions = !opts.SkipPermissions
+	restoreCfg.DryRun = opts.DryRun
+	restoreCfg.MirrorQuarantine = opts.MirrorQuarantine
+	restoreCfg.ItemVersion = opts.ItemVersion
+	restoreCfg.SuppressInvites = opts.SuppressInvites
+
+	if _, ok := opts.Populated[flags.AttendeesFN]; ok {
+		restoreCfg.Attendees = control.AttendeePolicy(opts.Attendees)
+	}
+
+	if restoreCfg.DryRun {
+		Infof(ctx, "Planning restore to folder %s", restoreCfg.Location)
+		return restoreCfg
+	}
 
 	Infof(ctx, "Restoring to folder %s", restoreCfg.Location)
 
@@ -10,6 +10,7 @@ import (
 	"github.com/alcionai/corso/src/cli/flags"
 	"github.com/alcionai/corso/src/internal/tester"
 	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/path"
 )
 
 type RestoreCfgUnitSuite struct {
@@ -54,6 +55,139 @@ func (suite *RestoreCfgUnitSuite) TestValidateRestoreConfigFlags() {
 			},
 			expect: assert.Error,
 		},
+		{
+			name: "principal map without permissions",
+			opts: RestoreCfgOpts{
+				PrincipalMap:    "map.csv",
+				SkipPermissions: true,
+			},
+			expect: assert.Error,
+		},
+		{
+			name: "mirror confirmed",
+			opts: RestoreCfgOpts{
+				Collisions:       string(control.Mirror),
+				ConfirmMirror:    true,
+				MirrorQuarantine: "quarantine",
+				Populated: flags.PopulatedFlags{
+					flags.CollisionsFN: {},
+				},
+			},
+			expect: assert.NoError,
+		},
+		{
+			name: "mirror dry run without confirmation",
+			opts: RestoreCfgOpts{
+				Collisions: string(control.Mirror),
+				DryRun:     true,
+				Populated: flags.PopulatedFlags{
+					flags.CollisionsFN: {},
+				},
+			},
+			expect: assert.NoError,
+		},
+		{
+			name: "mirror without confirmation",
+			opts: RestoreCfgOpts{
+				Collisions: string(control.Mirror),
+				Populated: flags.PopulatedFlags{
+					flags.CollisionsFN: {},
+				},
+			},
+			expect: assert.Error,
+		},
+		{
+			name: "mirror with file filter",
+			opts: RestoreCfgOpts{
+				Collisions:    string(control.Mirror),
+				ConfirmMirror: true,
+				Populated: flags.PopulatedFlags{
+					flags.CollisionsFN:        {},
+					flags.FileModifiedAfterFN: {},
+				},
+			},
+			expect: assert.Error,
+		},
+		{
+			name: "quarantine without mirror",
+			opts: RestoreCfgOpts{
+				Collisions:       string(control.Replace),
+				MirrorQuarantine: "quarantine",
+				Populated: flags.PopulatedFlags{
+					flags.CollisionsFN: {},
+				},
+			},
+			expect: assert.Error,
+		},
+		{
+			name: "attendee remap",
+			opts: RestoreCfgOpts{
+				Attendees:   string(control.AttendeesRemap),
+				AttendeeMap: "map.csv",
+				Populated: flags.PopulatedFlags{
+					flags.AttendeesFN: {},
+				},
+			},
+			expect: assert.NoError,
+		},
+		{
+			name: "invalid attendee policy",
+			opts: RestoreCfgOpts{
+				Attendees: "foo",
+				Populated: flags.PopulatedFlags{
+					flags.AttendeesFN: {},
+				},
+			},
+			expect: assert.Error,
+		},
+		{
+			name: "attendee remap without map",
+			opts: RestoreCfgOpts{
+				Attendees: string(control.AttendeesRemap),
+				Populated: flags.PopulatedFlags{
+					flags.AttendeesFN: {},
+				},
+			},
+			expect: assert.Error,
+		},
+		{
+			name: "attendee map without remap",
+			opts: RestoreCfgOpts{
+				Attendees:   string(control.AttendeesStrip),
+				AttendeeMap: "map.csv",
+				Populated: flags.PopulatedFlags{
+					flags.AttendeesFN: {},
+				},
+			},
+			expect: assert.Error,
+		},
+		{
+			name:   "to site",
+			opts:   RestoreCfgOpts{ToSite: "site"},
+			expect: assert.NoError,
+		},
+		{
+			name:   "to site and user",
+			opts:   RestoreCfgOpts{ToSite: "site", ToUser: "user"},
+			expect: assert.Error,
+		},
+		{
+			name:   "to user with protected resource",
+			opts:   RestoreCfgOpts{ToUser: "user", ProtectedResource: "site"},
+			expect: assert.Error,
+		},
+		{
+			name: "to site with mirror",
+			opts: RestoreCfgOpts{
+				Collisions:    string(control.Mirror),
+				ConfirmMirror: true,
+				ToSite:        "site",
+				Populated: flags.PopulatedFlags{
+					flags.CollisionsFN: {},
+				},
+			},
+			expect: assert.Error,
+		},
 	}
 	for _, test := range table {
 		suite.Run(test.name, func() {
@@ -142,6 +276,76 @@ func (suite *RestoreCfgUnitSuite) TestMakeRestoreConfig() {
 				IncludePermissions: false,
 			},
 		},
+		{
+			name: "dry run",
+			rco: &RestoreCfgOpts{
+				Collisions:  "collisions",
+				Destination: "destination",
+				DryRun:      true,
+			},
+			populated: flags.PopulatedFlags{
+				flags.CollisionsFN:  {},
+				flags.DestinationFN: {},
+			},
+			expect: control.RestoreConfig{
+				OnCollision: control.CollisionPolicy("collisions"),
+				Location:    "destination",
+				DryRun:      true,
+			},
+		},
+		{
+			name: "attendees populated",
+			rco: &RestoreCfgOpts{
+				Attendees:       string(control.AttendeesStrip),
+				SuppressInvites: true,
+			},
+			populated: flags.PopulatedFlags{
+				flags.AttendeesFN: {},
+			},
+			expect: control.RestoreConfig{
+				OnCollision:     control.Skip,
+				Location:        "Corso_Restore_",
+				Attendees:       control.AttendeesStrip,
+				SuppressInvites: true,
+			},
+		},
+		{
+			name: "attendees not populated",
+			rco: &RestoreCfgOpts{
+				Attendees: string(control.AttendeesStrip),
+			},
+			populated: flags.PopulatedFlags{},
+			expect: control.RestoreConfig{
+				OnCollision: control.Skip,
+				Location:    "Corso_Restore_",
+			},
+		},
+		{
+			name: "to site",
+			rco: &RestoreCfgOpts{
+				ToSite: "site",
+			},
+			populated: flags.PopulatedFlags{},
+			expect: control.RestoreConfig{
+				OnCollision:       control.Skip,
+				Location:          "Corso_Restore_",
+				ProtectedResource: "site",
+				TargetService:     path.SharePointService,
+			},
+		},
+		{
+			name: "to user",
+			rco: &RestoreCfgOpts{
+				ToUser: "user",
+			},
+			populated: flags.PopulatedFlags{},
+			expect: control.RestoreConfig{
+				OnCollision:       control.Skip,
+				Location:          "Corso_Restore_",
+				ProtectedResource: "user",
+				TargetService:     path.OneDriveService,
+			},
+		},
 	}
 	for _, test := range table {
 		suite.Run(test.name, func() {
@@ -156,6 +360,11 @@ func (suite *RestoreCfgUnitSuite) TestMakeRestoreConfig() {
 			result := MakeRestoreConfig(ctx, opts)
 			assert.Equal(t, test.expect.OnCollision, result.OnCollision)
 			assert.Contains(t, result.Location, test.expect.Location)
+			assert.Equal(t, test.expect.DryRun, result.DryRun)
+			assert.Equal(t, test.expect.ProtectedResource, result.ProtectedResource)
+			assert.Equal(t, test.expect.TargetService, result.TargetService)
+			assert.Equal(t, test.expect.Attendees, result.Attendees)
+			assert.Equal(t, test.expect.SuppressInvites, result.SuppressInvites)
 		})
 	}
 }
@@ -34,6 +34,12 @@ type SharePointOpts struct {
 	PageFolder []string
 	Page       []string
 
+	Notebook     []string
+	NotebookPage []string
+
+	// AsOf picks the newest complete backups taken before
+	// this time, instead of a single backup.
+	AsOf       string
 	RestoreCfg RestoreCfgOpts
 	ExportCfg  ExportCfgOpts
 
@@ -85,6 +91,10 @@ func MakeSharePointOpts(cmd *cobra.Command) SharePointOpts {
 		Page:       flags.PageFV,
 		PageFolder: flags.PageFolderFV,
 
+		Notebook:     flags.NotebookFV,
+		NotebookPage: flags.NotebookPageFV,
+
+		AsOf:       flags.AsOfFV,
 		RestoreCfg: makeRestoreCfgOpts(cmd),
 		ExportCfg:  makeExportCfgOpts(cmd),
 
@@ -99,6 +109,7 @@ func SharePointAllowedCategories() map[string]struct{} {
 	return map[string]struct{}{
 		flags.DataLibraries: {},
 		flags.DataLists:     {},
+		flags.DataNotebooks: {},
 	}
 }
 
@@ -115,6 +126,8 @@ func AddCategories(sel *selectors.SharePointBackup, cats []string) *selectors.Sh
 			sel.Include(sel.Lists(selectors.Any()))
 		case flags.DataLibraries:
 			sel.Include(sel.LibraryFolders(selectors.Any()))
+		case flags.DataNotebooks:
+			sel.Include(sel.Notebooks(selectors.Any()))
 		}
 	}
 
@@ -123,8 +136,8 @@ func AddCategories(sel *selectors.SharePointBackup, cats []string) *selectors.Sh
 
 // ValidateSharePointRestoreFlags checks common flags for correctness and interdependencies
 func ValidateSharePointRestoreFlags(backupID string, opts SharePointOpts) error {
-	if len(backupID) == 0 {
-		return clues.New("a backup ID is required")
+	if err := validateBackupSource(backupID, opts.AsOf); err != nil {
+		return err
 	}
 
 	// ensure url can parse all weburls provided by --site.
@@ -136,6 +149,25 @@ func ValidateSharePointRestoreFlags(backupID string, opts SharePointOpts) error
 		}
 	}
 
+	// only libraries can be restored into a user's drive.
+	if len(opts.RestoreCfg.ToUser) > 0 {
+		for _, fn := range []string{
+			flags.ListFN,
+			flags.ListCreatedAfterFN,
+			flags.ListCreatedBeforeFN,
+			flags.ListModifiedAfterFN,
+			flags.ListModifiedBeforeFN,
+			flags.PageFN,
+			flags.PageFolderFN,
+			flags.NotebookFN,
+			flags.NotebookPageFN,
+		} {
+			if _, ok := opts.Populated[fn]; ok {
+				return clues.New("--" + fn + " cannot be used with --" + flags.ToUserFN)
+			}
+		}
+	}
+
 	return validateCommonTimeFlags(opts)
 }
 
@@ -162,6 +194,7 @@ func IncludeSharePointRestoreDataSelectors(ctx context.Context, opts SharePointO
 	siteIDs, webUrls := len(opts.SiteID), len(opts.WebURL)
 	lists := len(opts.Lists)
 	pageFolders, pageItems := len(opts.PageFolder), len(opts.Page)
+	notebooks, notebookPages := len(opts.Notebook), len(opts.NotebookPage)
 
 	if siteIDs == 0 {
 		sites = selectors.Any()
@@ -169,8 +202,15 @@ func IncludeSharePointRestoreDataSelectors(ctx context.Context, opts SharePointO
 
 	sel := selectors.NewSharePointRestore(sites)
 
-	if folderPaths+fileNames+webUrls+lists+pageFolders+pageItems == 0 {
-		sel.Include(sel.AllData())
+	if folderPaths+fileNames+webUrls+lists+pageFolders+pageItems+notebooks+notebookPages == 0 {
+		// only libraries can be restored into a user's drive.
+		if len(opts.RestoreCfg.ToUser) > 0 {
+			sel.Include(sel.LibraryFolders(selectors.Any()))
+			return sel
+		}
+
+		sel.Include(sel.AllData(), sel.Notebooks(selectors.Any()))
+
 		return sel
 	}
 
@@ -214,6 +254,27 @@ func IncludeSharePointRestoreDataSelectors(ctx context.Context, opts SharePointO
 		}
 	}
 
+	if notebooks+notebookPages > 0 {
+		if notebooks == 0 {
+			opts.Notebook = selectors.Any()
+		}
+
+		if notebookPages == 0 {
+			opts.NotebookPage = selectors.Any()
+		}
+
+		opts.Notebook = trimFolderSlash(opts.Notebook)
+		containsNotebooks, prefixNotebooks := splitFoldersIntoContainsAndPrefix(opts.Notebook)
+
+		if len(containsNotebooks) > 0 {
+			sel.Include(sel.NotebookPages(containsNotebooks, opts.NotebookPage))
+		}
+
+		if len(prefixNotebooks) > 0 {
+			sel.Include(sel.NotebookPages(prefixNotebooks, opts.NotebookPage, selectors.PrefixMatch()))
+		}
+	}
+
 	if webUrls > 0 {
 		urls := make([]string, 0, len(opts.WebURL))
 
@@ -44,7 +44,15 @@ func (suite *SharePointUtilsSuite) TestIncludeSharePointRestoreDataSelectors() {
 		{
 			name:             "no inputs",
 			opts:             utils.SharePointOpts{},
-			expectIncludeLen: 3,
+			expectIncludeLen: 4,
+		},
+		{
+			name: "notebook contains and prefix",
+			opts: utils.SharePointOpts{
+				Notebook:     containsAndPrefix,
+				NotebookPage: single,
+			},
+			expectIncludeLen: 2,
 		},
 		{
 			name: "single inputs",
@@ -432,13 +440,19 @@ func (suite *SharePointUtilsSuite) TestAddSharepointCategories() {
 			cats:           []string{flags.DataLists},
 			expectScopeLen: 1,
 		},
+		{
+			name:           "notebooks",
+			cats:           []string{flags.DataNotebooks},
+			expectScopeLen: 1,
+		},
 		{
 			name: "all allowed",
 			cats: []string{
 				flags.DataLibraries,
 				flags.DataLists,
+				flags.DataNotebooks,
 			},
-			expectScopeLen: 2,
+			expectScopeLen: 3,
 		},
 		{
 			name:           "bad inputs",
@@ -12,7 +12,20 @@ import (
 
 type TeamsChatsOpts struct {
 	Users []string
+	Chats []string
 
+	ChatMember            string
+	ChatCreatedAfter      string
+	ChatCreatedBefore     string
+	ChatLastMessageAfter  string
+	ChatLastMessageBefore string
+
+	MessageCreatedAfter  string
+	MessageCreatedBefore string
+
+	// AsOf picks the newest complete backups taken before
+	// this time, instead of a single backup.
+	AsOf      string
 	ExportCfg ExportCfgOpts
 
 	Populated flags.PopulatedFlags
@@ -42,8 +55,19 @@ func AddTeamsChatsCategories(sel *selectors.TeamsChatsBackup, cats []string) *se
 func MakeTeamsChatsOpts(cmd *cobra.Command) TeamsChatsOpts {
 	return TeamsChatsOpts{
 		Users: flags.UserFV,
+		Chats: flags.ChatFV,
+
+		ChatMember:            flags.ChatMemberFV,
+		ChatCreatedAfter:      flags.ChatCreatedAfterFV,
+		ChatCreatedBefore:     flags.ChatCreatedBeforeFV,
+		ChatLastMessageAfter:  flags.ChatLastMessageAfterFV,
+		ChatLastMessageBefore: flags.ChatLastMessageBeforeFV,
+
+		MessageCreatedAfter:  flags.MessageCreatedAfterFV,
+		MessageCreatedBefore: flags.MessageCreatedBeforeFV,
 
-		ExportCfg: makeExportCfgOpts(cmd),
+		AsOf:      flags.AsOfFV,
+		ExportCfg: makeChatsExportCfgOpts(cmd),
 
 		// populated contains the list of flags that appear in the
 		// command, according to pflags.  Use this to differentiate
@@ -52,10 +76,20 @@ func MakeTeamsChatsOpts(cmd *cobra.Command) TeamsChatsOpts {
 	}
 }
 
+// makeChatsExportCfgOpts carries the message bounds into the export
+// config, so that exports leave out the messages outside of them.
+func makeChatsExportCfgOpts(cmd *cobra.Command) ExportCfgOpts {
+	opts := makeExportCfgOpts(cmd)
+	opts.MessageCreatedAfter = flags.MessageCreatedAfterFV
+	opts.MessageCreatedBefore = flags.MessageCreatedBeforeFV
+
+	return opts
+}
+
 // ValidateTeamsChatsRestoreFlags checks common flags for correctness and interdependencies
 func ValidateTeamsChatsRestoreFlags(backupID string, opts TeamsChatsOpts, isRestore bool) error {
-	if len(backupID) == 0 {
-		return clues.New("a backup ID is required")
+	if err := validateBackupSource(backupID, opts.AsOf); err != nil {
+		return err
 	}
 
 	// restore isn't currently supported
@@ -63,6 +97,30 @@ func ValidateTeamsChatsRestoreFlags(backupID string, opts TeamsChatsOpts, isRest
 		return clues.New("restore not supported")
 	}
 
+	if _, ok := opts.Populated[flags.ChatCreatedAfterFN]; ok && !IsValidTimeFormat(opts.ChatCreatedAfter) {
+		return clues.New("invalid time format for " + flags.ChatCreatedAfterFN)
+	}
+
+	if _, ok := opts.Populated[flags.ChatCreatedBeforeFN]; ok && !IsValidTimeFormat(opts.ChatCreatedBefore) {
+		return clues.New("invalid time format for " + flags.ChatCreatedBeforeFN)
+	}
+
+	if _, ok := opts.Populated[flags.ChatLastMessageAfterFN]; ok && !IsValidTimeFormat(opts.ChatLastMessageAfter) {
+		return clues.New("invalid time format for " + flags.ChatLastMessageAfterFN)
+	}
+
+	if _, ok := opts.Populated[flags.ChatLastMessageBeforeFN]; ok && !IsValidTimeFormat(opts.ChatLastMessageBefore) {
+		return clues.New("invalid time format for " + flags.ChatLastMessageBeforeFN)
+	}
+
+	if _, ok := opts.Populated[flags.MessageCreatedAfterFN]; ok && !IsValidTimeFormat(opts.MessageCreatedAfter) {
+		return clues.New("invalid time format for " + flags.MessageCreatedAfterFN)
+	}
+
+	if _, ok := opts.Populated[flags.MessageCreatedBeforeFN]; ok && !IsValidTimeFormat(opts.MessageCreatedBefore) {
+		return clues.New("invalid time format for " + flags.MessageCreatedBeforeFN)
+	}
+
 	return nil
 }
 
@@ -89,7 +147,16 @@ func IncludeTeamsChatsRestoreDataSelectors(ctx context.Context, opts TeamsChatsO
 		users = selectors.Any()
 	}
 
-	return selectors.NewTeamsChatsRestore(users)
+	sel := selectors.NewTeamsChatsRestore(users)
+
+	if len(opts.Chats) == 0 {
+		sel.Include(sel.AllData())
+		return sel
+	}
+
+	sel.Include(sel.Chats(opts.Chats))
+
+	return sel
 }
 
 // FilterTeamsChatsRestoreInfoSelectors builds the common info-selector filters.
@@ -97,5 +164,11 @@ func FilterTeamsChatsRestoreInfoSelectors(
 	sel *selectors.TeamsChatsRestore,
 	opts TeamsChatsOpts,
 ) {
-	// TODO: populate when adding filters
+	AddTeamsChatsFilter(sel, opts.ChatMember, sel.ChatMember)
+	AddTeamsChatsFilter(sel, opts.ChatCreatedAfter, sel.ChatCreatedAfter)
+	AddTeamsChatsFilter(sel, opts.ChatCreatedBefore, sel.ChatCreatedBefore)
+	AddTeamsChatsFilter(sel, opts.ChatLastMessageAfter, sel.ChatLastMessageAfter)
+	AddTeamsChatsFilter(sel, opts.ChatLastMessageBefore, sel.ChatLastMessageBefore)
+	AddTeamsChatsFilter(sel, opts.MessageCreatedAfter, sel.MessageCreatedAfter)
+	AddTeamsChatsFilter(sel, opts.MessageCreatedBefore, sel.MessageCreatedBefore)
 }
@@ -0,0 +1,179 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/cli/flags"
+	"github.com/alcionai/corso/src/cli/utils"
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/dttm"
+)
+
+type TeamsChatsUtilsSuite struct {
+	tester.Suite
+}
+
+func TestTeamsChatsUtilsSuite(t *testing.T) {
+	suite.Run(t, &TeamsChatsUtilsSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func (suite *TeamsChatsUtilsSuite) TestIncludeTeamsChatsRestoreDataSelectors() {
+	table := []struct {
+		name             string
+		opts             utils.TeamsChatsOpts
+		expectIncludeLen int
+	}{
+		{
+			name:             "no inputs",
+			opts:             utils.TeamsChatsOpts{},
+			expectIncludeLen: 1,
+		},
+		{
+			name: "single user",
+			opts: utils.TeamsChatsOpts{
+				Users: []string{"user"},
+			},
+			expectIncludeLen: 1,
+		},
+		{
+			name: "multiple chats",
+			opts: utils.TeamsChatsOpts{
+				Chats: []string{"chat1", "chat2"},
+			},
+			expectIncludeLen: 1,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			sel := utils.IncludeTeamsChatsRestoreDataSelectors(ctx, test.opts)
+			assert.Len(t, sel.Includes, test.expectIncludeLen)
+		})
+	}
+}
+
+func (suite *TeamsChatsUtilsSuite) TestValidateTeamsChatsRestoreFlags() {
+	table := []struct {
+		name      string
+		backupID  string
+		opts      utils.TeamsChatsOpts
+		isRestore bool
+		expect    assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "no backupID",
+			backupID: "",
+			opts:     utils.TeamsChatsOpts{},
+			expect:   assert.Error,
+		},
+		{
+			name:      "restore",
+			backupID:  "id",
+			opts:      utils.TeamsChatsOpts{},
+			isRestore: true,
+			expect:    assert.Error,
+		},
+		{
+			name:     "all valid",
+			backupID: "id",
+			opts: utils.TeamsChatsOpts{
+				ChatCreatedAfter:      dttm.Now(),
+				ChatCreatedBefore:     dttm.Now(),
+				ChatLastMessageAfter:  dttm.Now(),
+				ChatLastMessageBefore: dttm.Now(),
+				MessageCreatedAfter:   dttm.Now(),
+				MessageCreatedBefore:  dttm.Now(),
+				Populated: flags.PopulatedFlags{
+					flags.ChatCreatedAfterFN:      struct{}{},
+					flags.ChatCreatedBeforeFN:     struct{}{},
+					flags.ChatLastMessageAfterFN:  struct{}{},
+					flags.ChatLastMessageBeforeFN: struct{}{},
+					flags.MessageCreatedAfterFN:   struct{}{},
+					flags.MessageCreatedBeforeFN:  struct{}{},
+				},
+			},
+			expect: assert.NoError,
+		},
+		{
+			name:     "invalid chat created after",
+			backupID: "id",
+			opts: utils.TeamsChatsOpts{
+				ChatCreatedAfter: "1235",
+				Populated: flags.PopulatedFlags{
+					flags.ChatCreatedAfterFN: struct{}{},
+				},
+			},
+			expect: assert.Error,
+		},
+		{
+			name:     "invalid chat created before",
+			backupID: "id",
+			opts: utils.TeamsChatsOpts{
+				ChatCreatedBefore: "1235",
+				Populated: flags.PopulatedFlags{
+					flags.ChatCreatedBeforeFN: struct{}{},
+				},
+			},
+			expect: assert.Error,
+		},
+		{
+			name:     "invalid chat last message after",
+			backupID: "id",
+			opts: utils.TeamsChatsOpts{
+				ChatLastMessageAfter: "1235",
+				Populated: flags.PopulatedFlags{
+					flags.ChatLastMessageAfterFN: struct{}{},
+				},
+			},
+			expect: assert.Error,
+		},
+		{
+			name:     "invalid chat last message before",
+			backupID: "id",
+			opts: utils.TeamsChatsOpts{
+				ChatLastMessageBefore: "1235",
+				Populated: flags.PopulatedFlags{
+					flags.ChatLastMessageBeforeFN: struct{}{},
+				},
+			},
+			expect: assert.Error,
+		},
+		{
+			name:     "invalid message created after",
+			backupID: "id",
+			opts: utils.TeamsChatsOpts{
+				MessageCreatedAfter: "1235",
+				Populated: flags.PopulatedFlags{
+					flags.MessageCreatedAfterFN: struct{}{},
+				},
+			},
+			expect: assert.Error,
+		},
+		{
+			name:     "invalid message created before",
+			backupID: "id",
+			opts: utils.TeamsChatsOpts{
+				MessageCreatedBefore: "1235",
+				Populated: flags.PopulatedFlags{
+					flags.MessageCreatedBeforeFN: struct{}{},
+				},
+			},
+			expect: assert.Error,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			err := utils.ValidateTeamsChatsRestoreFlags(test.backupID, test.opts, test.isRestore)
+			test.expect(t, err)
+		})
+	}
+}
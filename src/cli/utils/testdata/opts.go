@@ -16,6 +16,7 @@ import (
 	"github.com/alcionai/corso/src/pkg/fault"
 	ftd "github.com/alcionai/corso/src/pkg/fault/testdata"
 	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/repository"
 	"github.com/alcionai/corso/src/pkg/selectors"
 	"github.com/alcionai/corso/src/pkg/store"
 )
@@ -1008,6 +1009,14 @@ func (MockBackupGetter) BackupsByTag(
 	return nil, clues.New("unexpected call to mock")
 }
 
+func (MockBackupGetter) BackupsAsOf(
+	context.Context,
+	selectors.Selector,
+	time.Time,
+) ([]repository.BackupAsOf, error) {
+	return nil, clues.New("unexpected call to mock")
+}
+
 func (bg *MockBackupGetter) GetBackupDetails(
 	ctx context.Context,
 	backupID string,
@@ -90,6 +90,26 @@ func GetAccountAndConnectWithOverrides(
 	return r, rdao, nil
 }
 
+// ConnectTargetAccount connects the repository to the separate target
+// tenant provided by flags or env vars, if any.  Restores and exports
+// interact with the target tenant once connected.
+func ConnectTargetAccount(
+	ctx context.Context,
+	r repository.Repositoryer,
+	pst path.ServiceType,
+) error {
+	acct, ok, err := config.GetTargetAccount()
+	if err != nil {
+		return clues.Stack(err)
+	}
+
+	if !ok {
+		return nil
+	}
+
+	return clues.Wrap(r.ConnectTargetDataProvider(ctx, acct, pst), "connecting to the target tenant").OrNil()
+}
+
 func AccountConnectAndWriteRepoConfig(
 	ctx context.Context,
 	cmd *cobra.Command,
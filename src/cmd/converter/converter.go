@@ -6,6 +6,9 @@ import (
 	"log"
 	"os"
 
+	"github.com/microsoft/kiota-abstractions-go/serialization"
+	kjson "github.com/microsoft/kiota-serialization-json-go"
+
 	"github.com/alcionai/corso/src/internal/converters/eml"
 	"github.com/alcionai/corso/src/internal/converters/ics"
 	"github.com/alcionai/corso/src/internal/converters/vcf"
@@ -49,9 +52,50 @@ func main() {
 		default:
 			log.Fatal("Unknown target format", to)
 		}
+	case "eml", "ics", "vcf":
+		if to != "json" {
+			log.Fatal("Unknown target format", to)
+		}
+
+		var item serialization.Parsable
+
+		switch from {
+		case "eml":
+			item, err = eml.ToMessageable(context.Background(), body)
+		case "ics":
+			item, err = ics.ToEventable(context.Background(), body)
+		case "vcf":
+			item, err = vcf.ToContactable(context.Background(), body)
+		}
+
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		out, err = toJSON(item)
+		if err != nil {
+			log.Fatal(err)
+		}
 	default:
 		log.Fatal("Unknown source format", from)
 	}
 
 	fmt.Print(out)
 }
+
+func toJSON(item serialization.Parsable) (string, error) {
+	writer := kjson.NewJsonSerializationWriter()
+	defer writer.Close()
+
+	err := writer.WriteObjectValue("", item)
+	if err != nil {
+		return "", err
+	}
+
+	bs, err := writer.GetSerializedContent()
+	if err != nil {
+		return "", err
+	}
+
+	return string(bs), nil
+}
@@ -0,0 +1,249 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/alcionai/clues"
+
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/dttm"
+	"github.com/alcionai/corso/src/pkg/export"
+	"github.com/alcionai/corso/src/pkg/logger"
+)
+
+// archiveWriter adds files to an archive.
+type archiveWriter interface {
+	// writeFile adds a single file to the archive.
+	writeFile(name string, body io.Reader) error
+	// Close finalizes the archive.  It does not close the underlying
+	// writer.
+	Close() error
+}
+
+type archiveCollection struct {
+	items <-chan export.Item
+}
+
+func (ac archiveCollection) BasePath() string {
+	return ""
+}
+
+func (ac archiveCollection) Items(ctx context.Context) <-chan export.Item {
+	return ac.items
+}
+
+// ExportCollection takes a list of export collections and archives
+// them into a single collection using the archive format from the
+// config.  If a max volume size is set, the archive is split into
+// numbered parts which can be concatenated to recreate the archive.
+func ExportCollection(
+	ctx context.Context,
+	expCollections []export.Collectioner,
+	cfg control.ExportConfig,
+) (export.Collectioner, error) {
+	if len(expCollections) == 0 {
+		return nil, clues.New("no export collections provided")
+	}
+
+	var (
+		name      = "Corso_Export_" + dttm.FormatNow(dttm.HumanReadable)
+		newWriter func(io.Writer) (archiveWriter, error)
+	)
+
+	switch cfg.ArchiveFormat {
+	case control.DefaultArchiveFormat, control.ZipArchiveFormat:
+		name += ".zip"
+		newWriter = newZipWriter
+	case control.TarArchiveFormat:
+		name += ".tar"
+		newWriter = newTarWriter
+	case control.TarZstdArchiveFormat:
+		name += ".tar.zst"
+		newWriter = newTarZstdWriter
+	default:
+		return nil, clues.New("unsupported archive format").
+			With("archive_format", cfg.ArchiveFormat)
+	}
+
+	if cfg.ArchiveVolumeSize < 0 {
+		return nil, clues.New("invalid archive volume size").
+			With("archive_volume_size", cfg.ArchiveVolumeSize)
+	}
+
+	ch := make(chan export.Item)
+	vw := newVolumeWriter(ch, name, cfg.ArchiveVolumeSize)
+
+	go func() {
+		defer close(ch)
+
+		err := writeArchive(ctx, expCollections, vw, newWriter)
+		if err != nil {
+			vw.CloseWithError(err)
+			return
+		}
+
+		vw.Close()
+	}()
+
+	return archiveCollection{ch}, nil
+}
+
+func writeArchive(
+	ctx context.Context,
+	expCollections []export.Collectioner,
+	out io.Writer,
+	newWriter func(io.Writer) (archiveWriter, error),
+) error {
+	aw, err := newWriter(out)
+	if err != nil {
+		return clues.Wrap(err, "creating archive writer")
+	}
+
+	counted := 0
+	log := logger.Ctx(ctx).
+		With("collection_count", len(expCollections))
+
+	for _, ec := range expCollections {
+		folder := ec.BasePath()
+		items := ec.Items(ctx)
+
+		for item := range items {
+			counted++
+
+			// Log every 1000 items that are processed
+			if counted%1000 == 0 {
+				log.Infow("progress archiving export items", "count_items", counted)
+			}
+
+			err := item.Error
+			if err != nil {
+				return clues.Wrap(err, "getting export item").With("id", item.ID)
+			}
+
+			name := item.Name
+
+			// We assume folder and name to not contain any path separators.
+			// Also, this should always use `/` as this is
+			// created within an archive and not written to disk.
+			// TODO(meain): Exchange paths might contain a path
+			// separator and will have to have special handling.
+			err = aw.writeFile(path.Join(folder, name), item.Body)
+
+			item.Body.Close()
+
+			if err != nil {
+				return clues.Stack(err).With("name", name, "id", item.ID)
+			}
+		}
+	}
+
+	if err := aw.Close(); err != nil {
+		return clues.Wrap(err, "finalizing archive")
+	}
+
+	log.Infow("completed archiving export items", "count_items", counted)
+
+	return nil
+}
+
+// ---------------------------------------------------------------------------
+// volumes
+// ---------------------------------------------------------------------------
+
+// volumeWriter streams the archive as one or more export items.  Each
+// volume is a pipe which is handed to the consumer as soon as it is
+// created, and closed once it reaches the max volume size.
+type volumeWriter struct {
+	ch      chan<- export.Item
+	name    string
+	maxSize int64
+
+	current *io.PipeWriter
+	written int64
+	part    int
+}
+
+func newVolumeWriter(ch chan<- export.Item, name string, maxSize int64) *volumeWriter {
+	return &volumeWriter{
+		ch:      ch,
+		name:    name,
+		maxSize: maxSize,
+	}
+}
+
+func (vw *volumeWriter) nextVolume() {
+	reader, writer := io.Pipe()
+
+	vw.current = writer
+	vw.written = 0
+	vw.part++
+
+	name := vw.name
+	if vw.maxSize > 0 {
+		name = fmt.Sprintf("%s.%03d", vw.name, vw.part)
+	}
+
+	vw.ch <- export.Item{
+		ID:   name,
+		Name: name,
+		Body: reader,
+	}
+}
+
+func (vw *volumeWriter) Write(p []byte) (int, error) {
+	var total int
+
+	for len(p) > 0 {
+		if vw.current == nil {
+			vw.nextVolume()
+		}
+
+		chunk := p
+
+		if vw.maxSize > 0 && int64(len(chunk)) > vw.maxSize-vw.written {
+			chunk = chunk[:vw.maxSize-vw.written]
+		}
+
+		n, err := vw.current.Write(chunk)
+		total += n
+		vw.written += int64(n)
+
+		if err != nil {
+			return total, err
+		}
+
+		p = p[n:]
+
+		if vw.maxSize > 0 && vw.written >= vw.maxSize {
+			vw.current.Close()
+			vw.current = nil
+		}
+	}
+
+	return total, nil
+}
+
+// Close closes the current volume.  There is always at least one
+// volume, even if nothing was written.
+func (vw *volumeWriter) Close() {
+	if vw.current == nil && vw.part == 0 {
+		vw.nextVolume()
+	}
+
+	if vw.current != nil {
+		vw.current.Close()
+	}
+}
+
+// CloseWithError closes the current volume, causing reads from it to
+// fail with the given error.
+func (vw *volumeWriter) CloseWithError(err error) {
+	if vw.current == nil {
+		vw.nextVolume()
+	}
+
+	vw.current.CloseWithError(err)
+}
@@ -0,0 +1,276 @@
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/alcionai/clues"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/export"
+)
+
+type ArchiveUnitSuite struct {
+	tester.Suite
+}
+
+func TestArchiveUnitSuite(t *testing.T) {
+	suite.Run(t, &ArchiveUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+type expCol struct {
+	base  string
+	items []export.Item
+}
+
+func (ec expCol) BasePath() string { return ec.base }
+
+func (ec expCol) Items(ctx context.Context) <-chan export.Item {
+	ch := make(chan export.Item, len(ec.items))
+	defer close(ch)
+
+	for _, item := range ec.items {
+		ch <- item
+	}
+
+	return ch
+}
+
+func makeCollections() []export.Collectioner {
+	return []export.Collectioner{
+		expCol{
+			base: "",
+			items: []export.Item{
+				{
+					ID:   "id1",
+					Name: "small",
+					Body: io.NopCloser(strings.NewReader("small body")),
+				},
+			},
+		},
+		expCol{
+			base: "folder",
+			items: []export.Item{
+				{
+					ID:   "id2",
+					Name: "large",
+					// larger than the spool buffer so that it spills to disk
+					Body: io.NopCloser(bytes.NewReader(bytes.Repeat([]byte("a"), TarSpoolBufferSize+10))),
+				},
+			},
+		},
+	}
+}
+
+// readVolumes reads all the volumes from the collection, returning
+// their names and the concatenated archive.
+func readVolumes(ctx context.Context, t *testing.T, ec export.Collectioner) ([]string, []byte) {
+	var (
+		names []string
+		buf   = &bytes.Buffer{}
+	)
+
+	for item := range ec.Items(ctx) {
+		require.NoError(t, item.Error, clues.ToCore(item.Error))
+
+		names = append(names, item.Name)
+
+		_, err := io.Copy(buf, item.Body)
+		require.NoError(t, err, clues.ToCore(err))
+
+		item.Body.Close()
+	}
+
+	return names, buf.Bytes()
+}
+
+func readTar(t *testing.T, r io.Reader) map[string]int {
+	files := map[string]int{}
+	tr := tar.NewReader(r)
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+
+		require.NoError(t, err, clues.ToCore(err))
+
+		bs, err := io.ReadAll(tr)
+		require.NoError(t, err, clues.ToCore(err))
+
+		files[hdr.Name] = len(bs)
+	}
+
+	return files
+}
+
+func (suite *ArchiveUnitSuite) TestExportCollection_tar() {
+	expectFiles := map[string]int{
+		"small":        len("small body"),
+		"folder/large": TarSpoolBufferSize + 10,
+	}
+
+	table := []struct {
+		name         string
+		format       control.ArchiveFormatType
+		expectSuffix string
+		decompress   func(t *testing.T, bs []byte) io.Reader
+	}{
+		{
+			name:         "tar",
+			format:       control.TarArchiveFormat,
+			expectSuffix: ".tar",
+			decompress: func(t *testing.T, bs []byte) io.Reader {
+				return bytes.NewReader(bs)
+			},
+		},
+		{
+			name:         "tar.zst",
+			format:       control.TarZstdArchiveFormat,
+			expectSuffix: ".tar.zst",
+			decompress: func(t *testing.T, bs []byte) io.Reader {
+				zr, err := zstd.NewReader(bytes.NewReader(bs))
+				require.NoError(t, err, clues.ToCore(err))
+
+				return zr
+			},
+		},
+	}
+
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			ec, err := ExportCollection(
+				ctx,
+				makeCollections(),
+				control.ExportConfig{Archive: true, ArchiveFormat: test.format})
+			require.NoError(t, err, clues.ToCore(err))
+
+			names, bs := readVolumes(ctx, t, ec)
+			require.Len(t, names, 1)
+			assert.True(t, strings.HasPrefix(names[0], "Corso_Export_"), "name prefix")
+			assert.True(t, strings.HasSuffix(names[0], test.expectSuffix), "name suffix")
+
+			assert.Equal(t, expectFiles, readTar(t, test.decompress(t, bs)))
+		})
+	}
+}
+
+func (suite *ArchiveUnitSuite) TestExportCollection_volumes() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	volumeSize := int64(1024 * 1024)
+
+	ec, err := ExportCollection(
+		ctx,
+		makeCollections(),
+		control.ExportConfig{
+			Archive:           true,
+			ArchiveFormat:     control.TarArchiveFormat,
+			ArchiveVolumeSize: volumeSize,
+		})
+	require.NoError(t, err, clues.ToCore(err))
+
+	var (
+		names []string
+		sizes []int
+		buf   = &bytes.Buffer{}
+	)
+
+	for item := range ec.Items(ctx) {
+		require.NoError(t, item.Error, clues.ToCore(item.Error))
+
+		bs, err := io.ReadAll(item.Body)
+		require.NoError(t, err, clues.ToCore(err))
+
+		names = append(names, item.Name)
+		sizes = append(sizes, len(bs))
+		buf.Write(bs)
+	}
+
+	// 5MB of content plus headers and padding
+	require.Len(t, names, 6)
+
+	for i, name := range names {
+		assert.Truef(t, strings.HasSuffix(name, fmt.Sprintf(".tar.%03d", i+1)), "volume name %s", name)
+	}
+
+	for _, size := range sizes[:len(sizes)-1] {
+		assert.Equal(t, int(volumeSize), size)
+	}
+
+	assert.LessOrEqual(t, sizes[len(sizes)-1], int(volumeSize))
+
+	files := readTar(t, buf)
+	assert.Len(t, files, 2)
+}
+
+func (suite *ArchiveUnitSuite) TestExportCollection_itemError() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	colls := []export.Collectioner{
+		expCol{
+			items: []export.Item{
+				{
+					ID:    "id1",
+					Error: assert.AnError,
+				},
+			},
+		},
+	}
+
+	ec, err := ExportCollection(
+		ctx,
+		colls,
+		control.ExportConfig{
+			Archive:           true,
+			ArchiveFormat:     control.TarArchiveFormat,
+			ArchiveVolumeSize: 1024,
+		})
+	require.NoError(t, err, clues.ToCore(err))
+
+	count := 0
+
+	for item := range ec.Items(ctx) {
+		count++
+
+		_, err := io.ReadAll(item.Body)
+		assert.ErrorIs(t, err, assert.AnError, clues.ToCore(err))
+	}
+
+	assert.Equal(t, 1, count, "single volume")
+}
+
+func (suite *ArchiveUnitSuite) TestExportCollection_badFormat() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	_, err := ExportCollection(
+		ctx,
+		makeCollections(),
+		control.ExportConfig{Archive: true, ArchiveFormat: "rar"})
+	assert.Error(t, err, clues.ToCore(err))
+}
@@ -0,0 +1,126 @@
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"time"
+
+	"github.com/alcionai/clues"
+	"github.com/klauspost/compress/zstd"
+)
+
+// TarSpoolBufferSize is the largest file that is held in memory while
+// writing a tar archive.  Tar headers need the size of the file before
+// its content, so larger files are spooled to a temporary file first.
+const TarSpoolBufferSize = 5 * 1024 * 1024
+
+type tarWriter struct {
+	wr     *tar.Writer
+	closer io.Closer
+	buf    []byte
+}
+
+func newTarWriter(w io.Writer) (archiveWriter, error) {
+	return &tarWriter{
+		wr:  tar.NewWriter(w),
+		buf: make([]byte, TarSpoolBufferSize),
+	}, nil
+}
+
+func newTarZstdWriter(w io.Writer) (archiveWriter, error) {
+	zw, err := zstd.NewWriter(w)
+	if err != nil {
+		return nil, clues.Wrap(err, "creating zstd writer")
+	}
+
+	return &tarWriter{
+		wr:     tar.NewWriter(zw),
+		closer: zw,
+		buf:    make([]byte, TarSpoolBufferSize),
+	}, nil
+}
+
+func (tw *tarWriter) writeFile(name string, body io.Reader) error {
+	size, content, cleanup, err := spool(body, tw.buf)
+	if err != nil {
+		return err
+	}
+
+	defer cleanup()
+
+	hdr := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0o644,
+		Size:     size,
+		ModTime:  time.Now(),
+		// pax allows for long and non-ascii file names
+		Format: tar.FormatPAX,
+	}
+
+	if err := tw.wr.WriteHeader(hdr); err != nil {
+		return clues.Wrap(err, "writing tar header")
+	}
+
+	if _, err := io.Copy(tw.wr, content); err != nil {
+		return clues.Wrap(err, "writing tar entry")
+	}
+
+	return nil
+}
+
+func (tw *tarWriter) Close() error {
+	if err := tw.wr.Close(); err != nil {
+		return clues.Wrap(err, "closing tar writer")
+	}
+
+	if tw.closer != nil {
+		return clues.Wrap(tw.closer.Close(), "closing compressed writer").OrNil()
+	}
+
+	return nil
+}
+
+// spool reads the body to find its size.  Small bodies are kept in
+// buf, anything larger is copied to a temporary file.
+func spool(body io.Reader, buf []byte) (int64, io.Reader, func(), error) {
+	n, err := io.ReadFull(body, buf)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return int64(n), bytes.NewReader(buf[:n]), func() {}, nil
+	}
+
+	if err != nil {
+		return 0, nil, nil, clues.Wrap(err, "reading item")
+	}
+
+	f, err := os.CreateTemp("", "corso-export-*")
+	if err != nil {
+		return 0, nil, nil, clues.Wrap(err, "creating spool file")
+	}
+
+	cleanup := func() {
+		f.Close()
+		os.Remove(f.Name())
+	}
+
+	if _, err := f.Write(buf[:n]); err != nil {
+		cleanup()
+		return 0, nil, nil, clues.Wrap(err, "writing spool file")
+	}
+
+	size, err := io.Copy(f, body)
+	if err != nil {
+		cleanup()
+		return 0, nil, nil, clues.Wrap(err, "writing spool file")
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		cleanup()
+		return 0, nil, nil, clues.Wrap(err, "rewinding spool file")
+	}
+
+	return int64(n) + size, f, cleanup, nil
+}
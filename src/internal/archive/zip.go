@@ -4,13 +4,11 @@ import (
 	"archive/zip"
 	"context"
 	"io"
-	"path"
 
 	"github.com/alcionai/clues"
 
-	"github.com/alcionai/corso/src/pkg/dttm"
+	"github.com/alcionai/corso/src/pkg/control"
 	"github.com/alcionai/corso/src/pkg/export"
-	"github.com/alcionai/corso/src/pkg/logger"
 )
 
 const (
@@ -19,24 +17,34 @@ const (
 	ZipCopyBufferSize = 5 * 1024 * 1024
 )
 
-type zipCollection struct {
-	reader io.ReadCloser
+type zipWriter struct {
+	wr  *zip.Writer
+	buf []byte
 }
 
-func (z zipCollection) BasePath() string {
-	return ""
+func newZipWriter(w io.Writer) (archiveWriter, error) {
+	return &zipWriter{
+		wr:  zip.NewWriter(w),
+		buf: make([]byte, ZipCopyBufferSize),
+	}, nil
 }
 
-func (z zipCollection) Items(ctx context.Context) <-chan export.Item {
-	rc := make(chan export.Item, 1)
-	defer close(rc)
+func (zw *zipWriter) writeFile(name string, body io.Reader) error {
+	f, err := zw.wr.Create(name)
+	if err != nil {
+		return clues.Wrap(err, "creating zip entry")
+	}
 
-	rc <- export.Item{
-		Name: "Corso_Export_" + dttm.FormatNow(dttm.HumanReadable) + ".zip",
-		Body: z.reader,
+	_, err = io.CopyBuffer(f, body, zw.buf)
+	if err != nil {
+		return clues.Wrap(err, "writing zip entry")
 	}
 
-	return rc
+	return nil
+}
+
+func (zw *zipWriter) Close() error {
+	return clues.Stack(zw.wr.Close()).OrNil()
 }
 
 // ZipExportCollection takes a list of export collections and zips
@@ -45,67 +53,11 @@ func ZipExportCollection(
 	ctx context.Context,
 	expCollections []export.Collectioner,
 ) (export.Collectioner, error) {
-	if len(expCollections) == 0 {
-		return nil, clues.New("no export collections provided")
-	}
-
-	reader, writer := io.Pipe()
-	wr := zip.NewWriter(writer)
-
-	go func() {
-		defer writer.Close()
-		defer wr.Close()
-
-		buf := make([]byte, ZipCopyBufferSize)
-		counted := 0
-		log := logger.Ctx(ctx).
-			With("collection_count", len(expCollections))
-
-		for _, ec := range expCollections {
-			folder := ec.BasePath()
-			items := ec.Items(ctx)
-
-			for item := range items {
-				counted++
-
-				// Log every 1000 items that are processed
-				if counted%1000 == 0 {
-					log.Infow("progress zipping export items", "count_items", counted)
-				}
-
-				err := item.Error
-				if err != nil {
-					writer.CloseWithError(clues.Wrap(err, "getting export item").With("id", item.ID))
-					return
-				}
-
-				name := item.Name
-
-				// We assume folder and name to not contain any path separators.
-				// Also, this should always use `/` as this is
-				// created within a zip file and not written to disk.
-				// TODO(meain): Exchange paths might contain a path
-				// separator and will have to have special handling.
-
-				//nolint:forbidigo
-				f, err := wr.Create(path.Join(folder, name))
-				if err != nil {
-					writer.CloseWithError(clues.Wrap(err, "creating zip entry").With("name", name).With("id", item.ID))
-					return
-				}
-
-				_, err = io.CopyBuffer(f, item.Body, buf)
-				if err != nil {
-					writer.CloseWithError(clues.Wrap(err, "writing zip entry").With("name", name).With("id", item.ID))
-					return
-				}
-
-				item.Body.Close()
-			}
-		}
-
-		log.Infow("completed zipping export items", "count_items", counted)
-	}()
-
-	return zipCollection{reader}, nil
+	return ExportCollection(
+		ctx,
+		expCollections,
+		control.ExportConfig{
+			Archive:       true,
+			ArchiveFormat: control.ZipArchiveFormat,
+		})
 }
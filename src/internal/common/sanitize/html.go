@@ -0,0 +1,321 @@
+package sanitize
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// allowedHTMLElements are the elements kept in sanitized html.  True for
+// void elements, which have no content and no end tag.  Elements that
+// aren't allowed or dropped get replaced by their content.
+var allowedHTMLElements = map[string]bool{
+	"a":          false,
+	"abbr":       false,
+	"address":    false,
+	"article":    false,
+	"aside":      false,
+	"b":          false,
+	"bdi":        false,
+	"bdo":        false,
+	"blockquote": false,
+	"br":         true,
+	"caption":    false,
+	"center":     false,
+	"cite":       false,
+	"code":       false,
+	"col":        true,
+	"colgroup":   false,
+	"dd":         false,
+	"del":        false,
+	"details":    false,
+	"dfn":        false,
+	"div":        false,
+	"dl":         false,
+	"dt":         false,
+	"em":         false,
+	"figcaption": false,
+	"figure":     false,
+	"font":       false,
+	"footer":     false,
+	"h1":         false,
+	"h2":         false,
+	"h3":         false,
+	"h4":         false,
+	"h5":         false,
+	"h6":         false,
+	"header":     false,
+	"hr":         true,
+	"i":          false,
+	"img":        true,
+	"ins":        false,
+	"kbd":        false,
+	"li":         false,
+	"mark":       false,
+	"ol":         false,
+	"p":          false,
+	"pre":        false,
+	"q":          false,
+	"s":          false,
+	"samp":       false,
+	"section":    false,
+	"small":      false,
+	"span":       false,
+	"strike":     false,
+	"strong":     false,
+	"sub":        false,
+	"summary":    false,
+	"sup":        false,
+	"table":      false,
+	"tbody":      false,
+	"td":         false,
+	"tfoot":      false,
+	"th":         false,
+	"thead":      false,
+	"time":       false,
+	"tr":         false,
+	"tt":         false,
+	"u":          false,
+	"ul":         false,
+	"var":        false,
+	"wbr":        true,
+}
+
+// droppedHTMLElements are removed along with their content, since they
+// run scripts, embed other documents, or hold raw text.
+var droppedHTMLElements = map[string]struct{}{
+	"applet":    {},
+	"base":      {},
+	"button":    {},
+	"embed":     {},
+	"frame":     {},
+	"frameset":  {},
+	"head":      {},
+	"iframe":    {},
+	"input":     {},
+	"link":      {},
+	"math":      {},
+	"meta":      {},
+	"noembed":   {},
+	"noframes":  {},
+	"noscript":  {},
+	"object":    {},
+	"plaintext": {},
+	"script":    {},
+	"select":    {},
+	"style":     {},
+	"svg":       {},
+	"template":  {},
+	"textarea":  {},
+	"title":     {},
+	"xmp":       {},
+}
+
+// allowedHTMLAttributes are the attributes kept on allowed elements.
+var allowedHTMLAttributes = map[string]struct{}{
+	"align":       {},
+	"alt":         {},
+	"bgcolor":     {},
+	"border":      {},
+	"cellpadding": {},
+	"cellspacing": {},
+	"cite":        {},
+	"class":       {},
+	"color":       {},
+	"colspan":     {},
+	"datetime":    {},
+	"dir":         {},
+	"face":        {},
+	"height":      {},
+	"href":        {},
+	"lang":        {},
+	"name":        {},
+	"nowrap":      {},
+	"rel":         {},
+	"rowspan":     {},
+	"scope":       {},
+	"size":        {},
+	"span":        {},
+	"src":         {},
+	"start":       {},
+	"style":       {},
+	"target":      {},
+	"title":       {},
+	"type":        {},
+	"valign":      {},
+	"value":       {},
+	"width":       {},
+}
+
+// urlHTMLAttributes hold urls, which are only kept when they use a
+// scheme from allowedURLSchemes.
+var urlHTMLAttributes = map[string]struct{}{
+	"cite": {},
+	"href": {},
+	"src":  {},
+}
+
+var allowedURLSchemes = map[string]struct{}{
+	// content ids reference inline attachments in message bodies.
+	"cid":    {},
+	"http":   {},
+	"https":  {},
+	"mailto": {},
+	"tel":    {},
+}
+
+// HTML rebuilds the html content from an allow-list of elements and
+// attributes, so that it can be opened safely in a browser.  Scripts,
+// styles, embedded documents, and foreign content such as svg are
+// dropped, along with event handlers and urls that could run scripts.
+// Unknown elements are replaced by their content, and all text is
+// escaped.  The content is parsed as the body of a document, so any
+// html, head, and body tags are removed.
+func HTML(content string) string {
+	nodes, err := html.ParseFragment(
+		strings.NewReader(content),
+		&html.Node{
+			Type:     html.ElementNode,
+			Data:     "body",
+			DataAtom: atom.Body,
+		})
+	if err != nil {
+		// the reader can't fail, but if it ever did, the
+		// escaped content is still safe to display.
+		return html.EscapeString(content)
+	}
+
+	buf := &strings.Builder{}
+
+	for _, n := range nodes {
+		writeHTMLNode(buf, n)
+	}
+
+	return buf.String()
+}
+
+func writeHTMLNode(buf *strings.Builder, n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		buf.WriteString(html.EscapeString(n.Data))
+		return
+
+	case html.ElementNode:
+		// foreign content (svg, mathml) is parsed with its own rules.
+		if len(n.Namespace) > 0 {
+			return
+		}
+
+		name := strings.ToLower(n.Data)
+
+		if _, ok := droppedHTMLElements[name]; ok {
+			return
+		}
+
+		void, ok := allowedHTMLElements[name]
+		if !ok {
+			writeHTMLChildren(buf, n)
+			return
+		}
+
+		buf.WriteString("<" + name)
+
+		for _, a := range n.Attr {
+			if !allowedAttr(a) {
+				continue
+			}
+
+			buf.WriteString(" " + strings.ToLower(a.Key) + `="` + html.EscapeString(a.Val) + `"`)
+		}
+
+		buf.WriteString(">")
+
+		if void {
+			return
+		}
+
+		writeHTMLChildren(buf, n)
+		buf.WriteString("</" + name + ">")
+
+	case html.DocumentNode:
+		writeHTMLChildren(buf, n)
+	}
+
+	// comments and doctypes are dropped.
+}
+
+func writeHTMLChildren(buf *strings.Builder, n *html.Node) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		writeHTMLNode(buf, c)
+	}
+}
+
+// allowedAttr returns true if the attribute is on the allow-list, and
+// can't run scripts.
+func allowedAttr(a html.Attribute) bool {
+	if len(a.Namespace) > 0 {
+		return false
+	}
+
+	key := strings.ToLower(a.Key)
+
+	if _, ok := allowedHTMLAttributes[key]; !ok {
+		return false
+	}
+
+	if _, ok := urlHTMLAttributes[key]; ok {
+		return allowedURL(key, a.Val)
+	}
+
+	if key == "style" {
+		return allowedStyle(a.Val)
+	}
+
+	return true
+}
+
+// allowedURL returns true if the url is relative, or uses an allowed
+// scheme.  Data urls are only allowed as the source of an image, where
+// they hold inline images.
+func allowedURL(attr, url string) bool {
+	// browsers ignore whitespace and control characters within the scheme.
+	url = strings.Map(
+		func(r rune) rune {
+			if r <= ' ' {
+				return -1
+			}
+
+			return unicode.ToLower(r)
+		},
+		url)
+
+	i := strings.IndexAny(url, ":/?#")
+	if i < 0 || url[i] != ':' {
+		return true
+	}
+
+	if url[:i] == "data" {
+		return attr == "src" && strings.HasPrefix(url, "data:image/")
+	}
+
+	_, ok := allowedURLSchemes[url[:i]]
+
+	return ok
+}
+
+// allowedStyle returns false for inline styles which could load scripts
+// in older browsers.  Css escapes are rejected as well, since they could
+// hide any of them.
+func allowedStyle(style string) bool {
+	style = strings.ToLower(style)
+
+	for _, s := range []string{"\\", "expression", "javascript:", "vbscript:", "behavior", "-moz-binding"} {
+		if strings.Contains(style, s) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,113 @@
+package sanitize_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/common/sanitize"
+	"github.com/alcionai/corso/src/internal/tester"
+)
+
+type SanitizeHTMLUnitSuite struct {
+	tester.Suite
+}
+
+func TestSanitizeHTMLUnitSuite(t *testing.T) {
+	suite.Run(t, &SanitizeHTMLUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func (suite *SanitizeHTMLUnitSuite) TestHTML() {
+	table := []struct {
+		name   string
+		input  string
+		expect string
+	}{
+		{
+			name:   "unchanged",
+			input:  `<div class="x"><p>hi <b>there</b></p><img src="data:image/png;base64,AA=="></div>`,
+			expect: `<div class="x"><p>hi <b>there</b></p><img src="data:image/png;base64,AA=="></div>`,
+		},
+		{
+			name:   "script",
+			input:  `<p>a</p><script>alert("<p>x</p>")</script><p>b</p>`,
+			expect: `<p>a</p><p>b</p>`,
+		},
+		{
+			name:   "nested dropped elements",
+			input:  `<object><object><p>x</p></object><p>y</p></object><p>z</p>`,
+			expect: `<p>z</p>`,
+		},
+		{
+			name:   "document",
+			input:  `<html><head><meta http-equiv="refresh" content="0;url=x"><base href="x"><style>p{}</style></head><body><p>a</p></body></html>`,
+			expect: `<p>a</p>`,
+		},
+		{
+			name:   "event handlers",
+			input:  `<img src="a.png" onerror="alert(1)"><div OnClick="x" title="t">a</div>`,
+			expect: `<img src="a.png"><div title="t">a</div>`,
+		},
+		{
+			name:   "script urls",
+			input:  `<a href=" java&#x09;script:alert(1)">a</a><a href="data:text/html,x">b</a><a href="https://x">c</a>`,
+			expect: `<a>a</a><a>b</a><a href="https://x">c</a>`,
+		},
+		{
+			name:   "relative urls",
+			input:  `<a href="/sites/x/page.aspx?a=b#c">a</a><a href="#top">b</a><a href="mailto:a@b.c">c</a>`,
+			expect: `<a href="/sites/x/page.aspx?a=b#c">a</a><a href="#top">b</a><a href="mailto:a@b.c">c</a>`,
+		},
+		{
+			name:   "iframe",
+			input:  `<iframe srcdoc="<script>x</script>"></iframe><p>a</p>`,
+			expect: `<p>a</p>`,
+		},
+		{
+			name:   "raw text in svg",
+			input:  `<svg><style><img src=x onerror=alert(1)></style></svg><p>a</p>`,
+			expect: `<p>a</p>`,
+		},
+		{
+			name:   "raw text in math",
+			input:  `<math><mtext><table><mglyph><style><img src=x onerror=alert(1)></style></mglyph></table></mtext></math>`,
+			expect: ``,
+		},
+		{
+			name:   "style",
+			input:  `<style><img src=x onerror=alert(1)></style><p>a</p>`,
+			expect: `<p>a</p>`,
+		},
+		{
+			name:   "unknown elements keep their content",
+			input:  `<o:p>a</o:p><custom-tag x="y"><b>b</b></custom-tag>`,
+			expect: `a<b>b</b>`,
+		},
+		{
+			name:   "unknown attributes",
+			input:  `<p data-x="y" xmlns:o="z" style="color: red">a</p>`,
+			expect: `<p style="color: red">a</p>`,
+		},
+		{
+			name:   "script styles",
+			input:  `<p style="width: expression(alert(1))">a</p><p style="background: url(\6a avascript:x)">b</p>`,
+			expect: `<p>a</p><p>b</p>`,
+		},
+		{
+			name:   "text is escaped",
+			input:  `<p>a &lt;img src=x onerror=alert(1)&gt; &amp; b</p><!-- <script>x</script> -->`,
+			expect: `<p>a &lt;img src=x onerror=alert(1)&gt; &amp; b</p>`,
+		},
+		{
+			name:   "attribute values are escaped",
+			input:  `<a title="&quot;&gt;<script>x</script>">a</a>`,
+			expect: `<a title="&#34;&gt;&lt;script&gt;x&lt;/script&gt;">a</a>`,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			assert.Equal(suite.T(), test.expect, sanitize.HTML(test.input))
+		})
+	}
+}
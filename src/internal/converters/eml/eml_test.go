@@ -488,3 +488,117 @@ func (suite *EMLUnitSuite) TestConvert_message_in_messageble_to_eml() {
 	// Known from testdata
 	assert.Contains(t, string(iattachments[0].Content), "X-LIC-LOCATION:Africa/Abidjan")
 }
+
+func (suite *EMLUnitSuite) TestConvert_eml_to_messageable() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	body := []byte(testdata.EmailWithAttachments)
+
+	out, err := FromJSON(ctx, body)
+	require.NoError(t, err, "converting to eml")
+
+	msg, err := api.BytesToMessageable(body)
+	require.NoError(t, err, "creating message")
+
+	rmsg, err := ToMessageable(ctx, []byte(out))
+	require.NoError(t, err, "converting eml to messageable")
+
+	assert.Equal(t, ptr.Val(msg.GetSubject()), ptr.Val(rmsg.GetSubject()))
+	assert.True(t, msg.GetSentDateTime().Equal(ptr.Val(rmsg.GetSentDateTime())), "sent time")
+	assert.Equal(
+		t,
+		ptr.Val(msg.GetFrom().GetEmailAddress().GetAddress()),
+		ptr.Val(rmsg.GetFrom().GetEmailAddress().GetAddress()))
+
+	checkRecipients := func(expect, got []models.Recipientable, msg string) {
+		require.Len(t, got, len(expect), msg)
+
+		for i := range expect {
+			assert.Equal(
+				t,
+				ptr.Val(expect[i].GetEmailAddress().GetAddress()),
+				ptr.Val(got[i].GetEmailAddress().GetAddress()),
+				msg)
+		}
+	}
+
+	checkRecipients(msg.GetToRecipients(), rmsg.GetToRecipients(), "to")
+	checkRecipients(msg.GetCcRecipients(), rmsg.GetCcRecipients(), "cc")
+	checkRecipients(msg.GetBccRecipients(), rmsg.GetBccRecipients(), "bcc")
+
+	assert.Equal(t, models.HTML_BODYTYPE, ptr.Val(rmsg.GetBody().GetContentType()))
+
+	source := strings.ReplaceAll(ptr.Val(rmsg.GetBody().GetContent()), "\n", "")
+	target := strings.ReplaceAll(ptr.Val(msg.GetBody().GetContent()), "\n", "")
+
+	// replace the cid with a constant value to make the comparison
+	re := regexp.MustCompile(`src="cid:[^"]*"`)
+	source = re.ReplaceAllString(source, `src="cid:replaced"`)
+	target = re.ReplaceAllString(target, `src="cid:replaced"`)
+
+	assert.Equal(t, target, source)
+
+	attachments := rmsg.GetAttachments()
+	require.Len(t, attachments, len(msg.GetAttachments()), "attachment count")
+
+	inline := 0
+
+	for _, att := range attachments {
+		fa, ok := att.(models.FileAttachmentable)
+		require.True(t, ok, "file attachment")
+
+		if ptr.Val(fa.GetIsInline()) {
+			inline++
+
+			assert.NotEmpty(t, ptr.Val(fa.GetContentId()), "inline attachment content id")
+		}
+
+		assert.NotEmpty(t, fa.GetContentBytes(), "attachment content")
+	}
+
+	assert.Equal(t, 1, inline, "inline attachment count")
+}
+
+func (suite *EMLUnitSuite) TestConvert_eml_with_message_to_messageable() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	body := []byte(testdata.EmailWithinEmail)
+
+	out, err := FromJSON(ctx, body)
+	require.NoError(t, err, "converting to eml")
+
+	msg, err := api.BytesToMessageable(body)
+	require.NoError(t, err, "creating message")
+
+	rmsg, err := ToMessageable(ctx, []byte(out))
+	require.NoError(t, err, "converting eml to messageable")
+
+	assert.Equal(t, ptr.Val(msg.GetSubject()), ptr.Val(rmsg.GetSubject()))
+
+	attachments := rmsg.GetAttachments()
+	require.Len(t, attachments, 3, "attachment count in parent email")
+
+	ia, ok := attachments[0].(models.ItemAttachmentable)
+	require.True(t, ok, "item attachment")
+
+	itm, err := msg.GetAttachments()[0].GetBackingStore().Get("item")
+	require.NoError(t, err, "getting item from message")
+
+	imsg := itm.(*models.Message)
+
+	rimsg, ok := ia.GetItem().(models.Messageable)
+	require.True(t, ok, "attached item is a message")
+
+	assert.Equal(t, ptr.Val(imsg.GetSubject()), ptr.Val(rimsg.GetSubject()))
+	assert.Equal(
+		t,
+		ptr.Val(imsg.GetFrom().GetEmailAddress().GetAddress()),
+		ptr.Val(rimsg.GetFrom().GetEmailAddress().GetAddress()))
+	assert.Len(t, rimsg.GetAttachments(), 1, "attachment count in child email")
+}
@@ -0,0 +1,205 @@
+package eml
+
+import (
+	"bytes"
+	"context"
+	"net/mail"
+
+	"github.com/alcionai/clues"
+	"github.com/jhillyerd/enmime"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+)
+
+// This file handles the reverse of eml.go, converting an .eml file
+// back into a graph message.
+
+func toRecipients(addrs []*mail.Address) []models.Recipientable {
+	recipients := make([]models.Recipientable, 0, len(addrs))
+
+	for _, addr := range addrs {
+		email := models.NewEmailAddress()
+		email.SetAddress(ptr.To(addr.Address))
+
+		if len(addr.Name) > 0 {
+			email.SetName(ptr.To(addr.Name))
+		}
+
+		recipient := models.NewRecipient()
+		recipient.SetEmailAddress(email)
+
+		recipients = append(recipients, recipient)
+	}
+
+	return recipients
+}
+
+func getRecipients(ctx context.Context, env *enmime.Envelope, header string) ([]models.Recipientable, error) {
+	if len(env.GetHeader(header)) == 0 {
+		return nil, nil
+	}
+
+	addrs, err := env.AddressList(header)
+	if err != nil {
+		return nil, clues.WrapWC(ctx, err, "parsing address list").With("header", header)
+	}
+
+	return toRecipients(addrs), nil
+}
+
+func toAttachment(ctx context.Context, part *enmime.Part, inline bool) (models.Attachmentable, error) {
+	name := part.FileName
+	if len(name) == 0 {
+		name = "Unnamed"
+	}
+
+	if part.ContentType == "message/rfc822" {
+		msg, err := ToMessageable(ctx, part.Content)
+		if err != nil {
+			return nil, clues.Wrap(err, "converting attached email")
+		}
+
+		attachment := models.NewItemAttachment()
+		attachment.SetName(ptr.To(name))
+		attachment.SetContentType(ptr.To(part.ContentType))
+		attachment.SetItem(msg)
+
+		return attachment, nil
+	}
+
+	attachment := models.NewFileAttachment()
+	attachment.SetName(ptr.To(name))
+	attachment.SetContentType(ptr.To(part.ContentType))
+	attachment.SetContentBytes(part.Content)
+	attachment.SetSize(ptr.To(int32(len(part.Content))))
+	attachment.SetIsInline(ptr.To(inline))
+
+	if len(part.ContentID) > 0 {
+		attachment.SetContentId(ptr.To(part.ContentID))
+	}
+
+	return attachment, nil
+}
+
+// ToMessageable converts an .eml file into a Messageable.
+func ToMessageable(ctx context.Context, body []byte) (models.Messageable, error) {
+	ctx = clues.Add(ctx, "body_len", len(body))
+
+	env, err := enmime.ReadEnvelope(bytes.NewReader(body))
+	if err != nil {
+		return nil, clues.WrapWC(ctx, err, "reading eml")
+	}
+
+	msg := models.NewMessage()
+
+	from, err := getRecipients(ctx, env, "From")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(from) > 0 {
+		msg.SetFrom(from[0])
+		msg.SetSender(from[0])
+	}
+
+	to, err := getRecipients(ctx, env, "To")
+	if err != nil {
+		return nil, err
+	}
+
+	msg.SetToRecipients(to)
+
+	cc, err := getRecipients(ctx, env, "Cc")
+	if err != nil {
+		return nil, err
+	}
+
+	msg.SetCcRecipients(cc)
+
+	bcc, err := getRecipients(ctx, env, "Bcc")
+	if err != nil {
+		return nil, err
+	}
+
+	msg.SetBccRecipients(bcc)
+
+	replyTo, err := getRecipients(ctx, env, "Reply-To")
+	if err != nil {
+		return nil, err
+	}
+
+	msg.SetReplyTo(replyTo)
+
+	subject := env.GetHeader("Subject")
+	if len(subject) > 0 {
+		msg.SetSubject(ptr.To(subject))
+	}
+
+	if len(env.GetHeader("Date")) > 0 {
+		date, err := env.Date()
+		if err != nil {
+			return nil, clues.WrapWC(ctx, err, "parsing date")
+		}
+
+		msg.SetSentDateTime(ptr.To(date))
+		msg.SetReceivedDateTime(ptr.To(date))
+	}
+
+	itemBody := models.NewItemBody()
+
+	if len(env.HTML) > 0 {
+		itemBody.SetContentType(ptr.To(models.HTML_BODYTYPE))
+		itemBody.SetContent(ptr.To(env.HTML))
+	} else {
+		itemBody.SetContentType(ptr.To(models.TEXT_BODYTYPE))
+		itemBody.SetContent(ptr.To(env.Text))
+	}
+
+	msg.SetBody(itemBody)
+
+	attachments := []models.Attachmentable{}
+
+	addParts := func(parts []*enmime.Part, inline bool) error {
+		for _, part := range parts {
+			attachment, err := toAttachment(ctx, part, inline)
+			if err != nil {
+				return err
+			}
+
+			attachments = append(attachments, attachment)
+		}
+
+		return nil
+	}
+
+	if err := addParts(env.Attachments, false); err != nil {
+		return nil, clues.Wrap(err, "converting attachments")
+	}
+
+	if err := addParts(env.Inlines, true); err != nil {
+		return nil, clues.Wrap(err, "converting inline attachments")
+	}
+
+	// multipart/related content (ex: images referenced by cid from the
+	// html body) does not always come with a content disposition.  The
+	// calendar data that FromMessageable adds as an alternative body for
+	// event messages will also show up here, but we do not have enough
+	// information to rebuild the eventMessage and so it is left out.
+	related := []*enmime.Part{}
+
+	for _, part := range env.OtherParts {
+		if len(part.ContentID) > 0 {
+			related = append(related, part)
+		}
+	}
+
+	if err := addParts(related, true); err != nil {
+		return nil, clues.Wrap(err, "converting related attachments")
+	}
+
+	msg.SetAttachments(attachments)
+	msg.SetHasAttachments(ptr.To(len(attachments) > 0))
+
+	return msg, nil
+}
@@ -20,6 +20,26 @@ var (
 		"friday":    "FR",
 		"saturday":  "SA",
 	}
+
+	// Map from iCal recurrence index to Graph API recurrence type
+	ICalToGraphIndex = map[int]string{
+		1:  "first",
+		2:  "second",
+		3:  "third",
+		4:  "fourth",
+		-1: "last",
+	}
+
+	// Map from iCal day of week representation to Graph API day of week representation
+	ICalToGraphDOW = map[string]string{
+		"SU": "sunday",
+		"MO": "monday",
+		"TU": "tuesday",
+		"WE": "wednesday",
+		"TH": "thursday",
+		"FR": "friday",
+		"SA": "saturday",
+	}
 )
 
 // Map from Window time zone to TZ database time zone
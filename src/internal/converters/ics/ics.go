@@ -457,50 +457,8 @@ func updateEventProperties(ctx context.Context, event models.Eventable, iCalEven
 	}
 
 	// DESCRIPTION - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.1.5
-	if event.GetBody() != nil {
-		description := ptr.Val(event.GetBody().GetContent())
-		contentType := event.GetBody().GetContentType().String()
-
-		if len(description) > 0 && contentType == "text" {
-			iCalEvent.SetDescription(description)
-		} else if len(description) > 0 {
-			if contentType == "html" {
-				// If we have html, we have two routes. If we don't have
-				// UTF-8, then we can do an exact reproduction of the
-				// original data in outlook by using X-ALT-DESC field and
-				// using the html there. But if we have UTF-8, then we
-				// have to use DESCRIPTION field and use the content
-				// stripped of html there. This because even though the
-				// field technically supports UTF-8, Outlook does not
-				// seem to work with it.  Exchange does similar things
-				// when it attaches the event to an email.
-
-				// nolint:lll
-				// https://learn.microsoft.com/en-us/openspecs/exchange_server_protocols/ms-oxcical/d7f285da-9c7a-4597-803b-b74193c898a8
-				// X-ALT-DESC field uses "Text" as in https://www.rfc-editor.org/rfc/rfc2445#section-4.3.11
-				if isASCII(description) {
-					// https://stackoverflow.com/a/859475
-					replacer := strings.NewReplacer("\r\n", "\\n", "\n", "\\n")
-					desc := replacer.Replace(description)
-					iCalEvent.AddProperty("X-ALT-DESC", desc, ics.WithFmtType("text/html"))
-				} else {
-					// Disable auto wrap, causes huge memory spikes
-					// https://github.com/jaytaylor/html2text/issues/48
-					prettyTablesOptions := html2text.NewPrettyTablesOptions()
-					prettyTablesOptions.AutoWrapText = false
-
-					stripped, err := html2text.FromString(
-						description,
-						html2text.Options{PrettyTables: true, PrettyTablesOptions: prettyTablesOptions})
-					if err != nil {
-						return clues.Wrap(err, "converting html to text").
-							With("description_length", len(description))
-					}
-
-					iCalEvent.SetDescription(stripped)
-				}
-			}
-		}
+	if err := addDescription(&iCalEvent.ComponentBase, event.GetBody()); err != nil {
+		return err
 	}
 
 	// TRANSP - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.2.7
@@ -757,6 +715,61 @@ func addTime(iCalEvent *ics.VEvent, prop ics.ComponentProperty, tm time.Time, al
 	}
 }
 
+// addDescription sets the DESCRIPTION (or X-ALT-DESC, for ascii html) of the
+// component from the item body.
+// https://www.rfc-editor.org/rfc/rfc5545#section-3.8.1.5
+func addDescription(cb *ics.ComponentBase, body models.ItemBodyable) error {
+	if body == nil {
+		return nil
+	}
+
+	description := ptr.Val(body.GetContent())
+	contentType := body.GetContentType().String()
+
+	if len(description) > 0 && contentType == "text" {
+		cb.SetDescription(description)
+	} else if len(description) > 0 {
+		if contentType == "html" {
+			// If we have html, we have two routes. If we don't have
+			// UTF-8, then we can do an exact reproduction of the
+			// original data in outlook by using X-ALT-DESC field and
+			// using the html there. But if we have UTF-8, then we
+			// have to use DESCRIPTION field and use the content
+			// stripped of html there. This because even though the
+			// field technically supports UTF-8, Outlook does not
+			// seem to work with it.  Exchange does similar things
+			// when it attaches the event to an email.
+
+			// nolint:lll
+			// https://learn.microsoft.com/en-us/openspecs/exchange_server_protocols/ms-oxcical/d7f285da-9c7a-4597-803b-b74193c898a8
+			// X-ALT-DESC field uses "Text" as in https://www.rfc-editor.org/rfc/rfc2445#section-4.3.11
+			if isASCII(description) {
+				// https://stackoverflow.com/a/859475
+				replacer := strings.NewReplacer("\r\n", "\\n", "\n", "\\n")
+				desc := replacer.Replace(description)
+				cb.AddProperty("X-ALT-DESC", desc, ics.WithFmtType("text/html"))
+			} else {
+				// Disable auto wrap, causes huge memory spikes
+				// https://github.com/jaytaylor/html2text/issues/48
+				prettyTablesOptions := html2text.NewPrettyTablesOptions()
+				prettyTablesOptions.AutoWrapText = false
+
+				stripped, err := html2text.FromString(
+					description,
+					html2text.Options{PrettyTables: true, PrettyTablesOptions: prettyTablesOptions})
+				if err != nil {
+					return clues.Wrap(err, "converting html to text").
+						With("description_length", len(description))
+				}
+
+				cb.SetDescription(stripped)
+			}
+		}
+	}
+
+	return nil
+}
+
 func getCancelledDates(ctx context.Context, event models.Eventable) ([]time.Time, error) {
 	dateStrings, err := api.GetCancelledEventDateStrings(event)
 	if err != nil {
@@ -24,6 +24,7 @@ import (
 	"github.com/alcionai/corso/src/internal/common/ptr"
 	"github.com/alcionai/corso/src/internal/converters/ics/tzdata"
 	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
 )
 
 type ICSUnitSuite struct {
@@ -1624,3 +1625,221 @@ func (s *ICSUnitSuite) TestGetTZDataKeyValues() {
 		})
 	}
 }
+
+func (s *ICSUnitSuite) TestICSToEventable() {
+	t := s.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	e := baseEvent()
+
+	start := getDateTimeZone(time.Date(2021, 1, 4, 9, 0, 0, 0, time.UTC), "Pacific Standard Time")
+	start.SetDateTime(ptr.To("2021-01-04T09:00:00.0000000"))
+	end := getDateTimeZone(time.Date(2021, 1, 4, 10, 0, 0, 0, time.UTC), "Pacific Standard Time")
+	end.SetDateTime(ptr.To("2021-01-04T10:00:00.0000000"))
+
+	e.SetStart(start)
+	e.SetEnd(end)
+
+	pattern := models.NewRecurrencePattern()
+	pattern.SetTypeEscaped(ptr.To(models.RELATIVEMONTHLY_RECURRENCEPATTERNTYPE))
+	pattern.SetInterval(ptr.To(int32(2)))
+	pattern.SetIndex(ptr.To(models.FIRST_WEEKINDEX))
+	pattern.SetDaysOfWeek([]models.DayOfWeek{models.MONDAY_DAYOFWEEK})
+	pattern.SetFirstDayOfWeek(ptr.To(models.SUNDAY_DAYOFWEEK))
+
+	rrange := models.NewRecurrenceRange()
+	rrange.SetTypeEscaped(ptr.To(models.ENDDATE_RECURRENCERANGETYPE))
+	rrange.SetStartDate(serialization.NewDateOnly(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)))
+	rrange.SetEndDate(serialization.NewDateOnly(time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC)))
+	rrange.SetRecurrenceTimeZone(ptr.To("Pacific Standard Time"))
+
+	recurrence := models.NewPatternedRecurrence()
+	recurrence.SetPattern(pattern)
+	recurrence.SetRangeEscaped(rrange)
+	e.SetRecurrence(recurrence)
+
+	body := models.NewItemBody()
+	body.SetContentType(ptr.To(models.HTML_BODYTYPE))
+	body.SetContent(ptr.To("<html><body>\n<p>body, with; specials</p>\n</body></html>"))
+	e.SetBody(body)
+
+	e.SetCategories([]string{"one", "two"})
+	e.SetShowAs(ptr.To(models.FREE_FREEBUSYSTATUS))
+	e.SetSensitivity(ptr.To(models.PRIVATE_SENSITIVITY))
+	e.SetImportance(ptr.To(models.HIGH_IMPORTANCE))
+
+	loc := models.NewLocation()
+	loc.SetDisplayName(ptr.To("Conference room"))
+	e.SetLocation(loc)
+
+	organizer := models.NewRecipient()
+	oaddr := models.NewEmailAddress()
+	oaddr.SetName(ptr.To("Organizer"))
+	oaddr.SetAddress(ptr.To("organizer@example.com"))
+	organizer.SetEmailAddress(oaddr)
+	e.SetOrganizer(organizer)
+
+	attendee := models.NewAttendee()
+	aaddr := models.NewEmailAddress()
+	aaddr.SetName(ptr.To("Attendee, One"))
+	aaddr.SetAddress(ptr.To("attendee@example.com"))
+	attendee.SetEmailAddress(aaddr)
+	attendee.SetTypeEscaped(ptr.To(models.OPTIONAL_ATTENDEETYPE))
+
+	status := models.NewResponseStatus()
+	status.SetResponse(ptr.To(models.TENTATIVELYACCEPTED_RESPONSETYPE))
+	attendee.SetStatus(status)
+	e.SetAttendees([]models.Attendeeable{attendee})
+
+	att := models.NewFileAttachment()
+	att.SetName(ptr.To("file.txt"))
+	att.SetContentType(ptr.To("text/plain"))
+	att.SetContentBytes([]byte("content"))
+	att.SetIsInline(ptr.To(true))
+	att.SetContentId(ptr.To("cid1"))
+	e.SetAttachments([]models.Attachmentable{att})
+
+	exception := baseEvent()
+	exception.SetSubject(ptr.To("Exception"))
+	exception.SetOriginalStart(ptr.To(time.Date(2021, 3, 1, 17, 0, 0, 0, time.UTC)))
+	exception.SetStart(getDateTimeZone(time.Date(2021, 3, 1, 18, 0, 0, 0, time.UTC), "UTC"))
+	exception.SetEnd(getDateTimeZone(time.Date(2021, 3, 1, 19, 0, 0, 0, time.UTC), "UTC"))
+
+	parsed, err := eventToMap(exception)
+	require.NoError(t, err, "parsing exception")
+
+	e.SetAdditionalData(map[string]any{
+		"cancelledOccurrences": []string{"OID.DEADBEEF=.2021-05-03"},
+		"exceptionOccurrences": []map[string]any{parsed},
+	})
+
+	bts, err := eventToJSON(e)
+	require.NoError(t, err, "getting serialized content")
+
+	out, err := FromJSON(ctx, bts)
+	require.NoError(t, err, "converting to ics")
+
+	revent, err := ToEventable(ctx, []byte(out))
+	require.NoError(t, err, "converting from ics")
+
+	assert.Equal(t, "Subject", ptr.Val(revent.GetSubject()), "subject")
+	assert.Equal(t, "Pacific Standard Time", ptr.Val(revent.GetStart().GetTimeZone()), "start timezone")
+	assert.Equal(t, "2021-01-04T09:00:00.0000000", ptr.Val(revent.GetStart().GetDateTime()), "start time")
+	assert.Equal(t, "2021-01-04T10:00:00.0000000", ptr.Val(revent.GetEnd().GetDateTime()), "end time")
+	assert.False(t, ptr.Val(revent.GetIsAllDay()), "all day")
+
+	rpattern := revent.GetRecurrence().GetPattern()
+	assert.Equal(t, models.RELATIVEMONTHLY_RECURRENCEPATTERNTYPE, ptr.Val(rpattern.GetTypeEscaped()), "recurrence type")
+	assert.Equal(t, int32(2), ptr.Val(rpattern.GetInterval()), "recurrence interval")
+	assert.Equal(t, models.FIRST_WEEKINDEX, ptr.Val(rpattern.GetIndex()), "recurrence index")
+	assert.Equal(t, []models.DayOfWeek{models.MONDAY_DAYOFWEEK}, rpattern.GetDaysOfWeek(), "recurrence days")
+	assert.Equal(t, models.SUNDAY_DAYOFWEEK, ptr.Val(rpattern.GetFirstDayOfWeek()), "recurrence first day")
+
+	rrrange := revent.GetRecurrence().GetRangeEscaped()
+	assert.Equal(t, models.ENDDATE_RECURRENCERANGETYPE, ptr.Val(rrrange.GetTypeEscaped()), "range type")
+	assert.Equal(t, "2021-01-04", rrrange.GetStartDate().String(), "range start")
+	assert.Equal(t, "2021-12-31", rrrange.GetEndDate().String(), "range end")
+	assert.Equal(t, "Pacific Standard Time", ptr.Val(rrrange.GetRecurrenceTimeZone()), "range timezone")
+
+	assert.Equal(t, models.HTML_BODYTYPE, ptr.Val(revent.GetBody().GetContentType()), "body type")
+	assert.Equal(t, ptr.Val(body.GetContent()), ptr.Val(revent.GetBody().GetContent()), "body")
+	assert.Equal(t, []string{"one", "two"}, revent.GetCategories(), "categories")
+	assert.Equal(t, models.FREE_FREEBUSYSTATUS, ptr.Val(revent.GetShowAs()), "show as")
+	assert.Equal(t, models.PRIVATE_SENSITIVITY, ptr.Val(revent.GetSensitivity()), "sensitivity")
+	assert.Equal(t, models.HIGH_IMPORTANCE, ptr.Val(revent.GetImportance()), "importance")
+	assert.Equal(t, "Conference room", ptr.Val(revent.GetLocation().GetDisplayName()), "location")
+
+	assert.Equal(t, "Organizer", ptr.Val(revent.GetOrganizer().GetEmailAddress().GetName()), "organizer name")
+	assert.Equal(
+		t,
+		"organizer@example.com",
+		ptr.Val(revent.GetOrganizer().GetEmailAddress().GetAddress()),
+		"organizer address")
+
+	require.Len(t, revent.GetAttendees(), 1, "attendees")
+	rattendee := revent.GetAttendees()[0]
+	assert.Equal(t, "Attendee, One", ptr.Val(rattendee.GetEmailAddress().GetName()), "attendee name")
+	assert.Equal(t, "attendee@example.com", ptr.Val(rattendee.GetEmailAddress().GetAddress()), "attendee address")
+	assert.Equal(t, models.OPTIONAL_ATTENDEETYPE, ptr.Val(rattendee.GetTypeEscaped()), "attendee type")
+	assert.Equal(
+		t,
+		models.TENTATIVELYACCEPTED_RESPONSETYPE,
+		ptr.Val(rattendee.GetStatus().GetResponse()),
+		"attendee response")
+
+	require.Len(t, revent.GetAttachments(), 1, "attachments")
+	ratt, ok := revent.GetAttachments()[0].(models.FileAttachmentable)
+	require.True(t, ok, "file attachment")
+	assert.Equal(t, "file.txt", ptr.Val(ratt.GetName()), "attachment name")
+	assert.Equal(t, "text/plain", ptr.Val(ratt.GetContentType()), "attachment content type")
+	assert.Equal(t, []byte("content"), ratt.GetContentBytes(), "attachment content")
+	assert.Equal(t, "cid1", ptr.Val(ratt.GetContentId()), "attachment content id")
+	assert.True(t, ptr.Val(ratt.GetIsInline()), "attachment inline")
+
+	cancelled, err := api.GetCancelledEventDateStrings(revent)
+	require.NoError(t, err, "getting cancelled dates")
+	assert.Equal(t, []string{"2021-05-03"}, cancelled, "cancelled dates")
+
+	exceptions, ok := revent.GetAdditionalData()["exceptionOccurrences"].([]any)
+	require.True(t, ok, "exception occurrences")
+	require.Len(t, exceptions, 1, "exception occurrences")
+
+	rexception, err := api.EventFromMap(exceptions[0].(map[string]any))
+	require.NoError(t, err, "parsing exception")
+
+	assert.Equal(t, "Exception", ptr.Val(rexception.GetSubject()), "exception subject")
+	assert.Equal(
+		t,
+		time.Date(2021, 3, 1, 17, 0, 0, 0, time.UTC),
+		ptr.Val(rexception.GetOriginalStart()),
+		"exception original start")
+	assert.Equal(
+		t,
+		"2021-03-01T18:00:00.0000000",
+		ptr.Val(rexception.GetStart().GetDateTime()),
+		"exception start")
+}
+
+func (s *ICSUnitSuite) TestICSToEventable_allDay() {
+	t := s.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	ical := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Test//Test",
+		"BEGIN:VEVENT",
+		"UID:uid",
+		"DTSTART;VALUE=DATE:20240105",
+		"RRULE:FREQ=WEEKLY;COUNT=3",
+		"SUMMARY:All day\\, every week",
+		"DESCRIPTION:line one\\nline two",
+		"PRIORITY:9",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	event, err := ToEventable(ctx, []byte(ical))
+	require.NoError(t, err, "converting from ics")
+
+	assert.Equal(t, "All day, every week", ptr.Val(event.GetSubject()), "subject")
+	assert.True(t, ptr.Val(event.GetIsAllDay()), "all day")
+	assert.Equal(t, "2024-01-05T00:00:00.0000000", ptr.Val(event.GetStart().GetDateTime()), "start")
+	assert.Equal(t, "2024-01-06T00:00:00.0000000", ptr.Val(event.GetEnd().GetDateTime()), "default end")
+	assert.Equal(t, "UTC", ptr.Val(event.GetStart().GetTimeZone()), "timezone")
+	assert.Equal(t, models.TEXT_BODYTYPE, ptr.Val(event.GetBody().GetContentType()), "body type")
+	assert.Equal(t, "line one\nline two", ptr.Val(event.GetBody().GetContent()), "body")
+	assert.Equal(t, models.LOW_IMPORTANCE, ptr.Val(event.GetImportance()), "importance")
+
+	pattern := event.GetRecurrence().GetPattern()
+	assert.Equal(t, models.WEEKLY_RECURRENCEPATTERNTYPE, ptr.Val(pattern.GetTypeEscaped()), "recurrence type")
+	assert.Equal(t, []models.DayOfWeek{models.FRIDAY_DAYOFWEEK}, pattern.GetDaysOfWeek(), "default days")
+
+	rrange := event.GetRecurrence().GetRangeEscaped()
+	assert.Equal(t, models.NUMBERED_RECURRENCERANGETYPE, ptr.Val(rrange.GetTypeEscaped()), "range type")
+	assert.Equal(t, int32(3), ptr.Val(rrange.GetNumberOfOccurrences()), "range count")
+}
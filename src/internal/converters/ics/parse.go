@@ -0,0 +1,792 @@
+package ics
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alcionai/clues"
+	ics "github.com/arran4/golang-ical"
+	"github.com/microsoft/kiota-abstractions-go/serialization"
+	kjson "github.com/microsoft/kiota-serialization-json-go"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/pkg/dttm"
+	"github.com/alcionai/corso/src/pkg/logger"
+)
+
+// This file handles the reverse of ics.go, converting an .ics file
+// back into a graph event.  It understands everything that is
+// produced by FromEventable, along with the common variations of
+// those properties found in files produced by other clients.
+
+// ToEventable converts an .ics file into an Eventable.  The first
+// VEVENT without a RECURRENCE-ID is used as the series master and
+// any VEVENT with a RECURRENCE-ID is added as an exception occurrence
+// in the same way graph would return it.
+func ToEventable(ctx context.Context, body []byte) (models.Eventable, error) {
+	ctx = clues.Add(ctx, "body_len", len(body))
+
+	cal, err := ics.ParseCalendar(bytes.NewReader(body))
+	if err != nil {
+		return nil, clues.WrapWC(ctx, err, "parsing ics")
+	}
+
+	var (
+		master     *ics.VEvent
+		exceptions []*ics.VEvent
+	)
+
+	for _, ev := range cal.Events() {
+		if ev.GetProperty(ics.ComponentProperty(ics.PropertyRecurrenceId)) != nil {
+			exceptions = append(exceptions, ev)
+			continue
+		}
+
+		if master == nil {
+			master = ev
+		}
+	}
+
+	if master == nil {
+		return nil, clues.NewWC(ctx, "no event found in ics")
+	}
+
+	event, err := eventFromVEvent(ctx, master)
+	if err != nil {
+		return nil, clues.Wrap(err, "converting event")
+	}
+
+	if len(exceptions) == 0 {
+		return event, nil
+	}
+
+	occurrences := make([]any, 0, len(exceptions))
+
+	for _, ex := range exceptions {
+		exception, err := eventFromVEvent(ctx, ex)
+		if err != nil {
+			return nil, clues.Wrap(err, "converting exception event")
+		}
+
+		rid := ex.GetProperty(ics.ComponentProperty(ics.PropertyRecurrenceId))
+
+		originalStart, _, _, err := parseTimeProperty(rid)
+		if err != nil {
+			return nil, clues.WrapWC(ctx, err, "parsing recurrence id")
+		}
+
+		exception.SetOriginalStart(ptr.To(originalStart.UTC()))
+
+		instance, err := eventableToMap(exception)
+		if err != nil {
+			return nil, clues.WrapWC(ctx, err, "converting exception event to map")
+		}
+
+		occurrences = append(occurrences, instance)
+	}
+
+	additional := event.GetAdditionalData()
+	additional["exceptionOccurrences"] = occurrences
+	event.SetAdditionalData(additional)
+
+	return event, nil
+}
+
+func eventFromVEvent(ctx context.Context, iCalEvent *ics.VEvent) (models.Eventable, error) {
+	event := models.NewEvent()
+	additional := map[string]any{}
+
+	uid := iCalEvent.Id()
+	if len(uid) > 0 {
+		event.SetICalUId(ptr.To(uid))
+	}
+
+	// CREATED - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.7.1
+	if prop := iCalEvent.GetProperty(ics.ComponentPropertyCreated); prop != nil {
+		created, _, _, err := parseTimeProperty(prop)
+		if err != nil {
+			return nil, clues.WrapWC(ctx, err, "parsing created time")
+		}
+
+		event.SetCreatedDateTime(ptr.To(created))
+	}
+
+	// LAST-MODIFIED - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.7.3
+	if prop := iCalEvent.GetProperty(ics.ComponentPropertyLastModified); prop != nil {
+		modified, _, _, err := parseTimeProperty(prop)
+		if err != nil {
+			return nil, clues.WrapWC(ctx, err, "parsing last modified time")
+		}
+
+		event.SetLastModifiedDateTime(ptr.To(modified))
+	}
+
+	// DTSTART - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.2.4
+	startProp := iCalEvent.GetProperty(ics.ComponentPropertyDtStart)
+	if startProp == nil {
+		return nil, clues.NewWC(ctx, "event is missing a start time")
+	}
+
+	start, startLoc, allDay, err := parseTimeProperty(startProp)
+	if err != nil {
+		return nil, clues.WrapWC(ctx, err, "parsing start time")
+	}
+
+	event.SetStart(toDateTimeTimeZone(start, startLoc))
+	event.SetIsAllDay(ptr.To(allDay))
+
+	// DTEND - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.2.2
+	// The end time is optional, and defaults to the start of the next
+	// day for all day events and to the start time for everything else.
+	end, endLoc := start, startLoc
+	if allDay {
+		end = start.AddDate(0, 0, 1)
+	}
+
+	if prop := iCalEvent.GetProperty(ics.ComponentPropertyDtEnd); prop != nil {
+		end, endLoc, _, err = parseTimeProperty(prop)
+		if err != nil {
+			return nil, clues.WrapWC(ctx, err, "parsing end time")
+		}
+	}
+
+	event.SetEnd(toDateTimeTimeZone(end, endLoc))
+
+	// RRULE - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.5.3
+	if prop := iCalEvent.GetProperty(ics.ComponentPropertyRrule); prop != nil {
+		recurrence, err := getPatternedRecurrence(ctx, prop.Value, start, startLoc)
+		if err != nil {
+			return nil, clues.Wrap(err, "parsing RRULE")
+		}
+
+		event.SetRecurrence(recurrence)
+	}
+
+	// STATUS - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.1.11
+	if prop := iCalEvent.GetProperty(ics.ComponentPropertyStatus); prop != nil {
+		if strings.EqualFold(prop.Value, string(ics.ObjectStatusCancelled)) {
+			event.SetIsCancelled(ptr.To(true))
+		}
+	}
+
+	// SUMMARY - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.1.12
+	if prop := iCalEvent.GetProperty(ics.ComponentPropertySummary); prop != nil {
+		event.SetSubject(ptr.To(ics.FromText(prop.Value)))
+	}
+
+	// DESCRIPTION - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.1.5
+	// X-ALT-DESC holds the original html if FromEventable was able to
+	// retain it, and so we prefer it over the plain text description.
+	if prop := iCalEvent.GetProperty("X-ALT-DESC"); prop != nil && len(prop.Value) > 0 {
+		body := models.NewItemBody()
+		body.SetContentType(ptr.To(models.HTML_BODYTYPE))
+		body.SetContent(ptr.To(strings.ReplaceAll(prop.Value, `\n`, "\n")))
+		event.SetBody(body)
+	} else if prop := iCalEvent.GetProperty(ics.ComponentPropertyDescription); prop != nil {
+		body := models.NewItemBody()
+		body.SetContentType(ptr.To(models.TEXT_BODYTYPE))
+		body.SetContent(ptr.To(ics.FromText(prop.Value)))
+		event.SetBody(body)
+	}
+
+	// TRANSP - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.2.7
+	if prop := iCalEvent.GetProperty(ics.ComponentPropertyTransp); prop != nil {
+		switch strings.ToUpper(prop.Value) {
+		case string(ics.TransparencyTransparent):
+			event.SetShowAs(ptr.To(models.FREE_FREEBUSYSTATUS))
+		case string(ics.TransparencyOpaque):
+			event.SetShowAs(ptr.To(models.BUSY_FREEBUSYSTATUS))
+		}
+	}
+
+	// CATEGORIES - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.1.2
+	categories := []string{}
+
+	for _, prop := range getProperties(iCalEvent, ics.ComponentPropertyCategories) {
+		for _, category := range splitText(prop.Value) {
+			if len(category) > 0 {
+				categories = append(categories, category)
+			}
+		}
+	}
+
+	if len(categories) > 0 {
+		event.SetCategories(categories)
+	}
+
+	// ORGANIZER - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.4.3
+	if prop := iCalEvent.GetProperty(ics.ComponentPropertyOrganizer); prop != nil {
+		organizer := models.NewRecipient()
+		organizer.SetEmailAddress(toEmailAddress(prop))
+		event.SetOrganizer(organizer)
+	}
+
+	// ATTENDEE - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.4.1
+	attendees := []models.Attendeeable{}
+
+	for _, prop := range getProperties(iCalEvent, ics.ComponentPropertyAttendee) {
+		attendees = append(attendees, toAttendee(prop))
+	}
+
+	if len(attendees) > 0 {
+		event.SetAttendees(attendees)
+	}
+
+	// LOCATION - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.1.7
+	// FromEventable flattens the address into LOCATION, which cannot be
+	// reliably split back apart.  Outlook's display name is preferred
+	// when available as that is the value graph would have provided.
+	locationName := ""
+
+	if prop := iCalEvent.GetProperty(ics.ComponentPropertyLocation); prop != nil {
+		locationName = ics.FromText(prop.Value)
+	}
+
+	if prop := iCalEvent.GetProperty("X-MICROSOFT-LOCATIONDISPLAYNAME"); prop != nil && len(prop.Value) > 0 {
+		locationName = ics.FromText(prop.Value)
+	}
+
+	if len(locationName) > 0 {
+		location := models.NewLocation()
+		location.SetDisplayName(ptr.To(locationName))
+		event.SetLocation(location)
+	}
+
+	// CLASS - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.1.3
+	if prop := iCalEvent.GetProperty(ics.ComponentPropertyClass); prop != nil {
+		switch strings.ToUpper(prop.Value) {
+		case "PRIVATE":
+			event.SetSensitivity(ptr.To(models.PRIVATE_SENSITIVITY))
+		case "CONFIDENTIAL":
+			event.SetSensitivity(ptr.To(models.CONFIDENTIAL_SENSITIVITY))
+		case "PUBLIC":
+			event.SetSensitivity(ptr.To(models.NORMAL_SENSITIVITY))
+		}
+	}
+
+	// PRIORITY - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.1.9
+	// 0 is undefined, 1-4 is high, 5 is normal and 6-9 is low
+	if prop := iCalEvent.GetProperty(ics.ComponentPropertyPriority); prop != nil {
+		priority, err := strconv.Atoi(prop.Value)
+		if err != nil {
+			return nil, clues.WrapWC(ctx, err, "parsing priority").With("priority", prop.Value)
+		}
+
+		switch {
+		case priority >= 1 && priority <= 4:
+			event.SetImportance(ptr.To(models.HIGH_IMPORTANCE))
+		case priority == 5:
+			event.SetImportance(ptr.To(models.NORMAL_IMPORTANCE))
+		case priority >= 6 && priority <= 9:
+			event.SetImportance(ptr.To(models.LOW_IMPORTANCE))
+		}
+	}
+
+	if prop := iCalEvent.GetProperty("X-MICROSOFT-SKYPETEAMSMEETINGURL"); prop != nil && len(prop.Value) > 0 {
+		meeting := models.NewOnlineMeetingInfo()
+		meeting.SetJoinUrl(ptr.To(prop.Value))
+		event.SetOnlineMeeting(meeting)
+		event.SetIsOnlineMeeting(ptr.To(true))
+	}
+
+	// ATTACH - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.1.1
+	attachments := []models.Attachmentable{}
+
+	for _, prop := range getProperties(iCalEvent, ics.ComponentPropertyAttach) {
+		attachment, err := toFileAttachment(ctx, prop)
+		if err != nil {
+			return nil, clues.Wrap(err, "converting attachment")
+		}
+
+		if attachment != nil {
+			attachments = append(attachments, attachment)
+		}
+	}
+
+	if len(attachments) > 0 {
+		event.SetAttachments(attachments)
+		event.SetHasAttachments(ptr.To(true))
+	}
+
+	// EXDATE - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.5.1
+	// Graph represents these as cancelled occurrences in the format
+	// "<id>.<date>", and only the date portion is used during restore.
+	cancelled := []any{}
+
+	for _, prop := range getProperties(iCalEvent, ics.ComponentPropertyExdate) {
+		for _, value := range strings.Split(prop.Value, ",") {
+			exdate := *prop
+			exdate.Value = value
+
+			date, loc, _, err := parseTimeProperty(&exdate)
+			if err != nil {
+				return nil, clues.WrapWC(ctx, err, "parsing exdate").With("exdate", value)
+			}
+
+			cancelled = append(
+				cancelled,
+				fmt.Sprintf("OID.%s.%s", uid, date.In(loc).Format(string(dttm.DateOnly))))
+		}
+	}
+
+	if len(cancelled) > 0 {
+		additional["cancelledOccurrences"] = cancelled
+	}
+
+	event.SetAdditionalData(additional)
+
+	return event, nil
+}
+
+// parseTimeProperty parses a DATE or DATE-TIME value along with the
+// timezone it was specified in.  Values without a TZID or with a
+// trailing Z are treated as UTC.
+func parseTimeProperty(prop *ics.IANAProperty) (time.Time, *time.Location, bool, error) {
+	var (
+		loc    = time.UTC
+		value  = prop.Value
+		allDay = len(value) == len(ICalDateFormat)
+		t      time.Time
+		err    error
+	)
+
+	tzids := prop.ICalParameters["TZID"]
+	if len(tzids) > 0 && !strings.HasSuffix(value, "Z") {
+		loc, err = loadLocation(tzids[0])
+		if err != nil {
+			return time.Time{}, nil, false, err
+		}
+	}
+
+	switch {
+	case allDay:
+		t, err = time.ParseInLocation(ICalDateFormat, value, loc)
+	case strings.HasSuffix(value, "Z"):
+		t, err = time.Parse(ICalDateTimeFormatUTC, value)
+	default:
+		t, err = time.ParseInLocation(ICalDateTimeFormat, value, loc)
+	}
+
+	if err != nil {
+		return time.Time{}, nil, false, clues.Wrap(err, "parsing time").With("given_time_string", value)
+	}
+
+	return t, loc, allDay, nil
+}
+
+func loadLocation(tz string) (*time.Location, error) {
+	loc, err := time.LoadLocation(tz)
+	if err == nil {
+		return loc, nil
+	}
+
+	timezone, ok := GraphTimeZoneToTZ[tz]
+	if !ok {
+		return nil, clues.New("unknown timezone").With("timezone", tz)
+	}
+
+	loc, err = time.LoadLocation(timezone)
+	if err != nil {
+		return nil, clues.Wrap(err, "loading timezone").With("converted_timezone", timezone)
+	}
+
+	return loc, nil
+}
+
+// graphTimeZone returns the windows timezone name that graph would
+// use for the location, falling back to the IANA name (which graph
+// also accepts) if there is no equivalent.
+func graphTimeZone(loc *time.Location) string {
+	if loc == time.UTC {
+		return "UTC"
+	}
+
+	names := []string{}
+
+	for windows, iana := range GraphTimeZoneToTZ {
+		if iana == loc.String() {
+			names = append(names, windows)
+		}
+	}
+
+	if len(names) == 0 {
+		return loc.String()
+	}
+
+	// multiple windows timezones can map to the same location
+	sort.Strings(names)
+
+	return names[0]
+}
+
+func toDateTimeTimeZone(t time.Time, loc *time.Location) models.DateTimeTimeZoneable {
+	dtz := models.NewDateTimeTimeZone()
+	dtz.SetDateTime(ptr.To(t.In(loc).Format(string(dttm.M365DateTimeTimeZone))))
+	dtz.SetTimeZone(ptr.To(graphTimeZone(loc)))
+
+	return dtz
+}
+
+// https://www.rfc-editor.org/rfc/rfc5545#section-3.8.5.3
+// https://learn.microsoft.com/en-us/graph/api/resources/patternedrecurrence?view=graph-rest-1.0
+func getPatternedRecurrence(
+	ctx context.Context,
+	rrule string,
+	start time.Time,
+	loc *time.Location,
+) (models.PatternedRecurrenceable, error) {
+	ctx = clues.Add(ctx, "rrule", rrule)
+
+	parts := map[string]string{}
+
+	for _, part := range strings.Split(rrule, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+
+		parts[strings.ToUpper(kv[0])] = kv[1]
+	}
+
+	var (
+		pattern = models.NewRecurrencePattern()
+		rrange  = models.NewRecurrenceRange()
+		byDay   = parts["BYDAY"]
+		ptype   models.RecurrencePatternType
+	)
+
+	switch parts["FREQ"] {
+	case "DAILY":
+		ptype = models.DAILY_RECURRENCEPATTERNTYPE
+	case "WEEKLY":
+		ptype = models.WEEKLY_RECURRENCEPATTERNTYPE
+	case "MONTHLY":
+		ptype = models.ABSOLUTEMONTHLY_RECURRENCEPATTERNTYPE
+		if len(byDay) > 0 {
+			ptype = models.RELATIVEMONTHLY_RECURRENCEPATTERNTYPE
+		}
+	case "YEARLY":
+		ptype = models.ABSOLUTEYEARLY_RECURRENCEPATTERNTYPE
+		if len(byDay) > 0 {
+			ptype = models.RELATIVEYEARLY_RECURRENCEPATTERNTYPE
+		}
+	default:
+		return nil, clues.NewWC(ctx, "unsupported recurrence frequency").With("freq", parts["FREQ"])
+	}
+
+	pattern.SetTypeEscaped(ptr.To(ptype))
+
+	interval := 1
+
+	if v, ok := parts["INTERVAL"]; ok {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, clues.WrapWC(ctx, err, "parsing interval")
+		}
+
+		interval = i
+	}
+
+	pattern.SetInterval(ptr.To(int32(interval)))
+
+	localStart := start.In(loc)
+
+	if v, ok := parts["BYMONTH"]; ok {
+		month, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, clues.WrapWC(ctx, err, "parsing month")
+		}
+
+		pattern.SetMonth(ptr.To(int32(month)))
+	} else if ptype == models.ABSOLUTEYEARLY_RECURRENCEPATTERNTYPE ||
+		ptype == models.RELATIVEYEARLY_RECURRENCEPATTERNTYPE {
+		// graph requires the month for yearly recurrences
+		pattern.SetMonth(ptr.To(int32(localStart.Month())))
+	}
+
+	if v, ok := parts["BYMONTHDAY"]; ok {
+		day, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, clues.WrapWC(ctx, err, "parsing day of month")
+		}
+
+		pattern.SetDayOfMonth(ptr.To(int32(day)))
+	} else if ptype == models.ABSOLUTEMONTHLY_RECURRENCEPATTERNTYPE ||
+		ptype == models.ABSOLUTEYEARLY_RECURRENCEPATTERNTYPE {
+		// graph requires the day of month for absolute recurrences
+		pattern.SetDayOfMonth(ptr.To(int32(localStart.Day())))
+	}
+
+	days := []string{}
+	if len(byDay) > 0 {
+		days = strings.Split(byDay, ",")
+	} else if ptype == models.WEEKLY_RECURRENCEPATTERNTYPE {
+		// graph requires the days of week for weekly recurrences
+		days = append(days, GraphToICalDOW[strings.ToLower(localStart.Weekday().String())])
+	}
+
+	dow := []models.DayOfWeek{}
+
+	for _, day := range days {
+		if len(day) < 2 {
+			return nil, clues.NewWC(ctx, "invalid day of week").With("day", day)
+		}
+
+		// The index can either prefix every day or (as is the case
+		// with FromEventable) only the first one.
+		code := day[len(day)-2:]
+		prefix := day[:len(day)-2]
+
+		if len(prefix) > 0 && pattern.GetIndex() == nil {
+			idx, err := strconv.Atoi(prefix)
+			if err != nil {
+				return nil, clues.WrapWC(ctx, err, "parsing day of week index").With("day", day)
+			}
+
+			index, err := models.ParseWeekIndex(ICalToGraphIndex[idx])
+			if err != nil || index == nil {
+				return nil, clues.NewWC(ctx, "unknown day of week index").With("day", day)
+			}
+
+			pattern.SetIndex(index.(*models.WeekIndex))
+		}
+
+		d, err := parseDayOfWeek(code)
+		if err != nil {
+			return nil, clues.StackWC(ctx, err)
+		}
+
+		dow = append(dow, ptr.Val(d))
+	}
+
+	if len(dow) > 0 {
+		pattern.SetDaysOfWeek(dow)
+	}
+
+	if v, ok := parts["WKST"]; ok {
+		d, err := parseDayOfWeek(v)
+		if err != nil {
+			return nil, clues.StackWC(ctx, err)
+		}
+
+		pattern.SetFirstDayOfWeek(d)
+	}
+
+	rrange.SetStartDate(serialization.NewDateOnly(localStart))
+	rrange.SetRecurrenceTimeZone(ptr.To(graphTimeZone(loc)))
+
+	switch {
+	case len(parts["UNTIL"]) > 0:
+		until, _, _, err := parseTimeProperty(&ics.IANAProperty{
+			BaseProperty: ics.BaseProperty{Value: parts["UNTIL"]},
+		})
+		if err != nil {
+			return nil, clues.WrapWC(ctx, err, "parsing recurrence end date")
+		}
+
+		rrange.SetTypeEscaped(ptr.To(models.ENDDATE_RECURRENCERANGETYPE))
+		rrange.SetEndDate(serialization.NewDateOnly(until.In(loc)))
+	case len(parts["COUNT"]) > 0:
+		count, err := strconv.Atoi(parts["COUNT"])
+		if err != nil {
+			return nil, clues.WrapWC(ctx, err, "parsing recurrence count")
+		}
+
+		rrange.SetTypeEscaped(ptr.To(models.NUMBERED_RECURRENCERANGETYPE))
+		rrange.SetNumberOfOccurrences(ptr.To(int32(count)))
+	default:
+		rrange.SetTypeEscaped(ptr.To(models.NOEND_RECURRENCERANGETYPE))
+	}
+
+	recurrence := models.NewPatternedRecurrence()
+	recurrence.SetPattern(pattern)
+	recurrence.SetRangeEscaped(rrange)
+
+	return recurrence, nil
+}
+
+func parseDayOfWeek(code string) (*models.DayOfWeek, error) {
+	name, ok := ICalToGraphDOW[strings.ToUpper(code)]
+	if !ok {
+		return nil, clues.New("unknown day of week").With("day", code)
+	}
+
+	d, err := models.ParseDayOfWeek(name)
+	if err != nil || d == nil {
+		return nil, clues.New("parsing day of week").With("day", code)
+	}
+
+	return d.(*models.DayOfWeek), nil
+}
+
+func getProperties(iCalEvent *ics.VEvent, prop ics.ComponentProperty) []*ics.IANAProperty {
+	props := []*ics.IANAProperty{}
+
+	for i := range iCalEvent.Properties {
+		if iCalEvent.Properties[i].IANAToken == string(prop) {
+			props = append(props, &iCalEvent.Properties[i])
+		}
+	}
+
+	return props
+}
+
+// splitText splits a multi valued TEXT property on unescaped commas
+// and unescapes each of the values.
+func splitText(value string) []string {
+	var (
+		values  = []string{}
+		current strings.Builder
+	)
+
+	for i := 0; i < len(value); i++ {
+		switch {
+		case value[i] == '\\' && i+1 < len(value):
+			current.WriteByte(value[i])
+			current.WriteByte(value[i+1])
+			i++
+		case value[i] == ',':
+			values = append(values, ics.FromText(current.String()))
+			current.Reset()
+		default:
+			current.WriteByte(value[i])
+		}
+	}
+
+	return append(values, ics.FromText(current.String()))
+}
+
+func getParam(prop *ics.IANAProperty, param string) string {
+	values := prop.ICalParameters[param]
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+func toEmailAddress(prop *ics.IANAProperty) models.EmailAddressable {
+	addr := prop.Value
+	if strings.HasPrefix(strings.ToLower(addr), "mailto:") {
+		addr = addr[len("mailto:"):]
+	}
+
+	email := models.NewEmailAddress()
+	email.SetAddress(ptr.To(addr))
+
+	name := getParam(prop, string(ics.ParameterCn))
+	if len(name) > 0 {
+		email.SetName(ptr.To(name))
+	}
+
+	return email
+}
+
+func toAttendee(prop *ics.IANAProperty) models.Attendeeable {
+	attendee := models.NewAttendee()
+	attendee.SetEmailAddress(toEmailAddress(prop))
+
+	switch ics.ParticipationRole(strings.ToUpper(getParam(prop, string(ics.ParameterRole)))) {
+	case ics.ParticipationRoleReqParticipant, ics.ParticipationRoleChair:
+		attendee.SetTypeEscaped(ptr.To(models.REQUIRED_ATTENDEETYPE))
+	case ics.ParticipationRoleOptParticipant:
+		attendee.SetTypeEscaped(ptr.To(models.OPTIONAL_ATTENDEETYPE))
+	case ics.ParticipationRoleNonParticipant:
+		attendee.SetTypeEscaped(ptr.To(models.RESOURCE_ATTENDEETYPE))
+	}
+
+	var resp *models.ResponseType
+
+	switch ics.ParticipationStatus(strings.ToUpper(getParam(prop, string(ics.ParameterParticipationStatus)))) {
+	case ics.ParticipationStatusAccepted:
+		resp = ptr.To(models.ACCEPTED_RESPONSETYPE)
+	case ics.ParticipationStatusDeclined:
+		resp = ptr.To(models.DECLINED_RESPONSETYPE)
+	case ics.ParticipationStatusTentative:
+		resp = ptr.To(models.TENTATIVELYACCEPTED_RESPONSETYPE)
+	case ics.ParticipationStatusNeedsAction:
+		resp = ptr.To(models.NOTRESPONDED_RESPONSETYPE)
+	}
+
+	if resp != nil {
+		status := models.NewResponseStatus()
+		status.SetResponse(resp)
+		attendee.SetStatus(status)
+	}
+
+	return attendee
+}
+
+func toFileAttachment(ctx context.Context, prop *ics.IANAProperty) (models.Attachmentable, error) {
+	if !strings.EqualFold(getParam(prop, "ENCODING"), "BASE64") {
+		// Graph has no equivalent for attachments that are only
+		// referenced by a uri.
+		logger.Ctx(ctx).
+			With("attachment_uri", prop.Value).
+			Info("skipping non inline attachment from ics import")
+
+		return nil, nil
+	}
+
+	content, err := base64.StdEncoding.DecodeString(prop.Value)
+	if err != nil {
+		return nil, clues.WrapWC(ctx, err, "decoding attachment content")
+	}
+
+	name := getParam(prop, "FILENAME")
+	if len(name) == 0 {
+		name = "Unnamed"
+	}
+
+	attachment := models.NewFileAttachment()
+	attachment.SetName(ptr.To(name))
+	attachment.SetContentBytes(content)
+	attachment.SetSize(ptr.To(int32(len(content))))
+
+	contentType := getParam(prop, string(ics.ParameterFmttype))
+	if len(contentType) > 0 {
+		attachment.SetContentType(ptr.To(contentType))
+	}
+
+	cid := getParam(prop, "CID")
+	if len(cid) > 0 {
+		attachment.SetContentId(ptr.To(cid))
+		attachment.SetIsInline(ptr.To(true))
+	}
+
+	return attachment, nil
+}
+
+// eventableToMap converts the event into the map[string]any form
+// that graph uses for exception occurrences.
+func eventableToMap(event models.Eventable) (map[string]any, error) {
+	writer := kjson.NewJsonSerializationWriter()
+	defer writer.Close()
+
+	err := writer.WriteObjectValue("", event)
+	if err != nil {
+		return nil, clues.Wrap(err, "serializing event")
+	}
+
+	bs, err := writer.GetSerializedContent()
+	if err != nil {
+		return nil, clues.Wrap(err, "getting serialized event")
+	}
+
+	instance := map[string]any{}
+
+	err = json.Unmarshal(bs, &instance)
+	if err != nil {
+		return nil, clues.Wrap(err, "unmarshalling event")
+	}
+
+	return instance, nil
+}
@@ -0,0 +1,179 @@
+package ics
+
+import (
+	"context"
+	"strings"
+
+	"github.com/alcionai/clues"
+	ics "github.com/arran4/golang-ical"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+)
+
+// https://www.rfc-editor.org/rfc/rfc5545#section-3.6.2
+// https://learn.microsoft.com/en-us/graph/api/resources/todotask?view=graph-rest-1.0
+
+func FromTaskJSON(ctx context.Context, body []byte) (string, error) {
+	task, err := api.BytesToTodoTaskable(body)
+	if err != nil {
+		return "", clues.WrapWC(ctx, err, "converting to todotaskable").
+			With("body_len", len(body))
+	}
+
+	return FromTodoTaskable(ctx, task)
+}
+
+// FromTodoTaskable converts the task into a VTODO.  The task's checklist
+// items are each added as their own VTODO, related to the task.
+func FromTodoTaskable(ctx context.Context, task models.TodoTaskable) (string, error) {
+	cal := ics.NewCalendar()
+	cal.SetProductId("-//Alcion//Corso") // Does this have to be customizable?
+
+	id := ptr.Val(task.GetId())
+	iCalTodo := cal.AddTodo(id)
+
+	err := updateTaskProperties(ctx, task, iCalTodo)
+	if err != nil {
+		return "", clues.Wrap(err, "updating task properties")
+	}
+
+	for _, ci := range task.GetChecklistItems() {
+		iCalItem := cal.AddTodo(ptr.Val(ci.GetId()))
+
+		// RELATED-TO - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.4.5
+		iCalItem.AddProperty(ics.ComponentProperty(ics.PropertyRelatedTo), id)
+		iCalItem.SetSummary(ptr.Val(ci.GetDisplayName()))
+
+		if created := ci.GetCreatedDateTime(); created != nil {
+			iCalItem.SetCreatedTime(ptr.Val(created))
+		}
+
+		if ptr.Val(ci.GetIsChecked()) {
+			iCalItem.SetStatus(ics.ObjectStatusCompleted)
+
+			if checked := ci.GetCheckedDateTime(); checked != nil {
+				iCalItem.SetCompletedAt(ptr.Val(checked))
+			}
+		} else {
+			iCalItem.SetStatus(ics.ObjectStatusNeedsAction)
+		}
+	}
+
+	return cal.Serialize(), nil
+}
+
+func updateTaskProperties(ctx context.Context, task models.TodoTaskable, iCalTodo *ics.VTodo) error {
+	// CREATED - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.7.1
+	created := task.GetCreatedDateTime()
+	if created != nil {
+		iCalTodo.SetCreatedTime(ptr.Val(created))
+	}
+
+	// LAST-MODIFIED - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.7.3
+	modified := task.GetLastModifiedDateTime()
+	if modified != nil {
+		iCalTodo.SetModifiedAt(ptr.Val(modified))
+	}
+
+	// SUMMARY - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.1.12
+	title := task.GetTitle()
+	if title != nil {
+		iCalTodo.SetSummary(ptr.Val(title))
+	}
+
+	// DESCRIPTION - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.1.5
+	if err := addDescription(&iCalTodo.ComponentBase, task.GetBody()); err != nil {
+		return err
+	}
+
+	// DTSTART - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.2.4
+	if start := task.GetStartDateTime(); start != nil && start.GetDateTime() != nil {
+		st, err := GetUTCTime(ptr.Val(start.GetDateTime()), ptr.Val(start.GetTimeZone()))
+		if err != nil {
+			return clues.WrapWC(ctx, err, "parsing start time")
+		}
+
+		iCalTodo.SetStartAt(st)
+	}
+
+	// DUE - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.2.3
+	if due := task.GetDueDateTime(); due != nil && due.GetDateTime() != nil {
+		dt, err := GetUTCTime(ptr.Val(due.GetDateTime()), ptr.Val(due.GetTimeZone()))
+		if err != nil {
+			return clues.WrapWC(ctx, err, "parsing due time")
+		}
+
+		iCalTodo.SetDueAt(dt)
+	}
+
+	// COMPLETED - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.2.1
+	if completed := task.GetCompletedDateTime(); completed != nil && completed.GetDateTime() != nil {
+		ct, err := GetUTCTime(ptr.Val(completed.GetDateTime()), ptr.Val(completed.GetTimeZone()))
+		if err != nil {
+			return clues.WrapWC(ctx, err, "parsing completed time")
+		}
+
+		iCalTodo.SetCompletedAt(ct)
+	}
+
+	// STATUS - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.1.11
+	// PERCENT-COMPLETE - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.1.8
+	if status := task.GetStatus(); status != nil {
+		switch ptr.Val(status) {
+		case models.COMPLETED_TASKSTATUS:
+			iCalTodo.SetStatus(ics.ObjectStatusCompleted)
+			iCalTodo.SetPercentComplete(100)
+		case models.INPROGRESS_TASKSTATUS:
+			iCalTodo.SetStatus(ics.ObjectStatusInProcess)
+		default:
+			iCalTodo.SetStatus(ics.ObjectStatusNeedsAction)
+		}
+	}
+
+	// PRIORITY - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.1.9
+	if importance := task.GetImportance(); importance != nil {
+		switch ptr.Val(importance) {
+		case models.HIGH_IMPORTANCE:
+			iCalTodo.SetPriority(1)
+		case models.NORMAL_IMPORTANCE:
+			iCalTodo.SetPriority(5)
+		case models.LOW_IMPORTANCE:
+			iCalTodo.SetPriority(9)
+		}
+	}
+
+	// CATEGORIES - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.1.2
+	categories := task.GetCategories()
+	if len(categories) > 0 {
+		iCalTodo.AddCategory(strings.Join(categories, ","))
+	}
+
+	// RRULE - https://www.rfc-editor.org/rfc/rfc5545#section-3.8.5.3
+	recurrence := task.GetRecurrence()
+	if recurrence != nil && recurrence.GetPattern() != nil {
+		pattern, err := getRecurrencePattern(ctx, recurrence)
+		if err != nil {
+			return clues.Wrap(err, "generating RRULE")
+		}
+
+		iCalTodo.AddRrule(pattern)
+	}
+
+	// VALARM - https://www.rfc-editor.org/rfc/rfc5545#section-3.6.6
+	reminder := task.GetReminderDateTime()
+	if ptr.Val(task.GetIsReminderOn()) && reminder != nil && reminder.GetDateTime() != nil {
+		rt, err := GetUTCTime(ptr.Val(reminder.GetDateTime()), ptr.Val(reminder.GetTimeZone()))
+		if err != nil {
+			return clues.WrapWC(ctx, err, "parsing reminder time")
+		}
+
+		alarm := iCalTodo.AddAlarm()
+		alarm.SetAction(ics.ActionDisplay)
+		alarm.SetTrigger(rt.Format(ICalDateTimeFormatUTC), ics.WithValue(string(ics.ValueDataTypeDateTime)))
+		alarm.SetProperty(ics.ComponentPropertyDescription, ptr.Val(title))
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package ics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/tester"
+)
+
+type TodoUnitSuite struct {
+	tester.Suite
+}
+
+func TestTodoUnitSuite(t *testing.T) {
+	suite.Run(t, &TodoUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func baseTask() *models.TodoTask {
+	task := models.NewTodoTask()
+
+	task.SetId(ptr.To("mango"))
+	task.SetTitle(ptr.To("Title"))
+
+	return task
+}
+
+func (s *TodoUnitSuite) TestTaskConversion() {
+	table := []struct {
+		name  string
+		task  func() *models.TodoTask
+		check func(t *testing.T, out string)
+	}{
+		{
+			name: "simple task",
+			task: baseTask,
+			check: func(t *testing.T, out string) {
+				assert.Contains(t, out, "BEGIN:VCALENDAR", "beginning of calendar")
+				assert.Contains(t, out, "PRODID:-//Alcion//Corso", "prodid")
+				assert.Contains(t, out, "BEGIN:VTODO", "beginning of todo")
+				assert.Contains(t, out, "UID:mango", "uid")
+				assert.Contains(t, out, "SUMMARY:Title", "summary")
+				assert.Contains(t, out, "END:VTODO", "end of todo")
+				assert.NotContains(t, out, "BEGIN:VEVENT", "no events")
+			},
+		},
+		{
+			name: "due and completed times",
+			task: func() *models.TodoTask {
+				task := baseTask()
+
+				task.SetDueDateTime(getDateTimeZone(time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC), "UTC"))
+				task.SetCompletedDateTime(getDateTimeZone(time.Date(2021, 1, 2, 13, 0, 0, 0, time.UTC), "UTC"))
+
+				return task
+			},
+			check: func(t *testing.T, out string) {
+				assert.Contains(t, out, "DUE:20210101T120000Z", "due time")
+				assert.Contains(t, out, "COMPLETED:20210102T130000Z", "completed time")
+			},
+		},
+		{
+			name: "completed status",
+			task: func() *models.TodoTask {
+				task := baseTask()
+				task.SetStatus(ptr.To(models.COMPLETED_TASKSTATUS))
+
+				return task
+			},
+			check: func(t *testing.T, out string) {
+				assert.Contains(t, out, "STATUS:COMPLETED", "status")
+				assert.Contains(t, out, "PERCENT-COMPLETE:100", "percent complete")
+			},
+		},
+		{
+			name: "in progress status",
+			task: func() *models.TodoTask {
+				task := baseTask()
+				task.SetStatus(ptr.To(models.INPROGRESS_TASKSTATUS))
+
+				return task
+			},
+			check: func(t *testing.T, out string) {
+				assert.Contains(t, out, "STATUS:IN-PROCESS", "status")
+			},
+		},
+		{
+			name: "importance",
+			task: func() *models.TodoTask {
+				task := baseTask()
+				task.SetImportance(ptr.To(models.HIGH_IMPORTANCE))
+
+				return task
+			},
+			check: func(t *testing.T, out string) {
+				assert.Contains(t, out, "PRIORITY:1", "priority")
+			},
+		},
+		{
+			name: "categories",
+			task: func() *models.TodoTask {
+				task := baseTask()
+				task.SetCategories([]string{"home", "garden"})
+
+				return task
+			},
+			check: func(t *testing.T, out string) {
+				assert.Contains(t, out, "CATEGORIES:home,garden", "categories")
+			},
+		},
+		{
+			name: "reminder",
+			task: func() *models.TodoTask {
+				task := baseTask()
+				task.SetIsReminderOn(ptr.To(true))
+				task.SetReminderDateTime(getDateTimeZone(time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC), "UTC"))
+
+				return task
+			},
+			check: func(t *testing.T, out string) {
+				assert.Contains(t, out, "BEGIN:VALARM", "alarm")
+				assert.Contains(t, out, "TRIGGER;VALUE=DATE-TIME:20210101T090000Z", "trigger")
+			},
+		},
+		{
+			name: "checklist items",
+			task: func() *models.TodoTask {
+				task := baseTask()
+
+				ci := models.NewChecklistItem()
+				ci.SetId(ptr.To("peach"))
+				ci.SetDisplayName(ptr.To("Step one"))
+				ci.SetIsChecked(ptr.To(true))
+
+				task.SetChecklistItems([]models.ChecklistItemable{ci})
+
+				return task
+			},
+			check: func(t *testing.T, out string) {
+				assert.Equal(t, 2, strings.Count(out, "BEGIN:VTODO"), "one todo per checklist item")
+				assert.Contains(t, out, "UID:peach", "checklist uid")
+				assert.Contains(t, out, "RELATED-TO:mango", "related to task")
+				assert.Contains(t, out, "SUMMARY:Step one", "checklist summary")
+				assert.Contains(t, out, "STATUS:COMPLETED", "checklist status")
+			},
+		},
+	}
+
+	for _, test := range table {
+		s.Run(test.name, func() {
+			t := s.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			out, err := FromTodoTaskable(ctx, test.task())
+			require.NoError(t, err, clues.ToCore(err))
+
+			test.check(t, out)
+		})
+	}
+}
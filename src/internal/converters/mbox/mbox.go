@@ -0,0 +1,84 @@
+package mbox
+
+// This package helps write multiple .eml messages into a single
+// mbox file.  We use the mboxrd variant as it is the only one where
+// the From line escaping is reversible.
+
+// Ref: https://datatracker.ietf.org/doc/html/rfc4155
+// Ref: https://www.loc.gov/preservation/digital/formats/fdd/fdd000385.shtml
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/alcionai/clues"
+)
+
+const (
+	// asctime format as used by the From line separator
+	fromLineDateFormat = "Mon Jan _2 15:04:05 2006"
+
+	// used in the From line when we do not know the sender
+	unknownSender = "MAILER-DAEMON"
+)
+
+var fromPrefix = []byte("From ")
+
+// Writer writes messages in the mboxrd format to the underlying writer.
+type Writer struct {
+	w *bufio.Writer
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: bufio.NewWriter(w)}
+}
+
+// WriteMessage adds a single message (in .eml format) to the mbox.
+// Line endings are normalized to LF and any line within the message
+// which matches `>*From ` is escaped with an additional `>`.
+func (mw *Writer) WriteMessage(sender string, date time.Time, msg string) error {
+	sender = strings.TrimSpace(sender)
+	if len(sender) == 0 || strings.ContainsAny(sender, " \t") {
+		sender = unknownSender
+	}
+
+	_, err := mw.w.WriteString("From " + sender + " " + date.UTC().Format(fromLineDateFormat) + "\n")
+	if err != nil {
+		return clues.Wrap(err, "writing from line")
+	}
+
+	msg = strings.ReplaceAll(msg, "\r\n", "\n")
+	msg = strings.TrimSuffix(msg, "\n")
+
+	for _, line := range strings.Split(msg, "\n") {
+		if isFromLine([]byte(line)) {
+			if err := mw.w.WriteByte('>'); err != nil {
+				return clues.Wrap(err, "escaping from line")
+			}
+		}
+
+		if _, err := mw.w.WriteString(line + "\n"); err != nil {
+			return clues.Wrap(err, "writing message")
+		}
+	}
+
+	// messages are separated by an empty line
+	if err := mw.w.WriteByte('\n'); err != nil {
+		return clues.Wrap(err, "writing message separator")
+	}
+
+	return nil
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (mw *Writer) Flush() error {
+	return clues.Stack(mw.w.Flush()).OrNil()
+}
+
+// isFromLine checks if the line is of the form `>*From `.
+func isFromLine(line []byte) bool {
+	return bytes.HasPrefix(bytes.TrimLeft(line, ">"), fromPrefix)
+}
@@ -0,0 +1,98 @@
+package mbox
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/alcionai/clues"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/tester"
+)
+
+type MboxUnitSuite struct {
+	tester.Suite
+}
+
+func TestMboxUnitSuite(t *testing.T) {
+	suite.Run(t, &MboxUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func (suite *MboxUnitSuite) TestWriteMessage() {
+	date := time.Date(2024, 1, 5, 9, 3, 4, 0, time.UTC)
+
+	table := []struct {
+		name   string
+		sender string
+		msg    string
+		expect string
+	}{
+		{
+			name:   "simple",
+			sender: "a@example.com",
+			msg:    "Subject: hi\r\n\r\nbody\r\n",
+			expect: "From a@example.com Fri Jan  5 09:03:04 2024\nSubject: hi\n\nbody\n\n",
+		},
+		{
+			name:   "unknown sender",
+			sender: "",
+			msg:    "Subject: hi\n\nbody",
+			expect: "From MAILER-DAEMON Fri Jan  5 09:03:04 2024\nSubject: hi\n\nbody\n\n",
+		},
+		{
+			name:   "sender with spaces",
+			sender: "not an address",
+			msg:    "body",
+			expect: "From MAILER-DAEMON Fri Jan  5 09:03:04 2024\nbody\n\n",
+		},
+		{
+			name:   "from lines are escaped",
+			sender: "a@example.com",
+			msg:    "Subject: hi\n\nFrom here\n>From there\n>>From everywhere\nFromage\n From nowhere",
+			expect: "From a@example.com Fri Jan  5 09:03:04 2024\n" +
+				"Subject: hi\n\n>From here\n>>From there\n>>>From everywhere\nFromage\n From nowhere\n\n",
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			var buf bytes.Buffer
+
+			mw := NewWriter(&buf)
+
+			err := mw.WriteMessage(test.sender, date, test.msg)
+			require.NoError(t, err, clues.ToCore(err))
+
+			err = mw.Flush()
+			require.NoError(t, err, clues.ToCore(err))
+
+			assert.Equal(t, test.expect, buf.String())
+		})
+	}
+}
+
+func (suite *MboxUnitSuite) TestWriteMessage_multiple() {
+	t := suite.T()
+
+	var buf bytes.Buffer
+
+	mw := NewWriter(&buf)
+
+	err := mw.WriteMessage("a@example.com", time.Date(2024, 1, 5, 9, 3, 4, 0, time.UTC), "one")
+	require.NoError(t, err, clues.ToCore(err))
+
+	err = mw.WriteMessage("b@example.com", time.Date(2024, 2, 15, 19, 3, 4, 0, time.UTC), "two")
+	require.NoError(t, err, clues.ToCore(err))
+
+	err = mw.Flush()
+	require.NoError(t, err, clues.ToCore(err))
+
+	expect := "From a@example.com Fri Jan  5 09:03:04 2024\none\n\n" +
+		"From b@example.com Thu Feb 15 19:03:04 2024\ntwo\n\n"
+
+	assert.Equal(t, expect, buf.String())
+}
@@ -0,0 +1,203 @@
+package vcf
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/alcionai/clues"
+	"github.com/emersion/go-vcard"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+)
+
+// This file handles the reverse of vcf.go, converting a vCard file
+// back into a graph contact.
+
+var birthdayFormats = []string{
+	"2006-01-02",
+	"20060102",
+	time.RFC3339,
+}
+
+func getTypes(field *vcard.Field) []string {
+	types := []string{}
+
+	for _, t := range field.Params.Types() {
+		types = append(types, strings.ToLower(t))
+	}
+
+	return types
+}
+
+func hasType(field *vcard.Field, typ string) bool {
+	for _, t := range getTypes(field) {
+		if t == typ {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toPhysicalAddress(addr *vcard.Address) models.PhysicalAddressable {
+	paddr := models.NewPhysicalAddress()
+	paddr.SetStreet(ptr.To(addr.StreetAddress))
+	paddr.SetCity(ptr.To(addr.Locality))
+	paddr.SetState(ptr.To(addr.Region))
+	paddr.SetPostalCode(ptr.To(addr.PostalCode))
+	paddr.SetCountryOrRegion(ptr.To(addr.Country))
+
+	return paddr
+}
+
+// ToContactable converts a vCard file into a Contactable.  Only the
+// first card in the file is converted.
+func ToContactable(ctx context.Context, body []byte) (models.Contactable, error) {
+	ctx = clues.Add(ctx, "body_length", len(body))
+
+	card, err := vcard.NewDecoder(bytes.NewReader(body)).Decode()
+	if err != nil {
+		return nil, clues.WrapWC(ctx, err, "decoding vcard")
+	}
+
+	contact := models.NewContact()
+
+	name := card.Name()
+	if name != nil {
+		contact.SetGivenName(ptr.To(name.GivenName))
+		contact.SetSurname(ptr.To(name.FamilyName))
+		contact.SetMiddleName(ptr.To(name.AdditionalName))
+		contact.SetTitle(ptr.To(name.HonorificPrefix))
+		contact.SetGeneration(ptr.To(name.HonorificSuffix))
+	}
+
+	fn := card.PreferredValue(vcard.FieldFormattedName)
+	if len(fn) > 0 {
+		contact.SetDisplayName(ptr.To(fn))
+	}
+
+	nick := card.PreferredValue(vcard.FieldNickname)
+	if len(nick) > 0 {
+		contact.SetNickName(ptr.To(nick))
+	}
+
+	bday := card.PreferredValue(vcard.FieldBirthday)
+	if len(bday) > 0 {
+		var parsed time.Time
+
+		for _, format := range birthdayFormats {
+			parsed, err = time.Parse(format, bday)
+			if err == nil {
+				break
+			}
+		}
+
+		if err != nil {
+			return nil, clues.WrapWC(ctx, err, "parsing birthday").With("birthday", bday)
+		}
+
+		contact.SetBirthday(ptr.To(parsed))
+	}
+
+	for _, addr := range card.Addresses() {
+		switch {
+		case hasType(addr.Field, vcard.TypeHome):
+			contact.SetHomeAddress(toPhysicalAddress(addr))
+		case hasType(addr.Field, vcard.TypeWork):
+			contact.SetBusinessAddress(toPhysicalAddress(addr))
+		default:
+			contact.SetOtherAddress(toPhysicalAddress(addr))
+		}
+	}
+
+	var (
+		businessPhones = []string{}
+		homePhones     = []string{}
+	)
+
+	for _, tel := range card[vcard.FieldTelephone] {
+		switch {
+		case hasType(tel, vcard.TypeCell) && contact.GetMobilePhone() == nil:
+			contact.SetMobilePhone(ptr.To(tel.Value))
+		case hasType(tel, vcard.TypeHome):
+			homePhones = append(homePhones, tel.Value)
+		default:
+			businessPhones = append(businessPhones, tel.Value)
+		}
+	}
+
+	contact.SetBusinessPhones(businessPhones)
+	contact.SetHomePhones(homePhones)
+
+	emails := []models.EmailAddressable{}
+
+	for _, field := range card[vcard.FieldEmail] {
+		email := models.NewEmailAddress()
+		email.SetAddress(ptr.To(field.Value))
+		email.SetName(ptr.To(field.Value))
+
+		types := getTypes(field)
+		if len(types) > 0 {
+			email.SetAdditionalData(map[string]any{"type": types[0]})
+		}
+
+		emails = append(emails, email)
+	}
+
+	contact.SetEmailAddresses(emails)
+
+	ims := []string{}
+	for _, field := range card[vcard.FieldIMPP] {
+		ims = append(ims, field.Value)
+	}
+
+	contact.SetImAddresses(ims)
+
+	// ORG is written as company;department;profession
+	org := card.PreferredValue(vcard.FieldOrganization)
+	if len(org) > 0 {
+		parts := strings.Split(org, ";")
+
+		contact.SetCompanyName(ptr.To(parts[0]))
+
+		if len(parts) > 1 {
+			contact.SetDepartment(ptr.To(parts[1]))
+		}
+
+		if len(parts) > 2 {
+			contact.SetProfession(ptr.To(strings.Join(parts[2:], ";")))
+		}
+	}
+
+	title := card.PreferredValue(vcard.FieldTitle)
+	if len(title) > 0 {
+		contact.SetJobTitle(ptr.To(title))
+	}
+
+	children := []string{}
+
+	for _, field := range card[vcard.FieldRelated] {
+		switch {
+		case hasType(field, vcard.TypeChild):
+			children = append(children, field.Value)
+		case hasType(field, vcard.TypeSpouse):
+			contact.SetSpouseName(ptr.To(field.Value))
+		case hasType(field, "manager"):
+			contact.SetManager(ptr.To(field.Value))
+		case hasType(field, "assistant"):
+			contact.SetAssistantName(ptr.To(field.Value))
+		}
+	}
+
+	contact.SetChildren(children)
+
+	note := card.PreferredValue(vcard.FieldNote)
+	if len(note) > 0 {
+		contact.SetPersonalNotes(ptr.To(note))
+	}
+
+	return contact, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/alcionai/corso/src/internal/common/ptr"
 	"github.com/alcionai/corso/src/internal/converters/vcf/testdata"
 	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
 )
 
 type VCFUnitSuite struct {
@@ -159,3 +160,72 @@ func (suite *VCFUnitSuite) TestConvert_contactable_cases() {
 		})
 	}
 }
+
+func (suite *VCFUnitSuite) TestConvert_vcf_to_contactable() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	body := []byte(testdata.ContactsInput)
+
+	contact, err := api.BytesToContactable(body)
+	require.NoError(t, err, "creating contact")
+
+	out, err := FromJSON(ctx, body)
+	require.NoError(t, err, "convert to vcf")
+
+	rcontact, err := ToContactable(ctx, []byte(out))
+	require.NoError(t, err, "convert from vcf")
+
+	assert.Equal(t, ptr.Val(contact.GetGivenName()), ptr.Val(rcontact.GetGivenName()), "given name")
+	assert.Equal(t, ptr.Val(contact.GetSurname()), ptr.Val(rcontact.GetSurname()), "surname")
+	assert.Equal(t, ptr.Val(contact.GetMiddleName()), ptr.Val(rcontact.GetMiddleName()), "middle name")
+	assert.Equal(t, ptr.Val(contact.GetTitle()), ptr.Val(rcontact.GetTitle()), "title")
+	assert.Equal(t, ptr.Val(contact.GetGeneration()), ptr.Val(rcontact.GetGeneration()), "generation")
+	assert.Equal(t, ptr.Val(contact.GetNickName()), ptr.Val(rcontact.GetNickName()), "nickname")
+	assert.Equal(
+		t,
+		contact.GetBirthday().Format(time.DateOnly),
+		rcontact.GetBirthday().Format(time.DateOnly),
+		"birthday")
+
+	assert.Equal(
+		t,
+		ptr.Val(contact.GetHomeAddress().GetStreet()),
+		ptr.Val(rcontact.GetHomeAddress().GetStreet()),
+		"home address")
+	assert.Equal(
+		t,
+		ptr.Val(contact.GetBusinessAddress().GetCity()),
+		ptr.Val(rcontact.GetBusinessAddress().GetCity()),
+		"business address")
+	assert.Equal(
+		t,
+		ptr.Val(contact.GetOtherAddress().GetStreet()),
+		ptr.Val(rcontact.GetOtherAddress().GetStreet()),
+		"other address")
+
+	assert.Equal(t, ptr.Val(contact.GetMobilePhone()), ptr.Val(rcontact.GetMobilePhone()), "mobile phone")
+	assert.ElementsMatch(t, contact.GetHomePhones(), rcontact.GetHomePhones(), "home phones")
+	assert.ElementsMatch(t, contact.GetBusinessPhones(), rcontact.GetBusinessPhones(), "business phones")
+	assert.ElementsMatch(t, contact.GetImAddresses(), rcontact.GetImAddresses(), "im addresses")
+
+	emails := []string{}
+	for _, e := range contact.GetEmailAddresses() {
+		emails = append(emails, ptr.Val(e.GetAddress()))
+	}
+
+	remails := []string{}
+	for _, e := range rcontact.GetEmailAddresses() {
+		remails = append(remails, ptr.Val(e.GetAddress()))
+	}
+
+	assert.ElementsMatch(t, emails, remails, "email addresses")
+
+	assert.Equal(t, ptr.Val(contact.GetCompanyName()), ptr.Val(rcontact.GetCompanyName()), "company")
+	assert.Equal(t, ptr.Val(contact.GetDepartment()), ptr.Val(rcontact.GetDepartment()), "department")
+	assert.Equal(t, ptr.Val(contact.GetJobTitle()), ptr.Val(rcontact.GetJobTitle()), "job title")
+	assert.Equal(t, ptr.Val(contact.GetSpouseName()), ptr.Val(rcontact.GetSpouseName()), "spouse")
+	assert.Equal(t, ptr.Val(contact.GetPersonalNotes()), ptr.Val(rcontact.GetPersonalNotes()), "notes")
+}
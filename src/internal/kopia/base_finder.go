@@ -3,6 +3,7 @@ package kopia
 import (
 	"context"
 	"sort"
+	"time"
 
 	"github.com/alcionai/clues"
 	"github.com/kopia/kopia/repo/manifest"
@@ -273,6 +274,7 @@ func (b *baseFinder) getBase(
 	ctx context.Context,
 	r identity.Reasoner,
 	tags map[string]string,
+	asOf time.Time,
 ) (*BackupBase, *BackupBase, error) {
 	allTags := map[string]string{}
 
@@ -288,6 +290,10 @@ func (b *baseFinder) getBase(
 		return nil, nil, clues.Wrap(err, "getting snapshots")
 	}
 
+	if !asOf.IsZero() {
+		metas = snapshotsBefore(metas, asOf)
+	}
+
 	// No snapshots means no backups so we can just exit here.
 	if len(metas) == 0 {
 		return nil, nil, nil
@@ -296,10 +302,47 @@ func (b *baseFinder) getBase(
 	return b.findBasesInSet(ctx, r, metas)
 }
 
+// snapshotsBefore returns the snapshots taken before the provided time.
+func snapshotsBefore(
+	metas []*manifest.EntryMetadata,
+	asOf time.Time,
+) []*manifest.EntryMetadata {
+	res := make([]*manifest.EntryMetadata, 0, len(metas))
+
+	for _, meta := range metas {
+		if meta.ModTime.Before(asOf) {
+			res = append(res, meta)
+		}
+	}
+
+	return res
+}
+
 func (b *baseFinder) FindBases(
 	ctx context.Context,
 	reasons []identity.Reasoner,
 	tags map[string]string,
+) BackupBases {
+	return b.findBases(ctx, reasons, tags, time.Time{})
+}
+
+// FindBasesAsOf behaves like FindBases, except that it only considers the
+// snapshots taken before asOf.  The merge bases it returns are the newest
+// complete backups of each reason as of that time.
+func (b *baseFinder) FindBasesAsOf(
+	ctx context.Context,
+	reasons []identity.Reasoner,
+	tags map[string]string,
+	asOf time.Time,
+) BackupBases {
+	return b.findBases(ctx, reasons, tags, asOf)
+}
+
+func (b *baseFinder) findBases(
+	ctx context.Context,
+	reasons []identity.Reasoner,
+	tags map[string]string,
+	asOf time.Time,
 ) BackupBases {
 	var (
 		// Backup models and item data snapshot manifests are 1:1 for bases so just
@@ -316,7 +359,7 @@ func (b *baseFinder) FindBases(
 			"search_category", searchReason.Category().String())
 		logger.Ctx(ictx).Info("searching for previous manifests")
 
-		mergeBase, assistBase, err := b.getBase(ictx, searchReason, tags)
+		mergeBase, assistBase, err := b.getBase(ictx, searchReason, tags, asOf)
 		if err != nil {
 			logger.Ctx(ctx).Info(
 				"getting base, falling back to full backup for reason",
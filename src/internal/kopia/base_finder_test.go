@@ -842,6 +842,72 @@ func (suite *BaseFinderUnitSuite) TestFindBases_CustomTags() {
 	}
 }
 
+func (suite *BaseFinderUnitSuite) TestFindBasesAsOf() {
+	inputData := []baseInfo{
+		newBaseInfoBuilder(1, testT1, testUser1Mail...).
+			build(),
+		newBaseInfoBuilder(2, testT2, testUser1Mail...).
+			setBackupType(model.AssistBackup).
+			build(),
+		newBaseInfoBuilder(3, testT3, testUser1Mail...).
+			build(),
+	}
+
+	table := []struct {
+		name         string
+		asOf         time.Time
+		expectedIdxs map[int][]identity.Reasoner
+	}{
+		{
+			name: "after all snapshots",
+			asOf: testT4,
+			expectedIdxs: map[int][]identity.Reasoner{
+				2: testUser1Mail,
+			},
+		},
+		{
+			name: "skips assist backups",
+			asOf: testT3,
+			expectedIdxs: map[int][]identity.Reasoner{
+				0: testUser1Mail,
+			},
+		},
+		{
+			name: "excludes snapshots at the time",
+			asOf: testT1,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			mans := make([]manifestInfo, 0, len(inputData))
+			bups := make([]backupInfo, 0, len(inputData))
+
+			for _, d := range inputData {
+				mans = append(mans, d.manifest)
+				bups = append(bups, d.backup)
+			}
+
+			bf := baseFinder{
+				sm: &mockSnapshotManager{data: mans},
+				bg: &mockModelGetter{data: bups},
+			}
+
+			bb := bf.FindBasesAsOf(ctx, testUser1Mail, nil, test.asOf)
+
+			checkBaseEntriesMatch(
+				t,
+				bb.MergeBases(),
+				inputData,
+				test.expectedIdxs)
+		})
+	}
+}
+
 func checkBaseEntriesMatch(
 	t *testing.T,
 	gotBases []BackupBase,
@@ -1316,16 +1316,17 @@ func (suite *KopiaIntegrationSuite) TestBackupCollections_NoDetailsForMeta() {
 	}{
 		{
 			name:                  "Uncached",
-			expectedUploadedFiles: 3,
+			expectedUploadedFiles: 4,
 			expectedCachedFiles:   0,
 			// MockStream implements item info even though OneDrive doesn't.
-			numDeetsEntries: 3,
+			numDeetsEntries: 4,
 			hasMetaDeets:    true,
 			cols: func() []data.BackupCollection {
 				streams := []data.Item{}
 				fileNames := []string{
 					testFileName,
 					testFileName + metadata.MetaFileSuffix,
+					testFileName + metadata.VersionsFileSuffix,
 					metadata.DirMetaFileSuffix,
 				}
 
@@ -1356,7 +1357,7 @@ func (suite *KopiaIntegrationSuite) TestBackupCollections_NoDetailsForMeta() {
 		{
 			name:                  "Cached",
 			expectedUploadedFiles: 1,
-			expectedCachedFiles:   2,
+			expectedCachedFiles:   3,
 			// Meta entries are filtered out.
 			numDeetsEntries: 1,
 			hasMetaDeets:    false,
@@ -1436,7 +1437,7 @@ func (suite *KopiaIntegrationSuite) TestBackupCollections_NoDetailsForMeta() {
 			}
 
 			// Shouldn't have any items to merge because the cached files are metadata
-			// files.
+			// files.  Previous versions streams never have details of their own.
 			assert.Equal(t, 0, prevShortRefs.ItemsToMerge(), "merge items")
 
 			checkSnapshotTags(
@@ -10,6 +10,7 @@ import (
 	"github.com/alcionai/corso/src/internal/data"
 	"github.com/alcionai/corso/src/internal/diagnostics"
 	kinject "github.com/alcionai/corso/src/internal/kopia/inject"
+	"github.com/alcionai/corso/src/internal/m365/collection/onenote"
 	"github.com/alcionai/corso/src/internal/m365/service/exchange"
 	"github.com/alcionai/corso/src/internal/m365/service/groups"
 	"github.com/alcionai/corso/src/internal/m365/service/onedrive"
@@ -205,6 +206,17 @@ func (ctrl *Controller) GetMetadataPaths(
 			for _, fn := range sharepoint.ListsMetadataFileNames() {
 				filePaths = append(filePaths, []string{fn})
 			}
+
+			paths = append(paths, sharepoint.PreviousListPaths(ctx, reason, r, base.GetSnapshotID())...)
+		case reason.Category() == path.NotebooksCategory:
+			for _, fn := range onenote.MetadataFileNames() {
+				filePaths = append(filePaths, []string{fn})
+			}
+		case reason.Service() == path.ExchangeService && reason.Category() == path.MailboxSettingsCategory:
+			// mailbox settings are re-fetched in full on every backup and
+			// don't produce any metadata.  Asking for files that were never
+			// written would drop the metadata for every other reason in the base.
+			continue
 		default:
 			for _, fn := range bupMD.AllMetadataFileNames() {
 				filePaths = append(filePaths, []string{fn})
@@ -16,6 +16,7 @@ import (
 	"github.com/alcionai/corso/src/internal/common/idname"
 	"github.com/alcionai/corso/src/internal/common/ptr"
 	"github.com/alcionai/corso/src/internal/data"
+	odmetadata "github.com/alcionai/corso/src/internal/m365/collection/drive/metadata"
 	"github.com/alcionai/corso/src/internal/m365/support"
 	"github.com/alcionai/corso/src/internal/observe"
 	"github.com/alcionai/corso/src/pkg/backup/details"
@@ -347,6 +348,20 @@ func (oc *Collection) getDriveItemContent(
 	return itemData, nil
 }
 
+// getItemVersions fetches the previous versions of the item that get
+// backed up, according to the version limits in the options.
+func (oc *Collection) getItemVersions(
+	ctx context.Context,
+	itemID string,
+) ([]odmetadata.ItemVersion, error) {
+	versions, err := oc.handler.GetItemVersions(ctx, oc.driveID, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	return selectVersions(versions, oc.ctrl.DriveItemVersions, time.Now()), nil
+}
+
 type itemAndAPIGetter interface {
 	GetItemer
 	api.Getter
@@ -571,6 +586,7 @@ func (oc *Collection) streamDriveItem(
 		itemInfo     details.ItemInfo
 		itemMeta     io.ReadCloser
 		itemMetaSize int
+		versions     []odmetadata.ItemVersion
 		metaFileName string
 		metaSuffix   string
 		err          error
@@ -603,8 +619,20 @@ func (oc *Collection) streamDriveItem(
 		metaSuffix = metadata.DirMetaFileSuffix
 	}
 
+	if isFile && oc.ctrl.DriveItemVersions.Enabled() {
+		versions, err = oc.getItemVersions(ctx, itemID)
+		if err != nil {
+			// Skip deleted items
+			if !clues.HasLabel(err, graph.LabelStatus(http.StatusNotFound)) && !errors.Is(err, core.ErrNotFound) {
+				errs.AddRecoverable(ctx, clues.Wrap(err, "getting item versions").Label(fault.LabelForceNoBackupCreation))
+			}
+
+			return
+		}
+	}
+
 	// Fetch metadata for the item
-	itemMeta, itemMetaSize, err = downloadItemMeta(ctx, oc.handler, oc.driveID, item)
+	itemMeta, itemMetaSize, err = downloadItemMeta(ctx, oc.handler, oc.driveID, item, versions)
 	if err != nil {
 		// Skip deleted items
 		if !clues.HasLabel(err, graph.LabelStatus(http.StatusNotFound)) && !errors.Is(err, core.ErrNotFound) {
@@ -621,6 +649,8 @@ func (oc *Collection) streamDriveItem(
 		itemSize,
 		parentPath)
 
+	setItemVersions(&itemInfo, versions)
+
 	ctx = clues.Add(ctx, "item_info", itemInfo)
 
 	// Drive content download requests are also rate limited by graph api.
@@ -653,6 +683,22 @@ func (oc *Collection) streamDriveItem(
 			itemInfo.Modified(),
 			oc.counter,
 			errs)
+
+		if len(versions) > 0 {
+			oc.data <- data.NewLazyItem(
+				ctx,
+				&versionsGetter{
+					getter:   oc.handler,
+					driveID:  oc.driveID,
+					itemID:   itemID,
+					itemName: itemName,
+					versions: versions,
+				},
+				itemID+metadata.VersionsFileSuffix,
+				itemInfo.Modified(),
+				oc.counter,
+				errs)
+		}
 	}
 
 	metaReader := lazy.NewLazyReadCloser(func() (io.ReadCloser, error) {
@@ -304,6 +304,113 @@ func (suite *CollectionUnitSuite) TestCollection() {
 	}
 }
 
+func (suite *CollectionUnitSuite) TestCollection_itemVersions() {
+	var (
+		t          = suite.T()
+		stubItemID = "fakeItemID"
+		collStatus = support.ControllerOperationStatus{}
+		wg         = sync.WaitGroup{}
+		now        = time.Now()
+		readItems  = map[string]data.Item{}
+	)
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	wg.Add(1)
+
+	folderPath, err := path.Build(
+		"a-tenant",
+		"a-user",
+		path.OneDriveService,
+		path.FilesCategory,
+		false,
+		path.Split("drive/driveID1/root:/folderPath")...)
+	require.NoError(t, err, clues.ToCore(err))
+
+	mbh := defaultOneDriveBH("a-user")
+	mbh.GI = getsItem{Err: assert.AnError}
+	mbh.GIP = getsItemPermission{Perm: models.NewPermissionCollectionResponse()}
+	mbh.Versions = []models.DriveItemVersionable{
+		driveItemVersion("3.0", now, 7),
+		driveItemVersion("2.0", now.Add(-time.Hour), 3),
+		driveItemVersion("1.0", now.Add(-2*time.Hour), 3),
+	}
+	mbh.GetResps = []*http.Response{
+		{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("current"))},
+		{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("two"))},
+	}
+	mbh.GetErrs = []error{nil, nil}
+
+	coll, err := NewCollection(
+		mbh,
+		mbh.ProtectedResource,
+		folderPath,
+		nil,
+		"drive-id",
+		"drive-name",
+		suite.testStatusUpdater(&wg, &collStatus),
+		control.Options{DriveItemVersions: control.DriveItemVersions{MaxCount: 1}},
+		false,
+		true,
+		nil,
+		count.New())
+	require.NoError(t, err, clues.ToCore(err))
+
+	stubItem := odTD.NewStubDriveItem(
+		stubItemID,
+		"name",
+		7,
+		now,
+		now,
+		true,
+		false)
+
+	coll.Add(custom.ToCustomDriveItem(stubItem))
+
+	for item := range coll.Items(ctx, fault.New(true)) {
+		readItems[item.ID()] = item
+	}
+
+	wg.Wait()
+
+	require.Len(t, readItems, 3)
+
+	read := func(name string) []byte {
+		item, ok := readItems[name]
+		require.True(t, ok, "item exists: "+name)
+
+		rr, err := readers.NewVersionedRestoreReader(item.ToReader())
+		require.NoError(t, err, clues.ToCore(err))
+
+		bs, err := io.ReadAll(rr)
+		require.NoError(t, err, clues.ToCore(err))
+
+		return bs
+	}
+
+	assert.Equal(t, "current", string(read(stubItemID+metadata.DataFileSuffix)))
+	assert.Equal(t, "two", string(read(stubItemID+metadata.VersionsFileSuffix)))
+
+	// only the data file produces details.
+	_, ok := readItems[stubItemID+metadata.VersionsFileSuffix].(data.ItemInfo)
+	assert.False(t, ok, "versions stream has no details")
+
+	info, err := readItems[stubItemID+metadata.DataFileSuffix].(data.ItemInfo).Info()
+	require.NoError(t, err, clues.ToCore(err))
+	assert.Equal(
+		t,
+		[]details.DriveItemVersion{{ID: "2.0", Modified: now.Add(-time.Hour), Size: 3}},
+		info.OneDrive.Versions)
+
+	meta := odmetadata.Metadata{}
+	err = json.Unmarshal(read(stubItemID+metadata.MetaFileSuffix), &meta)
+	require.NoError(t, err, clues.ToCore(err))
+	require.Len(t, meta.Versions, 1)
+	assert.Equal(t, "2.0", meta.Versions[0].ID)
+	assert.Equal(t, int64(3), meta.Versions[0].Size)
+}
+
 func (suite *CollectionUnitSuite) TestCollectionReadError() {
 	var (
 		t          = suite.T()
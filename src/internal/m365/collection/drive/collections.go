@@ -634,6 +634,7 @@ func (c *Collections) handleDelete(
 
 		excluded[itemID+metadata.DataFileSuffix] = struct{}{}
 		excluded[itemID+metadata.MetaFileSuffix] = struct{}{}
+		excluded[itemID+metadata.VersionsFileSuffix] = struct{}{}
 		// Exchange counts items streamed through it which includes deletions so
 		// add that here too.
 		c.NumFiles++
@@ -1179,6 +1180,7 @@ func (c *Collections) processItem(
 			// original one and download a fresh copy.
 			excludedItemIDs[itemID+metadata.DataFileSuffix] = struct{}{}
 			excludedItemIDs[itemID+metadata.MetaFileSuffix] = struct{}{}
+			excludedItemIDs[itemID+metadata.VersionsFileSuffix] = struct{}{}
 		}
 
 	default:
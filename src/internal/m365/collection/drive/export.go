@@ -2,9 +2,13 @@ package drive
 
 import (
 	"context"
+	"fmt"
+	"io"
+	stdpath "path"
 	"strings"
 
 	"github.com/alcionai/clues"
+	"github.com/spatialcurrent/go-lazy/pkg/lazy"
 
 	"github.com/alcionai/corso/src/internal/data"
 	"github.com/alcionai/corso/src/internal/version"
@@ -20,12 +24,14 @@ func NewExportCollection(
 	baseDir string,
 	backingCollection []data.RestoreCollection,
 	backupVersion int,
+	cec control.ExportConfig,
 	stats *metrics.ExportStats,
 ) export.Collectioner {
 	return export.BaseCollection{
 		BaseDir:           baseDir,
 		BackingCollection: backingCollection,
 		BackupVersion:     backupVersion,
+		Cfg:               cec,
 		Stream:            streamItems,
 		Stats:             stats,
 	}
@@ -51,6 +57,19 @@ func streamItems(
 				continue
 			}
 
+			// previous versions only get exported alongside their data file.
+			if strings.HasSuffix(itemUUID, metadata.VersionsFileSuffix) {
+				continue
+			}
+
+			if len(cec.ItemVersion) > 0 && backupVersion >= version.OneDrive6NameInMeta {
+				for _, ei := range versionedItems(ctx, rc, item, cec.ItemVersion, stats) {
+					ch <- ei
+				}
+
+				continue
+			}
+
 			name, err := getItemName(ctx, itemUUID, backupVersion, rc)
 			if err != nil {
 				ch <- export.Item{
@@ -133,3 +152,106 @@ func getItemName(
 
 	return "", clues.NewWC(ctx, "invalid item id")
 }
+
+// versionedItems produces the export items for the version of the file
+// picked by the item version: either a single previous version, which
+// gets exported in place of the current content, or every backed up
+// version alongside the current content.
+func versionedItems(
+	ctx context.Context,
+	fin data.FetchItemByNamer,
+	item data.Item,
+	itemVersion string,
+	stats *metrics.ExportStats,
+) []export.Item {
+	var (
+		itemUUID    = item.ID()
+		trimmedName = strings.TrimSuffix(itemUUID, metadata.DataFileSuffix)
+	)
+
+	meta, err := FetchAndReadMetadata(ctx, fin, trimmedName+metadata.MetaFileSuffix)
+	if err != nil {
+		return []export.Item{{
+			ID:    itemUUID,
+			Error: clues.WrapWC(ctx, err, "getting metadata"),
+		}}
+	}
+
+	if itemVersion != control.AllItemVersions {
+		v, offset, ok := findVersion(meta.Versions, itemVersion)
+		if !ok {
+			return []export.Item{{
+				ID: itemUUID,
+				Error: clues.NewWC(ctx, "item version not in backup").
+					With("item_version", itemVersion),
+			}}
+		}
+
+		stats.UpdateResourceCount(path.FilesCategory)
+
+		return []export.Item{{
+			ID:   itemUUID,
+			Name: meta.FileName,
+			Body: metrics.ReaderWithStats(
+				versionReader(ctx, fin, trimmedName, offset, v.Size),
+				path.FilesCategory,
+				stats),
+		}}
+	}
+
+	stats.UpdateResourceCount(path.FilesCategory)
+
+	items := []export.Item{{
+		ID:   itemUUID,
+		Name: meta.FileName,
+		Body: metrics.ReaderWithStats(item.ToReader(), path.FilesCategory, stats),
+	}}
+
+	var offset int64
+
+	for _, v := range meta.Versions {
+		stats.UpdateResourceCount(path.FilesCategory)
+
+		items = append(items, export.Item{
+			ID:   trimmedName + metadata.VersionsFileSuffix + "/" + v.ID,
+			Name: versionFileName(meta.FileName, v.ID),
+			Body: metrics.ReaderWithStats(
+				versionReader(ctx, fin, trimmedName, offset, v.Size),
+				path.FilesCategory,
+				stats),
+		})
+
+		offset += v.Size
+	}
+
+	return items
+}
+
+// versionReader lazily reads the version out of the versions stream.
+func versionReader(
+	ctx context.Context,
+	fin data.FetchItemByNamer,
+	trimmedName string,
+	offset, size int64,
+) io.ReadCloser {
+	return lazy.NewLazyReadCloser(func() (io.ReadCloser, error) {
+		vs := newVersionsStream(fin, trimmedName)
+
+		r, err := vs.open(ctx, offset, size)
+		if err != nil {
+			return nil, err
+		}
+
+		return struct {
+			io.Reader
+			io.Closer
+		}{r, vs}, nil
+	})
+}
+
+// versionFileName produces the name of the exported file for a previous
+// version, eg: "report (version 2.0).docx".
+func versionFileName(name, versionID string) string {
+	ext := stdpath.Ext(name)
+	return fmt.Sprintf("%s (version %s)%s", strings.TrimSuffix(name, ext), versionID, ext)
+}
@@ -38,6 +38,7 @@ type BackupHandler interface {
 	ItemInfoAugmenter
 	api.Getter
 	GetItemPermissioner
+	GetItemVersionser
 	GetItemer
 	GetRootFolderer
 	NewDrivePagerer
@@ -78,6 +79,13 @@ type GetItemPermissioner interface {
 	) (models.PermissionCollectionResponseable, error)
 }
 
+type GetItemVersionser interface {
+	GetItemVersions(
+		ctx context.Context,
+		driveID, itemID string,
+	) ([]models.DriveItemVersionable, error)
+}
+
 type GetItemer interface {
 	GetItem(
 		ctx context.Context,
@@ -104,6 +112,7 @@ type RestoreHandler interface {
 	GetItemsByCollisionKeyser
 	GetRootFolderer
 	ItemInfoAugmenter
+	MoveItemer
 	NewDrivePagerer
 	NewItemContentUploader
 	PostDriver
@@ -120,6 +129,13 @@ type DeleteItemer interface {
 	) error
 }
 
+type MoveItemer interface {
+	MoveItem(
+		ctx context.Context,
+		driveID, itemID, parentFolderID string,
+	) error
+}
+
 type DeleteItemPermissioner interface {
 	DeleteItemPermission(
 		ctx context.Context,
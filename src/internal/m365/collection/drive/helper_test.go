@@ -118,6 +118,7 @@ func makeExcludeMap(files ...string) map[string]struct{} {
 	for _, file := range files {
 		delList[file+metadata.DataFileSuffix] = struct{}{}
 		delList[file+metadata.MetaFileSuffix] = struct{}{}
+		delList[file+metadata.VersionsFileSuffix] = struct{}{}
 	}
 
 	return delList
@@ -646,6 +647,10 @@ type mockBackupHandler[T any] struct {
 	GI  getsItem
 	GIP getsItemPermission
 
+	// item versions, newest first
+	Versions    []models.DriveItemVersionable
+	VersionsErr error
+
 	PathPrefixFn  pathPrefixer
 	PathPrefixErr error
 
@@ -835,6 +840,13 @@ func (h mockBackupHandler[T]) GetItemPermission(
 	return h.GIP.GetItemPermission(ctx, "", "")
 }
 
+func (h mockBackupHandler[T]) GetItemVersions(
+	context.Context,
+	string, string,
+) ([]models.DriveItemVersionable, error) {
+	return h.Versions, h.VersionsErr
+}
+
 type canonPather func(*path.Builder, string, string) (path.Path, error)
 
 var defaultOneDriveCanonPather = func(pb *path.Builder, tID, ro string) (path.Path, error) {
@@ -1253,6 +1265,9 @@ type mockRestoreHandler struct {
 	CalledDeleteItemOn string
 	DeleteItemErr      error
 
+	CalledMoveItemOn []string
+	MoveItemErr      error
+
 	CalledPostItem bool
 	PostItemResp   models.DriveItemable
 	PostItemErr    error
@@ -1303,6 +1318,14 @@ func (h *mockRestoreHandler) DeleteItem(
 	return h.DeleteItemErr
 }
 
+func (h *mockRestoreHandler) MoveItem(
+	_ context.Context,
+	_, itemID, _ string,
+) error {
+	h.CalledMoveItemOn = append(h.CalledMoveItemOn, itemID)
+	return h.MoveItemErr
+}
+
 func (h *mockRestoreHandler) DeleteItemPermission(
 	context.Context,
 	string, string, string,
@@ -159,10 +159,12 @@ func downloadItemMeta(
 	getter GetItemPermissioner,
 	driveID string,
 	item *custom.DriveItem,
+	versions []metadata.ItemVersion,
 ) (io.ReadCloser, int, error) {
 	meta := metadata.Metadata{
 		FileName:    ptr.Val(item.GetName()),
 		SharingMode: metadata.SharingModeInherited,
+		Versions:    versions,
 	}
 
 	if item.GetShared() != nil {
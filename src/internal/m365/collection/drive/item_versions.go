@@ -0,0 +1,379 @@
+package drive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/data"
+	odmetadata "github.com/alcionai/corso/src/internal/m365/collection/drive/metadata"
+	"github.com/alcionai/corso/src/internal/observe"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph/metadata"
+)
+
+// The previous versions of a file get stored in a single stream next to
+// the file's data, named <itemID>.versions, which holds the content of each
+// version back to back, oldest first.  The item metadata lists the ID and
+// size of each version, which is enough to find a version within the stream.
+// Keeping the history in a single stream allows incremental backups to
+// exclude it from the merge base whenever the file changes, same as the
+// data and metadata files.
+
+const versionContentURLFmt = "https://graph.microsoft.com/v1.0/drives/%s/items/%s/versions/%s/content"
+
+// selectVersions picks the previous versions of the file that get backed
+// up within the limits, and returns them oldest first.  Graph lists the
+// versions newest first, starting with the current version.
+func selectVersions(
+	versions []models.DriveItemVersionable,
+	limits control.DriveItemVersions,
+	now time.Time,
+) []odmetadata.ItemVersion {
+	if !limits.Enabled() || len(versions) < 2 {
+		return nil
+	}
+
+	selected := []odmetadata.ItemVersion{}
+
+	for _, v := range versions[1:] {
+		if limits.MaxCount > 0 && len(selected) >= limits.MaxCount {
+			break
+		}
+
+		modified := ptr.Val(v.GetLastModifiedDateTime())
+
+		if limits.MaxAge > 0 && now.Sub(modified) > limits.MaxAge {
+			break
+		}
+
+		selected = append(selected, odmetadata.ItemVersion{
+			ID:       ptr.Val(v.GetId()),
+			Modified: modified,
+			Size:     ptr.Val(v.GetSize()),
+		})
+	}
+
+	// oldest first
+	for i, j := 0, len(selected)-1; i < j; i, j = i+1, j-1 {
+		selected[i], selected[j] = selected[j], selected[i]
+	}
+
+	return selected
+}
+
+// setItemVersions lists the versions in the details of the drive item.
+func setItemVersions(info *details.ItemInfo, versions []odmetadata.ItemVersion) {
+	if len(versions) == 0 {
+		return
+	}
+
+	dvs := make([]details.DriveItemVersion, 0, len(versions))
+
+	for _, v := range versions {
+		dvs = append(dvs, details.DriveItemVersion{
+			ID:       v.ID,
+			Modified: v.Modified,
+			Size:     v.Size,
+		})
+	}
+
+	switch {
+	case info.OneDrive != nil:
+		info.OneDrive.Versions = dvs
+	case info.SharePoint != nil:
+		info.SharePoint.Versions = dvs
+	case info.Groups != nil:
+		info.Groups.Versions = dvs
+	}
+}
+
+// ---------------------------------------------------------------------------
+// backup
+// ---------------------------------------------------------------------------
+
+var _ data.ItemDataGetter = &versionsGetter{}
+
+// versionsGetter produces the versions stream of a file.
+type versionsGetter struct {
+	getter   api.Getter
+	driveID  string
+	itemID   string
+	itemName string
+	versions []odmetadata.ItemVersion
+}
+
+func (vg *versionsGetter) GetData(
+	ctx context.Context,
+	_ *fault.Bus,
+) (io.ReadCloser, *details.ItemInfo, bool, error) {
+	var size int64
+
+	for _, v := range vg.versions {
+		size += v.Size
+	}
+
+	vr := &versionsReader{
+		ctx:      ctx,
+		getter:   vg.getter,
+		driveID:  vg.driveID,
+		itemID:   vg.itemID,
+		versions: vg.versions,
+	}
+
+	progReader := observe.ItemProgress(
+		ctx,
+		vr,
+		observe.ItemBackupMsg,
+		clues.Hide(vg.itemName+metadata.VersionsFileSuffix),
+		size)
+
+	return progReader, nil, false, nil
+}
+
+// versionsReader downloads the content of each version in turn.  The
+// content of every version must match the size in the metadata, or else
+// the versions after it couldn't be found within the stream.
+type versionsReader struct {
+	ctx      context.Context
+	getter   api.Getter
+	driveID  string
+	itemID   string
+	versions []odmetadata.ItemVersion
+
+	curr      io.ReadCloser
+	currID    string
+	remaining int64
+}
+
+func (vr *versionsReader) Read(p []byte) (int, error) {
+	for vr.curr == nil || vr.remaining == 0 {
+		if err := vr.next(); err != nil {
+			return 0, err
+		}
+	}
+
+	if int64(len(p)) > vr.remaining {
+		p = p[:vr.remaining]
+	}
+
+	n, err := vr.curr.Read(p)
+	vr.remaining -= int64(n)
+
+	if errors.Is(err, io.EOF) {
+		if vr.remaining > 0 {
+			return n, clues.StackWC(vr.ctx, io.ErrUnexpectedEOF).
+				With("version_id", vr.currID, "missing_bytes", vr.remaining)
+		}
+
+		err = nil
+	}
+
+	return n, clues.StackWC(vr.ctx, err).OrNil()
+}
+
+// next closes the current version and begins downloading the next one.
+func (vr *versionsReader) next() error {
+	if vr.curr != nil {
+		vr.curr.Close()
+		vr.curr = nil
+	}
+
+	if len(vr.versions) == 0 {
+		return io.EOF
+	}
+
+	v := vr.versions[0]
+	vr.versions = vr.versions[1:]
+
+	vr.currID = v.ID
+	vr.remaining = v.Size
+
+	// empty versions have nothing to download.
+	if v.Size == 0 {
+		return nil
+	}
+
+	url := fmt.Sprintf(versionContentURLFmt, vr.driveID, vr.itemID, v.ID)
+
+	rc, err := downloadFile(vr.ctx, vr.getter, url, true)
+	if err != nil {
+		return clues.Wrap(err, "downloading item version").With("version_id", v.ID)
+	}
+
+	vr.curr = rc
+
+	return nil
+}
+
+func (vr *versionsReader) Close() error {
+	if vr.curr == nil {
+		return nil
+	}
+
+	return vr.curr.Close()
+}
+
+// ---------------------------------------------------------------------------
+// restore and export
+// ---------------------------------------------------------------------------
+
+// findVersion returns the position of the version in the versions stream.
+func findVersion(
+	versions []odmetadata.ItemVersion,
+	versionID string,
+) (odmetadata.ItemVersion, int64, bool) {
+	var offset int64
+
+	for _, v := range versions {
+		if v.ID == versionID {
+			return v, offset, true
+		}
+
+		offset += v.Size
+	}
+
+	return odmetadata.ItemVersion{}, 0, false
+}
+
+// versionsStream reads versions out of the versions stream of a file.
+// Reading the versions in order reuses a single reader over the stream.
+type versionsStream struct {
+	fibn   data.FetchItemByNamer
+	name   string
+	rc     io.ReadCloser
+	offset int64
+}
+
+func newVersionsStream(fibn data.FetchItemByNamer, trimmedName string) *versionsStream {
+	return &versionsStream{
+		fibn: fibn,
+		name: trimmedName + metadata.VersionsFileSuffix,
+	}
+}
+
+// open returns a reader over the size bytes of the version at the offset.
+// The reader is only valid until the next call to open.
+func (vs *versionsStream) open(
+	ctx context.Context,
+	offset, size int64,
+) (io.Reader, error) {
+	if vs.rc == nil || vs.offset > offset {
+		vs.Close()
+
+		item, err := vs.fibn.FetchItemByName(ctx, vs.name)
+		if err != nil {
+			return nil, clues.Wrap(err, "getting item versions")
+		}
+
+		vs.rc = item.ToReader()
+		vs.offset = 0
+	}
+
+	if _, err := io.CopyN(io.Discard, vs.rc, offset-vs.offset); err != nil {
+		vs.Close()
+		return nil, clues.WrapWC(ctx, err, "seeking item version")
+	}
+
+	vs.offset = offset
+
+	return &offsetReader{
+		r:  io.LimitReader(vs.rc, size),
+		vs: vs,
+	}, nil
+}
+
+func (vs *versionsStream) Close() error {
+	if vs.rc == nil {
+		return nil
+	}
+
+	err := vs.rc.Close()
+	vs.rc = nil
+
+	return err
+}
+
+// offsetReader tracks the position of the reader within the stream.
+type offsetReader struct {
+	r  io.Reader
+	vs *versionsStream
+}
+
+func (or *offsetReader) Read(p []byte) (int, error) {
+	n, err := or.r.Read(p)
+	or.vs.offset += int64(n)
+
+	return n, err
+}
+
+// versionContent sources an upload from a version in the versions stream.
+func versionContent(
+	vs *versionsStream,
+	name string,
+	v odmetadata.ItemVersion,
+	offset int64,
+) fileContent {
+	return fileContent{
+		name: fmt.Sprintf("%s (version %s)", name, v.ID),
+		size: v.Size,
+		open: func(ctx context.Context, _ int) (io.ReadCloser, error) {
+			r, err := vs.open(ctx, offset, v.Size)
+			return io.NopCloser(r), err
+		},
+	}
+}
+
+// restoreContents produces the uploads which restore the version of the
+// file picked by the item version: the current content by default, a
+// single previous version, or every backed up version followed by the
+// current content.  The versions stream must be closed once the uploads
+// complete.
+func restoreContents(
+	ctx context.Context,
+	itemVersion string,
+	fibn data.FetchItemByNamer,
+	trimmedName string,
+	meta odmetadata.Metadata,
+	itemData data.Item,
+) ([]fileContent, *versionsStream, error) {
+	vs := newVersionsStream(fibn, trimmedName)
+
+	switch itemVersion {
+	case "":
+		current, err := itemContent(ctx, fibn, meta.FileName, itemData)
+		return []fileContent{current}, vs, err
+
+	case control.AllItemVersions:
+		var (
+			contents = make([]fileContent, 0, len(meta.Versions)+1)
+			offset   int64
+		)
+
+		for _, v := range meta.Versions {
+			contents = append(contents, versionContent(vs, meta.FileName, v, offset))
+			offset += v.Size
+		}
+
+		current, err := itemContent(ctx, fibn, meta.FileName, itemData)
+
+		return append(contents, current), vs, err
+	}
+
+	v, offset, ok := findVersion(meta.Versions, itemVersion)
+	if !ok {
+		return nil, vs, clues.NewWC(ctx, "item version not in backup").
+			With("item_version", itemVersion)
+	}
+
+	return []fileContent{versionContent(vs, meta.FileName, v, offset)}, vs, nil
+}
@@ -0,0 +1,455 @@
+package drive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/data"
+	dataMock "github.com/alcionai/corso/src/internal/data/mock"
+	odmetadata "github.com/alcionai/corso/src/internal/m365/collection/drive/metadata"
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/metrics"
+)
+
+type ItemVersionsUnitSuite struct {
+	tester.Suite
+}
+
+func TestItemVersionsUnitSuite(t *testing.T) {
+	suite.Run(t, &ItemVersionsUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func driveItemVersion(id string, modified time.Time, size int64) models.DriveItemVersionable {
+	v := models.NewDriveItemVersion()
+	v.SetId(ptr.To(id))
+	v.SetLastModifiedDateTime(ptr.To(modified))
+	v.SetSize(ptr.To(size))
+
+	return v
+}
+
+func (suite *ItemVersionsUnitSuite) TestSelectVersions() {
+	now := time.Now()
+
+	// newest first, starting with the current version
+	versions := []models.DriveItemVersionable{
+		driveItemVersion("4.0", now, 4),
+		driveItemVersion("3.0", now.Add(-1*time.Hour), 3),
+		driveItemVersion("2.0", now.Add(-48*time.Hour), 2),
+		driveItemVersion("1.0", now.Add(-96*time.Hour), 1),
+	}
+
+	table := []struct {
+		name     string
+		versions []models.DriveItemVersionable
+		limits   control.DriveItemVersions
+		expect   []string
+	}{
+		{
+			name:     "disabled",
+			versions: versions,
+			expect:   nil,
+		},
+		{
+			name:     "only the current version",
+			versions: versions[:1],
+			limits:   control.DriveItemVersions{MaxCount: 5},
+			expect:   nil,
+		},
+		{
+			name:     "count",
+			versions: versions,
+			limits:   control.DriveItemVersions{MaxCount: 2},
+			expect:   []string{"2.0", "3.0"},
+		},
+		{
+			name:     "count above available",
+			versions: versions,
+			limits:   control.DriveItemVersions{MaxCount: 10},
+			expect:   []string{"1.0", "2.0", "3.0"},
+		},
+		{
+			name:     "age",
+			versions: versions,
+			limits:   control.DriveItemVersions{MaxAge: 72 * time.Hour},
+			expect:   []string{"2.0", "3.0"},
+		},
+		{
+			name:     "count and age",
+			versions: versions,
+			limits:   control.DriveItemVersions{MaxCount: 1, MaxAge: 72 * time.Hour},
+			expect:   []string{"3.0"},
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			result := selectVersions(test.versions, test.limits, now)
+
+			ids := []string{}
+			for _, v := range result {
+				ids = append(ids, v.ID)
+			}
+
+			if test.expect == nil {
+				assert.Empty(t, result)
+				return
+			}
+
+			assert.Equal(t, test.expect, ids)
+		})
+	}
+}
+
+func (suite *ItemVersionsUnitSuite) TestSetItemVersions() {
+	t := suite.T()
+
+	versions := []odmetadata.ItemVersion{{ID: "1.0", Size: 1}}
+	expect := []details.DriveItemVersion{{ID: "1.0", Size: 1}}
+
+	od := details.ItemInfo{OneDrive: &details.OneDriveInfo{}}
+	setItemVersions(&od, versions)
+	assert.Equal(t, expect, od.OneDrive.Versions)
+
+	sp := details.ItemInfo{SharePoint: &details.SharePointInfo{}}
+	setItemVersions(&sp, versions)
+	assert.Equal(t, expect, sp.SharePoint.Versions)
+
+	gr := details.ItemInfo{Groups: &details.GroupsInfo{}}
+	setItemVersions(&gr, versions)
+	assert.Equal(t, expect, gr.Groups.Versions)
+}
+
+// versionContentGetter serves the content of each version by url.
+type versionContentGetter struct {
+	content map[string]string
+	urls    []string
+}
+
+func (g *versionContentGetter) Get(
+	_ context.Context,
+	url string,
+	_ map[string]string,
+	_ bool,
+) (*http.Response, error) {
+	g.urls = append(g.urls, url)
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(g.content[url])),
+	}, nil
+}
+
+func (suite *ItemVersionsUnitSuite) TestVersionsGetter() {
+	versionURL := func(id string) string {
+		return fmt.Sprintf(versionContentURLFmt, "driveID", "itemID", id)
+	}
+
+	table := []struct {
+		name       string
+		versions   []odmetadata.ItemVersion
+		expect     string
+		expectURLs []string
+		expectErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name: "oldest first",
+			versions: []odmetadata.ItemVersion{
+				{ID: "1.0", Size: 3},
+				{ID: "2.0", Size: 0},
+				{ID: "3.0", Size: 5},
+			},
+			expect:     "onethree",
+			expectURLs: []string{versionURL("1.0"), versionURL("3.0")},
+			expectErr:  assert.NoError,
+		},
+		{
+			name: "content shorter than the version size",
+			versions: []odmetadata.ItemVersion{
+				{ID: "1.0", Size: 10},
+				{ID: "3.0", Size: 5},
+			},
+			expect:     "one",
+			expectURLs: []string{versionURL("1.0")},
+			expectErr:  assert.Error,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			getter := &versionContentGetter{
+				content: map[string]string{
+					versionURL("1.0"): "one",
+					versionURL("2.0"): "",
+					versionURL("3.0"): "three",
+				},
+			}
+
+			vg := &versionsGetter{
+				getter:   getter,
+				driveID:  "driveID",
+				itemID:   "itemID",
+				itemName: "file.txt",
+				versions: test.versions,
+			}
+
+			rc, info, delInFlight, err := vg.GetData(ctx, fault.New(true))
+			require.NoError(t, err, clues.ToCore(err))
+			assert.Nil(t, info)
+			assert.False(t, delInFlight)
+
+			defer rc.Close()
+
+			bs, err := io.ReadAll(rc)
+			test.expectErr(t, err, clues.ToCore(err))
+			assert.Equal(t, test.expect, string(bs))
+			assert.Equal(t, test.expectURLs, getter.urls)
+		})
+	}
+}
+
+// versionsFetcher produces a fresh reader over the item on every fetch.
+type versionsFetcher struct {
+	items   map[string][]byte
+	fetches map[string]int
+}
+
+func (vf *versionsFetcher) FetchItemByName(
+	_ context.Context,
+	name string,
+) (data.Item, error) {
+	bs, ok := vf.items[name]
+	if !ok {
+		return nil, data.ErrNotFound
+	}
+
+	vf.fetches[name]++
+
+	return &dataMock.Item{
+		ItemID:   name,
+		ItemSize: int64(len(bs)),
+		Reader:   io.NopCloser(bytes.NewReader(bs)),
+	}, nil
+}
+
+func versionsTestData(t *testing.T) (*versionsFetcher, odmetadata.Metadata) {
+	meta := odmetadata.Metadata{
+		FileName: "report.docx",
+		Versions: []odmetadata.ItemVersion{
+			{ID: "1.0", Size: 3},
+			{ID: "2.0", Size: 3},
+			{ID: "3.0", Size: 5},
+		},
+	}
+
+	mbs, err := json.Marshal(meta)
+	require.NoError(t, err, clues.ToCore(err))
+
+	vf := &versionsFetcher{
+		items: map[string][]byte{
+			"id.data":     []byte("current"),
+			"id.meta":     mbs,
+			"id.versions": []byte("onetwothree"),
+		},
+		fetches: map[string]int{},
+	}
+
+	return vf, meta
+}
+
+func (suite *ItemVersionsUnitSuite) TestRestoreContents() {
+	table := []struct {
+		name          string
+		itemVersion   string
+		expect        []string
+		expectFetches int
+		expectErr     assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "current content",
+			expect:    []string{"current"},
+			expectErr: assert.NoError,
+		},
+		{
+			name:          "all versions",
+			itemVersion:   control.AllItemVersions,
+			expect:        []string{"one", "two", "three", "current"},
+			expectFetches: 1,
+			expectErr:     assert.NoError,
+		},
+		{
+			name:          "specific version",
+			itemVersion:   "2.0",
+			expect:        []string{"two"},
+			expectFetches: 1,
+			expectErr:     assert.NoError,
+		},
+		{
+			name:        "version not in backup",
+			itemVersion: "4.0",
+			expectErr:   assert.Error,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			vf, meta := versionsTestData(t)
+
+			itemData, err := vf.FetchItemByName(ctx, "id.data")
+			require.NoError(t, err, clues.ToCore(err))
+
+			contents, vs, err := restoreContents(ctx, test.itemVersion, vf, "id", meta, itemData)
+			test.expectErr(t, err, clues.ToCore(err))
+
+			if err != nil {
+				return
+			}
+
+			defer vs.Close()
+
+			result := []string{}
+
+			for _, fc := range contents {
+				r, err := fc.open(ctx, 0)
+				require.NoError(t, err, clues.ToCore(err))
+
+				bs, err := io.ReadAll(r)
+				require.NoError(t, err, clues.ToCore(err))
+				assert.Equal(t, fc.size, int64(len(bs)), fc.name)
+
+				result = append(result, string(bs))
+			}
+
+			assert.Equal(t, test.expect, result)
+			assert.Equal(t, test.expectFetches, vf.fetches["id.versions"])
+		})
+	}
+}
+
+func (suite *ItemVersionsUnitSuite) TestVersionsStream_reopen() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	vf, _ := versionsTestData(t)
+	vs := newVersionsStream(vf, "id")
+
+	defer vs.Close()
+
+	read := func(offset, size int64) string {
+		r, err := vs.open(ctx, offset, size)
+		require.NoError(t, err, clues.ToCore(err))
+
+		bs, err := io.ReadAll(r)
+		require.NoError(t, err, clues.ToCore(err))
+
+		return string(bs)
+	}
+
+	// reading forward reuses the stream.
+	assert.Equal(t, "two", read(3, 3))
+	assert.Equal(t, "three", read(6, 5))
+	assert.Equal(t, 1, vf.fetches["id.versions"])
+
+	// reading backward, such as on a retry, re-opens the stream.
+	assert.Equal(t, "three", read(6, 5))
+	assert.Equal(t, 2, vf.fetches["id.versions"])
+}
+
+func (suite *ItemVersionsUnitSuite) TestVersionedItems() {
+	table := []struct {
+		name        string
+		itemVersion string
+		expect      map[string]string
+		expectErr   bool
+	}{
+		{
+			name:        "all versions",
+			itemVersion: control.AllItemVersions,
+			expect: map[string]string{
+				"report.docx":               "current",
+				"report (version 1.0).docx": "one",
+				"report (version 2.0).docx": "two",
+				"report (version 3.0).docx": "three",
+			},
+		},
+		{
+			name:        "specific version",
+			itemVersion: "3.0",
+			expect: map[string]string{
+				"report.docx": "three",
+			},
+		},
+		{
+			name:        "version not in backup",
+			itemVersion: "4.0",
+			expectErr:   true,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			vf, _ := versionsTestData(t)
+
+			itemData, err := vf.FetchItemByName(ctx, "id.data")
+			require.NoError(t, err, clues.ToCore(err))
+
+			items := versionedItems(ctx, vf, itemData, test.itemVersion, metrics.NewExportStats())
+
+			if test.expectErr {
+				require.Len(t, items, 1)
+				assert.Error(t, items[0].Error)
+
+				return
+			}
+
+			result := map[string]string{}
+			ids := map[string]struct{}{}
+
+			for _, item := range items {
+				require.NoError(t, item.Error, clues.ToCore(item.Error))
+
+				bs, err := io.ReadAll(item.Body)
+				require.NoError(t, err, clues.ToCore(err))
+				require.NoError(t, item.Body.Close())
+
+				result[item.Name] = string(bs)
+				ids[item.ID] = struct{}{}
+			}
+
+			assert.Equal(t, test.expect, result)
+			assert.Len(t, ids, len(items), "unique item ids")
+		})
+	}
+}
@@ -44,4 +44,14 @@ type Metadata struct {
 	SharingMode SharingMode  `json:"permissionMode,omitempty"`
 	Permissions []Permission `json:"permissions,omitempty"`
 	LinkShares  []LinkShare  `json:"linkShares,omitempty"`
+	// Versions describes the previous versions of a file, oldest first,
+	// in the order their content is stored in the item's versions stream.
+	Versions []ItemVersion `json:"versions,omitempty"`
+}
+
+// ItemVersion describes a previous version of a file.
+type ItemVersion struct {
+	ID       string    `json:"id"`
+	Modified time.Time `json:"modified,omitempty"`
+	Size     int64     `json:"size"`
 }
@@ -584,6 +584,8 @@ func RestorePermissions(
 
 	if previousLinkShares != nil {
 		lsAdded, lsRemoved := odmetadata.DiffLinkShares(previousLinkShares, current.LinkShares)
+		lsAdded = caches.dropSiteScopedLinkShares(ctx, lsAdded, errs)
+		lsAdded = caches.Principals.mapLinkShares(ctx, lsAdded, caches.AvailableEntities, errs)
 		lsAdded = filterUnavailableEntitiesInLinkShare(ctx, lsAdded, caches.AvailableEntities, caches.OldLinkShareIDToNewID)
 
 		// Link shares have to be updated before permissions as we have to
@@ -610,6 +612,8 @@ func RestorePermissions(
 	}
 
 	permAdded, permRemoved := odmetadata.DiffPermissions(previous.Permissions, current.Permissions)
+	permAdded = caches.dropSiteScopedPermissions(ctx, permAdded, errs)
+	permAdded = caches.Principals.mapPermissions(ctx, permAdded, caches.AvailableEntities, errs)
 	permAdded = filterUnavailableEntitiesInPermissions(ctx, permAdded, caches.AvailableEntities, caches.OldPermIDToNewID)
 
 	if didReset {
@@ -619,7 +623,8 @@ func RestorePermissions(
 		logger.Ctx(ctx).Debug("link share creation reset all inherited permissions")
 
 		permRemoved = []odmetadata.Permission{}
-		permAdded = current.Permissions
+		permAdded = caches.dropSiteScopedPermissions(ctx, current.Permissions, errs)
+		permAdded = caches.Principals.mapPermissions(ctx, permAdded, caches.AvailableEntities, errs)
 	}
 
 	err = UpdatePermissions(
@@ -0,0 +1,225 @@
+package drive
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/alcionai/clues"
+
+	odmetadata "github.com/alcionai/corso/src/internal/m365/collection/drive/metadata"
+	"github.com/alcionai/corso/src/pkg/fault"
+)
+
+// PrincipalMap rewrites the users and groups granted access by restored
+// permissions and link shares, so that sharing can be carried over to
+// different principals, such as a departed user's successor, or the
+// same users in another tenant.
+type PrincipalMap struct {
+	// keyed by the lower-cased source ID or UPN.
+	targets map[string]string
+	alerts  principalAlerts
+}
+
+// NewPrincipalMap produces a principal map from the source ID or UPN to
+// the target ID or UPN.  Returns nil if the map is empty, in which case
+// principals are restored unchanged.
+func NewPrincipalMap(sourceToTarget map[string]string) *PrincipalMap {
+	if len(sourceToTarget) == 0 {
+		return nil
+	}
+
+	targets := make(map[string]string, len(sourceToTarget))
+
+	for src, tgt := range sourceToTarget {
+		targets[strings.ToLower(src)] = tgt
+	}
+
+	return &PrincipalMap{targets: targets}
+}
+
+// mapEntity returns the ID and email of the principal which replaces
+// the source principal.  Principals which have no mapping, or whose
+// target can't be found, are returned unchanged and reported as an
+// alert.
+func (pm *PrincipalMap) mapEntity(
+	ctx context.Context,
+	entityType odmetadata.GV2Type,
+	id, email string,
+	available ResourceIDNames,
+	errs *fault.Bus,
+) (string, string) {
+	if pm == nil {
+		return id, email
+	}
+
+	var cacher interface {
+		IDOf(name string) (string, bool)
+		NameOf(id string) (string, bool)
+	}
+
+	// only users and groups can be resolved within the tenant.
+	switch entityType {
+	case odmetadata.GV2User:
+		cacher = available.Users
+	case odmetadata.GV2Group:
+		cacher = available.Groups
+	default:
+		return id, email
+	}
+
+	target, ok := pm.targets[strings.ToLower(id)]
+	if !ok && len(email) > 0 {
+		target, ok = pm.targets[strings.ToLower(email)]
+	}
+
+	if !ok {
+		pm.alert(ctx, fault.AlertUnmappedPrincipal, entityType, id, email, "", errs)
+		return id, email
+	}
+
+	if cacher != nil {
+		if _, ok := cacher.NameOf(target); ok {
+			return target, ""
+		}
+
+		if targetID, ok := cacher.IDOf(target); ok {
+			return targetID, target
+		}
+	}
+
+	pm.alert(ctx, fault.AlertUnresolvedPrincipalTarget, entityType, id, email, target, errs)
+
+	return id, email
+}
+
+// alert reports the source principal, once per principal.
+func (pm *PrincipalMap) alert(
+	ctx context.Context,
+	message string,
+	entityType odmetadata.GV2Type,
+	id, email, target string,
+	errs *fault.Bus,
+) {
+	ctx = clues.Add(ctx, "principal_target", clues.Hide(target))
+
+	pm.alerts.add(
+		ctx,
+		message,
+		entityType,
+		id, email,
+		map[string]any{"target": target},
+		errs)
+}
+
+// principalAlerts reports alerts about the principals granted access by
+// restored permissions, once per alert message and principal.  The zero
+// value is ready to use.
+type principalAlerts struct {
+	mu      sync.Mutex
+	alerted map[string]struct{}
+}
+
+// add reports the alert, unless it was already reported for the principal.
+// The entity type is added to the alert's additional data.
+func (pa *principalAlerts) add(
+	ctx context.Context,
+	message string,
+	entityType odmetadata.GV2Type,
+	id, email string,
+	addtl map[string]any,
+	errs *fault.Bus,
+) {
+	key := strings.ToLower(message + "|" + string(entityType) + "|" + id + "|" + email)
+
+	pa.mu.Lock()
+
+	if pa.alerted == nil {
+		pa.alerted = map[string]struct{}{}
+	}
+
+	_, seen := pa.alerted[key]
+	pa.alerted[key] = struct{}{}
+
+	pa.mu.Unlock()
+
+	if seen {
+		return
+	}
+
+	ctx = clues.Add(
+		ctx,
+		"principal_entity_type", entityType,
+		"principal_entity_id", clues.Hide(id),
+		"principal_entity_email", clues.Hide(email))
+
+	if addtl == nil {
+		addtl = map[string]any{}
+	}
+
+	addtl["entity_type"] = string(entityType)
+
+	errs.AddAlert(ctx, fault.NewAlert(
+		message,
+		"", // no namespace
+		id,
+		email,
+		addtl))
+}
+
+// mapPermissions rewrites the grantee of each permission according
+// to the principal map.
+func (pm *PrincipalMap) mapPermissions(
+	ctx context.Context,
+	perms []odmetadata.Permission,
+	available ResourceIDNames,
+	errs *fault.Bus,
+) []odmetadata.Permission {
+	if pm == nil {
+		return perms
+	}
+
+	mapped := make([]odmetadata.Permission, 0, len(perms))
+
+	for _, p := range perms {
+		p.EntityID, p.Email = pm.mapEntity(ctx, p.EntityType, p.EntityID, p.Email, available, errs)
+		mapped = append(mapped, p)
+	}
+
+	return mapped
+}
+
+// mapLinkShares rewrites the recipients of each link share according
+// to the principal map.  Recipients outside of the organization, which
+// are only identified by their email, are left unchanged unless mapped.
+func (pm *PrincipalMap) mapLinkShares(
+	ctx context.Context,
+	linkShares []odmetadata.LinkShare,
+	available ResourceIDNames,
+	errs *fault.Bus,
+) []odmetadata.LinkShare {
+	if pm == nil {
+		return linkShares
+	}
+
+	mapped := make([]odmetadata.LinkShare, 0, len(linkShares))
+
+	for _, ls := range linkShares {
+		entities := make([]odmetadata.Entity, 0, len(ls.Entities))
+
+		for _, e := range ls.Entities {
+			_, isMapped := pm.targets[strings.ToLower(e.Email)]
+
+			if len(e.ID) > 0 || isMapped {
+				e.ID, e.Email = pm.mapEntity(ctx, e.EntityType, e.ID, e.Email, available, errs)
+			}
+
+			entities = append(entities, e)
+		}
+
+		ls.Entities = entities
+		mapped = append(mapped, ls)
+	}
+
+	return mapped
+}
@@ -0,0 +1,169 @@
+package drive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/common/idname"
+	"github.com/alcionai/corso/src/internal/m365/collection/drive/metadata"
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/fault"
+)
+
+type PrincipalMapUnitSuite struct {
+	tester.Suite
+}
+
+func TestPrincipalMapUnitSuite(t *testing.T) {
+	suite.Run(t, &PrincipalMapUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func (suite *PrincipalMapUnitSuite) TestNewPrincipalMap_empty() {
+	assert.Nil(suite.T(), NewPrincipalMap(nil))
+	assert.Nil(suite.T(), NewPrincipalMap(map[string]string{}))
+}
+
+func (suite *PrincipalMapUnitSuite) TestMapPermissions() {
+	available := ResourceIDNames{
+		Users: idname.NewCache(map[string]string{
+			"new-user-id":   "successor@example.com",
+			"other-user-id": "other@example.com",
+		}),
+		Groups: idname.NewCache(map[string]string{
+			"new-group-id": "team@example.com",
+		}),
+	}
+
+	pm := NewPrincipalMap(map[string]string{
+		"Departed@Example.com": "successor@example.com",
+		"old-group-id":         "new-group-id",
+		"old-user-id":          "missing@example.com",
+	})
+
+	table := []struct {
+		name         string
+		perm         metadata.Permission
+		expectID     string
+		expectAlerts []string
+	}{
+		{
+			name: "user mapped by upn",
+			perm: metadata.Permission{
+				EntityID:   "departed-id",
+				Email:      "departed@example.com",
+				EntityType: metadata.GV2User,
+			},
+			expectID: "new-user-id",
+		},
+		{
+			name: "group mapped by id",
+			perm: metadata.Permission{
+				EntityID:   "old-group-id",
+				EntityType: metadata.GV2Group,
+			},
+			expectID: "new-group-id",
+		},
+		{
+			name: "unmapped user",
+			perm: metadata.Permission{
+				EntityID:   "other-user-id",
+				EntityType: metadata.GV2User,
+			},
+			expectID:     "other-user-id",
+			expectAlerts: []string{fault.AlertUnmappedPrincipal},
+		},
+		{
+			name: "target not found",
+			perm: metadata.Permission{
+				EntityID:   "old-user-id",
+				EntityType: metadata.GV2User,
+			},
+			expectID:     "old-user-id",
+			expectAlerts: []string{fault.AlertUnresolvedPrincipalTarget},
+		},
+		{
+			name: "site group is not mapped",
+			perm: metadata.Permission{
+				EntityID:   "4",
+				EntityType: metadata.GV2SiteGroup,
+			},
+			expectID: "4",
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			errs := fault.New(false)
+
+			result := pm.mapPermissions(ctx, []metadata.Permission{test.perm}, available, errs)
+			assert.Equal(t, test.expectID, result[0].EntityID)
+
+			alerts := []string{}
+
+			for _, a := range errs.Alerts() {
+				alerts = append(alerts, a.Message)
+			}
+
+			assert.ElementsMatch(t, test.expectAlerts, alerts)
+		})
+	}
+}
+
+func (suite *PrincipalMapUnitSuite) TestMapLinkShares() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	var (
+		errs      = fault.New(false)
+		available = ResourceIDNames{
+			Users:  idname.NewCache(map[string]string{"new-user-id": "successor@example.com"}),
+			Groups: idname.NewCache(nil),
+		}
+		pm = NewPrincipalMap(map[string]string{"departed-id": "new-user-id"})
+		ls = metadata.LinkShare{
+			ID: "ls",
+			Entities: []metadata.Entity{
+				{ID: "departed-id", EntityType: metadata.GV2User},
+				{Email: "guest@elsewhere.com", EntityType: metadata.GV2User},
+				{ID: "unmapped-id", EntityType: metadata.GV2User},
+				{ID: "unmapped-id", EntityType: metadata.GV2User},
+			},
+		}
+	)
+
+	result := pm.mapLinkShares(ctx, []metadata.LinkShare{ls}, available, errs)
+	entities := result[0].Entities
+
+	assert.Equal(t, "new-user-id", entities[0].ID)
+	assert.Equal(t, "guest@elsewhere.com", entities[1].Email, "external users are kept")
+	assert.Equal(t, "unmapped-id", entities[2].ID)
+
+	// repeated principals only alert once
+	assert.Len(t, errs.Alerts(), 1)
+	assert.Equal(t, fault.AlertUnmappedPrincipal, errs.Alerts()[0].Message)
+	assert.Equal(t, "departed-id", ls.Entities[0].ID, "source link share is not modified")
+}
+
+func (suite *PrincipalMapUnitSuite) TestMapPermissions_nilMap() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	var (
+		pm    *PrincipalMap
+		errs  = fault.New(false)
+		perms = []metadata.Permission{{EntityID: "id", EntityType: metadata.GV2User}}
+	)
+
+	assert.Equal(t, perms, pm.mapPermissions(ctx, perms, ResourceIDNames{}, errs))
+	assert.Empty(t, errs.Alerts())
+}
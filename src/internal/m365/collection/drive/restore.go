@@ -75,6 +75,17 @@ func RestoreCollection(
 		return metrics, clues.WrapWC(ctx, err, "creating drive path")
 	}
 
+	if rcc.RestoreConfig.DryRun {
+		err := planCollection(ctx, rh, rcc, dc, caches, drivePath, fallbackDriveName, errs)
+		return metrics, clues.Stack(err).OrNil()
+	}
+
+	// Assemble folder hierarchy we're going to restore into (we recreate the folder hierarchy
+	// from the backup under this the restore folder instead of root)
+	// i.e. Restore into `<restoreContainerName>/<original folder path>`
+	// the drive into which this folder gets restored is tracked separately in drivePath.
+	restoreDir := restoreDirFor(rcc, caches, drivePath)
+
 	di, err := ensureDriveExists(
 		ctx,
 		rh,
@@ -92,18 +103,6 @@ func RestoreCollection(
 	drivePath.DriveID = di.id
 	drivePath.Root = di.rootFolderID
 
-	// Assemble folder hierarchy we're going to restore into (we recreate the folder hierarchy
-	// from the backup under this the restore folder instead of root)
-	// i.e. Restore into `<restoreContainerName>/<original folder path>`
-	// the drive into which this folder gets restored is tracked separately in drivePath.
-	restoreDir := &path.Builder{}
-
-	if len(rcc.RestoreConfig.Location) > 0 {
-		restoreDir = restoreDir.Append(rcc.RestoreConfig.Location)
-	}
-
-	restoreDir = restoreDir.Append(drivePath.Folders...)
-
 	ctx = clues.Add(
 		ctx,
 		"directory", dc.FullPath().Folder(false),
@@ -148,6 +147,12 @@ func RestoreCollection(
 	caches.ParentDirToMeta.Store(dc.FullPath().String(), colMeta)
 	items := dc.Items(ctx, errs)
 
+	var mirror *mirrorTracker
+
+	if rcc.RestoreConfig.OnCollision == control.Mirror {
+		mirror = newMirrorTracker()
+	}
+
 	semaphoreCh := make(chan struct{}, graph.Parallelism(path.OneDriveService).ItemUpload())
 	defer close(semaphoreCh)
 
@@ -198,6 +203,10 @@ func RestoreCollection(
 				copyBuffer := *copyBufferPtr
 				ctx = clues.Add(ctx, "restore_item_id", itemData.ID())
 
+				if mirror != nil {
+					mirror.add(ctx, rcc.BackupVersion, dc, itemData)
+				}
+
 				itemPath, err := dc.FullPath().AppendItem(itemData.ID())
 				if err != nil {
 					el.AddRecoverable(ctx, clues.WrapWC(ctx, err, "appending item to full path"))
@@ -234,8 +243,14 @@ func RestoreCollection(
 					return
 				}
 
+				deetsPath, err := detailsPath(rcc, caches, itemPath)
+				if err != nil {
+					el.AddRecoverable(ctx, clues.WrapWC(ctx, err, "adding restored item to details"))
+					return
+				}
+
 				// TODO: implement locationRef
-				updateDeets(ctx, itemPath, &path.Builder{}, itemInfo)
+				updateDeets(ctx, deetsPath, &path.Builder{}, itemInfo)
 
 				atomic.AddInt64(&metricsSuccess, 1)
 			}(ctx, itemData)
@@ -244,6 +259,25 @@ func RestoreCollection(
 
 	wg.Wait()
 
+	if mirror != nil && el.Failure() == nil {
+		err := removeUnmirroredItems(
+			ctx,
+			rh,
+			rcc,
+			dc,
+			drivePath,
+			restoreDir,
+			collisionKeyToItemID,
+			mirror,
+			caches,
+			updateDeets,
+			ctr,
+			errs)
+		if err != nil {
+			el.AddRecoverable(ctx, clues.Wrap(err, "mirroring restore folder"))
+		}
+	}
+
 	metrics.Objects = int(metricsObjects)
 	metrics.Bytes = metricsBytes
 	metrics.Successes = int(metricsSuccess)
@@ -302,6 +336,12 @@ func restoreItem(
 		return details.ItemInfo{}, true, nil
 	}
 
+	if strings.HasSuffix(itemUUID, metadata.VersionsFileSuffix) {
+		// previous versions get read alongside the data file, when
+		// the restore asks for them.
+		return details.ItemInfo{}, true, nil
+	}
+
 	if strings.HasSuffix(itemUUID, metadata.DirMetaFileSuffix) {
 		// Only the version.OneDrive1DataAndMetaFiles needed to deserialize the
 		// permission for child folders here. Later versions can request
@@ -389,13 +429,18 @@ func restoreV0File(
 	itemData data.Item,
 	ctr *count.Bus,
 ) (details.ItemInfo, error) {
+	content, err := itemContent(ctx, fibn, itemData.ID(), itemData)
+	if err != nil {
+		return details.ItemInfo{}, clues.Wrap(err, "restoring file")
+	}
+
 	_, itemInfo, err := restoreFile(
 		ctx,
 		rcc,
 		rh,
-		fibn,
 		itemData.ID(),
-		itemData,
+		itemData.ID(),
+		[]fileContent{content},
 		drivePath.DriveID,
 		restoreFolderID,
 		collisionKeyToItemID,
@@ -424,13 +469,18 @@ func restoreV1File(
 ) (details.ItemInfo, error) {
 	trimmedName := strings.TrimSuffix(itemData.ID(), metadata.DataFileSuffix)
 
+	content, err := itemContent(ctx, fibn, trimmedName, itemData)
+	if err != nil {
+		return details.ItemInfo{}, err
+	}
+
 	itemID, itemInfo, err := restoreFile(
 		ctx,
 		rcc,
 		rh,
-		fibn,
 		trimmedName,
-		itemData,
+		itemData.ID(),
+		[]fileContent{content},
 		drivePath.DriveID,
 		restoreFolderID,
 		caches.collisionKeyToItemID,
@@ -507,13 +557,26 @@ func restoreV6File(
 		return details.ItemInfo{}, clues.New("item with empty name")
 	}
 
+	contents, vs, err := restoreContents(
+		ctx,
+		rcc.RestoreConfig.ItemVersion,
+		fibn,
+		trimmedName,
+		meta,
+		itemData)
+	if err != nil {
+		return details.ItemInfo{}, err
+	}
+
+	defer vs.Close()
+
 	itemID, itemInfo, err := restoreFile(
 		ctx,
 		rcc,
 		rh,
-		fibn,
 		meta.FileName,
-		itemData,
+		itemData.ID(),
+		contents,
 		drivePath.DriveID,
 		restoreFolderID,
 		caches.collisionKeyToItemID,
@@ -710,24 +773,18 @@ func restoreFile(
 	ctx context.Context,
 	rcc inject.RestoreConsumerConfig,
 	ir itemRestorer,
-	fibn data.FetchItemByNamer,
 	name string,
-	itemData data.Item,
+	itemUUID string,
+	contents []fileContent,
 	driveID, parentFolderID string,
 	collisionKeyToItemID map[string]api.DriveItemIDType,
 	copyBuffer []byte,
 	ctr *count.Bus,
 ) (string, details.ItemInfo, error) {
-	ctx, end := diagnostics.Span(ctx, "gc:oneDrive:restoreItem", diagnostics.Label("item_uuid", itemData.ID()))
+	ctx, end := diagnostics.Span(ctx, "gc:oneDrive:restoreItem", diagnostics.Label("item_uuid", itemUUID))
 	defer end()
 
-	trace.Log(ctx, "gc:oneDrive:restoreItem", itemData.ID())
-
-	// Get the stream size (needed to create the upload session)
-	ss, ok := itemData.(data.ItemSize)
-	if !ok {
-		return "", details.ItemInfo{}, clues.NewWC(ctx, "item does not implement DataStreamInfo")
-	}
+	trace.Log(ctx, "gc:oneDrive:restoreItem", itemUUID)
 
 	var (
 		item                 = api.NewDriveItem(name, false)
@@ -748,7 +805,10 @@ func restoreFile(
 		}
 
 		collision = dci
-		shouldDeleteOriginal = rcc.RestoreConfig.OnCollision == control.Replace && !dci.IsFolder
+		// mirror restores replace any colliding files.
+		shouldDeleteOriginal = !dci.IsFolder &&
+			(rcc.RestoreConfig.OnCollision == control.Replace ||
+				rcc.RestoreConfig.OnCollision == control.Mirror)
 	}
 
 	// drive items do not support PUT requests on the drive item data, so
@@ -786,21 +846,106 @@ func restoreFile(
 		return "", details.ItemInfo{}, err
 	}
 
+	var written int64
+
+	// each upload after the first adds a new version of the file, which
+	// recreates the version history in the order of the contents.
+	for _, fc := range contents {
+		written, err = uploadContent(
+			ctx,
+			ir,
+			driveID,
+			ptr.Val(newItem.GetId()),
+			fc,
+			copyBuffer,
+			ctr)
+		if err != nil {
+			return "", details.ItemInfo{}, clues.Wrap(err, "uploading file")
+		}
+	}
+
+	dii := ir.AugmentItemInfo(
+		details.ItemInfo{},
+		rcc.ProtectedResource,
+		custom.ToCustomDriveItem(newItem),
+		written,
+		nil)
+
+	if shouldDeleteOriginal {
+		ctr.Inc(count.CollisionReplace)
+	} else {
+		ctr.Inc(count.NewItemCreated)
+	}
+
+	return ptr.Val(newItem.GetId()), dii, nil
+}
+
+// fileContent sources the bytes of a single upload into a restored file.
+type fileContent struct {
+	// name labels the upload in progress bars.
+	name string
+	size int64
+	// open produces a reader over the content.  Retried uploads re-open
+	// the content from the start.
+	open func(ctx context.Context, retry int) (io.ReadCloser, error)
+}
+
+// itemContent sources an upload from the backed up data of the item.
+func itemContent(
+	ctx context.Context,
+	fibn data.FetchItemByNamer,
+	name string,
+	itemData data.Item,
+) (fileContent, error) {
+	// Get the stream size (needed to create the upload session)
+	ss, ok := itemData.(data.ItemSize)
+	if !ok {
+		return fileContent{}, clues.NewWC(ctx, "item does not implement DataStreamInfo")
+	}
+
+	return fileContent{
+		name: name,
+		size: ss.Size(),
+		open: func(ctx context.Context, retry int) (io.ReadCloser, error) {
+			if retry == 0 {
+				return itemData.ToReader(), nil
+			}
+
+			// If it is not the first try, we have to pull the file
+			// again from kopia. Ideally we could just seek the stream
+			// but we don't have a Seeker available here.
+			itemData, err := fibn.FetchItemByName(ctx, itemData.ID())
+			if err != nil {
+				return nil, clues.Wrap(err, "get data file")
+			}
+
+			return itemData.ToReader(), nil
+		},
+	}, nil
+}
+
+// uploadContent writes the content into the item, and returns the number
+// of bytes written.
+func uploadContent(
+	ctx context.Context,
+	ir itemRestorer,
+	driveID, itemID string,
+	fc fileContent,
+	copyBuffer []byte,
+	ctr *count.Bus,
+) (int64, error) {
 	w, uploadURL, err := driveItemWriter(
 		ctx,
 		ir,
 		driveID,
-		ptr.Val(newItem.GetId()),
-		ss.Size(),
+		itemID,
+		fc.size,
 		ctr)
 	if err != nil {
-		return "", details.ItemInfo{}, clues.Wrap(err, "get item upload session")
+		return 0, clues.Wrap(err, "get item upload session")
 	}
 
-	var (
-		written        int64
-		progressReader io.ReadCloser
-	)
+	var written int64
 
 	// This is just to retry file upload, the uploadSession creation is
 	// not retried here We need extra logic to retry file upload as we
@@ -809,68 +954,49 @@ func restoreFile(
 	// show "register" any partial file uploads and so if we fail an
 	// upload the file size will be 0.
 	for i := 0; i <= maxUploadRetries; i++ {
-		pname := name
-		iReader := itemData.ToReader()
+		pname := fc.name
 
 		if i > 0 {
-			pname = fmt.Sprintf("%s (retry %d)", name, i)
-
-			// If it is not the first try, we have to pull the file
-			// again from kopia. Ideally we could just seek the stream
-			// but we don't have a Seeker available here.
-			itemData, err := fibn.FetchItemByName(ctx, itemData.ID())
-			if err != nil {
-				return "", details.ItemInfo{}, clues.Wrap(err, "get data file")
-			}
+			pname = fmt.Sprintf("%s (retry %d)", fc.name, i)
+		}
 
-			iReader = itemData.ToReader()
+		iReader, err := fc.open(ctx, i)
+		if err != nil {
+			return 0, err
 		}
 
-		progressReader = observe.ItemProgress(
+		progressReader := observe.ItemProgress(
 			ctx,
 			iReader,
 			observe.ItemRestoreMsg,
 			clues.Hide(pname),
-			ss.Size())
-		defer progressReader.Close()
+			fc.size)
 
 		// Upload the stream data
 		written, err = io.CopyBuffer(w, progressReader, copyBuffer)
-		if err == nil {
-			break
-		}
 
-		// close the progress bar immediately on error, else we might deadlock
+		// close the progress bar immediately, else we might deadlock.
 		// its safe to double call Close on the reader.
 		progressReader.Close()
 
+		if err == nil {
+			return written, nil
+		}
+
+		if i == maxUploadRetries {
+			return 0, err
+		}
+
 		// refresh the io.Writer to restart the upload
 		// TODO: @vkamra verify if var session is the desired input
 		w = graph.NewLargeItemWriter(
-			ptr.Val(newItem.GetId()),
+			itemID,
 			uploadURL,
-			ss.Size(),
+			fc.size,
 			ctr)
 	}
 
-	if err != nil {
-		return "", details.ItemInfo{}, clues.Wrap(err, "uploading file")
-	}
-
-	dii := ir.AugmentItemInfo(
-		details.ItemInfo{},
-		rcc.ProtectedResource,
-		custom.ToCustomDriveItem(newItem),
-		written,
-		nil)
-
-	if shouldDeleteOriginal {
-		ctr.Inc(count.CollisionReplace)
-	} else {
-		ctr.Inc(count.NewItemCreated)
-	}
-
-	return ptr.Val(newItem.GetId()), dii, nil
+	return written, nil
 }
 
 func FetchAndReadMetadata(
@@ -932,6 +1058,10 @@ func ensureDriveExists(
 	drivePath *path.DrivePath,
 	protectedResourceID, fallbackDriveName string,
 ) (driveInfo, error) {
+	if caches.crossService != nil {
+		return caches.crossService.drive, nil
+	}
+
 	driveID := drivePath.DriveID
 
 	// the drive might already be cached by ID.  it's okay
@@ -37,6 +37,16 @@ type restoreCaches struct {
 	OldPermIDToNewID      syncd.MapTo[string]
 	ParentDirToMeta       syncd.MapTo[metadata.Metadata]
 	AvailableEntities     ResourceIDNames
+	// Principals rewrites the grantees of restored permissions.
+	// Nil when the restore doesn't use a principal map.
+	Principals *PrincipalMap
+	// crossService is set when the drives in the backup get restored
+	// into a protected resource of a different service.
+	crossService *crossServiceTarget
+
+	// backupChildFolders holds the names of the folders in the backup,
+	// keyed by the path of their parent folder.
+	backupChildFolders map[string]map[string]struct{}
 
 	pool sync.Pool
 }
@@ -130,6 +140,7 @@ func NewRestoreCaches(
 		OldLinkShareIDToNewID: syncd.NewMapTo[string](),
 		OldPermIDToNewID:      syncd.NewMapTo[string](),
 		ParentDirToMeta:       syncd.NewMapTo[metadata.Metadata](),
+		backupChildFolders:    map[string]map[string]struct{}{},
 		// Buffer pool for uploads
 		pool: sync.Pool{
 			New: func() any {
@@ -0,0 +1,208 @@
+package drive
+
+import (
+	"context"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	odmetadata "github.com/alcionai/corso/src/internal/m365/collection/drive/metadata"
+	"github.com/alcionai/corso/src/internal/operations/inject"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/path"
+)
+
+// Cross-service restores put the drives of one service into the default
+// drive of a protected resource in another service, such as a user's
+// OneDrive into a site's document library.  Drive IDs and names from the
+// backup are meaningless in the target, so every drive in the backup is
+// restored into the target drive.  Permissions granted to site users and
+// site groups only exist within the backed up site, and get dropped.
+
+type crossServiceTarget struct {
+	service  path.ServiceType
+	category path.CategoryType
+	drive    driveInfo
+	// nestDrives restores each drive in the backup into a folder named
+	// after the drive, so that the contents of multiple libraries don't
+	// get merged together in the target drive.
+	nestDrives bool
+
+	alerts principalAlerts
+}
+
+// SetCrossServiceTarget restores every drive in the backup into the drive,
+// which belongs to a protected resource in the target service.
+func (rc *restoreCaches) SetCrossServiceTarget(
+	ctx context.Context,
+	md models.Driveable,
+	grf GetRootFolderer,
+	service path.ServiceType,
+) error {
+	if rc.crossService != nil {
+		return nil
+	}
+
+	var category path.CategoryType
+
+	switch service {
+	case path.OneDriveService:
+		category = path.FilesCategory
+	case path.SharePointService:
+		category = path.LibrariesCategory
+	default:
+		return clues.NewWC(ctx, "unsupported cross-service restore target").
+			With("target_service", service.String())
+	}
+
+	if err := rc.AddDrive(ctx, md, grf); err != nil {
+		return clues.Wrap(err, "caching target drive")
+	}
+
+	di, _ := rc.DriveIDToDriveInfo.Load(ptr.Val(md.GetId()))
+
+	rc.crossService = &crossServiceTarget{
+		service:  service,
+		category: category,
+		drive:    di,
+		// sites can hold many libraries, but users only have one drive.
+		nestDrives: service == path.OneDriveService,
+	}
+
+	return nil
+}
+
+// restoreDirFor produces the folder hierarchy, within the restore drive,
+// into which the drive folder gets restored.
+func restoreDirFor(
+	rcc inject.RestoreConsumerConfig,
+	caches *restoreCaches,
+	drivePath *path.DrivePath,
+) *path.Builder {
+	restoreDir := &path.Builder{}
+
+	if len(rcc.RestoreConfig.Location) > 0 {
+		restoreDir = restoreDir.Append(rcc.RestoreConfig.Location)
+	}
+
+	if caches.crossService != nil && caches.crossService.nestDrives {
+		driveName, ok := caches.BackupDriveIDName.NameOf(drivePath.DriveID)
+		if !ok {
+			driveName = drivePath.DriveID
+		}
+
+		restoreDir = restoreDir.Append(driveName)
+	}
+
+	return restoreDir.Append(drivePath.Folders...)
+}
+
+// detailsPath produces the repoRef under which a restored item is recorded
+// in the restore details.  Cross-service restores move the item into the
+// service, category, and protected resource of the target.
+func detailsPath(
+	rcc inject.RestoreConsumerConfig,
+	caches *restoreCaches,
+	itemPath path.Path,
+) (path.Path, error) {
+	cst := caches.crossService
+	if cst == nil {
+		return itemPath, nil
+	}
+
+	p, err := path.Build(
+		itemPath.Tenant(),
+		rcc.ProtectedResource.ID(),
+		cst.service,
+		cst.category,
+		true,
+		append(itemPath.Folders(), itemPath.Item())...)
+
+	return p, clues.Wrap(err, "translating item path to target service").OrNil()
+}
+
+func isSiteScoped(entityType odmetadata.GV2Type) bool {
+	return entityType == odmetadata.GV2SiteUser || entityType == odmetadata.GV2SiteGroup
+}
+
+// dropSiteScopedPermissions removes the permissions which can't carry over
+// into another service, reporting each dropped principal as an alert.
+func (rc *restoreCaches) dropSiteScopedPermissions(
+	ctx context.Context,
+	perms []odmetadata.Permission,
+	errs *fault.Bus,
+) []odmetadata.Permission {
+	if rc.crossService == nil {
+		return perms
+	}
+
+	kept := make([]odmetadata.Permission, 0, len(perms))
+
+	for _, p := range perms {
+		if !isSiteScoped(p.EntityType) {
+			kept = append(kept, p)
+			continue
+		}
+
+		rc.crossService.alert(ctx, p.EntityType, p.EntityID, p.Email, errs)
+		rc.OldPermIDToNewID.Store(p.ID, nonRestorablePermission)
+	}
+
+	return kept
+}
+
+// dropSiteScopedLinkShares removes the recipients of each link share which
+// can't carry over into another service.  Link shares left without any
+// recipients are dropped.
+func (rc *restoreCaches) dropSiteScopedLinkShares(
+	ctx context.Context,
+	linkShares []odmetadata.LinkShare,
+	errs *fault.Bus,
+) []odmetadata.LinkShare {
+	if rc.crossService == nil {
+		return linkShares
+	}
+
+	kept := make([]odmetadata.LinkShare, 0, len(linkShares))
+
+	for _, ls := range linkShares {
+		entities := make([]odmetadata.Entity, 0, len(ls.Entities))
+
+		for _, e := range ls.Entities {
+			if !isSiteScoped(e.EntityType) {
+				entities = append(entities, e)
+				continue
+			}
+
+			rc.crossService.alert(ctx, e.EntityType, e.ID, e.Email, errs)
+		}
+
+		// link shares without recipients are already skipped on restore.
+		if len(ls.Entities) > 0 && len(entities) == 0 {
+			rc.OldLinkShareIDToNewID.Store(ls.ID, nonRestorablePermission)
+			continue
+		}
+
+		ls.Entities = entities
+		kept = append(kept, ls)
+	}
+
+	return kept
+}
+
+// alert reports the dropped principal, once per principal.
+func (cst *crossServiceTarget) alert(
+	ctx context.Context,
+	entityType odmetadata.GV2Type,
+	id, email string,
+	errs *fault.Bus,
+) {
+	cst.alerts.add(
+		ctx,
+		fault.AlertUntransferablePermission,
+		entityType,
+		id, email,
+		map[string]any{"target_service": cst.service.String()},
+		errs)
+}
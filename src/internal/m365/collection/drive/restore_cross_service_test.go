@@ -0,0 +1,228 @@
+package drive
+
+import (
+	"testing"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/common/idname"
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	odmetadata "github.com/alcionai/corso/src/internal/m365/collection/drive/metadata"
+	"github.com/alcionai/corso/src/internal/operations/inject"
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/path"
+)
+
+type RestoreCrossServiceUnitSuite struct {
+	tester.Suite
+}
+
+func TestRestoreCrossServiceUnitSuite(t *testing.T) {
+	suite.Run(t, &RestoreCrossServiceUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func crossServiceCaches(
+	t *testing.T,
+	service path.ServiceType,
+) *restoreCaches {
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	md := models.NewDrive()
+	md.SetId(ptr.To("targetDriveID"))
+	md.SetName(ptr.To("Documents"))
+
+	rf := models.NewDriveItem()
+	rf.SetId(ptr.To("targetRootID"))
+
+	backupDrives := idname.NewCache(nil)
+	backupDrives.Add("driveID1", "Shared Documents")
+
+	caches := NewRestoreCaches(backupDrives)
+
+	err := caches.SetCrossServiceTarget(ctx, md, &mockGRF{rootFolder: rf}, service)
+	require.NoError(t, err, clues.ToCore(err))
+
+	return caches
+}
+
+func (suite *RestoreCrossServiceUnitSuite) TestSetCrossServiceTarget() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	caches := crossServiceCaches(t, path.SharePointService)
+
+	// every drive in the backup restores into the target drive.
+	for _, driveID := range []string{"driveID1", "unknown"} {
+		dp := &path.DrivePath{DriveID: driveID}
+
+		di, err := ensureDriveExists(ctx, nil, caches, dp, "site", "fallback")
+		require.NoError(t, err, clues.ToCore(err))
+		assert.Equal(t, "targetDriveID", di.id)
+		assert.Equal(t, "targetRootID", di.rootFolderID)
+
+		di, ok := lookupDrive(caches, driveID, "fallback")
+		assert.True(t, ok)
+		assert.Equal(t, "targetDriveID", di.id)
+	}
+
+	err := NewRestoreCaches(nil).SetCrossServiceTarget(
+		ctx,
+		models.NewDrive(),
+		&mockGRF{rootFolder: models.NewDriveItem()},
+		path.ExchangeService)
+	assert.Error(t, err, clues.ToCore(err))
+}
+
+func (suite *RestoreCrossServiceUnitSuite) TestRestoreDirFor() {
+	dp := &path.DrivePath{
+		DriveID: "driveID1",
+		Folders: []string{"a", "b"},
+	}
+
+	table := []struct {
+		name     string
+		caches   func(t *testing.T) *restoreCaches
+		location string
+		expect   path.Elements
+	}{
+		{
+			name:     "same service",
+			caches:   func(*testing.T) *restoreCaches { return NewRestoreCaches(nil) },
+			location: "restore",
+			expect:   path.Elements{"restore", "a", "b"},
+		},
+		{
+			name: "into sharepoint",
+			caches: func(t *testing.T) *restoreCaches {
+				return crossServiceCaches(t, path.SharePointService)
+			},
+			location: "restore",
+			expect:   path.Elements{"restore", "a", "b"},
+		},
+		{
+			name: "into onedrive",
+			caches: func(t *testing.T) *restoreCaches {
+				return crossServiceCaches(t, path.OneDriveService)
+			},
+			location: "restore",
+			expect:   path.Elements{"restore", "Shared Documents", "a", "b"},
+		},
+		{
+			name: "into onedrive, in place",
+			caches: func(t *testing.T) *restoreCaches {
+				return crossServiceCaches(t, path.OneDriveService)
+			},
+			expect: path.Elements{"Shared Documents", "a", "b"},
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			rcc := inject.RestoreConsumerConfig{
+				RestoreConfig: control.RestoreConfig{Location: test.location},
+			}
+
+			result := restoreDirFor(rcc, test.caches(t), dp)
+			assert.Equal(t, test.expect, result.Elements())
+		})
+	}
+}
+
+func (suite *RestoreCrossServiceUnitSuite) TestDetailsPath() {
+	t := suite.T()
+
+	itemPath, err := drivePathOf(t, "a").AppendItem("item.data")
+	require.NoError(t, err, clues.ToCore(err))
+
+	rcc := inject.RestoreConsumerConfig{
+		ProtectedResource: idname.NewProvider("siteID", "site"),
+	}
+
+	result, err := detailsPath(rcc, NewRestoreCaches(nil), itemPath)
+	require.NoError(t, err, clues.ToCore(err))
+	assert.Equal(t, itemPath.String(), result.String())
+
+	result, err = detailsPath(rcc, crossServiceCaches(t, path.SharePointService), itemPath)
+	require.NoError(t, err, clues.ToCore(err))
+	assert.Equal(t, path.SharePointService, result.Service())
+	assert.Equal(t, path.LibrariesCategory, result.Category())
+	assert.Equal(t, "siteID", result.ProtectedResource())
+	assert.Equal(t, itemPath.Folders(), result.Folders())
+	assert.Equal(t, "item.data", result.Item())
+}
+
+func (suite *RestoreCrossServiceUnitSuite) TestDropSiteScopedPermissions() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	perms := []odmetadata.Permission{
+		{ID: "p1", EntityID: "user", EntityType: odmetadata.GV2User},
+		{ID: "p2", EntityID: "4", EntityType: odmetadata.GV2SiteGroup},
+		{ID: "p3", EntityID: "5", EntityType: odmetadata.GV2SiteUser},
+		{ID: "p4", EntityID: "4", EntityType: odmetadata.GV2SiteGroup},
+	}
+
+	errs := fault.New(true)
+
+	kept := NewRestoreCaches(nil).dropSiteScopedPermissions(ctx, perms, errs)
+	assert.Equal(t, perms, kept, "same service keeps all permissions")
+	assert.Empty(t, errs.Alerts())
+
+	caches := crossServiceCaches(t, path.OneDriveService)
+
+	kept = caches.dropSiteScopedPermissions(ctx, perms, errs)
+	require.Len(t, kept, 1)
+	assert.Equal(t, "p1", kept[0].ID)
+
+	// one alert per dropped principal.
+	require.Len(t, errs.Alerts(), 2)
+
+	for _, a := range errs.Alerts() {
+		assert.Equal(t, fault.AlertUntransferablePermission, a.Message)
+	}
+
+	for _, id := range []string{"p2", "p3", "p4"} {
+		newID, ok := caches.OldPermIDToNewID.Load(id)
+		assert.True(t, ok, id)
+		assert.Equal(t, nonRestorablePermission, newID, id)
+	}
+}
+
+func (suite *RestoreCrossServiceUnitSuite) TestDropSiteScopedLinkShares() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	var (
+		user      = odmetadata.Entity{ID: "user", EntityType: odmetadata.GV2User}
+		siteGroup = odmetadata.Entity{ID: "4", EntityType: odmetadata.GV2SiteGroup}
+		shares    = []odmetadata.LinkShare{
+			{ID: "ls1", Entities: []odmetadata.Entity{user, siteGroup}},
+			{ID: "ls2", Entities: []odmetadata.Entity{siteGroup}},
+		}
+		errs   = fault.New(true)
+		caches = crossServiceCaches(t, path.OneDriveService)
+	)
+
+	kept := caches.dropSiteScopedLinkShares(ctx, shares, errs)
+	require.Len(t, kept, 1)
+	assert.Equal(t, "ls1", kept[0].ID)
+	assert.Equal(t, []odmetadata.Entity{user}, kept[0].Entities)
+	assert.Len(t, errs.Alerts(), 1)
+
+	_, ok := caches.OldLinkShareIDToNewID.Load("ls2")
+	assert.True(t, ok)
+}
@@ -0,0 +1,237 @@
+package drive
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/alcionai/clues"
+
+	"github.com/alcionai/corso/src/internal/data"
+	"github.com/alcionai/corso/src/internal/operations/inject"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/count"
+	"github.com/alcionai/corso/src/pkg/errs/core"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/logger"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+	"github.com/alcionai/corso/src/pkg/services/m365/custom"
+)
+
+// Mirror restores remove the items in each restored folder which weren't
+// in the backup.  Only folders which hold files in the backup get mirrored.
+// Folders which only get restored as the ancestors of the selected data
+// are left alone, since the backup doesn't tell us what else they held.
+
+// AddBackupFolders records the folders held by the restore collections,
+// so that a mirror restore can tell which folders in the restore target
+// weren't in the backup.  Collections outside of drives are ignored.
+func (rc *restoreCaches) AddBackupFolders(dcs []data.RestoreCollection) {
+	for _, dc := range dcs {
+		fp := dc.FullPath()
+
+		if fp.Category() != path.FilesCategory && fp.Category() != path.LibrariesCategory {
+			continue
+		}
+
+		drivePath, err := path.ToDrivePath(fp)
+		if err != nil || len(drivePath.Folders) == 0 {
+			continue
+		}
+
+		parent, err := fp.Dir()
+		if err != nil {
+			continue
+		}
+
+		children, ok := rc.backupChildFolders[parent.String()]
+		if !ok {
+			children = map[string]struct{}{}
+			rc.backupChildFolders[parent.String()] = children
+		}
+
+		children[drivePath.Folders[len(drivePath.Folders)-1]] = struct{}{}
+	}
+}
+
+// mirrorTracker collects the names of the backup items restored into a
+// folder.
+type mirrorTracker struct {
+	mu    sync.Mutex
+	names map[string]struct{}
+	// unknown is set if the name of any backup item couldn't be
+	// determined, in which case nothing gets removed from the folder.
+	unknown bool
+}
+
+func newMirrorTracker() *mirrorTracker {
+	return &mirrorTracker{names: map[string]struct{}{}}
+}
+
+func (mt *mirrorTracker) add(
+	ctx context.Context,
+	backupVersion int,
+	fibn data.FetchItemByNamer,
+	itemData data.Item,
+) {
+	name, ok, err := restoreItemName(ctx, backupVersion, fibn, itemData)
+
+	mt.mu.Lock()
+	defer mt.mu.Unlock()
+
+	if err != nil {
+		logger.CtxErr(ctx, err).Info("getting item name for mirror restore")
+		mt.unknown = true
+
+		return
+	}
+
+	if ok {
+		mt.names[name] = struct{}{}
+	}
+}
+
+// unmirroredItems returns the items in the restore folder which weren't in
+// the backup, keyed by name.  existing holds the items which were in the
+// restore folder before the restore began.
+func unmirroredItems(
+	ctx context.Context,
+	rcc inject.RestoreConsumerConfig,
+	dc data.RestoreCollection,
+	restoreDir *path.Builder,
+	existing map[string]api.DriveItemIDType,
+	mt *mirrorTracker,
+	caches *restoreCaches,
+) map[string]api.DriveItemIDType {
+	removals := map[string]api.DriveItemIDType{}
+
+	if mt.unknown || len(mt.names) == 0 {
+		logger.Ctx(ctx).Info("not mirroring folder without known backup files")
+		return removals
+	}
+
+	var (
+		quarantine   = rcc.RestoreConfig.MirrorQuarantine
+		childFolders = caches.backupChildFolders[dc.FullPath().String()]
+	)
+
+	for name, dit := range existing {
+		if dit.IsFolder {
+			if _, ok := childFolders[name]; ok {
+				continue
+			}
+
+			// never remove the quarantine folder itself.
+			if len(restoreDir.Elements()) == 0 && name == quarantine {
+				continue
+			}
+		} else if _, ok := mt.names[name]; ok {
+			continue
+		}
+
+		removals[name] = dit
+	}
+
+	return removals
+}
+
+type mirrorRemover interface {
+	DeleteItemer
+	GetFolderByNamer
+	ItemInfoAugmenter
+	MoveItemer
+	PostItemInContainerer
+}
+
+// removeUnmirroredItems deletes, or moves into the quarantine folder, every
+// item in the restore folder which wasn't in the backup.  existing holds the
+// items which were in the restore folder before the restore began.
+func removeUnmirroredItems(
+	ctx context.Context,
+	mr mirrorRemover,
+	rcc inject.RestoreConsumerConfig,
+	dc data.RestoreCollection,
+	drivePath *path.DrivePath,
+	restoreDir *path.Builder,
+	existing map[string]api.DriveItemIDType,
+	mt *mirrorTracker,
+	caches *restoreCaches,
+	addDeets func(context.Context, path.Path, *path.Builder, details.ItemInfo),
+	ctr *count.Bus,
+	errs *fault.Bus,
+) error {
+	var (
+		el                 = errs.Local()
+		quarantine         = rcc.RestoreConfig.MirrorQuarantine
+		quarantineFolderID string
+	)
+
+	for name, dit := range unmirroredItems(ctx, rcc, dc, restoreDir, existing, mt, caches) {
+		if el.Failure() != nil {
+			break
+		}
+
+		ictx := clues.Add(
+			ctx,
+			"mirror_remove_item_id", dit.ItemID,
+			"mirror_remove_item_name", clues.Hide(name),
+			"mirror_remove_is_folder", dit.IsFolder)
+
+		removal := details.RemovalDeleted
+
+		if len(quarantine) > 0 {
+			if len(quarantineFolderID) == 0 {
+				var err error
+
+				quarantineFolderID, err = createRestoreFolders(
+					ictx,
+					mr,
+					drivePath,
+					path.Builder{}.Append(quarantine).Append(restoreDir.Elements()...),
+					caches)
+				if err != nil {
+					return clues.Wrap(err, "creating quarantine folder")
+				}
+			}
+
+			if err := mr.MoveItem(ictx, drivePath.DriveID, dit.ItemID, quarantineFolderID); err != nil {
+				el.AddRecoverable(ictx, clues.Wrap(err, "quarantining item not in backup"))
+				continue
+			}
+
+			removal = details.RemovalQuarantined
+
+			ctr.Inc(count.MirrorQuarantined)
+		} else {
+			err := mr.DeleteItem(ictx, drivePath.DriveID, dit.ItemID)
+			if err != nil && !errors.Is(err, core.ErrNotFound) {
+				el.AddRecoverable(ictx, clues.Wrap(err, "deleting item not in backup"))
+				continue
+			}
+
+			ctr.Inc(count.MirrorDeleted)
+		}
+
+		item := api.NewDriveItem(name, dit.IsFolder)
+		item.SetId(&dit.ItemID)
+
+		info := mr.AugmentItemInfo(
+			details.ItemInfo{},
+			rcc.ProtectedResource,
+			custom.ToCustomDriveItem(item),
+			0,
+			restoreDir)
+		info.Removal = removal
+
+		itemPath, err := dc.FullPath().AppendItem(dit.ItemID)
+		if err != nil {
+			el.AddRecoverable(ictx, clues.WrapWC(ictx, err, "appending removed item to full path"))
+			continue
+		}
+
+		addDeets(ictx, itemPath, &path.Builder{}, info)
+	}
+
+	return el.Failure()
+}
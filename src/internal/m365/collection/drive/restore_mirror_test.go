@@ -0,0 +1,313 @@
+package drive
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/data"
+	dataMock "github.com/alcionai/corso/src/internal/data/mock"
+	odConsts "github.com/alcionai/corso/src/internal/m365/service/onedrive/consts"
+	"github.com/alcionai/corso/src/internal/operations/inject"
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/internal/version"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/count"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/restoreplan"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+)
+
+type RestoreMirrorUnitSuite struct {
+	tester.Suite
+}
+
+func TestRestoreMirrorUnitSuite(t *testing.T) {
+	suite.Run(t, &RestoreMirrorUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func drivePathOf(t *testing.T, folders ...string) path.Path {
+	p, err := path.Build(
+		"t",
+		"u",
+		path.OneDriveService,
+		path.FilesCategory,
+		false,
+		odConsts.DriveFolderPrefixBuilder("driveID1").Append(folders...).Elements()...)
+	require.NoError(t, err, clues.ToCore(err))
+
+	return p
+}
+
+func (suite *RestoreMirrorUnitSuite) TestRestoreCaches_AddBackupFolders() {
+	t := suite.T()
+
+	lp, err := path.Build("t", "u", path.SharePointService, path.ListsCategory, false, "lists", "l")
+	require.NoError(t, err, clues.ToCore(err))
+
+	caches := NewRestoreCaches(nil)
+	caches.AddBackupFolders([]data.RestoreCollection{
+		dataMock.Collection{Path: drivePathOf(t)},
+		dataMock.Collection{Path: drivePathOf(t, "a")},
+		dataMock.Collection{Path: drivePathOf(t, "a", "b")},
+		dataMock.Collection{Path: drivePathOf(t, "a", "c")},
+		dataMock.Collection{Path: lp},
+	})
+
+	expect := map[string]map[string]struct{}{
+		drivePathOf(t).String():      {"a": {}},
+		drivePathOf(t, "a").String(): {"b": {}, "c": {}},
+	}
+
+	assert.Equal(t, expect, caches.backupChildFolders)
+}
+
+func (suite *RestoreMirrorUnitSuite) TestRemoveUnmirroredItems() {
+	const quarantine = "quarantine"
+
+	table := []struct {
+		name          string
+		folders       []string
+		existing      map[string]api.DriveItemIDType
+		backupNames   []string
+		unknown       bool
+		quarantine    string
+		expectDelete  string
+		expectMove    []string
+		expectRemoved map[count.Key]int64
+		expectDeets   []details.RemovalType
+	}{
+		{
+			name:    "extra file deleted",
+			folders: []string{"a"},
+			existing: map[string]api.DriveItemIDType{
+				"in-backup": {ItemID: "id1"},
+				"extra":     {ItemID: "id2"},
+			},
+			backupNames:   []string{"in-backup"},
+			expectDelete:  "id2",
+			expectRemoved: map[count.Key]int64{count.MirrorDeleted: 1},
+			expectDeets:   []details.RemovalType{details.RemovalDeleted},
+		},
+		{
+			name:    "extra folder deleted",
+			folders: []string{"a"},
+			existing: map[string]api.DriveItemIDType{
+				"in-backup": {ItemID: "id1"},
+				"b":         {ItemID: "id2", IsFolder: true},
+				"extra":     {ItemID: "id3", IsFolder: true},
+			},
+			backupNames:   []string{"in-backup"},
+			expectDelete:  "id3",
+			expectRemoved: map[count.Key]int64{count.MirrorDeleted: 1},
+			expectDeets:   []details.RemovalType{details.RemovalDeleted},
+		},
+		{
+			name:    "extra file quarantined",
+			folders: []string{"a"},
+			existing: map[string]api.DriveItemIDType{
+				"in-backup": {ItemID: "id1"},
+				"extra":     {ItemID: "id2"},
+			},
+			backupNames:   []string{"in-backup"},
+			quarantine:    quarantine,
+			expectMove:    []string{"id2"},
+			expectRemoved: map[count.Key]int64{count.MirrorQuarantined: 1},
+			expectDeets:   []details.RemovalType{details.RemovalQuarantined},
+		},
+		{
+			name: "quarantine folder in drive root kept",
+			existing: map[string]api.DriveItemIDType{
+				"in-backup": {ItemID: "id1"},
+				quarantine:  {ItemID: "id2", IsFolder: true},
+			},
+			backupNames: []string{"in-backup"},
+			quarantine:  quarantine,
+		},
+		{
+			name:    "unknown backup names",
+			folders: []string{"a"},
+			existing: map[string]api.DriveItemIDType{
+				"in-backup": {ItemID: "id1"},
+				"extra":     {ItemID: "id2"},
+			},
+			backupNames: []string{"in-backup"},
+			unknown:     true,
+		},
+		{
+			name:    "no backup files",
+			folders: []string{"a"},
+			existing: map[string]api.DriveItemIDType{
+				"extra": {ItemID: "id2"},
+			},
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			var (
+				dcPath = drivePathOf(t, test.folders...)
+				dc     = dataMock.Collection{Path: dcPath}
+				caches = NewRestoreCaches(nil)
+				rh     = &mockRestoreHandler{
+					PostItemResp: models.NewDriveItem(),
+				}
+				mt    = newMirrorTracker()
+				ctr   = count.New()
+				deets []details.RemovalType
+				rcc   = inject.RestoreConsumerConfig{
+					BackupVersion: version.Backup,
+					RestoreConfig: control.RestoreConfig{
+						OnCollision:      control.Mirror,
+						MirrorQuarantine: test.quarantine,
+					},
+				}
+			)
+
+			rh.PostItemResp.SetId(ptr.To("quarantine-id"))
+
+			caches.AddBackupFolders([]data.RestoreCollection{
+				dc,
+				dataMock.Collection{Path: drivePathOf(t, append(test.folders, "b")...)},
+			})
+
+			for _, name := range test.backupNames {
+				mt.names[name] = struct{}{}
+			}
+
+			mt.unknown = test.unknown
+
+			dp, err := path.ToDrivePath(dcPath)
+			require.NoError(t, err, clues.ToCore(err))
+
+			err = removeUnmirroredItems(
+				ctx,
+				rh,
+				rcc,
+				dc,
+				dp,
+				path.Builder{}.Append(test.folders...),
+				test.existing,
+				mt,
+				caches,
+				func(_ context.Context, _ path.Path, _ *path.Builder, info details.ItemInfo) {
+					deets = append(deets, info.Removal)
+				},
+				ctr,
+				fault.New(true))
+			require.NoError(t, err, clues.ToCore(err))
+
+			assert.Equal(t, test.expectDelete, rh.CalledDeleteItemOn, "deleted item")
+			assert.Equal(t, test.expectMove, rh.CalledMoveItemOn, "quarantined items")
+			for _, k := range []count.Key{count.MirrorDeleted, count.MirrorQuarantined} {
+				assert.Equal(t, test.expectRemoved[k], ctr.Get(k), k)
+			}
+			assert.Equal(t, test.expectDeets, deets, "details entries")
+		})
+	}
+}
+
+func (suite *RestoreMirrorUnitSuite) TestPlanCollection_mirrorRemovals() {
+	table := []struct {
+		name       string
+		policy     control.CollisionPolicy
+		quarantine string
+		expect     map[string]restoreplan.Action
+	}{
+		{
+			name:   "replace",
+			policy: control.Replace,
+			expect: map[string]restoreplan.Action{
+				"in-backup": restoreplan.Replace,
+				"new":       restoreplan.Create,
+			},
+		},
+		{
+			name:   "mirror",
+			policy: control.Mirror,
+			expect: map[string]restoreplan.Action{
+				"in-backup": restoreplan.Replace,
+				"new":       restoreplan.Create,
+				"extra":     restoreplan.Remove,
+			},
+		},
+		{
+			name:       "mirror with quarantine",
+			policy:     control.Mirror,
+			quarantine: "quarantine",
+			expect: map[string]restoreplan.Action{
+				"in-backup": restoreplan.Replace,
+				"new":       restoreplan.Create,
+				"extra":     restoreplan.Quarantine,
+			},
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			var (
+				dcPath = drivePathOf(t)
+				dc     = dataMock.Collection{
+					Path: dcPath,
+					ItemData: []data.Item{
+						&dataMock.Item{ItemID: "in-backup"},
+						&dataMock.Item{ItemID: "new"},
+					},
+				}
+				caches = NewRestoreCaches(nil)
+				rh     = &mockRestoreHandler{
+					CollisionKeyMap: map[string]api.DriveItemIDType{
+						"in-backup": {ItemID: "id1"},
+						"extra":     {ItemID: "id2"},
+					},
+				}
+				plan = restoreplan.New()
+				rcc  = inject.RestoreConsumerConfig{
+					BackupVersion: version.NoBackup,
+					Plan:          plan,
+					RestoreConfig: control.RestoreConfig{
+						OnCollision:      test.policy,
+						MirrorQuarantine: test.quarantine,
+						DryRun:           true,
+					},
+				}
+			)
+
+			caches.DriveIDToDriveInfo.Store("driveID1", driveInfo{
+				id:           "driveID1",
+				name:         "drive",
+				rootFolderID: "root",
+			})
+
+			dp, err := path.ToDrivePath(dcPath)
+			require.NoError(t, err, clues.ToCore(err))
+
+			err = planCollection(ctx, rh, rcc, dc, caches, dp, "fallback", fault.New(true))
+			require.NoError(t, err, clues.ToCore(err))
+
+			actions := map[string]restoreplan.Action{}
+
+			for _, item := range plan.Items() {
+				actions[item.Name] = item.Action
+			}
+
+			assert.Equal(t, test.expect, actions)
+		})
+	}
+}
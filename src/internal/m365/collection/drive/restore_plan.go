@@ -0,0 +1,238 @@
+package drive
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/alcionai/clues"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/data"
+	"github.com/alcionai/corso/src/internal/diagnostics"
+	"github.com/alcionai/corso/src/internal/operations/inject"
+	"github.com/alcionai/corso/src/internal/version"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/restoreplan"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph/metadata"
+)
+
+// planCollection records how each item in the collection would get
+// restored, without writing any data.  Drives and folders which don't
+// exist yet are reported as new containers instead of being created.
+// Mirror restores also record the existing items they would remove.
+func planCollection(
+	ctx context.Context,
+	rh RestoreHandler,
+	rcc inject.RestoreConsumerConfig,
+	dc data.RestoreCollection,
+	caches *restoreCaches,
+	drivePath *path.DrivePath,
+	fallbackDriveName string,
+	errs *fault.Bus,
+) error {
+	ctx, end := diagnostics.Span(ctx, "gc:drive:planCollection", diagnostics.Label("path", dc.FullPath()))
+	defer end()
+
+	var (
+		el                   = errs.Local()
+		category             = dc.FullPath().Category()
+		collisionKeyToItemID = map[string]api.DriveItemIDType{}
+		restoreDir           = restoreDirFor(rcc, caches, drivePath)
+		mirror               = newMirrorTracker()
+	)
+
+	di, driveExists := lookupDrive(caches, drivePath.DriveID, fallbackDriveName)
+	containerPath := path.Builder{}.Append(di.name).Append(restoreDir.Elements()...)
+
+	ctx = clues.Add(ctx, "restore_destination", restoreDir, "drive_id", di.id)
+
+	folderID := ""
+
+	if driveExists {
+		var err error
+
+		folderID, err = lookupRestoreFolder(ctx, rh, di, restoreDir, caches)
+		if err != nil {
+			return clues.Wrap(err, "looking up restore folder")
+		}
+	}
+
+	if len(folderID) > 0 {
+		var err error
+
+		collisionKeyToItemID, err = rh.GetItemsInContainerByCollisionKey(ctx, di.id, folderID)
+		if err != nil {
+			return clues.Wrap(err, "generating map of item collision keys")
+		}
+	}
+
+	for itemData := range dc.Items(ctx, errs) {
+		if el.Failure() != nil {
+			break
+		}
+
+		ictx := clues.Add(ctx, "item_id", itemData.ID())
+
+		name, ok, err := restoreItemName(ictx, rcc.BackupVersion, dc, itemData)
+		if err != nil {
+			el.AddRecoverable(ictx, clues.Wrap(err, "getting item name"))
+
+			mirror.unknown = true
+
+			continue
+		}
+
+		if !ok {
+			continue
+		}
+
+		mirror.names[name] = struct{}{}
+
+		collision, collides := collisionKeyToItemID[api.DriveItemCollisionKey(api.NewDriveItem(name, false))]
+		action := restoreplan.ActionFor(collides, rcc.RestoreConfig.OnCollision)
+
+		// files which collide with a folder can't replace it, and
+		// get restored as a copy instead.
+		if action == restoreplan.Replace && collision.IsFolder {
+			action = restoreplan.Copy
+		}
+
+		rcc.Plan.Add(restoreplan.Item{
+			ItemID:        itemData.ID(),
+			Name:          name,
+			Category:      category.HumanString(),
+			Action:        action,
+			ContainerPath: containerPath.String(),
+			NewContainer:  len(folderID) == 0,
+		})
+	}
+
+	if rcc.RestoreConfig.OnCollision != control.Mirror || el.Failure() != nil {
+		return el.Failure()
+	}
+
+	removal := restoreplan.Remove
+	if len(rcc.RestoreConfig.MirrorQuarantine) > 0 {
+		removal = restoreplan.Quarantine
+	}
+
+	for name, dit := range unmirroredItems(ctx, rcc, dc, restoreDir, collisionKeyToItemID, mirror, caches) {
+		rcc.Plan.Add(restoreplan.Item{
+			ItemID:        dit.ItemID,
+			Name:          name,
+			Category:      category.HumanString(),
+			Action:        removal,
+			ContainerPath: containerPath.String(),
+		})
+	}
+
+	return el.Failure()
+}
+
+// lookupDrive mirrors ensureDriveExists without creating any drives.
+// Returns false if the restore would create a new drive.
+func lookupDrive(
+	caches *restoreCaches,
+	driveID, fallbackDriveName string,
+) (driveInfo, bool) {
+	if caches.crossService != nil {
+		return caches.crossService.drive, true
+	}
+
+	if di, ok := caches.DriveIDToDriveInfo.Load(driveID); ok {
+		return di, true
+	}
+
+	oldName, ok := caches.BackupDriveIDName.NameOf(driveID)
+	if !ok {
+		return driveInfo{name: fallbackDriveName}, false
+	}
+
+	if di, ok := caches.DriveNameToDriveInfo.Load(oldName); ok {
+		return di, true
+	}
+
+	return driveInfo{name: oldName}, false
+}
+
+// lookupRestoreFolder mirrors createRestoreFolders without creating any
+// folders.  Returns an empty ID if any folder in the hierarchy does not
+// exist yet.
+func lookupRestoreFolder(
+	ctx context.Context,
+	gfbn GetFolderByNamer,
+	di driveInfo,
+	restoreDir *path.Builder,
+	caches *restoreCaches,
+) (string, error) {
+	var (
+		location       = path.Builder{}.Append(di.id)
+		parentFolderID = di.rootFolderID
+	)
+
+	for _, folderName := range restoreDir.Elements() {
+		location = location.Append(folderName)
+
+		if fl, ok := caches.Folders.get(location); ok {
+			parentFolderID = ptr.Val(fl.GetId())
+			continue
+		}
+
+		folderItem, err := gfbn.GetFolderByName(ctx, di.id, parentFolderID, folderName)
+		if errors.Is(err, api.ErrFolderNotFound) {
+			return "", nil
+		}
+
+		if err != nil {
+			return "", clues.Wrap(err, "getting folder").With("folder_location", location)
+		}
+
+		parentFolderID = ptr.Val(folderItem.GetId())
+		caches.Folders.set(location, folderItem)
+	}
+
+	return parentFolderID, nil
+}
+
+// restoreItemName produces the name the item gets restored with,
+// according to the backup version.  Returns false for metadata items,
+// which don't get restored as files of their own.
+func restoreItemName(
+	ctx context.Context,
+	backupVersion int,
+	fibn data.FetchItemByNamer,
+	itemData data.Item,
+) (string, bool, error) {
+	itemID := itemData.ID()
+
+	if backupVersion < version.OneDrive1DataAndMetaFiles {
+		return itemID, true, nil
+	}
+
+	if strings.HasSuffix(itemID, metadata.MetaFileSuffix) ||
+		strings.HasSuffix(itemID, metadata.DirMetaFileSuffix) ||
+		strings.HasSuffix(itemID, metadata.VersionsFileSuffix) {
+		return "", false, nil
+	}
+
+	trimmedName := strings.TrimSuffix(itemID, metadata.DataFileSuffix)
+
+	if backupVersion < version.OneDrive6NameInMeta {
+		return trimmedName, true, nil
+	}
+
+	meta, err := FetchAndReadMetadata(ctx, fibn, trimmedName+metadata.MetaFileSuffix)
+	if err != nil {
+		return "", false, err
+	}
+
+	if len(meta.FileName) == 0 {
+		return "", false, clues.NewWC(ctx, "item with empty name")
+	}
+
+	return meta.FileName, true, nil
+}
@@ -117,6 +117,20 @@ func (suite *RestoreUnitSuite) TestRestoreItem_collisionHandling() {
 			},
 			expectCounts: counts{0, 1, 0},
 		},
+		{
+			name: "collision, mirror",
+			collisionKeys: map[string]api.DriveItemIDType{
+				mock.DriveItemFileName: {ItemID: mndiID},
+			},
+			onCollision:   control.Mirror,
+			expectSkipped: assert.False,
+			expectMock: func(t *testing.T, rh *mockRestoreHandler) {
+				assert.True(t, rh.CalledPostItem, "new item posted")
+				assert.True(t, rh.CalledDeleteItem, "new item deleted")
+				assert.Equal(t, mndiID, rh.CalledDeleteItemOn, "deleted the correct item")
+			},
+			expectCounts: counts{0, 1, 0},
+		},
 		{
 			name: "collision, replace - err already deleted",
 			collisionKeys: map[string]api.DriveItemIDType{
@@ -832,3 +846,68 @@ func (suite *RestoreUnitSuite) TestEnsureDriveExists() {
 		})
 	}
 }
+
+func (suite *RestoreUnitSuite) TestLookupDrive() {
+	oldDriveIDNames := idname.NewCache(nil)
+	oldDriveIDNames.Add("old-id", "name")
+
+	populatedCache := func(id string) *restoreCaches {
+		rc := NewRestoreCaches(oldDriveIDNames)
+		di := driveInfo{
+			id:   id,
+			name: "name",
+		}
+		rc.DriveIDToDriveInfo.Store(id, di)
+		rc.DriveNameToDriveInfo.Store("name", di)
+
+		return rc
+	}
+
+	table := []struct {
+		name         string
+		driveID      string
+		rc           *restoreCaches
+		expectExists bool
+		expectName   string
+		expectID     string
+	}{
+		{
+			name:         "drive in cache",
+			driveID:      "id",
+			rc:           populatedCache("id"),
+			expectExists: true,
+			expectName:   "name",
+			expectID:     "id",
+		},
+		{
+			name:         "drive with same name but different id exists",
+			driveID:      "old-id",
+			rc:           populatedCache("diff"),
+			expectExists: true,
+			expectName:   "name",
+			expectID:     "diff",
+		},
+		{
+			name:       "drive would be created with old name",
+			driveID:    "old-id",
+			rc:         NewRestoreCaches(oldDriveIDNames),
+			expectName: "name",
+		},
+		{
+			name:       "drive would be created with fallback name",
+			driveID:    "unknown-id",
+			rc:         NewRestoreCaches(nil),
+			expectName: "fallback",
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			di, exists := lookupDrive(test.rc, test.driveID, "fallback")
+			assert.Equal(t, test.expectExists, exists)
+			assert.Equal(t, test.expectName, di.name)
+			assert.Equal(t, test.expectID, di.id)
+		})
+	}
+}
@@ -161,6 +161,13 @@ func (h siteBackupHandler) GetItemPermission(
 	return h.ac.GetItemPermission(ctx, driveID, itemID)
 }
 
+func (h siteBackupHandler) GetItemVersions(
+	ctx context.Context,
+	driveID, itemID string,
+) ([]models.DriveItemVersionable, error) {
+	return h.ac.GetItemVersions(ctx, driveID, itemID)
+}
+
 func (h siteBackupHandler) GetItem(
 	ctx context.Context,
 	driveID, itemID string,
@@ -227,6 +234,13 @@ func (h siteRestoreHandler) DeleteItem(
 	return h.ac.Drives().DeleteItem(ctx, driveID, itemID)
 }
 
+func (h siteRestoreHandler) MoveItem(
+	ctx context.Context,
+	driveID, itemID, parentFolderID string,
+) error {
+	return h.ac.Drives().MoveItem(ctx, driveID, itemID, parentFolderID)
+}
+
 func (h siteRestoreHandler) DeleteItemPermission(
 	ctx context.Context,
 	driveID, itemID, permissionID string,
@@ -166,6 +166,13 @@ func (h userDriveBackupHandler) GetItemPermission(
 	return h.ac.GetItemPermission(ctx, driveID, itemID)
 }
 
+func (h userDriveBackupHandler) GetItemVersions(
+	ctx context.Context,
+	driveID, itemID string,
+) ([]models.DriveItemVersionable, error) {
+	return h.ac.GetItemVersions(ctx, driveID, itemID)
+}
+
 func (h userDriveBackupHandler) GetItem(
 	ctx context.Context,
 	driveID, itemID string,
@@ -228,6 +235,13 @@ func (h userDriveRestoreHandler) DeleteItem(
 	return h.ac.DeleteItem(ctx, driveID, itemID)
 }
 
+func (h userDriveRestoreHandler) MoveItem(
+	ctx context.Context,
+	driveID, itemID, parentFolderID string,
+) error {
+	return h.ac.MoveItem(ctx, driveID, itemID, parentFolderID)
+}
+
 func (h userDriveRestoreHandler) DeleteItemPermission(
 	ctx context.Context,
 	driveID, itemID, permissionID string,
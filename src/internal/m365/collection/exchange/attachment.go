@@ -8,6 +8,9 @@ import (
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 
 	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/converters/eml"
+	"github.com/alcionai/corso/src/pkg/count"
+	"github.com/alcionai/corso/src/pkg/fault"
 	"github.com/alcionai/corso/src/pkg/logger"
 	"github.com/alcionai/corso/src/pkg/services/m365/api"
 )
@@ -56,6 +59,8 @@ func uploadAttachment(
 	ap attachmentPoster,
 	userID, containerID, parentItemID string,
 	attachment models.Attachmentable,
+	errs *fault.Bus,
+	ctr *count.Bus,
 ) error {
 	var (
 		attachmentType = attachmentType(attachment)
@@ -82,15 +87,20 @@ func uploadAttachment(
 		return nil
 	}
 
-	// item Attachments to be skipped until the completion of Issue #2353
 	if attachmentType == models.ITEM_ATTACHMENTTYPE {
-		a, err := toItemAttachment(attachment)
+		a, err := toItemAttachment(ctx, attachment, parentItemID, errs)
 		if err != nil {
 			logger.CtxErr(ctx, err).Info(fmt.Sprintf("item attachment type not supported: %v", attachmentType))
 			return nil
 		}
 
 		attachment = a
+
+		// nested attachments are posted along with their parent, which
+		// can push the request body past the size limit of a small upload.
+		if max(int64(size), nestedAttachmentsSize(attachment)) >= largeAttachmentSize {
+			return uploadLargeItemAttachment(ctx, ap, userID, containerID, parentItemID, attachment, errs, ctr)
+		}
 	}
 
 	// for file attachments sized >= 3MB
@@ -111,6 +121,133 @@ func uploadAttachment(
 	return ap.PostSmallAttachment(ctx, userID, containerID, parentItemID, attachment)
 }
 
+// uploadLargeItemAttachment uploads an item attachment which is too large to
+// post in a single request.  Upload sessions only accept file attachments,
+// so attached messages are converted to eml files.  Other attached items
+// are posted without their nested attachments, each of which is reported
+// as an alert.
+func uploadLargeItemAttachment(
+	ctx context.Context,
+	ap attachmentPoster,
+	userID, containerID, parentItemID string,
+	attachment models.Attachmentable,
+	errs *fault.Bus,
+	ctr *count.Bus,
+) error {
+	ia, ok := attachment.(models.ItemAttachmentable)
+	if !ok {
+		return clues.NewWC(ctx, "large attachment is not an item attachment")
+	}
+
+	msg, ok := ia.GetItem().(models.Messageable)
+	if !ok {
+		for _, nested := range nestedAttachments(ia.GetItem()) {
+			alertDroppedNestedAttachment(ctx, attachment, nested, parentItemID, errs)
+			ctr.Inc(count.NestedAttachmentsDropped)
+		}
+
+		stripNestedAttachments(ia.GetItem())
+
+		return ap.PostSmallAttachment(ctx, userID, containerID, parentItemID, attachment)
+	}
+
+	content, err := eml.FromMessageable(ctx, msg)
+	if err != nil {
+		return clues.Wrap(err, "converting item attachment to eml")
+	}
+
+	name := ptr.Val(attachment.GetName())
+	if len(name) == 0 {
+		name = ptr.Val(msg.GetSubject())
+	}
+
+	_, err = ap.PostLargeAttachment(
+		ctx,
+		userID,
+		containerID,
+		parentItemID,
+		name+".eml",
+		[]byte(content))
+
+	return clues.Wrap(err, "uploading large item attachment").OrNil()
+}
+
+// alertDroppedNestedAttachment reports an attachment nested within the
+// attached item, which gets restored without it.
+func alertDroppedNestedAttachment(
+	ctx context.Context,
+	attachment, nested models.Attachmentable,
+	parentItemID string,
+	errs *fault.Bus,
+) {
+	var (
+		id   = ptr.Val(nested.GetId())
+		name = ptr.Val(nested.GetName())
+	)
+
+	ctx = clues.Add(
+		ctx,
+		"nested_attachment_id", id,
+		"nested_attachment_name", clues.Hide(name))
+
+	logger.Ctx(ctx).Info("dropping nested attachment of large item attachment")
+
+	errs.AddAlert(ctx, fault.NewAlert(
+		fault.AlertDroppedNestedAttachment,
+		"", // no namespace
+		id,
+		name,
+		map[string]any{
+			"attachment_id":   ptr.Val(attachment.GetId()),
+			"attachment_name": ptr.Val(attachment.GetName()),
+			"parent_item_id":  parentItemID,
+		}))
+}
+
+// nestedAttachments returns the attachments of an attached item.
+func nestedAttachments(item models.OutlookItemable) []models.Attachmentable {
+	switch item := item.(type) {
+	case models.Messageable:
+		return item.GetAttachments()
+	case models.Eventable:
+		return item.GetAttachments()
+	}
+
+	return nil
+}
+
+// nestedAttachmentsSize sums the content of all attachments nested within
+// an item attachment.
+func nestedAttachmentsSize(attachment models.Attachmentable) int64 {
+	ia, ok := attachment.(models.ItemAttachmentable)
+	if !ok {
+		return 0
+	}
+
+	var size int64
+
+	for _, a := range nestedAttachments(ia.GetItem()) {
+		if fa, ok := a.(models.FileAttachmentable); ok {
+			size += int64(len(fa.GetContentBytes()))
+		}
+
+		size += nestedAttachmentsSize(a)
+	}
+
+	return size
+}
+
+func stripNestedAttachments(item models.OutlookItemable) {
+	switch item := item.(type) {
+	case models.Messageable:
+		item.SetAttachments(nil)
+		item.SetHasAttachments(nil)
+	case models.Eventable:
+		item.SetAttachments(nil)
+		item.SetHasAttachments(nil)
+	}
+}
+
 func getOutlookOdataType(query models.Attachmentable) string {
 	attachment, ok := query.(models.ItemAttachmentable)
 	if !ok {
@@ -0,0 +1,170 @@
+package exchange
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/converters/eml/testdata"
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/count"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+)
+
+type recordingAttachmentPoster struct {
+	small      []models.Attachmentable
+	largeNames []string
+	large      [][]byte
+}
+
+func (m *recordingAttachmentPoster) PostSmallAttachment(
+	_ context.Context,
+	_, _, _ string,
+	body models.Attachmentable,
+) error {
+	m.small = append(m.small, body)
+	return nil
+}
+
+func (m *recordingAttachmentPoster) PostLargeAttachment(
+	_ context.Context,
+	_, _, _, name string,
+	content []byte,
+) (string, error) {
+	m.largeNames = append(m.largeNames, name)
+	m.large = append(m.large, content)
+
+	return "id", nil
+}
+
+type AttachmentUnitSuite struct {
+	tester.Suite
+}
+
+func TestAttachmentUnitSuite(t *testing.T) {
+	suite.Run(t, &AttachmentUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+// nestedAttachment produces an attached message which holds a file
+// attachment with the provided content.
+func nestedAttachment(t *testing.T, content []byte) models.Attachmentable {
+	message, err := api.BytesToMessageable([]byte(testdata.EmailWithinEmail))
+	require.NoError(t, err, clues.ToCore(err))
+
+	attachment := message.GetAttachments()[0]
+	item := attachment.(models.ItemAttachmentable).GetItem().(models.Messageable)
+	item.GetAttachments()[0].(models.FileAttachmentable).SetContentBytes(content)
+
+	return attachment
+}
+
+func (suite *AttachmentUnitSuite) TestUploadAttachment_nestedItems() {
+	largeContent := bytes.Repeat([]byte("a"), largeAttachmentSize)
+
+	table := []struct {
+		name        string
+		content     []byte
+		expectSmall int
+		expectLarge int
+	}{
+		{
+			name:        "small",
+			content:     []byte("small"),
+			expectSmall: 1,
+		},
+		{
+			name:        "large nested file attachment",
+			content:     largeContent,
+			expectLarge: 1,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			ap := &recordingAttachmentPoster{}
+
+			errs := fault.New(true)
+
+			err := uploadAttachment(
+				ctx,
+				ap,
+				"uid", "cid", "iid",
+				nestedAttachment(t, test.content),
+				errs,
+				count.New())
+			require.NoError(t, err, clues.ToCore(err))
+			assert.Empty(t, errs.Alerts(), "no nested attachments are dropped")
+
+			require.Len(t, ap.small, test.expectSmall)
+			require.Len(t, ap.large, test.expectLarge)
+
+			if test.expectSmall > 0 {
+				ia := ap.small[0].(models.ItemAttachmentable)
+				nested := ia.GetItem().(models.Messageable).GetAttachments()
+				assert.Len(t, nested, 1, "nested attachments are posted with their parent")
+			}
+
+			if test.expectLarge > 0 {
+				assert.Equal(t, "Purpose of life.eml", ap.largeNames[0])
+				assert.Contains(t, string(ap.large[0]), "Abidjan.ics")
+			}
+		})
+	}
+}
+
+func (suite *AttachmentUnitSuite) TestUploadAttachment_largeNestedEvent() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	fa := models.NewFileAttachment()
+	fa.SetOdataType(ptr.To(fileAttachmentOdataValue))
+	fa.SetName(ptr.To("large.txt"))
+	fa.SetContentBytes(bytes.Repeat([]byte("a"), largeAttachmentSize))
+
+	event := models.NewEvent()
+	event.SetSubject(ptr.To("event"))
+	event.SetAttachments([]models.Attachmentable{fa})
+
+	attachment := models.NewItemAttachment()
+	attachment.SetOdataType(ptr.To(itemAttachmentOdataValue))
+	attachment.SetName(ptr.To("event"))
+	attachment.SetItem(event)
+
+	var (
+		ap   = &recordingAttachmentPoster{}
+		errs = fault.New(true)
+		ctr  = count.New()
+	)
+
+	err := uploadAttachment(ctx, ap, "uid", "cid", "iid", attachment, errs, ctr)
+	require.NoError(t, err, clues.ToCore(err))
+
+	// only messages can be converted into a file for an upload session.
+	require.Len(t, ap.small, 1)
+	assert.Empty(t, ap.large)
+
+	posted := ap.small[0].(models.ItemAttachmentable).GetItem().(models.Eventable)
+	assert.Equal(t, "event", ptr.Val(posted.GetSubject()))
+	assert.Empty(t, posted.GetAttachments())
+
+	// the dropped nested attachment is reported.
+	alerts := errs.Alerts()
+	require.Len(t, alerts, 1)
+	assert.Equal(t, fault.AlertDroppedNestedAttachment, alerts[0].Message)
+	assert.Equal(t, "large.txt", alerts[0].Item.Name)
+	assert.Equal(t, int64(1), ctr.Get(count.NestedAttachmentsDropped))
+}
@@ -2,10 +2,12 @@ package exchange
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 
 	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/pkg/control"
 )
 
 type attendee struct {
@@ -17,9 +19,12 @@ type attendee struct {
 // FormatAttendees returns string representation of an attendee
 // Return Format: - Name <email@example.com>, Accepted | Declined | Tentative | No Response
 func FormatAttendees(event models.Eventable, isHTML bool) string {
+	return formatAttendees(event.GetAttendees(), isHTML)
+}
+
+func formatAttendees(response []models.Attendeeable, isHTML bool) string {
 	var (
 		failed   int
-		response = event.GetAttendees()
 		required = make([]attendee, 0)
 		optional = make([]attendee, 0)
 		resource = make([]attendee, 0)
@@ -113,3 +118,128 @@ func (at *attendee) String(isHTML bool) string {
 
 	return contents
 }
+
+// ---------------------------------------------------------------------------
+// restore
+// ---------------------------------------------------------------------------
+
+// attendeeRebinder decides which attendees of a backed up event get invited
+// by the restored event, and which only get listed in its body.  The zero
+// value lists every attendee in the body.
+type attendeeRebinder struct {
+	policy control.AttendeePolicy
+	// addresses maps the lowercased source address to the target address.
+	addresses       map[string]string
+	suppressInvites bool
+}
+
+func newAttendeeRebinder(rc control.RestoreConfig) attendeeRebinder {
+	addresses := make(map[string]string, len(rc.AttendeeMap))
+
+	for src, tgt := range rc.AttendeeMap {
+		addresses[strings.ToLower(strings.TrimSpace(src))] = strings.TrimSpace(tgt)
+	}
+
+	return attendeeRebinder{
+		policy:          rc.Attendees,
+		addresses:       addresses,
+		suppressInvites: rc.SuppressInvites,
+	}
+}
+
+func (ar attendeeRebinder) mapAddress(address string) (string, bool) {
+	tgt, ok := ar.addresses[strings.ToLower(address)]
+	return tgt, ok && len(tgt) > 0
+}
+
+// rebind splits the attendees of the backed up event into the ones that
+// get invited by the restored event, and the ones that get listed in its
+// body.
+func (ar attendeeRebinder) rebind(
+	attendees []models.Attendeeable,
+) ([]models.Attendeeable, []models.Attendeeable) {
+	switch ar.policy {
+	case control.AttendeesStrip:
+		return []models.Attendeeable{}, nil
+	case control.AttendeesRemap:
+	default:
+		return []models.Attendeeable{}, attendees
+	}
+
+	var (
+		invited = []models.Attendeeable{}
+		listed  = []models.Attendeeable{}
+	)
+
+	for _, a := range attendees {
+		if a.GetEmailAddress() == nil {
+			continue
+		}
+
+		address, ok := ar.mapAddress(ptr.Val(a.GetEmailAddress().GetAddress()))
+		if !ok {
+			listed = append(listed, a)
+			continue
+		}
+
+		remapped := remapAttendee(a, address)
+
+		if ar.suppressInvites {
+			// listing the attendee requires the response of the original.
+			remapped.SetStatus(a.GetStatus())
+			listed = append(listed, remapped)
+
+			continue
+		}
+
+		invited = append(invited, remapped)
+	}
+
+	return invited, listed
+}
+
+// remapAttendee produces a new attendee of the same type as the original,
+// at the provided address.
+func remapAttendee(orig models.Attendeeable, address string) models.Attendeeable {
+	ea := models.NewEmailAddress()
+	ea.SetName(orig.GetEmailAddress().GetName())
+	ea.SetAddress(&address)
+
+	remapped := models.NewAttendee()
+	remapped.SetEmailAddress(ea)
+	remapped.SetTypeEscaped(orig.GetTypeEscaped())
+
+	return remapped
+}
+
+// rebindLocations remaps the room addresses of the event's locations, and
+// drops the addresses that aren't mapped, so that the restored event won't
+// reference the original rooms.  Locations are left untouched when the
+// attendees get listed in the body.
+func (ar attendeeRebinder) rebindLocations(event models.Eventable) {
+	if ar.policy != control.AttendeesStrip && ar.policy != control.AttendeesRemap {
+		return
+	}
+
+	rebindLocation := func(loc models.Locationable) {
+		if loc == nil || len(ptr.Val(loc.GetLocationEmailAddress())) == 0 {
+			return
+		}
+
+		var address *string
+
+		if ar.policy == control.AttendeesRemap {
+			if tgt, ok := ar.mapAddress(ptr.Val(loc.GetLocationEmailAddress())); ok {
+				address = &tgt
+			}
+		}
+
+		loc.SetLocationEmailAddress(address)
+	}
+
+	rebindLocation(event.GetLocation())
+
+	for _, loc := range event.GetLocations() {
+		rebindLocation(loc)
+	}
+}
@@ -295,7 +295,7 @@ func populateCollections(
 				addAndRem.DU.Reset,
 				cl),
 			qp.ProtectedResource.ID(),
-			bh.itemHandler(),
+			bh.itemHandler(cID),
 			bh,
 			addAndRem.Added,
 			addAndRem.Removed,
@@ -471,6 +471,8 @@ func includeContainer(
 		ok = scope.Matches(selectors.ExchangeContactFolder, directory)
 	case path.EventsCategory:
 		ok = scope.Matches(selectors.ExchangeEventCalendar, directory)
+	case path.TasksCategory:
+		ok = scope.Matches(selectors.ExchangeTaskList, directory)
 	default:
 		return nil, nil, false
 	}
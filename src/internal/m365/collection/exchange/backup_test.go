@@ -83,7 +83,7 @@ type mockBackupHandler struct {
 }
 
 func (bh mockBackupHandler) itemEnumerator() addedAndRemovedItemGetter { return bh.mg }
-func (bh mockBackupHandler) itemHandler() itemGetterSerializer         { return mockItemGetter{} }
+func (bh mockBackupHandler) itemHandler(string) itemGetterSerializer   { return mockItemGetter{} }
 func (bh mockBackupHandler) folderGetter() containerGetter             { return bh.fg }
 func (bh mockBackupHandler) previewIncludeContainers() []string        { return bh.previewIncludes }
 func (bh mockBackupHandler) previewExcludeContainers() []string        { return bh.previewExcludes }
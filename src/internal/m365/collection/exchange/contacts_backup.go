@@ -27,7 +27,7 @@ func (h contactBackupHandler) itemEnumerator() addedAndRemovedItemGetter {
 	return h.ac
 }
 
-func (h contactBackupHandler) itemHandler() itemGetterSerializer {
+func (h contactBackupHandler) itemHandler(string) itemGetterSerializer {
 	return h.ac
 }
 
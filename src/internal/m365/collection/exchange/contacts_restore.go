@@ -83,6 +83,15 @@ func (h contactRestoreHandler) DefaultRootContainer() string {
 	return api.DefaultContacts
 }
 
+func (h contactRestoreHandler) collisionKeyAndName(body []byte) (string, string, error) {
+	item, err := api.BytesToContactable(body)
+	if err != nil {
+		return "", "", clues.Wrap(err, "creating contact from bytes")
+	}
+
+	return api.ContactCollisionKey(item), ptr.Val(item.GetDisplayName()), nil
+}
+
 func (h contactRestoreHandler) restore(
 	ctx context.Context,
 	body []byte,
@@ -32,7 +32,7 @@ func (h eventBackupHandler) itemEnumerator() addedAndRemovedItemGetter {
 	return h.ac
 }
 
-func (h eventBackupHandler) itemHandler() itemGetterSerializer {
+func (h eventBackupHandler) itemHandler(string) itemGetterSerializer {
 	return h.ac
 }
 
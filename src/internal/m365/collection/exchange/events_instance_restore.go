@@ -11,6 +11,7 @@ import (
 
 	"github.com/alcionai/corso/src/internal/common/ptr"
 	"github.com/alcionai/corso/src/internal/common/str"
+	"github.com/alcionai/corso/src/pkg/count"
 	"github.com/alcionai/corso/src/pkg/dttm"
 	"github.com/alcionai/corso/src/pkg/fault"
 	"github.com/alcionai/corso/src/pkg/services/m365/api"
@@ -37,9 +38,11 @@ type eventInstanceAndAttachmenter interface {
 func updateRecurringEvents(
 	ctx context.Context,
 	eiaa eventInstanceAndAttachmenter,
+	ar attendeeRebinder,
 	userID, containerID, itemID string,
 	event models.Eventable,
 	errs *fault.Bus,
+	ctr *count.Bus,
 ) error {
 	if event.GetRecurrence() == nil {
 		return nil
@@ -56,7 +59,7 @@ func updateRecurringEvents(
 		return clues.Wrap(err, "update cancelled occurrences")
 	}
 
-	err = updateExceptionOccurrences(ctx, eiaa, userID, containerID, itemID, exceptionOccurrences, errs)
+	err = updateExceptionOccurrences(ctx, eiaa, ar, userID, containerID, itemID, exceptionOccurrences, errs, ctr)
 	if err != nil {
 		return clues.Wrap(err, "update exception occurrences")
 	}
@@ -70,11 +73,13 @@ func updateRecurringEvents(
 func updateExceptionOccurrences(
 	ctx context.Context,
 	eiaa eventInstanceAndAttachmenter,
+	ar attendeeRebinder,
 	userID string,
 	containerID string,
 	itemID string,
 	exceptionOccurrences any,
 	errs *fault.Bus,
+	ctr *count.Bus,
 ) error {
 	if exceptionOccurrences == nil {
 		return nil
@@ -119,7 +124,7 @@ func updateExceptionOccurrences(
 				With("instances_count", len(instances), "search_start", startStr, "search_end", endStr)
 		}
 
-		evt = toEventSimplified(evt)
+		evt = toEventSimplified(evt, ar)
 
 		_, err = eiaa.PatchItem(ictx, userID, ptr.Val(instances[0].GetId()), evt)
 		if err != nil {
@@ -141,7 +146,8 @@ func updateExceptionOccurrences(
 			containerID,
 			ptr.Val(instances[0].GetId()),
 			evt,
-			errs)
+			errs,
+			ctr)
 		if err != nil {
 			return clues.Wrap(err, "updating event instance attachments")
 		}
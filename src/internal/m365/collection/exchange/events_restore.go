@@ -26,14 +26,17 @@ var (
 )
 
 type eventRestoreHandler struct {
-	ac api.Events
+	ac        api.Events
+	attendees attendeeRebinder
 }
 
 func newEventRestoreHandler(
 	ac api.Client,
+	restoreCfg control.RestoreConfig,
 ) eventRestoreHandler {
 	return eventRestoreHandler{
-		ac: ac.Events(),
+		ac:        ac.Events(),
+		attendees: newAttendeeRebinder(restoreCfg),
 	}
 }
 
@@ -82,6 +85,15 @@ func (h eventRestoreHandler) DefaultRootContainer() string {
 	return api.DefaultCalendar
 }
 
+func (h eventRestoreHandler) collisionKeyAndName(body []byte) (string, string, error) {
+	item, err := api.BytesToEventable(body)
+	if err != nil {
+		return "", "", clues.Wrap(err, "creating event from bytes")
+	}
+
+	return api.EventCollisionKey(item), ptr.Val(item.GetSubject()), nil
+}
+
 func (h eventRestoreHandler) restore(
 	ctx context.Context,
 	body []byte,
@@ -94,6 +106,7 @@ func (h eventRestoreHandler) restore(
 	return restoreEvent(
 		ctx,
 		h.ac,
+		h.attendees,
 		body,
 		userID, destinationID,
 		collisionKeyToItemID,
@@ -110,6 +123,7 @@ type eventRestorer interface {
 func restoreEvent(
 	ctx context.Context,
 	er eventRestorer,
+	ar attendeeRebinder,
 	body []byte,
 	userID, destinationID string,
 	collisionKeyToItemID map[string]string,
@@ -145,7 +159,7 @@ func restoreEvent(
 		shouldDeleteOriginal = collisionPolicy == control.Replace
 	}
 
-	event = toEventSimplified(event)
+	event = toEventSimplified(event, ar)
 
 	var attachments []models.Attachmentable
 
@@ -180,7 +194,8 @@ func restoreEvent(
 		userID,
 		destinationID,
 		ptr.Val(item.GetId()),
-		errs)
+		errs,
+		ctr)
 	if err != nil {
 		return nil, clues.Stack(err)
 	}
@@ -196,11 +211,13 @@ func restoreEvent(
 	err = updateRecurringEvents(
 		ctx,
 		er,
+		ar,
 		userID,
 		destinationID,
 		ptr.Val(item.GetId()),
 		event,
-		errs)
+		errs,
+		ctr)
 	if err != nil {
 		return nil, clues.Stack(err)
 	}
@@ -244,6 +261,7 @@ func updateAttachments(
 	userID, containerID, eventID string,
 	event models.Eventable,
 	errs *fault.Bus,
+	ctr *count.Bus,
 ) error {
 	el := errs.Local()
 
@@ -322,7 +340,7 @@ func updateAttachments(
 		}
 
 		if !found {
-			err = uploadAttachment(ctx, agdp, userID, containerID, eventID, att)
+			err = uploadAttachment(ctx, agdp, userID, containerID, eventID, att, el, ctr)
 			if err != nil {
 				return clues.Wrap(err, "uploading attachment").
 					With("attachment_id", id)
@@ -121,7 +121,7 @@ func (suite *EventsRestoreIntgSuite) SetupSuite() {
 func (suite *EventsRestoreIntgSuite) TestCreateContainerDestination() {
 	runCreateDestinationTest(
 		suite.T(),
-		newEventRestoreHandler(suite.m365.AC),
+		newEventRestoreHandler(suite.m365.AC, testdata.DefaultRestoreConfig("")),
 		path.EventsCategory,
 		suite.m365.TenantID,
 		suite.m365.User.ID,
@@ -264,6 +264,7 @@ func (suite *EventsRestoreIntgSuite) TestRestoreEvent() {
 			_, err := restoreEvent(
 				ctx,
 				test.apiMock,
+				attendeeRebinder{},
 				body,
 				suite.m365.User.ID,
 				"destination",
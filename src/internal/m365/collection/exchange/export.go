@@ -4,11 +4,14 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"time"
 
 	"github.com/alcionai/clues"
 
+	"github.com/alcionai/corso/src/internal/common/ptr"
 	"github.com/alcionai/corso/src/internal/converters/eml"
 	"github.com/alcionai/corso/src/internal/converters/ics"
+	"github.com/alcionai/corso/src/internal/converters/mbox"
 	"github.com/alcionai/corso/src/internal/converters/vcf"
 	"github.com/alcionai/corso/src/internal/data"
 	"github.com/alcionai/corso/src/pkg/control"
@@ -17,6 +20,7 @@ import (
 	"github.com/alcionai/corso/src/pkg/logger"
 	"github.com/alcionai/corso/src/pkg/metrics"
 	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
 )
 
 func NewExportCollection(
@@ -57,8 +61,10 @@ func streamItems(
 			ext = ".eml"
 		case path.ContactsCategory:
 			ext = ".vcf"
-		case path.EventsCategory:
+		case path.EventsCategory, path.TasksCategory:
 			ext = ".ics"
+		case path.MailboxSettingsCategory:
+			ext = ".json"
 		}
 
 		for item := range rc.Items(ictx, errs) {
@@ -132,6 +138,23 @@ func streamItems(
 
 					continue
 				}
+			case path.TasksCategory:
+				outData, err = ics.FromTaskJSON(ctx, content)
+				if err != nil {
+					err = clues.Wrap(err, "converting to ics")
+
+					logger.CtxErr(ctx, err).Info("processing collection item")
+
+					ch <- export.Item{
+						ID:    id,
+						Error: err,
+					}
+
+					continue
+				}
+			case path.MailboxSettingsCategory:
+				// settings are stored as graph json, which is exported as-is.
+				outData = string(content)
 			}
 
 			emlReader := io.NopCloser(bytes.NewReader([]byte(outData)))
@@ -161,3 +184,167 @@ func streamItems(
 		}
 	}
 }
+
+// NewMboxExportCollection creates an export collection which writes
+// all the emails in each of the backing collections into a single
+// mbox file named after the folder.
+func NewMboxExportCollection(
+	baseDir string,
+	backingCollection []data.RestoreCollection,
+	backupVersion int,
+	stats *metrics.ExportStats,
+) export.Collectioner {
+	return export.BaseCollection{
+		BaseDir:           baseDir,
+		BackingCollection: backingCollection,
+		BackupVersion:     backupVersion,
+		Stream:            streamMbox,
+		Stats:             stats,
+	}
+}
+
+// streamMbox streams the emails in each of the backingCollections as a
+// single mbox item into the export stream chan.  Since the consumer
+// has to finish reading the mbox before it can read the next item,
+// errors for individual emails are sent only after the mbox is written.
+func streamMbox(
+	ctx context.Context,
+	drc []data.RestoreCollection,
+	backupVersion int,
+	config control.ExportConfig,
+	ch chan<- export.Item,
+	stats *metrics.ExportStats,
+) {
+	defer close(ch)
+
+	for _, rc := range drc {
+		var (
+			ictx     = clues.Add(ctx, "path_short_ref", rc.FullPath().ShortRef())
+			category = rc.FullPath().Category()
+			folders  = rc.FullPath().Folders()
+			name     = category.HumanString()
+			errs     = fault.New(false)
+			failed   = []export.Item{}
+			done     = make(chan struct{})
+		)
+
+		if len(folders) > 0 {
+			name = folders[len(folders)-1]
+		}
+
+		reader, writer := io.Pipe()
+
+		go func() {
+			defer close(done)
+
+			var (
+				mw       = mbox.NewWriter(writer)
+				writeErr error
+			)
+
+			for item := range rc.Items(ictx, errs) {
+				// Once writing fails (ex: the consumer closed the reader)
+				// there is no point in processing the rest of the items,
+				// but we still drain the channel to release the producer.
+				if writeErr != nil {
+					continue
+				}
+
+				id := item.ID()
+				itemCtx := clues.Add(ictx, "stream_item_id", id)
+
+				stats.UpdateResourceCount(category)
+
+				sender, date, outData, err := toMboxMessage(itemCtx, item)
+				if err != nil {
+					logger.CtxErr(ctx, err).Info("processing collection item")
+
+					failed = append(failed, export.Item{
+						ID:    id,
+						Error: err,
+					})
+
+					continue
+				}
+
+				writeErr = mw.WriteMessage(sender, date, outData)
+				if writeErr != nil {
+					failed = append(failed, export.Item{
+						ID:    id,
+						Error: clues.WrapWC(itemCtx, writeErr, "writing to mbox"),
+					})
+				}
+			}
+
+			if writeErr == nil {
+				writeErr = mw.Flush()
+			}
+
+			writer.CloseWithError(writeErr)
+		}()
+
+		ch <- export.Item{
+			ID:   rc.FullPath().ShortRef(),
+			Name: name + ".mbox",
+			Body: metrics.ReaderWithStats(reader, category, stats),
+		}
+
+		<-done
+
+		for _, item := range failed {
+			ch <- item
+		}
+
+		items, recovered := errs.ItemsAndRecovered()
+
+		// Return all the items that we failed to source from the persistence layer
+		for _, err := range items {
+			ch <- export.Item{
+				ID:    err.ID,
+				Error: &err,
+			}
+		}
+
+		for _, err := range recovered {
+			ch <- export.Item{
+				Error: err,
+			}
+		}
+	}
+}
+
+// toMboxMessage converts the item to eml along with the sender and
+// date that are needed for the mbox From line.
+func toMboxMessage(ctx context.Context, item data.Item) (string, time.Time, string, error) {
+	reader := item.ToReader()
+	content, err := io.ReadAll(reader)
+
+	reader.Close()
+
+	if err != nil {
+		return "", time.Time{}, "", clues.WrapWC(ctx, err, "reading export item")
+	}
+
+	msg, err := api.BytesToMessageable(content)
+	if err != nil {
+		return "", time.Time{}, "", clues.WrapWC(ctx, err, "converting to messageable")
+	}
+
+	outData, err := eml.FromMessageable(ctx, msg)
+	if err != nil {
+		return "", time.Time{}, "", clues.Wrap(err, "converting to eml")
+	}
+
+	var sender string
+
+	if msg.GetFrom() != nil && msg.GetFrom().GetEmailAddress() != nil {
+		sender = ptr.Val(msg.GetFrom().GetEmailAddress().GetAddress())
+	}
+
+	date := ptr.Val(msg.GetReceivedDateTime())
+	if date.IsZero() {
+		date = ptr.Val(msg.GetSentDateTime())
+	}
+
+	return sender, date, outData, nil
+}
@@ -21,7 +21,10 @@ import (
 
 type backupHandler interface {
 	itemEnumerator() addedAndRemovedItemGetter
-	itemHandler() itemGetterSerializer
+	// itemHandler produces the getter for items in the container.  Most
+	// categories can look up items by ID alone, but tasks can only be
+	// addressed within their list.
+	itemHandler(containerID string) itemGetterSerializer
 	folderGetter() containerGetter
 	previewIncludeContainers() []string
 	previewExcludeContainers() []string
@@ -56,6 +59,7 @@ func BackupHandlers(ac api.Client) map[path.CategoryType]backupHandler {
 		path.ContactsCategory: newContactBackupHandler(ac),
 		path.EmailCategory:    newMailBackupHandler(ac),
 		path.EventsCategory:   newEventBackupHandler(ac),
+		path.TasksCategory:    newTaskBackupHandler(ac),
 	}
 }
 
@@ -73,6 +77,7 @@ type canSkipItemFailurer interface {
 
 type restoreHandler interface {
 	itemRestorer
+	itemPlanner
 	containerAPI
 	getItemsByCollisionKeyser
 	NewContainerCache(userID string) graph.ContainerResolver
@@ -101,6 +106,14 @@ type itemRestorer interface {
 	) (*details.ExchangeInfo, error)
 }
 
+// produces the details needed to plan the restore of a single item
+// without writing it.
+type itemPlanner interface {
+	// collisionKeyAndName deserializes the item, returning its collision
+	// key and a human readable name.
+	collisionKeyAndName(body []byte) (string, string, error)
+}
+
 // produces structs that interface with the graph/cache_container
 // CachedContainer interface.
 type containerAPI interface {
@@ -125,11 +138,13 @@ type containerByNamer interface {
 // primary interface controller for all per-cateogry restoration behavior.
 func RestoreHandlers(
 	ac api.Client,
+	restoreCfg control.RestoreConfig,
 ) map[path.CategoryType]restoreHandler {
 	return map[path.CategoryType]restoreHandler{
 		path.ContactsCategory: newContactRestoreHandler(ac),
 		path.EmailCategory:    newMailRestoreHandler(ac),
-		path.EventsCategory:   newEventRestoreHandler(ac),
+		path.EventsCategory:   newEventRestoreHandler(ac, restoreCfg),
+		path.TasksCategory:    newTaskRestoreHandler(ac),
 	}
 }
 
@@ -0,0 +1,222 @@
+package exchange
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoft/kiota-abstractions-go/serialization"
+	kjson "github.com/microsoft/kiota-serialization-json-go"
+
+	"github.com/alcionai/corso/src/internal/converters/eml"
+	"github.com/alcionai/corso/src/internal/converters/ics"
+	"github.com/alcionai/corso/src/internal/converters/vcf"
+	"github.com/alcionai/corso/src/internal/data"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/logger"
+	"github.com/alcionai/corso/src/pkg/path"
+)
+
+// importCategories maps the file extensions that can be imported
+// to the category of data they hold.
+var importCategories = map[string]path.CategoryType{
+	".eml": path.EmailCategory,
+	".ics": path.EventsCategory,
+	".vcf": path.ContactsCategory,
+}
+
+// ProduceImportCollections converts the .eml, .ics, and .vcf files in dir
+// into restore collections, so that they can be restored in the same way
+// as the items in a backup.  Each directory produces one collection per
+// category, and keeps its path relative to dir.  Files at the top of dir
+// are placed in a folder named after dir.  Other files are ignored.
+func ProduceImportCollections(
+	ctx context.Context,
+	dir, tenantID, resourceID string,
+) ([]data.RestoreCollection, error) {
+	ctx = clues.Add(ctx, "import_dir", clues.Hide(dir))
+
+	type collKey struct {
+		category path.CategoryType
+		folder   string
+	}
+
+	var (
+		files = map[collKey][]string{}
+		base  = filepath.Base(filepath.Clean(dir))
+	)
+
+	err := filepath.WalkDir(dir, func(fp string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return clues.WrapWC(ctx, err, "walking import directory")
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		category, ok := importCategories[strings.ToLower(filepath.Ext(fp))]
+		if !ok {
+			logger.Ctx(ctx).Infow("skipping file with unknown extension", "file_name", clues.Hide(d.Name()))
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, filepath.Dir(fp))
+		if err != nil {
+			return clues.WrapWC(ctx, err, "getting relative file location")
+		}
+
+		if rel == "." {
+			rel = base
+		}
+
+		key := collKey{category, filepath.ToSlash(rel)}
+		files[key] = append(files[key], fp)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, clues.NewWC(ctx, "no .eml, .ics, or .vcf files found")
+	}
+
+	colls := make([]data.RestoreCollection, 0, len(files))
+
+	for key, fps := range files {
+		fullPath, err := path.Build(
+			tenantID,
+			resourceID,
+			path.ExchangeService,
+			key.category,
+			false,
+			strings.Split(key.folder, "/")...)
+		if err != nil {
+			return nil, clues.WrapWC(ctx, err, "building import collection path")
+		}
+
+		sort.Strings(fps)
+
+		colls = append(colls, data.NoFetchRestoreCollection{
+			Collection: &importCollection{
+				fullPath: fullPath,
+				files:    fps,
+			},
+		})
+	}
+
+	// restore collections in a stable order.
+	sort.Slice(colls, func(i, j int) bool {
+		return colls[i].FullPath().String() < colls[j].FullPath().String()
+	})
+
+	return colls, nil
+}
+
+// importCollection converts the files in a directory into graph
+// models as its items are read.
+type importCollection struct {
+	fullPath path.Path
+	files    []string
+}
+
+func (ic importCollection) FullPath() path.Path {
+	return ic.fullPath
+}
+
+func (ic importCollection) Items(ctx context.Context, errs *fault.Bus) <-chan data.Item {
+	ch := make(chan data.Item)
+
+	go func() {
+		defer close(ch)
+
+		el := errs.Local()
+
+		for _, fp := range ic.files {
+			if el.Failure() != nil {
+				return
+			}
+
+			ictx := clues.Add(ctx, "file_name", clues.Hide(filepath.Base(fp)))
+
+			body, err := importFile(ictx, ic.fullPath.Category(), fp)
+			if err != nil {
+				el.AddRecoverable(ictx, err)
+				continue
+			}
+
+			ch <- &importItem{
+				id:   filepath.Base(fp),
+				body: body,
+			}
+		}
+	}()
+
+	return ch
+}
+
+// importFile reads the file at fp and serializes it as the json
+// of the graph model for the category.
+func importFile(
+	ctx context.Context,
+	category path.CategoryType,
+	fp string,
+) ([]byte, error) {
+	body, err := os.ReadFile(fp)
+	if err != nil {
+		return nil, clues.WrapWC(ctx, err, "reading import file")
+	}
+
+	var item serialization.Parsable
+
+	switch category {
+	case path.EmailCategory:
+		item, err = eml.ToMessageable(ctx, body)
+	case path.EventsCategory:
+		item, err = ics.ToEventable(ctx, body)
+	case path.ContactsCategory:
+		item, err = vcf.ToContactable(ctx, body)
+	default:
+		return nil, clues.NewWC(ctx, "unsupported import category")
+	}
+
+	if err != nil {
+		return nil, clues.Wrap(err, "converting import file")
+	}
+
+	writer := kjson.NewJsonSerializationWriter()
+	defer writer.Close()
+
+	if err := writer.WriteObjectValue("", item); err != nil {
+		return nil, clues.WrapWC(ctx, err, "serializing imported item")
+	}
+
+	bs, err := writer.GetSerializedContent()
+
+	return bs, clues.WrapWC(ctx, err, "serializing imported item").OrNil()
+}
+
+type importItem struct {
+	id   string
+	body []byte
+}
+
+func (ii importItem) ID() string {
+	return ii.id
+}
+
+func (ii importItem) ToReader() io.ReadCloser {
+	return io.NopCloser(bytes.NewReader(ii.body))
+}
+
+func (ii importItem) Deleted() bool {
+	return false
+}
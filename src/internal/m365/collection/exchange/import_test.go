@@ -0,0 +1,155 @@
+package exchange
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alcionai/clues"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/converters/eml"
+	"github.com/alcionai/corso/src/internal/converters/eml/testdata"
+	"github.com/alcionai/corso/src/internal/converters/ics"
+	"github.com/alcionai/corso/src/internal/converters/vcf"
+	exchMock "github.com/alcionai/corso/src/internal/m365/service/exchange/mock"
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+)
+
+type ImportUnitSuite struct {
+	tester.Suite
+}
+
+func TestImportUnitSuite(t *testing.T) {
+	suite.Run(t, &ImportUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func (suite *ImportUnitSuite) TestProduceImportCollections() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	dir := filepath.Join(t.TempDir(), "exports")
+
+	mail, err := eml.FromJSON(ctx, []byte(testdata.EmailWithAttachments))
+	require.NoError(t, err, clues.ToCore(err))
+
+	event, err := ics.FromJSON(ctx, exchMock.EventWithSubjectBytes("fnords"))
+	require.NoError(t, err, clues.ToCore(err))
+
+	contact, err := vcf.FromJSON(ctx, exchMock.ContactBytes("smarf"))
+	require.NoError(t, err, clues.ToCore(err))
+
+	files := map[string]string{
+		"mail.eml":                 mail,
+		"Inbox/Important/mail.EML": mail,
+		"Calendar/event.ics":       event,
+		"Contacts/contact.vcf":     contact,
+		"Contacts/readme.txt":      "not an item",
+	}
+
+	for name, content := range files {
+		fp := filepath.Join(dir, filepath.FromSlash(name))
+
+		err := os.MkdirAll(filepath.Dir(fp), 0o700)
+		require.NoError(t, err, clues.ToCore(err))
+
+		err = os.WriteFile(fp, []byte(content), 0o600)
+		require.NoError(t, err, clues.ToCore(err))
+	}
+
+	colls, err := ProduceImportCollections(ctx, dir, "tid", "rid")
+	require.NoError(t, err, clues.ToCore(err))
+	require.Len(t, colls, 4)
+
+	type expectColl struct {
+		category path.CategoryType
+		folders  path.Elements
+		itemID   string
+	}
+
+	expect := []expectColl{
+		{path.ContactsCategory, path.Elements{"Contacts"}, "contact.vcf"},
+		{path.EmailCategory, path.Elements{"Inbox", "Important"}, "mail.EML"},
+		{path.EmailCategory, path.Elements{"exports"}, "mail.eml"},
+		{path.EventsCategory, path.Elements{"Calendar"}, "event.ics"},
+	}
+
+	for i, coll := range colls {
+		fp := coll.FullPath()
+
+		assert.Equal(t, path.ExchangeService, fp.Service())
+		assert.Equal(t, "rid", fp.ProtectedResource())
+		assert.Equal(t, expect[i].category, fp.Category())
+		assert.Equal(t, expect[i].folders, fp.Folders())
+
+		errs := fault.New(true)
+
+		var items int
+
+		for item := range coll.Items(ctx, errs) {
+			items++
+
+			assert.Equal(t, expect[i].itemID, item.ID())
+
+			buf := &bytes.Buffer{}
+			_, err := buf.ReadFrom(item.ToReader())
+			require.NoError(t, err, clues.ToCore(err))
+
+			switch fp.Category() {
+			case path.EmailCategory:
+				msg, err := api.BytesToMessageable(buf.Bytes())
+				require.NoError(t, err, clues.ToCore(err))
+				assert.Equal(t, "Mail with everything", ptr.Val(msg.GetSubject()))
+				assert.NotEmpty(t, msg.GetAttachments())
+			case path.EventsCategory:
+				evt, err := api.BytesToEventable(buf.Bytes())
+				require.NoError(t, err, clues.ToCore(err))
+				assert.Equal(t, "fnords", ptr.Val(evt.GetSubject()))
+			case path.ContactsCategory:
+				cnt, err := api.BytesToContactable(buf.Bytes())
+				require.NoError(t, err, clues.ToCore(err))
+				assert.Equal(t, "smarf", ptr.Val(cnt.GetMiddleName()))
+			}
+		}
+
+		assert.Equal(t, 1, items)
+		assert.NoError(t, errs.Failure(), clues.ToCore(errs.Failure()))
+		assert.Empty(t, errs.Recovered())
+	}
+}
+
+func (suite *ImportUnitSuite) TestProduceImportCollections_badFiles() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	dir := t.TempDir()
+
+	_, err := ProduceImportCollections(ctx, dir, "tid", "rid")
+	assert.Error(t, err, "empty directory")
+
+	err = os.WriteFile(filepath.Join(dir, "bad.vcf"), []byte("not a vcard"), 0o600)
+	require.NoError(t, err, clues.ToCore(err))
+
+	colls, err := ProduceImportCollections(ctx, dir, "tid", "rid")
+	require.NoError(t, err, clues.ToCore(err))
+	require.Len(t, colls, 1)
+
+	errs := fault.New(false)
+
+	for range colls[0].Items(ctx, errs) {
+		assert.Fail(t, "unexpected item from an invalid file")
+	}
+
+	assert.Len(t, errs.Recovered(), 1)
+}
@@ -27,7 +27,7 @@ func (h mailBackupHandler) itemEnumerator() addedAndRemovedItemGetter {
 	return h.ac
 }
 
-func (h mailBackupHandler) itemHandler() itemGetterSerializer {
+func (h mailBackupHandler) itemHandler(string) itemGetterSerializer {
 	return h.ac
 }
 
@@ -82,6 +82,15 @@ func (h mailRestoreHandler) DefaultRootContainer() string {
 	return api.MsgFolderRoot
 }
 
+func (h mailRestoreHandler) collisionKeyAndName(body []byte) (string, string, error) {
+	item, err := api.BytesToMessageable(body)
+	if err != nil {
+		return "", "", clues.Wrap(err, "creating mail from bytes")
+	}
+
+	return api.MailCollisionKey(item), ptr.Val(item.GetSubject()), nil
+}
+
 func (h mailRestoreHandler) restore(
 	ctx context.Context,
 	body []byte,
@@ -178,7 +187,8 @@ func restoreMail(
 		userID,
 		destinationID,
 		ptr.Val(item.GetId()),
-		errs)
+		errs,
+		ctr)
 	if err != nil {
 		return nil, clues.Stack(err)
 	}
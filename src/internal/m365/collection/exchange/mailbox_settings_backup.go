@@ -0,0 +1,284 @@
+package exchange
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoft/kiota-abstractions-go/serialization"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/data"
+	"github.com/alcionai/corso/src/internal/m365/support"
+	"github.com/alcionai/corso/src/internal/operations/inject"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/count"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/logger"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/selectors"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+)
+
+// The names of the mailbox settings backed up alongside the inbox rules.
+// Each is stored as an item of the same ID in the settings folder.
+const (
+	automaticRepliesSetting = "automaticReplies"
+	workingHoursSetting     = "workingHours"
+	timeZoneSetting         = "timeZone"
+	categoriesSetting       = "categories"
+)
+
+type mailboxSettingsGetter interface {
+	GetSettings(ctx context.Context, userID string) (models.MailboxSettingsable, error)
+	GetInboxRules(ctx context.Context, userID string) ([]models.MessageRuleable, error)
+	GetMasterCategories(ctx context.Context, userID string) ([]models.OutlookCategoryable, error)
+	Serialize(ctx context.Context, item serialization.Parsable) ([]byte, error)
+}
+
+// CreateMailboxSettingsCollections produces one collection holding the
+// user's inbox rules, and another holding the rest of their mailbox
+// configuration, for each of the two folders matched by the scope.
+// The settings are small and have no delta queries, so every backup
+// captures all of them, and neither collection merges items from the
+// previous backup.
+func CreateMailboxSettingsCollections(
+	ctx context.Context,
+	bpc inject.BackupProducerConfig,
+	msg mailboxSettingsGetter,
+	tenantID string,
+	scope selectors.ExchangeScope,
+	su support.StatusUpdater,
+	counter *count.Bus,
+	errs *fault.Bus,
+) ([]data.BackupCollection, error) {
+	logger.Ctx(ctx).Debug("creating mailbox settings collections")
+
+	var (
+		userID      = bpc.ProtectedResource.ID()
+		collections = []data.BackupCollection{}
+		el          = errs.Local()
+	)
+
+	folders := map[string]func(context.Context) ([]data.Item, error){
+		selectors.MailboxSettingsRulesFolder: func(ctx context.Context) ([]data.Item, error) {
+			return inboxRuleItems(ctx, msg, userID)
+		},
+		selectors.MailboxSettingsConfigFolder: func(ctx context.Context) ([]data.Item, error) {
+			return mailboxConfigItems(ctx, msg, userID)
+		},
+	}
+
+	for _, folder := range []string{selectors.MailboxSettingsRulesFolder, selectors.MailboxSettingsConfigFolder} {
+		if el.Failure() != nil {
+			break
+		}
+
+		ictx := clues.Add(ctx, "mailbox_settings_folder", folder)
+
+		if !scope.Matches(selectors.ExchangeMailboxSettingFolder, folder) {
+			counter.Inc(count.SkippedContainers)
+			continue
+		}
+
+		counter.Inc(count.Containers)
+
+		items, err := folders[folder](ictx)
+		if err != nil {
+			el.AddRecoverable(ictx, clues.Stack(err).Label(fault.LabelForceNoBackupCreation))
+			continue
+		}
+
+		p, err := path.Build(
+			tenantID,
+			userID,
+			path.ExchangeService,
+			path.MailboxSettingsCategory,
+			false,
+			folder)
+		if err != nil {
+			el.AddRecoverable(ictx, clues.WrapWC(ictx, err, "creating mailbox settings collection path").
+				Label(count.BadCollPath))
+			continue
+		}
+
+		loc := path.Builder{}.Append(folder)
+
+		collections = append(
+			collections,
+			&settingsCollection{
+				BaseCollection: data.NewBaseCollection(p, nil, loc, bpc.Options, true, counter),
+				items:          items,
+				statusUpdater:  su,
+			})
+	}
+
+	return collections, el.Failure()
+}
+
+// inboxRuleItems produces an item for each of the user's inbox rules.
+func inboxRuleItems(
+	ctx context.Context,
+	msg mailboxSettingsGetter,
+	userID string,
+) ([]data.Item, error) {
+	rules, err := msg.GetInboxRules(ctx, userID)
+	if err != nil {
+		return nil, clues.Stack(err)
+	}
+
+	items := make([]data.Item, 0, len(rules))
+
+	for _, rule := range rules {
+		id := ptr.Val(rule.GetId())
+
+		bs, err := msg.Serialize(ctx, rule)
+		if err != nil {
+			return nil, clues.Wrap(err, "serializing inbox rule").With("rule_id", id)
+		}
+
+		item, err := settingItem(bs, id, api.InboxRuleInfo(rule, bs))
+		if err != nil {
+			return nil, clues.StackWC(ctx, err)
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// mailboxConfigItems produces an item for each of the user's automatic
+// replies, working hours, time zone, and categories.  The first three are
+// stored as mailbox settings where only that property is populated, so
+// that each can be applied on its own.
+func mailboxConfigItems(
+	ctx context.Context,
+	msg mailboxSettingsGetter,
+	userID string,
+) ([]data.Item, error) {
+	settings, err := msg.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, clues.Stack(err)
+	}
+
+	var (
+		items   = []data.Item{}
+		partial = map[string]models.MailboxSettingsable{}
+		states  = map[string]string{}
+	)
+
+	if ars := settings.GetAutomaticRepliesSetting(); ars != nil {
+		ms := models.NewMailboxSettings()
+		ms.SetAutomaticRepliesSetting(ars)
+
+		partial[automaticRepliesSetting] = ms
+
+		if ars.GetStatus() != nil {
+			states[automaticRepliesSetting] = ars.GetStatus().String()
+		}
+	}
+
+	if wh := settings.GetWorkingHours(); wh != nil {
+		ms := models.NewMailboxSettings()
+		ms.SetWorkingHours(wh)
+
+		partial[workingHoursSetting] = ms
+	}
+
+	if tz := settings.GetTimeZone(); len(ptr.Val(tz)) > 0 {
+		ms := models.NewMailboxSettings()
+		ms.SetTimeZone(tz)
+
+		partial[timeZoneSetting] = ms
+		states[timeZoneSetting] = ptr.Val(tz)
+	}
+
+	for _, name := range []string{automaticRepliesSetting, workingHoursSetting, timeZoneSetting} {
+		ms, ok := partial[name]
+		if !ok {
+			continue
+		}
+
+		bs, err := msg.Serialize(ctx, ms)
+		if err != nil {
+			return nil, clues.Wrap(err, "serializing mailbox setting").With("setting", name)
+		}
+
+		item, err := settingItem(bs, name, api.MailboxSettingInfo(name, states[name], bs))
+		if err != nil {
+			return nil, clues.StackWC(ctx, err)
+		}
+
+		items = append(items, item)
+	}
+
+	cats, err := msg.GetMasterCategories(ctx, userID)
+	if err != nil {
+		return nil, clues.Stack(err)
+	}
+
+	resp := models.NewOutlookCategoryCollectionResponse()
+	resp.SetValue(cats)
+
+	bs, err := msg.Serialize(ctx, resp)
+	if err != nil {
+		return nil, clues.Wrap(err, "serializing categories")
+	}
+
+	item, err := settingItem(bs, categoriesSetting, api.MailboxSettingInfo(categoriesSetting, "", bs))
+	if err != nil {
+		return nil, clues.StackWC(ctx, err)
+	}
+
+	return append(items, item), nil
+}
+
+func settingItem(bs []byte, id string, info *details.ExchangeInfo) (data.Item, error) {
+	return data.NewPrefetchedItemWithInfo(
+		io.NopCloser(bytes.NewReader(bs)),
+		id,
+		details.ItemInfo{Exchange: info})
+}
+
+var _ data.BackupCollection = &settingsCollection{}
+
+// settingsCollection holds mailbox settings, which are retrieved while
+// the collection is created.
+type settingsCollection struct {
+	data.BaseCollection
+
+	items         []data.Item
+	statusUpdater support.StatusUpdater
+}
+
+func (col *settingsCollection) Items(ctx context.Context, _ *fault.Bus) <-chan data.Item {
+	stream := make(chan data.Item, len(col.items))
+
+	var size int64
+
+	for _, item := range col.items {
+		if ii, ok := item.(data.ItemInfo); ok {
+			if info, err := ii.Info(); err == nil && info.Exchange != nil {
+				size += info.Exchange.Size
+			}
+		}
+
+		stream <- item
+	}
+
+	close(stream)
+
+	updateStatus(
+		ctx,
+		col.statusUpdater,
+		len(col.items),
+		len(col.items),
+		size,
+		col.FullPath().Folder(false),
+		nil)
+
+	return stream
+}
@@ -0,0 +1,563 @@
+package exchange
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/data"
+	"github.com/alcionai/corso/src/internal/diagnostics"
+	"github.com/alcionai/corso/src/internal/m365/support"
+	"github.com/alcionai/corso/src/internal/observe"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/count"
+	"github.com/alcionai/corso/src/pkg/errs/core"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/logger"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/restoreplan"
+	"github.com/alcionai/corso/src/pkg/selectors"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
+)
+
+type mailboxSettingsRestorer interface {
+	GetInboxRules(ctx context.Context, userID string) ([]models.MessageRuleable, error)
+	PostInboxRule(ctx context.Context, userID string, body models.MessageRuleable) (models.MessageRuleable, error)
+	DeleteInboxRule(ctx context.Context, userID, ruleID string) error
+	GetMasterCategories(ctx context.Context, userID string) ([]models.OutlookCategoryable, error)
+	PostMasterCategory(
+		ctx context.Context,
+		userID string,
+		body models.OutlookCategoryable,
+	) (models.OutlookCategoryable, error)
+	PatchMasterCategory(ctx context.Context, userID, categoryID string, body models.OutlookCategoryable) error
+	PatchSettings(ctx context.Context, userID string, body models.MailboxSettingsable) error
+	GetMailFolder(ctx context.Context, userID, folderID string) (graph.Container, error)
+}
+
+var _ mailboxSettingsRestorer = settingsRestoreClient{}
+
+type settingsRestoreClient struct {
+	api.MailboxSettings
+}
+
+// GetMailFolder is used to check that the folders referenced by a rule's
+// actions still exist.
+func (c settingsRestoreClient) GetMailFolder(
+	ctx context.Context,
+	userID, folderID string,
+) (graph.Container, error) {
+	return c.Mail().GetContainerByID(ctx, userID, folderID)
+}
+
+// mailboxSettingsPolicy produces the collision policy that applies to the
+// items in the folder.  Every mailbox already has settings, so there's no
+// way to restore a copy of them alongside the existing ones.
+func mailboxSettingsPolicy(folder string, policy control.CollisionPolicy) control.CollisionPolicy {
+	if folder != selectors.MailboxSettingsRulesFolder && policy == control.Copy {
+		return control.Skip
+	}
+
+	return policy
+}
+
+// RestoreMailboxSettingsCollection restores the inbox rules or mailbox
+// settings in the collection directly to the user's mailbox.  Unlike
+// other exchange data, the settings have no container, so the restore
+// location doesn't apply.  Rules collide with existing rules of the same
+// name.  Settings always collide, and only get applied when replacing.
+func RestoreMailboxSettingsCollection(
+	ctx context.Context,
+	ac api.Client,
+	dc data.RestoreCollection,
+	resourceID string,
+	collisionPolicy control.CollisionPolicy,
+	deets *details.Builder,
+	errs *fault.Bus,
+	ctr *count.Bus,
+) (support.CollectionMetrics, error) {
+	return restoreMailboxSettings(
+		ctx,
+		settingsRestoreClient{ac.MailboxSettings()},
+		dc,
+		resourceID,
+		collisionPolicy,
+		deets,
+		errs,
+		ctr)
+}
+
+func restoreMailboxSettings(
+	ctx context.Context,
+	msr mailboxSettingsRestorer,
+	dc data.RestoreCollection,
+	resourceID string,
+	collisionPolicy control.CollisionPolicy,
+	deets *details.Builder,
+	errs *fault.Bus,
+	ctr *count.Bus,
+) (support.CollectionMetrics, error) {
+	ctx, end := diagnostics.Span(ctx, "m365:exchange:restoreMailboxSettings", diagnostics.Label("path", dc.FullPath()))
+	defer end()
+
+	var (
+		el       = errs.Local()
+		metrics  support.CollectionMetrics
+		fullPath = dc.FullPath()
+		folder   = fullPath.Folder(false)
+		policy   = mailboxSettingsPolicy(folder, collisionPolicy)
+		existing map[string]string
+		err      error
+	)
+
+	ctx = clues.Add(ctx, "mailbox_settings_folder", folder)
+
+	if folder == selectors.MailboxSettingsRulesFolder {
+		existing, err = inboxRulesByCollisionKey(ctx, msr, resourceID)
+	} else {
+		existing, err = categoriesByName(ctx, msr, resourceID)
+	}
+
+	if err != nil {
+		return metrics, clues.Wrap(err, "building item collision cache")
+	}
+
+	progressMessage := observe.CollectionProgress(
+		ctx,
+		fullPath.Category().HumanString(),
+		folder)
+	defer close(progressMessage)
+
+	for itemData := range dc.Items(ctx, errs) {
+		if el.Failure() != nil {
+			break
+		}
+
+		ictx := clues.Add(ctx, "item_id", itemData.ID())
+		metrics.Objects++
+
+		buf := &bytes.Buffer{}
+
+		if _, err := buf.ReadFrom(itemData.ToReader()); err != nil {
+			el.AddRecoverable(ictx, clues.WrapWC(ictx, err, "reading item bytes"))
+			continue
+		}
+
+		var (
+			body = buf.Bytes()
+			info *details.ExchangeInfo
+		)
+
+		switch {
+		case folder == selectors.MailboxSettingsRulesFolder:
+			info, err = restoreInboxRule(ictx, msr, body, resourceID, existing, policy, errs, ctr)
+		case itemData.ID() == categoriesSetting:
+			info, err = restoreCategories(ictx, msr, body, resourceID, existing, policy, ctr)
+		default:
+			info, err = restoreMailboxSetting(ictx, msr, body, itemData.ID(), resourceID, policy, ctr)
+		}
+
+		if err != nil {
+			if !errors.Is(err, core.ErrAlreadyExists) {
+				el.AddRecoverable(ictx, clues.Wrap(err, "restoring mailbox setting"))
+			}
+
+			continue
+		}
+
+		metrics.Bytes += int64(len(body))
+		metrics.Successes++
+
+		itemPath, err := fullPath.AppendItem(itemData.ID())
+		if err != nil {
+			el.AddRecoverable(ictx, clues.WrapWC(ictx, err, "adding item to collection path"))
+			continue
+		}
+
+		err = deets.Add(
+			itemPath,
+			path.Builder{}.Append(itemPath.Folders()...),
+			details.ItemInfo{Exchange: info})
+		if err != nil {
+			// These deets additions are for cli display purposes only.
+			// no need to fail out on error.
+			logger.Ctx(ictx).Infow("accounting for restored item", "error", err)
+		}
+
+		progressMessage <- struct{}{}
+	}
+
+	return metrics, el.Failure()
+}
+
+func restoreInboxRule(
+	ctx context.Context,
+	msr mailboxSettingsRestorer,
+	body []byte,
+	userID string,
+	collisionKeyToItemID map[string]string,
+	collisionPolicy control.CollisionPolicy,
+	errs *fault.Bus,
+	ctr *count.Bus,
+) (*details.ExchangeInfo, error) {
+	rule, err := api.BytesToMessageRuleable(body)
+	if err != nil {
+		return nil, clues.WrapWC(ctx, err, "creating inbox rule from bytes")
+	}
+
+	var (
+		collisionKey         = api.InboxRuleCollisionKey(rule)
+		collisionID          string
+		shouldDeleteOriginal bool
+	)
+
+	if id, ok := collisionKeyToItemID[collisionKey]; ok {
+		log := logger.Ctx(ctx).With("collision_key", clues.Hide(collisionKey))
+		log.Debug("item collision")
+
+		if collisionPolicy == control.Skip {
+			ctr.Inc(count.CollisionSkip)
+			log.Debug("skipping item with collision")
+
+			return nil, core.ErrAlreadyExists
+		}
+
+		collisionID = id
+		shouldDeleteOriginal = collisionPolicy == control.Replace
+	}
+
+	if err := dropMissingFolderActions(ctx, msr, rule, userID, errs); err != nil {
+		return nil, clues.Stack(err)
+	}
+
+	// the id and error state are produced by the server.
+	rule.SetId(nil)
+	rule.SetHasError(nil)
+	rule.SetIsReadOnly(nil)
+
+	if _, err := msr.PostInboxRule(ctx, userID, rule); err != nil {
+		return nil, clues.Wrap(err, "restoring inbox rule")
+	}
+
+	// post first, then delete, so that a failure between the two calls
+	// leaves the user with a duplicate rule instead of no rule at all.
+	if shouldDeleteOriginal {
+		err := msr.DeleteInboxRule(ctx, userID, collisionID)
+		if err != nil && !errors.Is(err, core.ErrNotFound) {
+			return nil, clues.Wrap(err, "deleting colliding inbox rule")
+		}
+
+		ctr.Inc(count.CollisionReplace)
+	} else {
+		ctr.Inc(count.NewItemCreated)
+	}
+
+	return api.InboxRuleInfo(rule, body), nil
+}
+
+// dropMissingFolderActions removes the move and copy actions from the rule
+// when their destination folder doesn't exist in the mailbox, since graph
+// rejects rules that reference unknown folders.  An alert is raised for
+// each dropped action.
+func dropMissingFolderActions(
+	ctx context.Context,
+	msr mailboxSettingsRestorer,
+	rule models.MessageRuleable,
+	userID string,
+	errs *fault.Bus,
+) error {
+	acts := rule.GetActions()
+	if acts == nil {
+		return nil
+	}
+
+	actions := []struct {
+		name  string
+		get   func() *string
+		clear func()
+	}{
+		{
+			name:  "moveToFolder",
+			get:   acts.GetMoveToFolder,
+			clear: func() { acts.SetMoveToFolder(nil) },
+		},
+		{
+			name:  "copyToFolder",
+			get:   acts.GetCopyToFolder,
+			clear: func() { acts.SetCopyToFolder(nil) },
+		},
+	}
+
+	for _, act := range actions {
+		folderID := ptr.Val(act.get())
+		if len(folderID) == 0 {
+			continue
+		}
+
+		_, err := msr.GetMailFolder(ctx, userID, folderID)
+		if err == nil {
+			continue
+		}
+
+		if !errors.Is(err, core.ErrNotFound) {
+			return clues.Wrap(err, "getting rule action folder")
+		}
+
+		act.clear()
+
+		errs.AddAlert(ctx, fault.NewAlert(
+			fault.AlertDroppedRuleAction,
+			"", // no namespace
+			ptr.Val(rule.GetId()),
+			ptr.Val(rule.GetDisplayName()),
+			map[string]any{
+				"action":    act.name,
+				"folder_id": folderID,
+			}))
+	}
+
+	return nil
+}
+
+// restoreCategories adds each backed up category that's missing from the
+// user's master category list.  Existing categories keep their color
+// unless they're getting replaced.
+func restoreCategories(
+	ctx context.Context,
+	msr mailboxSettingsRestorer,
+	body []byte,
+	userID string,
+	nameToID map[string]string,
+	collisionPolicy control.CollisionPolicy,
+	ctr *count.Bus,
+) (*details.ExchangeInfo, error) {
+	cats, err := api.BytesToOutlookCategories(body)
+	if err != nil {
+		return nil, clues.WrapWC(ctx, err, "creating categories from bytes")
+	}
+
+	for _, cat := range cats {
+		name := ptr.Val(cat.GetDisplayName())
+		id, ok := nameToID[name]
+
+		switch {
+		case !ok:
+			cat.SetId(nil)
+
+			if _, err := msr.PostMasterCategory(ctx, userID, cat); err != nil {
+				return nil, clues.Wrap(err, "restoring category")
+			}
+
+			ctr.Inc(count.NewItemCreated)
+
+		case collisionPolicy == control.Replace:
+			// only the color of a category can be changed.
+			patch := models.NewOutlookCategory()
+			patch.SetColor(cat.GetColor())
+
+			if err := msr.PatchMasterCategory(ctx, userID, id, patch); err != nil {
+				return nil, clues.Wrap(err, "replacing category")
+			}
+
+			ctr.Inc(count.CollisionReplace)
+
+		default:
+			ctr.Inc(count.CollisionSkip)
+		}
+	}
+
+	return api.MailboxSettingInfo(categoriesSetting, "", body), nil
+}
+
+// restoreMailboxSetting applies a single backed up mailbox setting,
+// such as the automatic replies, when replacing.
+func restoreMailboxSetting(
+	ctx context.Context,
+	msr mailboxSettingsRestorer,
+	body []byte,
+	name, userID string,
+	collisionPolicy control.CollisionPolicy,
+	ctr *count.Bus,
+) (*details.ExchangeInfo, error) {
+	if collisionPolicy != control.Replace {
+		ctr.Inc(count.CollisionSkip)
+		logger.Ctx(ctx).Debug("skipping mailbox setting")
+
+		return nil, core.ErrAlreadyExists
+	}
+
+	settings, err := api.BytesToMailboxSettingsable(body)
+	if err != nil {
+		return nil, clues.WrapWC(ctx, err, "creating mailbox settings from bytes")
+	}
+
+	if err := msr.PatchSettings(ctx, userID, settings); err != nil {
+		return nil, clues.Wrap(err, "restoring mailbox setting")
+	}
+
+	ctr.Inc(count.CollisionReplace)
+
+	var state string
+
+	if ars := settings.GetAutomaticRepliesSetting(); ars != nil && ars.GetStatus() != nil {
+		state = ars.GetStatus().String()
+	} else if tz := settings.GetTimeZone(); tz != nil {
+		state = ptr.Val(tz)
+	}
+
+	return api.MailboxSettingInfo(name, state, body), nil
+}
+
+func inboxRulesByCollisionKey(
+	ctx context.Context,
+	msr mailboxSettingsRestorer,
+	userID string,
+) (map[string]string, error) {
+	rules, err := msr.GetInboxRules(ctx, userID)
+	if err != nil {
+		return nil, clues.Stack(err)
+	}
+
+	m := map[string]string{}
+
+	for _, rule := range rules {
+		m[api.InboxRuleCollisionKey(rule)] = ptr.Val(rule.GetId())
+	}
+
+	return m, nil
+}
+
+func categoriesByName(
+	ctx context.Context,
+	msr mailboxSettingsRestorer,
+	userID string,
+) (map[string]string, error) {
+	cats, err := msr.GetMasterCategories(ctx, userID)
+	if err != nil {
+		return nil, clues.Stack(err)
+	}
+
+	m := map[string]string{}
+
+	for _, cat := range cats {
+		m[ptr.Val(cat.GetDisplayName())] = ptr.Val(cat.GetId())
+	}
+
+	return m, nil
+}
+
+// PlanMailboxSettingsCollection records how each inbox rule or mailbox
+// setting in the collection would get restored, without writing any data.
+func PlanMailboxSettingsCollection(
+	ctx context.Context,
+	ac api.Client,
+	dc data.RestoreCollection,
+	resourceID string,
+	collisionPolicy control.CollisionPolicy,
+	plan *restoreplan.Plan,
+	errs *fault.Bus,
+) error {
+	return planMailboxSettings(
+		ctx,
+		settingsRestoreClient{ac.MailboxSettings()},
+		dc,
+		resourceID,
+		collisionPolicy,
+		plan,
+		errs)
+}
+
+func planMailboxSettings(
+	ctx context.Context,
+	msr mailboxSettingsRestorer,
+	dc data.RestoreCollection,
+	resourceID string,
+	collisionPolicy control.CollisionPolicy,
+	plan *restoreplan.Plan,
+	errs *fault.Bus,
+) error {
+	ctx, end := diagnostics.Span(ctx, "m365:exchange:planMailboxSettings", diagnostics.Label("path", dc.FullPath()))
+	defer end()
+
+	var (
+		el       = errs.Local()
+		fullPath = dc.FullPath()
+		folder   = fullPath.Folder(false)
+		policy   = mailboxSettingsPolicy(folder, collisionPolicy)
+		existing map[string]string
+		err      error
+	)
+
+	if folder == selectors.MailboxSettingsRulesFolder {
+		existing, err = inboxRulesByCollisionKey(ctx, msr, resourceID)
+	} else {
+		existing, err = categoriesByName(ctx, msr, resourceID)
+	}
+
+	if err != nil {
+		return clues.Wrap(err, "building item collision cache")
+	}
+
+	for itemData := range dc.Items(ctx, errs) {
+		if el.Failure() != nil {
+			break
+		}
+
+		ictx := clues.Add(ctx, "item_id", itemData.ID())
+		buf := &bytes.Buffer{}
+
+		if _, err := buf.ReadFrom(itemData.ToReader()); err != nil {
+			el.AddRecoverable(ictx, clues.WrapWC(ictx, err, "reading item bytes"))
+			continue
+		}
+
+		var (
+			name     = itemData.ID()
+			collides = true
+		)
+
+		switch {
+		case folder == selectors.MailboxSettingsRulesFolder:
+			rule, err := api.BytesToMessageRuleable(buf.Bytes())
+			if err != nil {
+				el.AddRecoverable(ictx, clues.StackWC(ictx, err))
+				continue
+			}
+
+			name = api.InboxRuleCollisionKey(rule)
+			_, collides = existing[name]
+
+		case itemData.ID() == categoriesSetting:
+			cats, err := api.BytesToOutlookCategories(buf.Bytes())
+			if err != nil {
+				el.AddRecoverable(ictx, clues.StackWC(ictx, err))
+				continue
+			}
+
+			// missing categories are always added, so the list only
+			// collides when every category already exists.
+			for _, cat := range cats {
+				if _, ok := existing[ptr.Val(cat.GetDisplayName())]; !ok {
+					collides = false
+					break
+				}
+			}
+		}
+
+		plan.Add(restoreplan.Item{
+			ItemID:        itemData.ID(),
+			Name:          name,
+			Category:      fullPath.Category().HumanString(),
+			Action:        restoreplan.ActionFor(collides, policy),
+			ContainerPath: folder,
+		})
+	}
+
+	return el.Failure()
+}
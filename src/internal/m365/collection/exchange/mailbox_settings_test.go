@@ -0,0 +1,545 @@
+package exchange
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	inMock "github.com/alcionai/corso/src/internal/common/idname/mock"
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/data"
+	dataMock "github.com/alcionai/corso/src/internal/data/mock"
+	"github.com/alcionai/corso/src/internal/m365/support"
+	"github.com/alcionai/corso/src/internal/operations/inject"
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/count"
+	"github.com/alcionai/corso/src/pkg/errs/core"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/restoreplan"
+	"github.com/alcionai/corso/src/pkg/selectors"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
+)
+
+// ---------------------------------------------------------------------------
+// mocks
+// ---------------------------------------------------------------------------
+
+var (
+	_ mailboxSettingsGetter   = &mockMailboxSettings{}
+	_ mailboxSettingsRestorer = &mockMailboxSettings{}
+)
+
+type mockMailboxSettings struct {
+	api.MailboxSettings
+
+	settings   models.MailboxSettingsable
+	rules      []models.MessageRuleable
+	categories []models.OutlookCategoryable
+	folders    map[string]struct{}
+
+	postedRules      []models.MessageRuleable
+	deletedRules     []string
+	postedCategories []string
+	patchedColors    map[string]models.CategoryColor
+	patchedSettings  []models.MailboxSettingsable
+}
+
+func (m *mockMailboxSettings) GetSettings(context.Context, string) (models.MailboxSettingsable, error) {
+	return m.settings, nil
+}
+
+func (m *mockMailboxSettings) GetInboxRules(context.Context, string) ([]models.MessageRuleable, error) {
+	return m.rules, nil
+}
+
+func (m *mockMailboxSettings) GetMasterCategories(context.Context, string) ([]models.OutlookCategoryable, error) {
+	return m.categories, nil
+}
+
+func (m *mockMailboxSettings) PostInboxRule(
+	_ context.Context,
+	_ string,
+	body models.MessageRuleable,
+) (models.MessageRuleable, error) {
+	m.postedRules = append(m.postedRules, body)
+	return body, nil
+}
+
+func (m *mockMailboxSettings) DeleteInboxRule(_ context.Context, _, ruleID string) error {
+	m.deletedRules = append(m.deletedRules, ruleID)
+	return nil
+}
+
+func (m *mockMailboxSettings) PostMasterCategory(
+	_ context.Context,
+	_ string,
+	body models.OutlookCategoryable,
+) (models.OutlookCategoryable, error) {
+	m.postedCategories = append(m.postedCategories, ptr.Val(body.GetDisplayName()))
+	return body, nil
+}
+
+func (m *mockMailboxSettings) PatchMasterCategory(
+	_ context.Context,
+	_, categoryID string,
+	body models.OutlookCategoryable,
+) error {
+	if m.patchedColors == nil {
+		m.patchedColors = map[string]models.CategoryColor{}
+	}
+
+	m.patchedColors[categoryID] = ptr.Val(body.GetColor())
+
+	return nil
+}
+
+func (m *mockMailboxSettings) PatchSettings(_ context.Context, _ string, body models.MailboxSettingsable) error {
+	m.patchedSettings = append(m.patchedSettings, body)
+	return nil
+}
+
+func (m *mockMailboxSettings) GetMailFolder(_ context.Context, _, folderID string) (graph.Container, error) {
+	if _, ok := m.folders[folderID]; ok {
+		return models.NewMailFolder(), nil
+	}
+
+	return nil, clues.Stack(core.ErrNotFound)
+}
+
+func inboxRule(id, name, moveTo string) models.MessageRuleable {
+	rule := models.NewMessageRule()
+	rule.SetId(ptr.To(id))
+	rule.SetDisplayName(ptr.To(name))
+	rule.SetIsEnabled(ptr.To(true))
+	rule.SetIsReadOnly(ptr.To(false))
+
+	if len(moveTo) > 0 {
+		acts := models.NewMessageRuleActions()
+		acts.SetMoveToFolder(ptr.To(moveTo))
+		rule.SetActions(acts)
+	}
+
+	return rule
+}
+
+func outlookCategory(id, name string, color models.CategoryColor) models.OutlookCategoryable {
+	cat := models.NewOutlookCategory()
+	cat.SetId(ptr.To(id))
+	cat.SetDisplayName(ptr.To(name))
+	cat.SetColor(&color)
+
+	return cat
+}
+
+// ---------------------------------------------------------------------------
+// tests
+// ---------------------------------------------------------------------------
+
+type MailboxSettingsUnitSuite struct {
+	tester.Suite
+}
+
+func TestMailboxSettingsUnitSuite(t *testing.T) {
+	suite.Run(t, &MailboxSettingsUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func (suite *MailboxSettingsUnitSuite) TestCreateMailboxSettingsCollections() {
+	status := models.ALWAYSENABLED_AUTOMATICREPLIESSTATUS
+
+	ars := models.NewAutomaticRepliesSetting()
+	ars.SetStatus(&status)
+
+	settings := models.NewMailboxSettings()
+	settings.SetAutomaticRepliesSetting(ars)
+	settings.SetTimeZone(ptr.To("UTC"))
+
+	msg := &mockMailboxSettings{
+		settings: settings,
+		rules: []models.MessageRuleable{
+			inboxRule("r1", "Forward invoices", ""),
+			inboxRule("r2", "Archive", "archive-id"),
+		},
+		categories: []models.OutlookCategoryable{
+			outlookCategory("c1", "Red category", models.PRESET0_CATEGORYCOLOR),
+		},
+	}
+
+	table := []struct {
+		name        string
+		scope       func(*selectors.ExchangeBackup) []selectors.ExchangeScope
+		expectItems map[string][]string
+	}{
+		{
+			name: "all settings",
+			scope: func(sel *selectors.ExchangeBackup) []selectors.ExchangeScope {
+				return sel.MailboxSettings(selectors.Any(), selectors.Any())
+			},
+			expectItems: map[string][]string{
+				selectors.MailboxSettingsRulesFolder: {"r1", "r2"},
+				selectors.MailboxSettingsConfigFolder: {
+					automaticRepliesSetting,
+					timeZoneSetting,
+					categoriesSetting,
+				},
+			},
+		},
+		{
+			name: "only rules",
+			scope: func(sel *selectors.ExchangeBackup) []selectors.ExchangeScope {
+				return sel.InboxRules(selectors.Any())
+			},
+			expectItems: map[string][]string{
+				selectors.MailboxSettingsRulesFolder: {"r1", "r2"},
+			},
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			sel := selectors.NewExchangeBackup([]string{"user_id"})
+			scope := test.scope(sel)[0]
+
+			bpc := inject.BackupProducerConfig{
+				Options:           control.DefaultOptions(),
+				ProtectedResource: inMock.NewProvider("user_id", "user_name"),
+			}
+
+			colls, err := CreateMailboxSettingsCollections(
+				ctx,
+				bpc,
+				msg,
+				"tenant",
+				scope,
+				func(*support.ControllerOperationStatus) {},
+				count.New(),
+				fault.New(true))
+			require.NoError(t, err, clues.ToCore(err))
+			require.Len(t, colls, len(test.expectItems))
+
+			for _, coll := range colls {
+				folder := coll.FullPath().Folder(false)
+
+				assert.Equal(t, path.MailboxSettingsCategory, coll.FullPath().Category())
+				assert.Equal(t, data.NewState, coll.State())
+				assert.True(t, coll.DoNotMergeItems(), "do not merge items")
+
+				ids := []string{}
+
+				for item := range coll.Items(ctx, fault.New(true)) {
+					ids = append(ids, item.ID())
+
+					info, err := item.(data.ItemInfo).Info()
+					require.NoError(t, err, clues.ToCore(err))
+					assert.Equal(t, details.ExchangeMailboxSetting, info.Exchange.ItemType)
+					assert.NotEmpty(t, info.Exchange.SettingHash)
+				}
+
+				assert.Equal(t, test.expectItems[folder], ids, folder)
+			}
+		})
+	}
+}
+
+func (suite *MailboxSettingsUnitSuite) TestRestoreMailboxSettings_inboxRules() {
+	table := []struct {
+		name          string
+		policy        control.CollisionPolicy
+		expectPosted  []string
+		expectDeleted []string
+		expectCount   map[count.Key]int64
+	}{
+		{
+			name:         "skip",
+			policy:       control.Skip,
+			expectPosted: []string{"New rule"},
+			expectCount: map[count.Key]int64{
+				count.CollisionSkip:  1,
+				count.NewItemCreated: 1,
+			},
+		},
+		{
+			name:         "copy",
+			policy:       control.Copy,
+			expectPosted: []string{"Forward invoices", "New rule"},
+			expectCount: map[count.Key]int64{
+				count.NewItemCreated: 2,
+			},
+		},
+		{
+			name:          "replace",
+			policy:        control.Replace,
+			expectPosted:  []string{"Forward invoices", "New rule"},
+			expectDeleted: []string{"existing-id"},
+			expectCount: map[count.Key]int64{
+				count.CollisionReplace: 1,
+				count.NewItemCreated:   1,
+			},
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			p, err := path.Build(
+				"t", "u",
+				path.ExchangeService,
+				path.MailboxSettingsCategory,
+				false,
+				selectors.MailboxSettingsRulesFolder)
+			require.NoError(t, err, clues.ToCore(err))
+
+			var (
+				msr = &mockMailboxSettings{
+					rules:   []models.MessageRuleable{inboxRule("existing-id", "Forward invoices", "")},
+					folders: map[string]struct{}{},
+				}
+				deets = &details.Builder{}
+				ctr   = count.New()
+				items = []data.Item{}
+			)
+
+			for _, rule := range []models.MessageRuleable{
+				inboxRule("r1", "Forward invoices", ""),
+				inboxRule("r2", "New rule", ""),
+			} {
+				bs, err := msr.Serialize(ctx, rule)
+				require.NoError(t, err, clues.ToCore(err))
+
+				items = append(items, &dataMock.Item{
+					ItemID: ptr.Val(rule.GetId()),
+					Reader: io.NopCloser(bytes.NewReader(bs)),
+				})
+			}
+
+			metrics, err := restoreMailboxSettings(
+				ctx,
+				msr,
+				dataMock.Collection{Path: p, ItemData: items},
+				"u",
+				test.policy,
+				deets,
+				fault.New(true),
+				ctr)
+			require.NoError(t, err, clues.ToCore(err))
+
+			assert.Equal(t, 2, metrics.Objects)
+			assert.Equal(t, len(test.expectPosted), metrics.Successes)
+			assert.Len(t, deets.Details().Items(), len(test.expectPosted))
+			assert.Equal(t, test.expectDeleted, msr.deletedRules)
+
+			posted := []string{}
+
+			for _, rule := range msr.postedRules {
+				assert.Nil(t, rule.GetId(), "restored rule id")
+				assert.Nil(t, rule.GetIsReadOnly(), "restored rule read-only state")
+
+				posted = append(posted, ptr.Val(rule.GetDisplayName()))
+			}
+
+			assert.Equal(t, test.expectPosted, posted)
+
+			for k, v := range test.expectCount {
+				assert.Equal(t, v, ctr.Get(k), k)
+			}
+		})
+	}
+}
+
+func (suite *MailboxSettingsUnitSuite) TestDropMissingFolderActions() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	var (
+		msr  = &mockMailboxSettings{folders: map[string]struct{}{"found": {}}}
+		errs = fault.New(true)
+		kept = inboxRule("r1", "kept", "found")
+		drop = inboxRule("r2", "dropped", "missing")
+	)
+
+	drop.GetActions().SetCopyToFolder(ptr.To("found"))
+
+	err := dropMissingFolderActions(ctx, msr, kept, "u", errs)
+	require.NoError(t, err, clues.ToCore(err))
+	assert.Equal(t, "found", ptr.Val(kept.GetActions().GetMoveToFolder()))
+
+	err = dropMissingFolderActions(ctx, msr, drop, "u", errs)
+	require.NoError(t, err, clues.ToCore(err))
+	assert.Nil(t, drop.GetActions().GetMoveToFolder())
+	assert.Equal(t, "found", ptr.Val(drop.GetActions().GetCopyToFolder()))
+
+	alerts := errs.Alerts()
+	require.Len(t, alerts, 1)
+	assert.Equal(t, fault.AlertDroppedRuleAction, alerts[0].Message)
+	assert.Equal(t, "r2", alerts[0].Item.ID)
+}
+
+func (suite *MailboxSettingsUnitSuite) TestRestoreMailboxSettings_settings() {
+	table := []struct {
+		name            string
+		policy          control.CollisionPolicy
+		expectSuccesses int
+		expectPatched   int
+		expectPatchedCs map[string]models.CategoryColor
+	}{
+		{
+			name:            "skip",
+			policy:          control.Skip,
+			expectSuccesses: 1,
+		},
+		{
+			name:            "copy is treated as skip",
+			policy:          control.Copy,
+			expectSuccesses: 1,
+		},
+		{
+			name:            "replace",
+			policy:          control.Replace,
+			expectSuccesses: 2,
+			expectPatched:   1,
+			expectPatchedCs: map[string]models.CategoryColor{
+				"existing-id": models.PRESET3_CATEGORYCOLOR,
+			},
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			p, err := path.Build(
+				"t", "u",
+				path.ExchangeService,
+				path.MailboxSettingsCategory,
+				false,
+				selectors.MailboxSettingsConfigFolder)
+			require.NoError(t, err, clues.ToCore(err))
+
+			msr := &mockMailboxSettings{
+				categories: []models.OutlookCategoryable{
+					outlookCategory("existing-id", "Red category", models.PRESET0_CATEGORYCOLOR),
+				},
+			}
+
+			tz := models.NewMailboxSettings()
+			tz.SetTimeZone(ptr.To("UTC"))
+
+			tzBytes, err := msr.Serialize(ctx, tz)
+			require.NoError(t, err, clues.ToCore(err))
+
+			cats := models.NewOutlookCategoryCollectionResponse()
+			cats.SetValue([]models.OutlookCategoryable{
+				outlookCategory("c1", "Red category", models.PRESET3_CATEGORYCOLOR),
+				outlookCategory("c2", "Blue category", models.PRESET7_CATEGORYCOLOR),
+			})
+
+			catBytes, err := msr.Serialize(ctx, cats)
+			require.NoError(t, err, clues.ToCore(err))
+
+			items := []data.Item{
+				&dataMock.Item{ItemID: timeZoneSetting, Reader: io.NopCloser(bytes.NewReader(tzBytes))},
+				&dataMock.Item{ItemID: categoriesSetting, Reader: io.NopCloser(bytes.NewReader(catBytes))},
+			}
+
+			metrics, err := restoreMailboxSettings(
+				ctx,
+				msr,
+				dataMock.Collection{Path: p, ItemData: items},
+				"u",
+				test.policy,
+				&details.Builder{},
+				fault.New(true),
+				count.New())
+			require.NoError(t, err, clues.ToCore(err))
+
+			assert.Equal(t, 2, metrics.Objects)
+			assert.Equal(t, test.expectSuccesses, metrics.Successes)
+			assert.Len(t, msr.patchedSettings, test.expectPatched)
+			assert.Equal(t, test.expectPatchedCs, msr.patchedColors)
+			// missing categories are always added
+			assert.Equal(t, []string{"Blue category"}, msr.postedCategories)
+		})
+	}
+}
+
+func (suite *MailboxSettingsUnitSuite) TestPlanMailboxSettings() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	p, err := path.Build(
+		"t", "u",
+		path.ExchangeService,
+		path.MailboxSettingsCategory,
+		false,
+		selectors.MailboxSettingsRulesFolder)
+	require.NoError(t, err, clues.ToCore(err))
+
+	msr := &mockMailboxSettings{
+		rules: []models.MessageRuleable{inboxRule("existing-id", "Forward invoices", "")},
+	}
+
+	items := []data.Item{}
+
+	for _, rule := range []models.MessageRuleable{
+		inboxRule("r1", "Forward invoices", ""),
+		inboxRule("r2", "New rule", ""),
+	} {
+		bs, err := msr.Serialize(ctx, rule)
+		require.NoError(t, err, clues.ToCore(err))
+
+		items = append(items, &dataMock.Item{
+			ItemID: ptr.Val(rule.GetId()),
+			Reader: io.NopCloser(bytes.NewReader(bs)),
+		})
+	}
+
+	plan := restoreplan.New()
+
+	err = planMailboxSettings(
+		ctx,
+		msr,
+		dataMock.Collection{Path: p, ItemData: items},
+		"u",
+		control.Replace,
+		plan,
+		fault.New(true))
+	require.NoError(t, err, clues.ToCore(err))
+
+	actions := map[string]restoreplan.Action{}
+
+	for _, item := range plan.Items() {
+		actions[item.Name] = item.Action
+	}
+
+	assert.Equal(
+		t,
+		map[string]restoreplan.Action{
+			"Forward invoices": restoreplan.Replace,
+			"New rule":         restoreplan.Create,
+		},
+		actions)
+	assert.Empty(t, msr.postedRules, "dry runs don't write")
+}
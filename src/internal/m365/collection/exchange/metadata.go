@@ -24,6 +24,7 @@ func ParseMetadataCollections(
 		path.ContactsCategory: {},
 		path.EmailCategory:    {},
 		path.EventsCategory:   {},
+		path.TasksCategory:    {},
 	}
 
 	// found tracks the metadata we've loaded, to make sure we don't
@@ -32,6 +33,7 @@ func ParseMetadataCollections(
 		path.ContactsCategory: {},
 		path.EmailCategory:    {},
 		path.EventsCategory:   {},
+		path.TasksCategory:    {},
 	}
 
 	// errors from metadata items should not stop the backup,
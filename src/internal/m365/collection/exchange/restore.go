@@ -231,6 +231,7 @@ func uploadAttachments(
 	as []models.Attachmentable,
 	resourceID, destinationID, itemID string,
 	errs *fault.Bus,
+	ctr *count.Bus,
 ) error {
 	el := errs.Local()
 
@@ -269,7 +270,9 @@ func uploadAttachments(
 				resourceID,
 				destinationID,
 				itemID,
-				a)
+				a,
+				el,
+				ctr)
 
 			// Sometimes graph returns a 404 when we try to post the attachment.
 			// We're not sure why, but maybe it has to do with attaching many items.
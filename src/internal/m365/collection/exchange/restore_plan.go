@@ -0,0 +1,80 @@
+package exchange
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/alcionai/clues"
+
+	"github.com/alcionai/corso/src/internal/data"
+	"github.com/alcionai/corso/src/internal/diagnostics"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/restoreplan"
+)
+
+// PlanCollection records how each item in the collection would get
+// restored, without writing any data.  An empty destinationID means the
+// destination container does not exist yet, and would be created by the
+// restore.
+func PlanCollection(
+	ctx context.Context,
+	rh restoreHandler,
+	dc data.RestoreCollection,
+	resourceID, destinationID string,
+	destination *path.Builder,
+	collisionPolicy control.CollisionPolicy,
+	plan *restoreplan.Plan,
+	errs *fault.Bus,
+) error {
+	ctx, end := diagnostics.Span(ctx, "m365:exchange:planCollection", diagnostics.Label("path", dc.FullPath()))
+	defer end()
+
+	var (
+		el                   = errs.Local()
+		category             = dc.FullPath().Category()
+		collisionKeyToItemID = map[string]string{}
+		err                  error
+	)
+
+	if len(destinationID) > 0 {
+		collisionKeyToItemID, err = rh.GetItemsInContainerByCollisionKey(ctx, resourceID, destinationID)
+		if err != nil {
+			return clues.Wrap(err, "building item collision cache")
+		}
+	}
+
+	for itemData := range dc.Items(ctx, errs) {
+		if el.Failure() != nil {
+			break
+		}
+
+		ictx := clues.Add(ctx, "item_id", itemData.ID())
+		buf := &bytes.Buffer{}
+
+		if _, err := buf.ReadFrom(itemData.ToReader()); err != nil {
+			el.AddRecoverable(ictx, clues.WrapWC(ictx, err, "reading item bytes"))
+			continue
+		}
+
+		collisionKey, name, err := rh.collisionKeyAndName(buf.Bytes())
+		if err != nil {
+			el.AddRecoverable(ictx, clues.StackWC(ictx, err))
+			continue
+		}
+
+		_, collides := collisionKeyToItemID[collisionKey]
+
+		plan.Add(restoreplan.Item{
+			ItemID:        itemData.ID(),
+			Name:          name,
+			Category:      category.HumanString(),
+			Action:        restoreplan.ActionFor(collides, collisionPolicy),
+			ContainerPath: destination.String(),
+			NewContainer:  len(destinationID) == 0,
+		})
+	}
+
+	return el.Failure()
+}
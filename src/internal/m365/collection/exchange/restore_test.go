@@ -86,7 +86,7 @@ func (suite *RestoreIntgSuite) TestRestoreEvent() {
 
 	var (
 		subject = testdata.DefaultRestoreConfig("event").Location
-		handler = newEventRestoreHandler(suite.m365.AC)
+		handler = newEventRestoreHandler(suite.m365.AC, testdata.DefaultRestoreConfig(""))
 	)
 
 	calendar, err := handler.ac.CreateContainer(ctx, suite.m365.User.ID, "", subject)
@@ -156,7 +156,7 @@ func (suite *RestoreIntgSuite) TestRestoreEvent() {
 // TestRestoreExchangeObject verifies path.Category usage for restored objects
 func (suite *RestoreIntgSuite) TestRestoreExchangeObject() {
 	t := suite.T()
-	handlers := RestoreHandlers(suite.m365.AC)
+	handlers := RestoreHandlers(suite.m365.AC, testdata.DefaultRestoreConfig(""))
 
 	tests := []struct {
 		name        string
@@ -387,7 +387,7 @@ func (suite *RestoreIntgSuite) TestRestoreAndBackupEvent_recurringInstancesWithA
 
 	var (
 		subject = testdata.DefaultRestoreConfig("event").Location
-		handler = newEventRestoreHandler(suite.m365.AC)
+		handler = newEventRestoreHandler(suite.m365.AC, testdata.DefaultRestoreConfig(""))
 	)
 
 	calendar, err := handler.ac.CreateContainer(ctx, suite.m365.User.ID, "", subject)
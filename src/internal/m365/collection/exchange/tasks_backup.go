@@ -0,0 +1,96 @@
+package exchange
+
+import (
+	"context"
+
+	"github.com/microsoft/kiota-abstractions-go/serialization"
+
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
+)
+
+var _ backupHandler = &taskBackupHandler{}
+
+type taskBackupHandler struct {
+	ac api.Tasks
+}
+
+func newTaskBackupHandler(
+	ac api.Client,
+) taskBackupHandler {
+	act := ac.Tasks()
+
+	return taskBackupHandler{
+		ac: act,
+	}
+}
+
+func (h taskBackupHandler) itemEnumerator() addedAndRemovedItemGetter {
+	return h.ac
+}
+
+func (h taskBackupHandler) itemHandler(containerID string) itemGetterSerializer {
+	return taskItemHandler{
+		ac:     h.ac,
+		listID: containerID,
+	}
+}
+
+func (h taskBackupHandler) folderGetter() containerGetter {
+	return h.ac
+}
+
+func (h taskBackupHandler) previewIncludeContainers() []string {
+	return []string{
+		"tasks",
+	}
+}
+
+func (h taskBackupHandler) previewExcludeContainers() []string {
+	return nil
+}
+
+func (h taskBackupHandler) NewContainerCache(
+	userID string,
+) (string, graph.ContainerResolver) {
+	return api.DefaultTaskList, &taskContainerCache{
+		userID: userID,
+		enumer: h.ac,
+	}
+}
+
+func (h taskBackupHandler) CanSkipItemFailure(
+	err error,
+	resourceID string,
+	opts control.Options,
+) (fault.SkipCause, bool) {
+	return "", false
+}
+
+var _ itemGetterSerializer = &taskItemHandler{}
+
+// taskItemHandler binds the task api to a single list, since tasks
+// can't be fetched by their ID alone.
+type taskItemHandler struct {
+	ac     api.Tasks
+	listID string
+}
+
+func (h taskItemHandler) GetItem(
+	ctx context.Context,
+	userID, itemID string,
+	errs *fault.Bus,
+) (serialization.Parsable, *details.ExchangeInfo, error) {
+	return h.ac.GetItem(ctx, userID, h.listID, itemID, errs)
+}
+
+func (h taskItemHandler) Serialize(
+	ctx context.Context,
+	item serialization.Parsable,
+	userID, itemID string,
+) ([]byte, error) {
+	return h.ac.Serialize(ctx, item, userID, itemID)
+}
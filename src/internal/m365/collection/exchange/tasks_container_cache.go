@@ -0,0 +1,118 @@
+package exchange
+
+import (
+	"context"
+	"time"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/logger"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
+)
+
+var _ graph.ContainerResolver = &taskContainerCache{}
+
+// taskContainerCache resolves To Do task lists.  Like calendars, task
+// lists have a flat hierarchy, so each list is its own root.
+type taskContainerCache struct {
+	*containerResolver
+	enumer containersEnumerator[models.TodoTaskListable]
+	userID string
+}
+
+// init ensures that the structure's fields are initialized.
+// Fields Initialized when cache == nil:
+// [tcc.cache]
+func (tcc *taskContainerCache) init() {
+	if tcc.containerResolver == nil {
+		tcc.containerResolver = newContainerResolver(nil)
+	}
+}
+
+// Populate utility function for populating the taskContainerCache.
+// Executes 1 additional Graph Query
+// @param baseID: ignored. Present to conform to interface
+func (tcc *taskContainerCache) Populate(
+	ctx context.Context,
+	errs *fault.Bus,
+	baseID string,
+	baseContainerPath ...string,
+) error {
+	start := time.Now()
+
+	logger.Ctx(ctx).Info("populating container cache")
+
+	tcc.init()
+
+	el := errs.Local()
+
+	containers, err := tcc.enumer.EnumerateContainers(
+		ctx,
+		tcc.userID,
+		"")
+	ctx = clues.Add(ctx, "num_enumerated_containers", len(containers))
+
+	if err != nil {
+		return clues.WrapWC(ctx, err, "enumerating containers")
+	}
+
+	for _, c := range containers {
+		if el.Failure() != nil {
+			return el.Failure()
+		}
+
+		cacheFolder := graph.NewCacheFolder(
+			api.TaskListDisplayable{TodoTaskListable: c},
+			path.Builder{}.Append(ptr.Val(c.GetId())),
+			path.Builder{}.Append(ptr.Val(c.GetDisplayName())))
+
+		err := tcc.addFolder(&cacheFolder)
+		if err != nil {
+			err := clues.StackWC(ctx, err).Label(fault.LabelForceNoBackupCreation)
+			errs.AddRecoverable(ctx, err)
+		}
+	}
+
+	if err := tcc.populatePaths(ctx, errs); err != nil {
+		return clues.Wrap(err, "populating paths")
+	}
+
+	logger.Ctx(ctx).Infow(
+		"done populating container cache",
+		"duration", time.Since(start))
+
+	return el.Failure()
+}
+
+// AddToCache adds container to map in field 'cache'
+// @returns error iff the required values are not accessible.
+func (tcc *taskContainerCache) AddToCache(ctx context.Context, f graph.Container) error {
+	tcc.init()
+
+	if err := checkIDAndName(f); err != nil {
+		return clues.WrapWC(ctx, err, "validating container")
+	}
+
+	temp := graph.NewCacheFolder(
+		f,
+		path.Builder{}.Append(ptr.Val(f.GetId())),          // storage path
+		path.Builder{}.Append(ptr.Val(f.GetDisplayName()))) // display location
+
+	if err := tcc.addFolder(&temp); err != nil {
+		return clues.WrapWC(ctx, err, "adding container")
+	}
+
+	// Populate the path for this entry so calls to PathInCache succeed no matter
+	// when they're made.
+	_, _, err := tcc.IDToPath(ctx, ptr.Val(f.GetId()))
+	if err != nil {
+		return clues.Wrap(err, "setting path to container id")
+	}
+
+	return nil
+}
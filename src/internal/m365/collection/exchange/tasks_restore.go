@@ -0,0 +1,240 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/count"
+	"github.com/alcionai/corso/src/pkg/errs/core"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/logger"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
+)
+
+var (
+	_ itemRestorer   = &taskRestoreHandler{}
+	_ restoreHandler = &taskRestoreHandler{}
+)
+
+type taskRestoreHandler struct {
+	ac api.Tasks
+}
+
+func newTaskRestoreHandler(
+	ac api.Client,
+) taskRestoreHandler {
+	return taskRestoreHandler{
+		ac: ac.Tasks(),
+	}
+}
+
+func (h taskRestoreHandler) NewContainerCache(userID string) graph.ContainerResolver {
+	return &taskContainerCache{
+		userID: userID,
+		enumer: h.ac,
+	}
+}
+
+func (h taskRestoreHandler) ShouldSetContainerToDefaultRoot(
+	restoreFolderPath string,
+	collectionPath path.Path,
+) bool {
+	return false
+}
+
+func (h taskRestoreHandler) FormatRestoreDestination(
+	destinationContainerName string,
+	_ path.Path, // ignored because task lists cannot be nested
+) *path.Builder {
+	if len(destinationContainerName) == 0 {
+		destinationContainerName = api.DefaultTaskList
+	}
+
+	return path.Builder{}.Append(destinationContainerName)
+}
+
+func (h taskRestoreHandler) CreateContainer(
+	ctx context.Context,
+	userID, _, containerName string, // parent container not used
+) (graph.Container, error) {
+	return h.ac.CreateContainer(ctx, userID, "", containerName)
+}
+
+func (h taskRestoreHandler) GetContainerByName(
+	ctx context.Context,
+	userID, _, containerName string, // parent container not used
+) (graph.Container, error) {
+	return h.ac.GetContainerByName(ctx, userID, "", containerName)
+}
+
+// always returns the provided value
+func (h taskRestoreHandler) DefaultRootContainer() string {
+	return api.DefaultTaskList
+}
+
+func (h taskRestoreHandler) collisionKeyAndName(body []byte) (string, string, error) {
+	item, err := api.BytesToTodoTaskable(body)
+	if err != nil {
+		return "", "", clues.Wrap(err, "creating task from bytes")
+	}
+
+	return api.TaskCollisionKey(item), ptr.Val(item.GetTitle()), nil
+}
+
+func (h taskRestoreHandler) restore(
+	ctx context.Context,
+	body []byte,
+	userID, destinationID string,
+	collisionKeyToItemID map[string]string,
+	collisionPolicy control.CollisionPolicy,
+	errs *fault.Bus,
+	ctr *count.Bus,
+) (*details.ExchangeInfo, error) {
+	return restoreTask(
+		ctx,
+		h.ac,
+		body,
+		userID, destinationID,
+		collisionKeyToItemID,
+		collisionPolicy,
+		errs,
+		ctr)
+}
+
+type taskRestorer interface {
+	postItemer[models.TodoTaskable]
+	PostChecklistItem(
+		ctx context.Context,
+		userID, containerID, itemID string,
+		body models.ChecklistItemable,
+	) (models.ChecklistItemable, error)
+	DeleteItem(
+		ctx context.Context,
+		userID, containerID, itemID string,
+	) error
+}
+
+func restoreTask(
+	ctx context.Context,
+	tr taskRestorer,
+	body []byte,
+	userID, destinationID string,
+	collisionKeyToItemID map[string]string,
+	collisionPolicy control.CollisionPolicy,
+	errs *fault.Bus,
+	ctr *count.Bus,
+) (*details.ExchangeInfo, error) {
+	task, err := api.BytesToTodoTaskable(body)
+	if err != nil {
+		return nil, clues.WrapWC(ctx, err, "creating task from bytes")
+	}
+
+	ctx = clues.Add(ctx, "item_id", ptr.Val(task.GetId()))
+
+	var (
+		collisionKey         = api.TaskCollisionKey(task)
+		collisionID          string
+		shouldDeleteOriginal bool
+	)
+
+	if id, ok := collisionKeyToItemID[collisionKey]; ok {
+		log := logger.Ctx(ctx).With("collision_key", clues.Hide(collisionKey))
+		log.Debug("item collision")
+
+		if collisionPolicy == control.Skip {
+			ctr.Inc(count.CollisionSkip)
+			log.Debug("skipping item with collision")
+
+			return nil, core.ErrAlreadyExists
+		}
+
+		collisionID = id
+		shouldDeleteOriginal = collisionPolicy == control.Replace
+	}
+
+	// checklist items can't be posted along with the task, so they
+	// get added one at a time once the task exists.
+	checklist := task.GetChecklistItems()
+
+	task = toTaskCreatable(task)
+
+	item, err := tr.PostItem(ctx, userID, destinationID, task)
+	if err != nil {
+		return nil, clues.Wrap(err, "restoring task")
+	}
+
+	for _, ci := range checklist {
+		ci.SetId(nil)
+		ci.SetCreatedDateTime(nil)
+
+		_, err := tr.PostChecklistItem(ctx, userID, destinationID, ptr.Val(item.GetId()), ci)
+		if err != nil {
+			return nil, clues.Wrap(err, "restoring task checklist item")
+		}
+	}
+
+	// same as contacts: post first, then delete.  In case of failure
+	// between the two calls, at least we'll have accidentally over-produced
+	// data instead of deleting the user's data.
+	if shouldDeleteOriginal {
+		err := tr.DeleteItem(ctx, userID, destinationID, collisionID)
+		if err != nil && !errors.Is(err, core.ErrNotFound) {
+			return nil, clues.Wrap(err, "deleting colliding task")
+		}
+	}
+
+	info := api.TaskInfo(item)
+	info.Size = int64(len(body))
+
+	if shouldDeleteOriginal {
+		ctr.Inc(count.CollisionReplace)
+	} else {
+		ctr.Inc(count.NewItemCreated)
+	}
+
+	return info, nil
+}
+
+// toTaskCreatable strips the read-only and navigation properties
+// that the tasks POST won't accept.
+func toTaskCreatable(task models.TodoTaskable) models.TodoTaskable {
+	task.SetId(nil)
+	task.SetCreatedDateTime(nil)
+	task.SetLastModifiedDateTime(nil)
+	task.SetBodyLastModifiedDateTime(nil)
+	task.SetChecklistItems(nil)
+	task.SetAttachments(nil)
+	task.SetAttachmentSessions(nil)
+	task.SetExtensions(nil)
+	task.SetHasAttachments(nil)
+
+	// linked resources can be created along with the task.
+	for _, lr := range task.GetLinkedResources() {
+		lr.SetId(nil)
+	}
+
+	delete(task.GetAdditionalData(), "@odata.etag")
+	delete(task.GetAdditionalData(), "@odata.context")
+
+	return task
+}
+
+func (h taskRestoreHandler) GetItemsInContainerByCollisionKey(
+	ctx context.Context,
+	userID, containerID string,
+) (map[string]string, error) {
+	m, err := h.ac.GetItemsInContainerByCollisionKey(ctx, userID, containerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
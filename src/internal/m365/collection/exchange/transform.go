@@ -1,6 +1,7 @@
 package exchange
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -8,6 +9,8 @@ import (
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 
 	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/logger"
 )
 
 //==========================================================
@@ -84,10 +87,13 @@ var eventUnsupportedAdditionalData = []string{
 // To overcome some of the MS Graph API challenges, the event object is modified in the following ways:
 //   - Instead of adding attendees and generating spurious notifications,
 //     add a summary of attendees at the beginning to the event before the original body content
-//   - event.attendees is set to an empty list
-func toEventSimplified(orig models.Eventable) models.Eventable {
-	attendees := FormatAttendees(orig, ptr.Val(orig.GetBody().GetContentType()) == models.HTML_BODYTYPE)
-	orig.SetAttendees([]models.Attendeeable{})
+//   - event.attendees only holds the attendees which the rebinder invites,
+//     which is none unless they get remapped to new addresses
+func toEventSimplified(orig models.Eventable, ar attendeeRebinder) models.Eventable {
+	invited, listed := ar.rebind(orig.GetAttendees())
+	attendees := formatAttendees(listed, ptr.Val(orig.GetBody().GetContentType()) == models.HTML_BODYTYPE)
+	orig.SetAttendees(invited)
+	ar.rebindLocations(orig)
 	origBody := orig.GetBody()
 	newContent := insertStringToBody(origBody, attendees)
 	newBody := models.NewItemBody()
@@ -190,8 +196,14 @@ func insertStringToBody(body getContenter, newContent string) string {
 // support ODataType values
 
 // toItemAttachment transforms internal item, OutlookItemables, into
-// objects that are able to be uploaded into M365.
-func toItemAttachment(orig models.Attachmentable) (models.Attachmentable, error) {
+// objects that are able to be uploaded into M365.  Nested attachments
+// which can't be uploaded are dropped, and reported as alerts.
+func toItemAttachment(
+	ctx context.Context,
+	orig models.Attachmentable,
+	parentItemID string,
+	errs *fault.Bus,
+) (models.Attachmentable, error) {
 	transform, ok := orig.(models.ItemAttachmentable)
 	if !ok { // Shouldn't ever happen
 		return nil, clues.New("transforming attachment to item attachment")
@@ -207,7 +219,7 @@ func toItemAttachment(orig models.Attachmentable) (models.Attachmentable, error)
 		return transform, nil
 
 	case models.Eventable:
-		newEvent, err := sanitizeEvent(val)
+		newEvent, err := sanitizeEvent(ctx, val, orig, parentItemID, errs)
 		if err != nil {
 			return nil, err
 		}
@@ -217,7 +229,7 @@ func toItemAttachment(orig models.Attachmentable) (models.Attachmentable, error)
 		return transform, nil
 
 	case models.Messageable:
-		newMessage, err := sanitizeMessage(val)
+		newMessage, err := sanitizeMessage(ctx, val, orig, parentItemID, errs)
 		if err != nil {
 			return nil, err
 		}
@@ -234,27 +246,49 @@ func toItemAttachment(orig models.Attachmentable) (models.Attachmentable, error)
 	}
 }
 
-// TODO #2428 (dadam39): re-apply nested attachments for itemAttachments
-// func sanitizeAttachments(attached []models.Attachmentable) ([]models.Attachmentable, error) {
-// 	attachments := make([]models.Attachmentable, len(attached))
-
-// 	for _, ax := range attached {
-// 		if ptr.Val(ax.GetOdataType()) == itemAttachment {
-// 			newAttachment, err := ToItemAttachment(ax)
-// 			if err != nil {
-// 				return nil, err
-// 			}
-
-// 			attachments = append(attachments, newAttachment)
+// sanitizeAttachments prepares the attachments of the item attached by
+// attachment for upload.  Item attachments are sanitized recursively, so
+// that attached items keep their own attachments.
+func sanitizeAttachments(
+	ctx context.Context,
+	attachment models.Attachmentable,
+	attached []models.Attachmentable,
+	parentItemID string,
+	errs *fault.Bus,
+) []models.Attachmentable {
+	attachments := make([]models.Attachmentable, 0, len(attached))
+
+	for _, ax := range attached {
+		switch ptr.Val(ax.GetOdataType()) {
+		case itemAttachmentOdataValue:
+			newAttachment, err := toItemAttachment(ctx, ax, parentItemID, errs)
+			if err != nil {
+				// unsupported items get dropped, same as at the top level,
+				// instead of failing the item which holds them.
+				logger.CtxErr(ctx, err).Info("dropping unsupported nested item attachment")
+				alertDroppedNestedAttachment(ctx, attachment, ax, parentItemID, errs)
+
+				continue
+			}
+
+			ax = newAttachment
+
+		case referenceAttachmentOdataValue:
+			// the contents of inline reference attachments are part of the body.
+			if ptr.Val(ax.GetIsInline()) {
+				continue
+			}
+		}
 
-// 			continue
-// 		}
+		// IDs from the backup can't be reused when the attachment is
+		// created as part of its parent.
+		ax.SetId(nil)
 
-// 		attachments = append(attachments, ax)
-// 	}
+		attachments = append(attachments, ax)
+	}
 
-// 	return attachments, nil
-// }
+	return attachments
+}
 
 // sanitizeContact removes fields which prevent a Contact from
 // being uploaded as an attachment.
@@ -267,7 +301,13 @@ func sanitizeContact(orig models.Contactable) models.Contactable {
 
 // sanitizeEvent transfers data into event object and
 // removes unique IDs from the M365 object
-func sanitizeEvent(orig models.Eventable) (models.Eventable, error) {
+func sanitizeEvent(
+	ctx context.Context,
+	orig models.Eventable,
+	attachment models.Attachmentable,
+	parentItemID string,
+	errs *fault.Bus,
+) (models.Eventable, error) {
 	newEvent := models.NewEvent()
 	newEvent.SetAttendees(orig.GetAttendees())
 	newEvent.SetBody(orig.GetBody())
@@ -275,8 +315,6 @@ func sanitizeEvent(orig models.Eventable) (models.Eventable, error) {
 	newEvent.SetCalendar(orig.GetCalendar())
 	newEvent.SetCreatedDateTime(orig.GetCreatedDateTime())
 	newEvent.SetEnd(orig.GetEnd())
-	// TODO: dadams39 Nested attachments not supported
-	// Upstream: https://github.com/microsoft/kiota-serialization-json-go/issues/61
 	newEvent.SetHasAttachments(nil)
 	newEvent.SetHideAttendees(orig.GetHideAttendees())
 	newEvent.SetImportance(orig.GetImportance())
@@ -299,27 +337,23 @@ func sanitizeEvent(orig models.Eventable) (models.Eventable, error) {
 	newEvent.SetIsDraft(nil)
 	newEvent.SetAdditionalData(orig.GetAdditionalData())
 
-	// TODO #2428 (dadam39): re-apply nested attachments for itemAttachments
-	// Upstream: https://github.com/microsoft/kiota-serialization-json-go/issues/61
-	// attachments, err := sanitizeAttachments(message.GetAttachments())
-	// if err != nil {
-	// 	return nil, err
-	// }
-	newEvent.SetAttachments(nil)
+	attachments := sanitizeAttachments(ctx, attachment, orig.GetAttachments(), parentItemID, errs)
+	newEvent.SetAttachments(attachments)
 
 	return newEvent, nil
 }
 
-func sanitizeMessage(orig models.Messageable) (models.Messageable, error) {
+func sanitizeMessage(
+	ctx context.Context,
+	orig models.Messageable,
+	attachment models.Attachmentable,
+	parentItemID string,
+	errs *fault.Bus,
+) (models.Messageable, error) {
 	message := toMessage(orig)
 
-	// TODO #2428 (dadam39): re-apply nested attachments for itemAttachments
-	// Upstream: https://github.com/microsoft/kiota-serialization-json-go/issues/61
-	// attachments, err := sanitizeAttachments(message.GetAttachments())
-	// if err != nil {
-	// 	return nil, err
-	// }
-	message.SetAttachments(nil)
+	attachments := sanitizeAttachments(ctx, attachment, message.GetAttachments(), parentItemID, errs)
+	message.SetAttachments(attachments)
 
 	// The following fields are set to nil to
 	// not interfere with M365 guard checks.
@@ -4,14 +4,19 @@ import (
 	"testing"
 
 	"github.com/alcionai/clues"
+	kjson "github.com/microsoft/kiota-serialization-json-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/converters/eml"
+	"github.com/alcionai/corso/src/internal/converters/eml/testdata"
 	exchMock "github.com/alcionai/corso/src/internal/m365/service/exchange/mock"
 	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/fault"
 	"github.com/alcionai/corso/src/pkg/services/m365/api"
 )
 
@@ -38,6 +43,101 @@ func (suite *TransformUnitTest) TestToMessage() {
 	assert.NotEqual(t, message.GetId(), clone.GetId())
 }
 
+func (suite *TransformUnitTest) TestToItemAttachment_nested() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	message, err := api.BytesToMessageable([]byte(testdata.EmailWithinEmail))
+	require.NoError(t, err, clues.ToCore(err))
+
+	attachments := message.GetAttachments()
+	require.NotEmpty(t, attachments)
+
+	for _, attachment := range attachments {
+		result, err := toItemAttachment(ctx, attachment, "parent", fault.New(true))
+		require.NoError(t, err, clues.ToCore(err))
+
+		// the attachment must survive the trip through serialization
+		// to be posted, along with everything nested inside it.
+		sw := kjson.NewJsonSerializationWriter()
+		require.NoError(t, sw.WriteObjectValue("", result))
+
+		bs, err := sw.GetSerializedContent()
+		require.NoError(t, err, clues.ToCore(err))
+
+		parsed, err := api.CreateFromBytes(bs, models.CreateAttachmentFromDiscriminatorValue)
+		require.NoError(t, err, clues.ToCore(err))
+
+		ia, ok := parsed.(models.ItemAttachmentable)
+		require.True(t, ok, "item attachment")
+
+		nestedMsg, ok := ia.GetItem().(models.Messageable)
+		require.True(t, ok, "attached message")
+		assert.Empty(t, nestedMsg.GetId())
+
+		nested := nestedMsg.GetAttachments()
+		require.Len(t, nested, 1)
+
+		fa, ok := nested[0].(models.FileAttachmentable)
+		require.True(t, ok, "nested file attachment")
+		assert.Equal(t, "Abidjan.ics", ptr.Val(fa.GetName()))
+		assert.NotEmpty(t, fa.GetContentBytes())
+		assert.Empty(t, fa.GetId(), "nested attachment IDs are dropped")
+
+		// restored messages are exported the same as backed up messages.
+		out, err := eml.FromMessageable(ctx, nestedMsg)
+		require.NoError(t, err, clues.ToCore(err))
+		assert.Contains(t, out, "Abidjan.ics")
+	}
+}
+
+func (suite *TransformUnitTest) TestToItemAttachment_unsupportedNested() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	// an attached message holding an attached item of an unsupported type.
+	unsupported := models.NewItemAttachment()
+	unsupported.SetOdataType(ptr.To(itemAttachmentOdataValue))
+	unsupported.SetId(ptr.To("nested-id"))
+	unsupported.SetName(ptr.To("nested"))
+	unsupported.SetItem(models.NewOutlookItem())
+
+	file := models.NewFileAttachment()
+	file.SetOdataType(ptr.To("#microsoft.graph.fileAttachment"))
+	file.SetName(ptr.To("file.txt"))
+
+	msg := models.NewMessage()
+	msg.SetAttachments([]models.Attachmentable{unsupported, file})
+
+	attachment := models.NewItemAttachment()
+	attachment.SetOdataType(ptr.To(itemAttachmentOdataValue))
+	attachment.SetId(ptr.To("attachment-id"))
+	attachment.SetItem(msg)
+
+	errs := fault.New(true)
+
+	result, err := toItemAttachment(ctx, attachment, "parent", errs)
+	require.NoError(t, err, clues.ToCore(err))
+	require.NoError(t, errs.Failure(), clues.ToCore(errs.Failure()))
+
+	ia, ok := result.(models.ItemAttachmentable)
+	require.True(t, ok, "item attachment")
+
+	nested := ia.GetItem().(models.Messageable).GetAttachments()
+	require.Len(t, nested, 1, "unsupported item is dropped")
+	assert.Equal(t, "file.txt", ptr.Val(nested[0].GetName()))
+
+	alerts := errs.Alerts()
+	require.Len(t, alerts, 1)
+	assert.Equal(t, fault.AlertDroppedNestedAttachment, alerts[0].Message)
+	assert.Equal(t, "nested-id", alerts[0].Item.ID)
+	assert.Equal(t, "parent", alerts[0].Item.Additional["parent_item_id"])
+}
+
 func (suite *TransformUnitTest) TestToEventSimplified_attendees() {
 	t := suite.T()
 	bytes := exchMock.EventWithAttendeesBytes("M365 Event Support Test")
@@ -45,7 +145,7 @@ func (suite *TransformUnitTest) TestToEventSimplified_attendees() {
 	require.NoError(t, err, clues.ToCore(err))
 
 	attendees := event.GetAttendees()
-	newEvent := toEventSimplified(event)
+	newEvent := toEventSimplified(event, attendeeRebinder{})
 
 	assert.Empty(t, newEvent.GetHideAttendees())
 	assert.Equal(t, ptr.Val(event.GetBody().GetContentType()), ptr.Val(newEvent.GetBody().GetContentType()))
@@ -58,6 +158,104 @@ func (suite *TransformUnitTest) TestToEventSimplified_attendees() {
 	}
 }
 
+func (suite *TransformUnitTest) TestToEventSimplified_attendeePolicies() {
+	const (
+		george = "george.martinez@8qzvrj.onmicrosoft.com"
+		lee    = "LeeG@8qzvrj.onmicrosoft.com"
+		room   = "room@8qzvrj.onmicrosoft.com"
+	)
+
+	attendeeMap := map[string]string{
+		"George.Martinez@8qzvrj.onmicrosoft.com": "george@sandbox.com",
+		room:                                     "room@sandbox.com",
+	}
+
+	table := []struct {
+		name            string
+		rc              control.RestoreConfig
+		expectInvited   []string
+		expectInBody    []string
+		expectNotInBody []string
+		expectRoom      string
+	}{
+		{
+			name:         "default",
+			rc:           control.RestoreConfig{},
+			expectInBody: []string{george, lee},
+			expectRoom:   room,
+		},
+		{
+			name:            "strip",
+			rc:              control.RestoreConfig{Attendees: control.AttendeesStrip},
+			expectNotInBody: []string{george, lee},
+		},
+		{
+			name: "remap",
+			rc: control.RestoreConfig{
+				Attendees:   control.AttendeesRemap,
+				AttendeeMap: attendeeMap,
+			},
+			expectInvited:   []string{"george@sandbox.com"},
+			expectInBody:    []string{lee},
+			expectNotInBody: []string{george},
+			expectRoom:      "room@sandbox.com",
+		},
+		{
+			name: "remap, suppress invites",
+			rc: control.RestoreConfig{
+				Attendees:       control.AttendeesRemap,
+				AttendeeMap:     attendeeMap,
+				SuppressInvites: true,
+			},
+			expectInBody:    []string{"george@sandbox.com", lee},
+			expectNotInBody: []string{george},
+			expectRoom:      "room@sandbox.com",
+		},
+		{
+			name: "remap, unmapped room",
+			rc: control.RestoreConfig{
+				Attendees:   control.AttendeesRemap,
+				AttendeeMap: map[string]string{lee: "lee@sandbox.com"},
+			},
+			expectInvited: []string{"lee@sandbox.com"},
+			expectInBody:  []string{george},
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			event, err := api.BytesToEventable(exchMock.EventWithAttendeesBytes("M365 Event Support Test"))
+			require.NoError(t, err, clues.ToCore(err))
+
+			event.GetLocation().SetLocationEmailAddress(ptr.To(room))
+
+			newEvent := toEventSimplified(event, newAttendeeRebinder(test.rc))
+
+			invited := []string{}
+
+			for _, a := range newEvent.GetAttendees() {
+				invited = append(invited, ptr.Val(a.GetEmailAddress().GetAddress()))
+				assert.Nil(t, a.GetStatus(), "invited attendees have no response")
+			}
+
+			assert.ElementsMatch(t, test.expectInvited, invited, "invited attendees")
+
+			content := ptr.Val(newEvent.GetBody().GetContent())
+
+			for _, addr := range test.expectInBody {
+				assert.Contains(t, content, addr)
+			}
+
+			for _, addr := range test.expectNotInBody {
+				assert.NotContains(t, content, addr)
+			}
+
+			assert.Equal(t, test.expectRoom, ptr.Val(newEvent.GetLocation().GetLocationEmailAddress()))
+		})
+	}
+}
+
 func (suite *TransformUnitTest) TestToEventSimplified_noAdditionalRemovedFields() {
 	t := suite.T()
 
@@ -68,7 +266,7 @@ func (suite *TransformUnitTest) TestToEventSimplified_noAdditionalRemovedFields(
 	event, err := api.BytesToEventable(bytes)
 	require.NoError(t, err, clues.ToCore(err))
 
-	newEvent := toEventSimplified(event)
+	newEvent := toEventSimplified(event, attendeeRebinder{})
 
 	serializedBytes, err := api.Client{}.Events().Serialize(
 		ctx,
@@ -178,7 +376,7 @@ func (suite *TransformUnitTest) TestToEventSimplified_recurrence() {
 	for _, test := range tests {
 		suite.Run(test.name, func() {
 			event := test.event()
-			newEvent := toEventSimplified(event)
+			newEvent := toEventSimplified(event, attendeeRebinder{})
 			assert.True(t, test.validateOutput(newEvent), test.name)
 		})
 	}
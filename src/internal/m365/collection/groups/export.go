@@ -38,8 +38,14 @@ func NewExportCollection(
 	switch cat {
 	case path.ChannelMessagesCategory:
 		streamItems = streamChannelMessages
+		if cec.Format == control.HTMLFormat {
+			streamItems = streamChannelMessagesHTML
+		}
 	case path.ConversationPostsCategory:
 		streamItems = streamConversationPosts
+		if cec.Format == control.HTMLFormat {
+			streamItems = streamConversationPostsHTML
+		}
 	default:
 		return nil
 	}
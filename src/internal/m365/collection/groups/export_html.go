@@ -0,0 +1,591 @@
+package groups
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"html/template"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/common/sanitize"
+	"github.com/alcionai/corso/src/internal/data"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/dttm"
+	"github.com/alcionai/corso/src/pkg/export"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/logger"
+	"github.com/alcionai/corso/src/pkg/metrics"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph/metadata"
+)
+
+// The html export produces a self-contained, human readable transcript
+// for each channel thread and conversation thread.  Any content that
+// was backed up along with the message (hosted contents, attachment
+// bytes) is embedded as a data uri so that the file can be viewed
+// without access to the tenant.
+
+const indexFileName = "index.html"
+
+const transcriptTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{ .Title }}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+.message { border-left: 3px solid #6264a7; margin: 1em 0; padding: 0.5em 1em; }
+.reply { margin-left: 2em; border-left-color: #c8c6c4; }
+.header { color: #605e5c; font-size: 0.9em; }
+.author { font-weight: bold; color: #252423; }
+.subject { font-weight: bold; margin-top: 0.5em; }
+.content { margin-top: 0.5em; }
+.content img { max-width: 100%; }
+.attachments { font-size: 0.9em; }
+</style>
+</head>
+<body>
+<h1>{{ .Title }}</h1>
+{{- range .Messages }}
+<div class="message{{ if .IsReply }} reply{{ end }}">
+<div class="header"><span class="author">{{ .From }}</span> <span class="time">{{ .Created }}</span>
+{{- if .Edited }} <span class="edited">(edited {{ .Edited }})</span>{{ end }}</div>
+{{- if .Subject }}
+<div class="subject">{{ .Subject }}</div>
+{{- end }}
+<div class="content">{{ .Content }}</div>
+{{- if .Attachments }}
+<ul class="attachments">
+{{- range .Attachments }}
+<li>{{ if .URL }}<a href="{{ .URL }}"{{ if .Download }} download="{{ .Name }}"{{ end }}>{{ .Name }}</a>{{ else }}{{ .Name }}{{ end }}</li>
+{{- end }}
+</ul>
+{{- end }}
+</div>
+{{- end }}
+</body>
+</html>
+`
+
+const indexTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{ .Title }}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+th, td { text-align: left; padding: 0.25em 1em; border-bottom: 1px solid #e1dfdd; }
+</style>
+</head>
+<body>
+<h1>{{ .Title }}</h1>
+<table>
+<tr><th>Thread</th><th>Started by</th><th>Created</th><th>Replies</th></tr>
+{{- range .Entries }}
+<tr><td><a href="{{ .Link }}">{{ .Title }}</a></td><td>{{ .From }}</td><td>{{ .Created }}</td><td>{{ .Replies }}</td></tr>
+{{- end }}
+</table>
+</body>
+</html>
+`
+
+var (
+	transcriptTmpl = template.Must(template.New("transcript").Parse(transcriptTemplate))
+	indexTmpl      = template.Must(template.New("index").Parse(indexTemplate))
+)
+
+type (
+	htmlAttachment struct {
+		Name     string
+		URL      template.URL
+		Download bool
+	}
+
+	htmlMessage struct {
+		Attachments []htmlAttachment
+		Content     template.HTML
+		Created     string
+		Edited      string
+		From        string
+		IsReply     bool
+		Subject     string
+
+		createdAt time.Time
+	}
+
+	htmlTranscript struct {
+		Title    string
+		Messages []htmlMessage
+	}
+
+	htmlIndexEntry struct {
+		Created string
+		From    string
+		Link    string
+		Replies int
+		Title   string
+
+		createdAt time.Time
+	}
+
+	htmlIndex struct {
+		Title   string
+		Entries []htmlIndexEntry
+	}
+)
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return dttm.FormatToTabularDisplay(t)
+}
+
+func dataURI(contentType string, content []byte) template.URL {
+	if len(contentType) == 0 {
+		contentType = "application/octet-stream"
+	}
+
+	//nolint:gosec // the content is the user's own backed up data
+	return template.URL("data:" + contentType + ";base64," + base64.StdEncoding.EncodeToString(content))
+}
+
+// bodyToHTML returns the body as html, escaping it first if the body
+// was plain text, and sanitizing it otherwise.
+func bodyToHTML(body models.ItemBodyable) string {
+	if body == nil {
+		return ""
+	}
+
+	content := ptr.Val(body.GetContent())
+
+	if ptr.Val(body.GetContentType()) == models.TEXT_BODYTYPE {
+		return strings.ReplaceAll(template.HTMLEscapeString(content), "\n", "<br>\n")
+	}
+
+	return sanitize.HTML(content)
+}
+
+func renderTemplate(tmpl *template.Template, v any) (io.ReadCloser, error) {
+	buf := &bytes.Buffer{}
+
+	err := tmpl.Execute(buf, v)
+	if err != nil {
+		return nil, clues.Wrap(err, "rendering html")
+	}
+
+	return io.NopCloser(buf), nil
+}
+
+//-------------------------------------------------------------
+// Channel Messages
+//-------------------------------------------------------------
+
+// streamChannelMessagesHTML streams each thread in the backingCollection
+// as an html transcript, followed by an index of all the threads.
+func streamChannelMessagesHTML(
+	ctx context.Context,
+	drc []data.RestoreCollection,
+	backupVersion int,
+	cec control.ExportConfig,
+	ch chan<- export.Item,
+	stats *metrics.ExportStats,
+) {
+	defer close(ch)
+
+	errs := fault.New(false)
+
+	for _, rc := range drc {
+		folders := rc.FullPath().Folders()
+		index := htmlIndex{Entries: []htmlIndexEntry{}}
+
+		if len(folders) > 0 {
+			index.Title = folders[len(folders)-1]
+		}
+
+		for item := range rc.Items(ctx, errs) {
+			name := item.ID() + ".html"
+
+			body, entry, err := formatChannelMessageHTML(item.ToReader())
+			if err != nil {
+				ch <- export.Item{
+					ID:    item.ID(),
+					Error: err,
+				}
+
+				continue
+			}
+
+			stats.UpdateResourceCount(path.ChannelMessagesCategory)
+			body = metrics.ReaderWithStats(body, path.ChannelMessagesCategory, stats)
+
+			entry.Link = name
+			index.Entries = append(index.Entries, entry)
+
+			ch <- export.Item{
+				ID:   item.ID(),
+				Name: name,
+				Body: body,
+			}
+		}
+
+		if len(index.Entries) > 0 {
+			sort.SliceStable(index.Entries, func(i, j int) bool {
+				return index.Entries[i].createdAt.Before(index.Entries[j].createdAt)
+			})
+
+			body, err := renderTemplate(indexTmpl, index)
+			if err != nil {
+				ch <- export.Item{
+					ID:    indexFileName,
+					Error: clues.WrapWC(ctx, err, "creating channel index"),
+				}
+			} else {
+				ch <- export.Item{
+					ID:   indexFileName,
+					Name: indexFileName,
+					Body: body,
+				}
+			}
+		}
+
+		items, recovered := errs.ItemsAndRecovered()
+
+		// Return all the items that we failed to source from the persistence layer
+		for _, item := range items {
+			ch <- export.Item{
+				ID:    item.ID,
+				Error: &item,
+			}
+		}
+
+		for _, err := range recovered {
+			ch <- export.Item{
+				Error: err,
+			}
+		}
+	}
+}
+
+func formatChannelMessageHTML(rc io.ReadCloser) (io.ReadCloser, htmlIndexEntry, error) {
+	defer rc.Close()
+
+	bs, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, htmlIndexEntry{}, clues.Wrap(err, "reading item bytes")
+	}
+
+	cfb, err := api.CreateFromBytes(bs, models.CreateChatMessageFromDiscriminatorValue)
+	if err != nil {
+		return nil, htmlIndexEntry{}, clues.Wrap(err, "deserializing bytes to message")
+	}
+
+	msg, ok := cfb.(models.ChatMessageable)
+	if !ok {
+		return nil, htmlIndexEntry{}, clues.New("expected deserialized item to implement models.ChatMessageable")
+	}
+
+	root := makeHTMLChannelMessage(msg)
+	replies := msg.GetReplies()
+
+	transcript := htmlTranscript{
+		Title:    root.Subject,
+		Messages: make([]htmlMessage, 0, len(replies)+1),
+	}
+
+	if len(transcript.Title) == 0 {
+		transcript.Title = "Thread started by " + root.From
+	}
+
+	transcript.Messages = append(transcript.Messages, root)
+
+	for _, r := range replies {
+		reply := makeHTMLChannelMessage(r)
+		reply.IsReply = true
+
+		transcript.Messages = append(transcript.Messages, reply)
+	}
+
+	// the root message is always first, followed by the replies in the
+	// order in which they were posted.
+	sort.SliceStable(transcript.Messages[1:], func(i, j int) bool {
+		return transcript.Messages[i+1].createdAt.Before(transcript.Messages[j+1].createdAt)
+	})
+
+	body, err := renderTemplate(transcriptTmpl, transcript)
+	if err != nil {
+		return nil, htmlIndexEntry{}, clues.Stack(err)
+	}
+
+	entry := htmlIndexEntry{
+		Created:   root.Created,
+		From:      root.From,
+		Replies:   len(replies),
+		Title:     transcript.Title,
+		createdAt: root.createdAt,
+	}
+
+	return body, entry, nil
+}
+
+// hosted contents are referenced from the message body with urls of
+// the form `.../hostedContents/{id}/$value`.
+func hostedContentSrcRE(id string) *regexp.Regexp {
+	return regexp.MustCompile(`src="[^"]*/hostedContents/` + regexp.QuoteMeta(id) + `/\$value"`)
+}
+
+func makeHTMLChannelMessage(item models.ChatMessageable) htmlMessage {
+	content := bodyToHTML(item.GetBody())
+
+	for _, h := range item.GetHostedContents() {
+		if len(h.GetContentBytes()) == 0 {
+			continue
+		}
+
+		src := `src="` + string(dataURI(ptr.Val(h.GetContentType()), h.GetContentBytes())) + `"`
+		content = hostedContentSrcRE(ptr.Val(h.GetId())).ReplaceAllLiteralString(content, src)
+	}
+
+	attachments := item.GetAttachments()
+	htmlAttachments := make([]htmlAttachment, 0, len(attachments))
+
+	for _, a := range attachments {
+		name := ptr.Val(a.GetName())
+		if len(name) == 0 {
+			name = ptr.Val(a.GetId())
+		}
+
+		//nolint:gosec // links to the original location of the attachment
+		htmlAttachments = append(htmlAttachments, htmlAttachment{
+			Name: name,
+			URL:  template.URL(ptr.Val(a.GetContentUrl())),
+		})
+	}
+
+	var edited string
+
+	created := ptr.Val(item.GetCreatedDateTime())
+	modified := ptr.Val(item.GetLastModifiedDateTime())
+
+	if modified.After(created) {
+		edited = formatTime(modified)
+	}
+
+	return htmlMessage{
+		Attachments: htmlAttachments,
+		//nolint:gosec // the message body is sanitized by bodyToHTML
+		Content:   template.HTML(content),
+		Created:   formatTime(created),
+		Edited:    edited,
+		From:      api.GetChatMessageFrom(item),
+		Subject:   ptr.Val(item.GetSubject()),
+		createdAt: created,
+	}
+}
+
+//-------------------------------------------------------------
+// Conversation Posts
+//-------------------------------------------------------------
+
+// streamConversationPostsHTML streams all the posts in each of the
+// backingCollections as a single html transcript for the thread.
+func streamConversationPostsHTML(
+	ctx context.Context,
+	drc []data.RestoreCollection,
+	backupVersion int,
+	cec control.ExportConfig,
+	ch chan<- export.Item,
+	stats *metrics.ExportStats,
+) {
+	defer close(ch)
+
+	errs := fault.New(false)
+
+	for _, rc := range drc {
+		var (
+			ictx       = clues.Add(ctx, "path_short_ref", rc.FullPath().ShortRef())
+			folders    = rc.FullPath().Folders()
+			name       = rc.FullPath().Category().HumanString()
+			transcript = htmlTranscript{Messages: []htmlMessage{}}
+		)
+
+		if len(folders) > 0 {
+			name = folders[len(folders)-1]
+		}
+
+		for item := range rc.Items(ictx, errs) {
+			itemCtx := clues.Add(ictx, "stream_item_id", item.ID())
+
+			// Trim .data suffix from itemID. Also, we don't expect .meta files
+			// here since details are not persisted for metadata files.
+			trimmedID := strings.TrimSuffix(item.ID(), metadata.DataFileSuffix)
+
+			postMetadata, err := fetchAndReadMetadata(itemCtx, trimmedID, rc)
+			if err != nil {
+				ch <- export.Item{
+					ID:    item.ID(),
+					Error: err,
+				}
+
+				continue
+			}
+
+			reader := item.ToReader()
+			content, err := io.ReadAll(reader)
+
+			reader.Close()
+
+			if err != nil {
+				ch <- export.Item{
+					ID:    item.ID(),
+					Error: clues.WrapWC(itemCtx, err, "reading item bytes"),
+				}
+
+				continue
+			}
+
+			post, err := api.BytesToPostable(content)
+			if err != nil {
+				err = clues.WrapWC(itemCtx, err, "converting to postable")
+
+				logger.CtxErr(itemCtx, err).Info("processing collection item")
+
+				ch <- export.Item{
+					ID:    item.ID(),
+					Error: err,
+				}
+
+				continue
+			}
+
+			if len(transcript.Title) == 0 {
+				transcript.Title = postMetadata.Topic
+			}
+
+			stats.UpdateResourceCount(path.ConversationPostsCategory)
+
+			transcript.Messages = append(transcript.Messages, makeHTMLPost(itemCtx, post))
+		}
+
+		if len(transcript.Messages) > 0 {
+			if len(transcript.Title) == 0 {
+				transcript.Title = name
+			}
+
+			sort.SliceStable(transcript.Messages, func(i, j int) bool {
+				return transcript.Messages[i].createdAt.Before(transcript.Messages[j].createdAt)
+			})
+
+			for i := range transcript.Messages[1:] {
+				transcript.Messages[i+1].IsReply = true
+			}
+
+			body, err := renderTemplate(transcriptTmpl, transcript)
+			if err != nil {
+				ch <- export.Item{
+					ID:    rc.FullPath().ShortRef(),
+					Error: clues.WrapWC(ictx, err, "creating conversation transcript"),
+				}
+			} else {
+				ch <- export.Item{
+					ID:   rc.FullPath().ShortRef(),
+					Name: name + ".html",
+					Body: metrics.ReaderWithStats(body, path.ConversationPostsCategory, stats),
+				}
+			}
+		}
+
+		items, recovered := errs.ItemsAndRecovered()
+
+		// Return all the items that we failed to source from the persistence layer
+		for _, item := range items {
+			ch <- export.Item{
+				ID:    item.ID,
+				Error: &item,
+			}
+		}
+
+		for _, err := range recovered {
+			ch <- export.Item{
+				Error: err,
+			}
+		}
+	}
+}
+
+func makeHTMLPost(ctx context.Context, post models.Postable) htmlMessage {
+	content := bodyToHTML(post.GetBody())
+
+	attachments := post.GetAttachments()
+	htmlAttachments := make([]htmlAttachment, 0, len(attachments))
+
+	for _, a := range attachments {
+		name := ptr.Val(a.GetName())
+		if len(name) == 0 {
+			name = "Unnamed"
+		}
+
+		fa, ok := a.(models.FileAttachmentable)
+		if !ok || len(fa.GetContentBytes()) == 0 {
+			logger.Ctx(ctx).
+				With("attachment_id", ptr.Val(a.GetId()),
+					"attachment_type", ptr.Val(a.GetOdataType())).
+				Info("no contentBytes for attachment")
+
+			htmlAttachments = append(htmlAttachments, htmlAttachment{Name: name})
+
+			continue
+		}
+
+		uri := dataURI(ptr.Val(fa.GetContentType()), fa.GetContentBytes())
+
+		// inline attachments are referenced from the body using their
+		// content id and don't need to be listed separately.
+		cid := ptr.Val(fa.GetContentId())
+		if ptr.Val(fa.GetIsInline()) && len(cid) > 0 {
+			content = strings.ReplaceAll(content, "cid:"+cid, string(uri))
+			continue
+		}
+
+		htmlAttachments = append(htmlAttachments, htmlAttachment{
+			Name:     name,
+			URL:      uri,
+			Download: true,
+		})
+	}
+
+	var from string
+
+	if post.GetFrom() != nil && post.GetFrom().GetEmailAddress() != nil {
+		addr := post.GetFrom().GetEmailAddress()
+		from = ptr.Val(addr.GetName())
+
+		if len(from) == 0 {
+			from = ptr.Val(addr.GetAddress())
+		} else if len(ptr.Val(addr.GetAddress())) > 0 {
+			from += " <" + ptr.Val(addr.GetAddress()) + ">"
+		}
+	}
+
+	created := ptr.Val(post.GetCreatedDateTime())
+
+	return htmlMessage{
+		Attachments: htmlAttachments,
+		//nolint:gosec // the post body is sanitized by bodyToHTML
+		Content:   template.HTML(content),
+		Created:   formatTime(created),
+		From:      from,
+		createdAt: created,
+	}
+}
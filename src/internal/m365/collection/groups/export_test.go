@@ -3,13 +3,19 @@ package groups
 import (
 	"bytes"
 	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/alcionai/clues"
+	"github.com/microsoft/kiota-abstractions-go/serialization"
+	kjson "github.com/microsoft/kiota-serialization-json-go"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/alcionai/corso/src/internal/common/ptr"
 	"github.com/alcionai/corso/src/internal/data"
 	dataMock "github.com/alcionai/corso/src/internal/data/mock"
 	"github.com/alcionai/corso/src/internal/tester"
@@ -274,3 +280,256 @@ func (suite *ExportUnitSuite) TestStreamConversationPosts() {
 		})
 	}
 }
+
+func serialize(t *testing.T, item serialization.Parsable) io.ReadCloser {
+	sw := kjson.NewJsonSerializationWriter()
+
+	err := sw.WriteObjectValue("", item)
+	require.NoError(t, err, clues.ToCore(err))
+
+	bs, err := sw.GetSerializedContent()
+	require.NoError(t, err, clues.ToCore(err))
+
+	return io.NopCloser(bytes.NewReader(bs))
+}
+
+func (suite *ExportUnitSuite) TestStreamChannelMessagesHTML() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	testPath, err := path.Build(
+		"t",
+		"g",
+		path.GroupsService,
+		path.ChannelMessagesCategory,
+		false,
+		"general")
+	require.NoError(t, err, clues.ToCore(err))
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	makeMessage := func(content, author string, created time.Time) models.ChatMessageable {
+		msg := models.NewChatMessage()
+		msg.SetCreatedDateTime(ptr.To(created))
+
+		body := models.NewItemBody()
+		body.SetContentType(ptr.To(models.HTML_BODYTYPE))
+		body.SetContent(ptr.To(content))
+		msg.SetBody(body)
+
+		user := models.NewTeamworkUserIdentity()
+		user.SetDisplayName(ptr.To(author))
+
+		from := models.NewChatMessageFromIdentitySet()
+		from.SetUser(user)
+		msg.SetFrom(from)
+
+		return msg
+	}
+
+	root := makeMessage(
+		`<p>root</p><img src="https://graph.microsoft.com/v1.0/hostedContents/hcid/$value">`,
+		"zim",
+		now)
+	root.SetSubject(ptr.To("invasion plans"))
+
+	hosted := models.NewChatMessageHostedContent()
+	hosted.SetId(ptr.To("hcid"))
+	hosted.SetContentType(ptr.To("image/png"))
+	hosted.SetContentBytes([]byte("png bytes"))
+	root.SetHostedContents([]models.ChatMessageHostedContentable{hosted})
+
+	attachment := models.NewChatMessageAttachment()
+	attachment.SetName(ptr.To("plans.docx"))
+	attachment.SetContentUrl(ptr.To("https://example.com/plans.docx"))
+	root.SetAttachments([]models.ChatMessageAttachmentable{attachment})
+
+	// replies are intentionally out of order
+	root.SetReplies([]models.ChatMessageable{
+		makeMessage("<p>second</p>", "dib", now.Add(2*time.Minute)),
+		makeMessage("<p>first</p>", "gir", now.Add(time.Minute)),
+	})
+
+	coll := dataMock.Collection{
+		Path: testPath,
+		ItemData: []data.Item{
+			&dataMock.Item{
+				ItemID: "thread",
+				Reader: serialize(t, root),
+			},
+		},
+	}
+
+	ch := make(chan export.Item)
+
+	go streamChannelMessagesHTML(
+		ctx,
+		[]data.RestoreCollection{coll},
+		version.NoBackup,
+		control.ExportConfig{Format: control.HTMLFormat},
+		ch,
+		&metrics.ExportStats{})
+
+	bodies := map[string]string{}
+
+	for itm := range ch {
+		require.NoError(t, itm.Error, clues.ToCore(itm.Error))
+
+		bs, err := io.ReadAll(itm.Body)
+		require.NoError(t, err, clues.ToCore(err))
+
+		bodies[itm.Name] = string(bs)
+	}
+
+	require.Len(t, bodies, 2)
+
+	thread := bodies["thread.html"]
+	assert.Contains(t, thread, "<title>invasion plans</title>")
+	assert.Contains(t, thread, "zim")
+	assert.Contains(t, thread, `src="data:image/png;base64,cG5nIGJ5dGVz"`)
+	assert.NotContains(t, thread, "hostedContents")
+	assert.Contains(t, thread, `<a href="https://example.com/plans.docx">plans.docx</a>`)
+	assert.Less(
+		t,
+		strings.Index(thread, "first"),
+		strings.Index(thread, "second"),
+		"replies are sorted by creation time")
+
+	index := bodies[indexFileName]
+	assert.Contains(t, index, "<title>general</title>")
+	assert.Contains(t, index, `<a href="thread.html">invasion plans</a>`)
+}
+
+func (suite *ExportUnitSuite) TestStreamConversationPostsHTML() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	testPath, err := path.Build(
+		"t",
+		"g",
+		path.GroupsService,
+		path.ConversationPostsCategory,
+		false,
+		"convID",
+		"threadID")
+	require.NoError(t, err, clues.ToCore(err))
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	makePost := func(content string, created time.Time) models.Postable {
+		post := models.NewPost()
+		post.SetCreatedDateTime(ptr.To(created))
+
+		body := models.NewItemBody()
+		body.SetContentType(ptr.To(models.HTML_BODYTYPE))
+		body.SetContent(ptr.To(content))
+		post.SetBody(body)
+
+		addr := models.NewEmailAddress()
+		addr.SetName(ptr.To("gir"))
+		addr.SetAddress(ptr.To("gir@irk.com"))
+
+		from := models.NewRecipient()
+		from.SetEmailAddress(addr)
+		post.SetFrom(from)
+
+		return post
+	}
+
+	first := makePost(`<p>first</p><img src="cid:img1">`, now)
+
+	inline := models.NewFileAttachment()
+	inline.SetName(ptr.To("img.png"))
+	inline.SetContentType(ptr.To("image/png"))
+	inline.SetContentBytes([]byte("png bytes"))
+	inline.SetContentId(ptr.To("img1"))
+	inline.SetIsInline(ptr.To(true))
+
+	file := models.NewFileAttachment()
+	file.SetName(ptr.To("notes.txt"))
+	file.SetContentType(ptr.To("text/plain"))
+	file.SetContentBytes([]byte("notes"))
+
+	first.SetAttachments([]models.Attachmentable{inline, file})
+
+	second := makePost("<p>second</p>", now.Add(time.Minute))
+
+	makeMeta := func() io.ReadCloser {
+		return io.NopCloser(
+			bytes.NewReader([]byte(`{"topic":"doom", "recipients":["em@il"]}`)))
+	}
+
+	coll := dataMock.Collection{
+		Path: testPath,
+		// posts are intentionally out of order
+		ItemData: []data.Item{
+			&dataMock.Item{
+				ItemID: "second.data",
+				Reader: serialize(t, second),
+			},
+			&dataMock.Item{
+				ItemID: "first.data",
+				Reader: serialize(t, first),
+			},
+		},
+		AuxItems: map[string]data.Item{
+			"first.meta": &dataMock.Item{
+				ItemID: "first.meta",
+				Reader: makeMeta(),
+			},
+			"second.meta": &dataMock.Item{
+				ItemID: "second.meta",
+				Reader: makeMeta(),
+			},
+		},
+	}
+
+	ch := make(chan export.Item)
+	stats := &metrics.ExportStats{}
+
+	go streamConversationPostsHTML(
+		ctx,
+		[]data.RestoreCollection{coll},
+		version.NoBackup,
+		control.ExportConfig{Format: control.HTMLFormat},
+		ch,
+		stats)
+
+	items := []export.Item{}
+
+	for itm := range ch {
+		require.NoError(t, itm.Error, clues.ToCore(itm.Error))
+		items = append(items, itm)
+	}
+
+	require.Len(t, items, 1)
+	assert.Equal(t, "threadID.html", items[0].Name)
+
+	bs, err := io.ReadAll(items[0].Body)
+	require.NoError(t, err, clues.ToCore(err))
+
+	transcript := string(bs)
+
+	assert.Contains(t, transcript, "<title>doom</title>")
+	assert.Contains(t, transcript, "gir &lt;gir@irk.com&gt;")
+	assert.Contains(t, transcript, `src="data:image/png;base64,cG5nIGJ5dGVz"`)
+	assert.NotContains(t, transcript, "img.png")
+	assert.Contains(
+		t,
+		transcript,
+		`<a href="data:text/plain;base64,bm90ZXM=" download="notes.txt">notes.txt</a>`)
+	assert.Less(
+		t,
+		strings.Index(transcript, "first"),
+		strings.Index(transcript, "second"),
+		"posts are sorted by creation time")
+
+	assert.Equal(
+		t,
+		int64(2),
+		stats.GetStats()[path.ConversationPostsCategory].ResourceCount)
+}
@@ -0,0 +1,788 @@
+package groups
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/data"
+	"github.com/alcionai/corso/src/internal/diagnostics"
+	"github.com/alcionai/corso/src/internal/m365/support"
+	"github.com/alcionai/corso/src/internal/operations/inject"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/count"
+	"github.com/alcionai/corso/src/pkg/dttm"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/logger"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/restoreplan"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph/metadata"
+)
+
+// Graph doesn't allow setting the sender or timestamps of a post.  The
+// original values are kept on the restored post as legacy extended
+// properties instead.
+// Master Property Value Document:
+//
+//	https://interoperability.blob.core.windows.net/files/MS-OXPROPS/%5bMS-OXPROPS%5d.pdf
+const (
+	// Section: 2.635 PidTagClientSubmitTime
+	postSendDateTimeProperty = "SystemTime 0x0039"
+	// Section: 2.789 PidTagMessageDeliveryTime
+	postReceiveDateTimeProperty = "SystemTime 0x0E06"
+	// Section: 2.1011 PidTagSentRepresentingName
+	postSentRepresentingNameProperty = "String 0x0042"
+	// Section: 2.1008 PidTagSentRepresentingEmailAddress
+	postSentRepresentingEmailProperty = "String 0x0065"
+)
+
+// existing posts are fetched along with the original sender and send
+// time of any post that was restored by a previous run.
+var postCollisionKeyExpand = "singleValueExtendedProperties($filter=id eq '" +
+	postSendDateTimeProperty + "' or id eq '" + postSentRepresentingEmailProperty + "')"
+
+type conversationRestorer interface {
+	PostConversation(
+		ctx context.Context,
+		groupID string,
+		body models.Conversationable,
+	) (models.Conversationable, error)
+	PostConversationThread(
+		ctx context.Context,
+		groupID, conversationID string,
+		body models.ConversationThreadable,
+	) (models.ConversationThreadable, error)
+	ReplyToConversationThread(
+		ctx context.Context,
+		groupID, conversationID, threadID string,
+		post models.Postable,
+	) error
+	DeleteConversation(
+		ctx context.Context,
+		groupID, conversationID string,
+	) error
+	GetConversationThreads(
+		ctx context.Context,
+		groupID, conversationID string,
+		cc api.CallConfig,
+	) ([]models.ConversationThreadable, error)
+	GetConversationThreadPosts(
+		ctx context.Context,
+		groupID, conversationID, threadID string,
+		cc api.CallConfig,
+	) ([]models.Postable, error)
+}
+
+var _ conversationRestorer = api.Conversations{}
+
+// ConversationRestoreCache tracks the conversations in the restore target
+// across all collections in a restore.  Backups hold one collection per
+// conversation thread, so a conversation gets restored one thread at a time.
+type ConversationRestoreCache struct {
+	// maps conversation topics in the restore target to their IDs.
+	collisionKeyToConvID map[string]string
+	// maps the IDs of backed up conversations to the conversation their
+	// threads get restored into.  An empty ID means the conversation is
+	// yet to be created; only used when planning.
+	restored map[string]string
+	// the IDs of the conversations created by this restore.  These never
+	// collide with the backup.
+	created map[string]struct{}
+	// maps conversation IDs to the collision keys of their posts, and the
+	// thread that holds each post.
+	postKeys map[string]map[string]string
+}
+
+// NewConversationRestoreCache produces a cache from the collision keys of
+// the conversations in the restore target.
+func NewConversationRestoreCache(collisionKeyToConvID map[string]string) *ConversationRestoreCache {
+	return &ConversationRestoreCache{
+		collisionKeyToConvID: collisionKeyToConvID,
+		restored:             map[string]string{},
+		created:              map[string]struct{}{},
+		postKeys:             map[string]map[string]string{},
+	}
+}
+
+// collision returns the ID of the conversation in the restore target
+// which collides with the topic.  Conversations created by this restore
+// are never returned.
+func (crc *ConversationRestoreCache) collision(topic string) (string, bool) {
+	convID, ok := crc.collisionKeyToConvID[topic]
+	if !ok {
+		return "", false
+	}
+
+	if _, created := crc.created[convID]; created {
+		return "", false
+	}
+
+	return convID, true
+}
+
+// existingPostKeys returns the collision keys of the posts in the
+// conversation, mapped to the thread holding each post.
+func (crc *ConversationRestoreCache) existingPostKeys(
+	ctx context.Context,
+	cr conversationRestorer,
+	groupID, convID string,
+) (map[string]string, error) {
+	if keys, ok := crc.postKeys[convID]; ok {
+		return keys, nil
+	}
+
+	keys, err := getConversationPostKeys(ctx, cr, groupID, convID)
+	if err != nil {
+		return nil, err
+	}
+
+	crc.postKeys[convID] = keys
+
+	return keys, nil
+}
+
+// restorePost is a post read from the backup, along with the
+// conversation metadata stored beside it.
+type restorePost struct {
+	itemID string
+	postID string
+	topic  string
+	post   models.Postable
+}
+
+// RestoreConversationCollection rebuilds the conversation thread in the
+// collection within the restore target group.  Posts are restored in the
+// order they were created; the first starts a new thread and the rest
+// are added as replies.  Threads from the same backed up conversation are
+// restored into the same conversation.
+//
+// Collisions are keyed on the conversation topic, and only apply to
+// conversations that existed before the restore.  When a conversation
+// with the same topic exists, posts are further matched on their sender
+// and creation time (see postCollisionKey):
+//   - skip: posts missing from the conversation are added to the thread
+//     holding the rest of the backed up thread, or to a new thread.
+//   - copy: a new conversation is created with the same topic.
+//   - replace: the conversation is deleted and rebuilt from the backup.
+func RestoreConversationCollection(
+	ctx context.Context,
+	cr conversationRestorer,
+	rcc inject.RestoreConsumerConfig,
+	dc data.RestoreCollection,
+	cache *ConversationRestoreCache,
+	deets *details.Builder,
+	errs *fault.Bus,
+	ctr *count.Bus,
+) (support.CollectionMetrics, error) {
+	var (
+		metrics = support.CollectionMetrics{}
+		groupID = rcc.ProtectedResource.ID()
+		el      = errs.Local()
+	)
+
+	ctx, end := diagnostics.Span(ctx, "m365:groups:restoreConversation", diagnostics.Label("path", dc.FullPath()))
+	defer end()
+
+	posts, err := readConversationPosts(ctx, dc, &metrics, errs)
+	if err != nil || len(posts) == 0 {
+		return metrics, err
+	}
+
+	srcConvID, err := backupConversationID(dc)
+	if err != nil {
+		return metrics, clues.StackWC(ctx, err)
+	}
+
+	var (
+		topic    = posts[0].topic
+		policy   = rcc.RestoreConfig.OnCollision
+		existing = map[string]string{}
+		threadID string
+	)
+
+	ctx = clues.Add(
+		ctx,
+		"conversation_topic", clues.Hide(topic),
+		"backup_conversation_id", srcConvID)
+
+	// mirroring only applies to drive items.
+	if policy == control.Mirror {
+		policy = control.Replace
+	}
+
+	convID, restored := cache.restored[srcConvID]
+
+	switch {
+	case restored:
+		// another thread of this conversation was already restored.
+		if _, created := cache.created[convID]; !created && policy == control.Skip {
+			existing, err = cache.existingPostKeys(ctx, cr, groupID, convID)
+			if err != nil {
+				return metrics, clues.Stack(err)
+			}
+		}
+
+	default:
+		var collides bool
+
+		convID, collides = cache.collision(topic)
+		if !collides {
+			break
+		}
+
+		ctx = clues.Add(ctx, "collision_conversation_id", convID)
+
+		existing, err = cache.existingPostKeys(ctx, cr, groupID, convID)
+		if err != nil {
+			return metrics, clues.Stack(err)
+		}
+
+		switch policy {
+		case control.Replace:
+			if err := cr.DeleteConversation(ctx, groupID, convID); err != nil {
+				return metrics, clues.Wrap(err, "deleting colliding conversation")
+			}
+
+			delete(cache.collisionKeyToConvID, topic)
+
+			convID = ""
+		case control.Skip:
+			cache.restored[srcConvID] = convID
+		default:
+			convID = ""
+			existing = map[string]string{}
+		}
+	}
+
+	if policy == control.Skip {
+		// missing posts are added to the thread holding the rest of the
+		// backed up thread.
+		for _, rp := range posts {
+			if tid, ok := existing[postCollisionKey(rp.post)]; ok {
+				threadID = tid
+				break
+			}
+		}
+	}
+
+	for _, rp := range posts {
+		if el.Failure() != nil {
+			break
+		}
+
+		ictx := clues.Add(ctx, "post_id", rp.postID)
+
+		_, collision := existing[postCollisionKey(rp.post)]
+		if collision && policy == control.Skip {
+			ctr.Inc(count.CollisionSkip)
+			logger.Ctx(ictx).Debug("skipping post with collision")
+
+			continue
+		}
+
+		post := toRestorePost(ictx, rp.post, errs, ctr)
+
+		switch {
+		case len(convID) == 0:
+			convID, threadID, err = createConversation(ictx, cr, groupID, topic, post)
+			if err != nil {
+				el.AddRecoverable(ictx, clues.Wrap(err, "restoring conversation"))
+				// without a thread, none of the replies can be restored.
+				return metrics, el.Failure()
+			}
+
+			cache.created[convID] = struct{}{}
+			cache.restored[srcConvID] = convID
+
+		case len(threadID) == 0:
+			threadID, err = createThread(ictx, cr, groupID, convID, topic, post)
+			if err != nil {
+				el.AddRecoverable(ictx, clues.Wrap(err, "restoring conversation thread"))
+				// without a thread, none of the replies can be restored.
+				return metrics, el.Failure()
+			}
+
+		default:
+			if err := cr.ReplyToConversationThread(ictx, groupID, convID, threadID, post); err != nil {
+				el.AddRecoverable(ictx, clues.Wrap(err, "restoring post"))
+				continue
+			}
+		}
+
+		if collision {
+			ctr.Inc(count.CollisionReplace)
+		} else {
+			ctr.Inc(count.NewItemCreated)
+		}
+
+		metrics.Successes++
+
+		itemPath, err := dc.FullPath().AppendItem(rp.itemID)
+		if err != nil {
+			el.AddRecoverable(ictx, clues.WrapWC(ictx, err, "appending item to full path"))
+			continue
+		}
+
+		info := api.ConversationPostInfo(rp.post)
+		info.Post.Topic = topic
+
+		err = deets.Add(
+			itemPath,
+			path.Builder{}.Append(topic),
+			details.ItemInfo{Groups: info})
+		if err != nil {
+			// Not critical enough to need to stop restore operation.
+			logger.CtxErr(ictx, err).Infow("adding restored post to details")
+		}
+	}
+
+	return metrics, el.Failure()
+}
+
+// PlanConversationCollection records how each post in the collection
+// would get restored, without writing any data.
+func PlanConversationCollection(
+	ctx context.Context,
+	cr conversationRestorer,
+	rcc inject.RestoreConsumerConfig,
+	dc data.RestoreCollection,
+	cache *ConversationRestoreCache,
+	plan *restoreplan.Plan,
+	errs *fault.Bus,
+) error {
+	var (
+		metrics = support.CollectionMetrics{}
+		groupID = rcc.ProtectedResource.ID()
+		policy  = rcc.RestoreConfig.OnCollision
+	)
+
+	posts, err := readConversationPosts(ctx, dc, &metrics, errs)
+	if err != nil || len(posts) == 0 {
+		return err
+	}
+
+	srcConvID, err := backupConversationID(dc)
+	if err != nil {
+		return clues.StackWC(ctx, err)
+	}
+
+	var (
+		topic    = posts[0].topic
+		existing = map[string]string{}
+		collides bool
+	)
+
+	if policy == control.Mirror {
+		policy = control.Replace
+	}
+
+	convID, restored := cache.restored[srcConvID]
+
+	switch {
+	case restored:
+		if len(convID) > 0 && policy == control.Skip {
+			existing, err = cache.existingPostKeys(ctx, cr, groupID, convID)
+			if err != nil {
+				return clues.Stack(err)
+			}
+		}
+
+	default:
+		convID, collides = cache.collision(topic)
+		if collides {
+			existing, err = cache.existingPostKeys(ctx, cr, groupID, convID)
+			if err != nil {
+				return clues.Stack(err)
+			}
+		}
+
+		switch {
+		case collides && policy == control.Skip:
+		case collides && policy == control.Replace:
+			delete(cache.collisionKeyToConvID, topic)
+
+			convID = ""
+		default:
+			convID = ""
+			existing = map[string]string{}
+		}
+
+		cache.restored[srcConvID] = convID
+	}
+
+	for _, rp := range posts {
+		_, collision := existing[postCollisionKey(rp.post)]
+
+		action := restoreplan.ActionFor(collision, policy)
+		// copies restore the whole thread into a new conversation.
+		if collides && !collision && policy == control.Copy {
+			action = restoreplan.Copy
+		}
+
+		plan.Add(restoreplan.Item{
+			ItemID:        rp.itemID,
+			Name:          rp.postID,
+			Category:      path.ConversationPostsCategory.HumanString(),
+			Action:        action,
+			ContainerPath: topic,
+			NewContainer:  len(convID) == 0,
+		})
+	}
+
+	return nil
+}
+
+// backupConversationID returns the ID of the backed up conversation that
+// holds the thread in the collection.
+func backupConversationID(dc data.RestoreCollection) (string, error) {
+	// expects: [conversationID, threadID]
+	folders := dc.FullPath().Folders()
+	if len(folders) == 0 {
+		return "", clues.New("conversation collection path has no folders").
+			With("path", dc.FullPath())
+	}
+
+	return folders[0], nil
+}
+
+// readConversationPosts reads every post in the collection, ordered by
+// their creation time.
+func readConversationPosts(
+	ctx context.Context,
+	dc data.RestoreCollection,
+	metrics *support.CollectionMetrics,
+	errs *fault.Bus,
+) ([]restorePost, error) {
+	var (
+		el    = errs.Local()
+		posts = []restorePost{}
+	)
+
+	for itemData := range dc.Items(ctx, errs) {
+		if el.Failure() != nil {
+			break
+		}
+
+		var (
+			postID = strings.TrimSuffix(itemData.ID(), metadata.DataFileSuffix)
+			ictx   = clues.Add(ctx, "post_id", postID)
+		)
+
+		metrics.Objects++
+
+		bs, err := io.ReadAll(itemData.ToReader())
+		if err != nil {
+			el.AddRecoverable(ictx, clues.WrapWC(ictx, err, "reading backup data"))
+			continue
+		}
+
+		metrics.Bytes += int64(len(bs))
+
+		post, err := api.BytesToPostable(bs)
+		if err != nil {
+			el.AddRecoverable(ictx, clues.WrapWC(ictx, err, "deserializing post"))
+			continue
+		}
+
+		meta, err := fetchAndReadMetadata(ictx, postID, dc)
+		if err != nil {
+			el.AddRecoverable(ictx, clues.Stack(err))
+			continue
+		}
+
+		posts = append(posts, restorePost{
+			itemID: itemData.ID(),
+			postID: postID,
+			topic:  meta.Topic,
+			post:   post,
+		})
+	}
+
+	sort.SliceStable(posts, func(i, j int) bool {
+		return ptr.Val(posts[i].post.GetCreatedDateTime()).
+			Before(ptr.Val(posts[j].post.GetCreatedDateTime()))
+	})
+
+	return posts, el.Failure()
+}
+
+// getConversationPostKeys returns the collision keys of every post in
+// the conversation, mapped to the ID of the thread holding the post.
+func getConversationPostKeys(
+	ctx context.Context,
+	cr conversationRestorer,
+	groupID, convID string,
+) (map[string]string, error) {
+	threads, err := cr.GetConversationThreads(ctx, groupID, convID, api.CallConfig{})
+	if err != nil {
+		return nil, clues.Wrap(err, "getting threads in colliding conversation")
+	}
+
+	keys := map[string]string{}
+
+	for _, thread := range threads {
+		threadID := ptr.Val(thread.GetId())
+
+		posts, err := cr.GetConversationThreadPosts(
+			ctx,
+			groupID,
+			convID,
+			threadID,
+			api.CallConfig{Expand: []string{postCollisionKeyExpand}})
+		if err != nil {
+			return nil, clues.Wrap(err, "getting posts in colliding conversation").
+				With("collision_thread_id", threadID)
+		}
+
+		for _, p := range posts {
+			keys[postCollisionKey(p)] = threadID
+		}
+	}
+
+	return keys, nil
+}
+
+// postCollisionKey identifies a post by its sender and the time it was
+// created.  Graph assigns new IDs and timestamps to restored posts, so
+// posts restored by a previous run are keyed on the original values that
+// were kept in their extended properties.
+func postCollisionKey(post models.Postable) string {
+	var (
+		sender  string
+		created = ptr.Val(post.GetCreatedDateTime())
+		from    = post.GetFrom()
+	)
+
+	if from == nil {
+		from = post.GetSender()
+	}
+
+	if from != nil && from.GetEmailAddress() != nil {
+		sender = ptr.Val(from.GetEmailAddress().GetAddress())
+	}
+
+	for _, svep := range post.GetSingleValueExtendedProperties() {
+		value := ptr.Val(svep.GetValue())
+
+		switch {
+		case len(value) == 0:
+			continue
+		case sameExtendedProperty(ptr.Val(svep.GetId()), postSentRepresentingEmailProperty):
+			sender = value
+		case sameExtendedProperty(ptr.Val(svep.GetId()), postSendDateTimeProperty):
+			if t, err := dttm.ParseTime(value); err == nil {
+				created = t
+			}
+		}
+	}
+
+	// extended properties only hold the time to the second.
+	return strings.ToLower(sender) + dttm.FormatToLegacy(created.UTC())
+}
+
+// sameExtendedProperty compares two legacy extended property IDs.  Graph
+// doesn't zero-pad the property tag in the IDs it returns, so the tags are
+// compared by value.
+func sameExtendedProperty(a, b string) bool {
+	at, ah, aok := strings.Cut(a, " ")
+	bt, bh, bok := strings.Cut(b, " ")
+
+	if !aok || !bok || !strings.EqualFold(at, bt) {
+		return strings.EqualFold(a, b)
+	}
+
+	an, aerr := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(ah), "0x"), 16, 32)
+	bn, berr := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(bh), "0x"), 16, 32)
+
+	if aerr != nil || berr != nil {
+		return strings.EqualFold(a, b)
+	}
+
+	return an == bn
+}
+
+// createConversation starts a new conversation with the post, and
+// returns the IDs of the conversation and its thread.
+func createConversation(
+	ctx context.Context,
+	cr conversationRestorer,
+	groupID, topic string,
+	post models.Postable,
+) (string, string, error) {
+	thread := models.NewConversationThread()
+	thread.SetTopic(ptr.To(topic))
+	thread.SetPosts([]models.Postable{post})
+
+	body := models.NewConversation()
+	body.SetTopic(ptr.To(topic))
+	body.SetThreads([]models.ConversationThreadable{thread})
+
+	conv, err := cr.PostConversation(ctx, groupID, body)
+	if err != nil {
+		return "", "", clues.Stack(err)
+	}
+
+	convID := ptr.Val(conv.GetId())
+
+	threads, err := cr.GetConversationThreads(ctx, groupID, convID, api.CallConfig{})
+	if err != nil {
+		return "", "", clues.Wrap(err, "getting threads in restored conversation")
+	}
+
+	if len(threads) == 0 {
+		return "", "", clues.NewWC(ctx, "restored conversation has no threads")
+	}
+
+	return convID, ptr.Val(threads[0].GetId()), nil
+}
+
+// createThread starts a new thread in the conversation with the post,
+// and returns the ID of the thread.
+func createThread(
+	ctx context.Context,
+	cr conversationRestorer,
+	groupID, convID, topic string,
+	post models.Postable,
+) (string, error) {
+	body := models.NewConversationThread()
+	body.SetTopic(ptr.To(topic))
+	body.SetPosts([]models.Postable{post})
+
+	thread, err := cr.PostConversationThread(ctx, groupID, convID, body)
+	if err != nil {
+		return "", clues.Stack(err)
+	}
+
+	return ptr.Val(thread.GetId()), nil
+}
+
+// toRestorePost copies the writable properties of the backed up post
+// into a new post.  File attachments are sent inline with the post, since
+// graph doesn't return the ID of replies for uploading them separately.
+// Any other attachments can't be restored, and produce an alert.
+func toRestorePost(
+	ctx context.Context,
+	orig models.Postable,
+	errs *fault.Bus,
+	ctr *count.Bus,
+) models.Postable {
+	post := models.NewPost()
+	post.SetBody(orig.GetBody())
+	post.SetNewParticipants(orig.GetNewParticipants())
+
+	attachments := make([]models.Attachmentable, 0, len(orig.GetAttachments()))
+
+	for _, a := range orig.GetAttachments() {
+		fa, ok := a.(models.FileAttachmentable)
+		if !ok {
+			alertDroppedPostAttachment(ctx, orig, a, "unsupported attachment type", errs, ctr)
+			continue
+		}
+
+		content, err := api.GetAttachmentContent(fa)
+		if err != nil {
+			logger.CtxErr(ctx, err).Info("post attachment has no content")
+			alertDroppedPostAttachment(ctx, orig, a, "attachment has no content", errs, ctr)
+
+			continue
+		}
+
+		att := models.NewFileAttachment()
+		att.SetName(fa.GetName())
+		att.SetContentType(fa.GetContentType())
+		att.SetContentId(fa.GetContentId())
+		att.SetIsInline(fa.GetIsInline())
+		att.SetContentBytes(content)
+
+		attachments = append(attachments, att)
+	}
+
+	if len(attachments) > 0 {
+		post.SetAttachments(attachments)
+	}
+
+	post.SetSingleValueExtendedProperties(postSVEPs(orig))
+
+	return post
+}
+
+// alertDroppedPostAttachment records an attachment that couldn't be
+// restored along with its post.
+func alertDroppedPostAttachment(
+	ctx context.Context,
+	post models.Postable,
+	attachment models.Attachmentable,
+	reason string,
+	errs *fault.Bus,
+	ctr *count.Bus,
+) {
+	var (
+		id   = ptr.Val(attachment.GetId())
+		name = ptr.Val(attachment.GetName())
+	)
+
+	ctx = clues.Add(
+		ctx,
+		"attachment_id", id,
+		"attachment_name", clues.Hide(name),
+		"attachment_odata_type", ptr.Val(attachment.GetOdataType()))
+
+	logger.Ctx(ctx).Info("dropping post attachment: " + reason)
+
+	ctr.Inc(count.PostAttachmentsDropped)
+
+	errs.AddAlert(ctx, fault.NewAlert(
+		fault.AlertDroppedPostAttachment,
+		"", // no namespace
+		id,
+		name,
+		map[string]any{
+			"post_id":     ptr.Val(post.GetId()),
+			"odata_type":  ptr.Val(attachment.GetOdataType()),
+			"drop_reason": reason,
+		}))
+}
+
+func postSVEPs(orig models.Postable) []models.SingleValueLegacyExtendedPropertyable {
+	svleps := []models.SingleValueLegacyExtendedPropertyable{}
+
+	add := func(id, value string) {
+		if len(value) == 0 {
+			return
+		}
+
+		svlep := models.NewSingleValueLegacyExtendedProperty()
+		svlep.SetId(ptr.To(id))
+		svlep.SetValue(ptr.To(value))
+
+		svleps = append(svleps, svlep)
+	}
+
+	if orig.GetCreatedDateTime() != nil {
+		add(postSendDateTimeProperty, dttm.FormatToLegacy(ptr.Val(orig.GetCreatedDateTime())))
+	}
+
+	if orig.GetReceivedDateTime() != nil {
+		add(postReceiveDateTimeProperty, dttm.FormatToLegacy(ptr.Val(orig.GetReceivedDateTime())))
+	}
+
+	from := orig.GetFrom()
+	if from == nil {
+		from = orig.GetSender()
+	}
+
+	if from != nil && from.GetEmailAddress() != nil {
+		add(postSentRepresentingNameProperty, ptr.Val(from.GetEmailAddress().GetName()))
+		add(postSentRepresentingEmailProperty, ptr.Val(from.GetEmailAddress().GetAddress()))
+	}
+
+	return svleps
+}
@@ -0,0 +1,548 @@
+package groups
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alcionai/clues"
+	kjson "github.com/microsoft/kiota-serialization-json-go"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/common/idname"
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/data"
+	dataMock "github.com/alcionai/corso/src/internal/data/mock"
+	"github.com/alcionai/corso/src/internal/operations/inject"
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/count"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/restoreplan"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+)
+
+type mockConversationRestorer struct {
+	// existing conversation ID -> posts in its first thread
+	existing map[string][]models.Postable
+
+	created        []models.Conversationable
+	createdThreads []string
+	repliedTo      []string
+	deleted        []string
+}
+
+func (m *mockConversationRestorer) PostConversation(
+	_ context.Context,
+	_ string,
+	body models.Conversationable,
+) (models.Conversationable, error) {
+	m.created = append(m.created, body)
+
+	id := "new-conv"
+	if len(m.created) > 1 {
+		id += "-" + strconv.Itoa(len(m.created))
+	}
+
+	conv := models.NewConversation()
+	conv.SetId(ptr.To(id))
+
+	return conv, nil
+}
+
+func (m *mockConversationRestorer) PostConversationThread(
+	_ context.Context,
+	_, conversationID string,
+	_ models.ConversationThreadable,
+) (models.ConversationThreadable, error) {
+	m.createdThreads = append(m.createdThreads, conversationID)
+
+	thread := models.NewConversationThread()
+	thread.SetId(ptr.To(conversationID + "-thread-" + strconv.Itoa(len(m.createdThreads))))
+
+	return thread, nil
+}
+
+func (m *mockConversationRestorer) ReplyToConversationThread(
+	_ context.Context,
+	_, conversationID, _ string,
+	_ models.Postable,
+) error {
+	m.repliedTo = append(m.repliedTo, conversationID)
+	return nil
+}
+
+func (m *mockConversationRestorer) DeleteConversation(
+	_ context.Context,
+	_, conversationID string,
+) error {
+	m.deleted = append(m.deleted, conversationID)
+	return nil
+}
+
+func (m *mockConversationRestorer) GetConversationThreads(
+	_ context.Context,
+	_, conversationID string,
+	_ api.CallConfig,
+) ([]models.ConversationThreadable, error) {
+	thread := models.NewConversationThread()
+	thread.SetId(ptr.To(conversationID + "-thread"))
+
+	return []models.ConversationThreadable{thread}, nil
+}
+
+func (m *mockConversationRestorer) GetConversationThreadPosts(
+	_ context.Context,
+	_, conversationID, threadID string,
+	_ api.CallConfig,
+) ([]models.Postable, error) {
+	if threadID != conversationID+"-thread" {
+		return nil, nil
+	}
+
+	return m.existing[conversationID], nil
+}
+
+type RestoreUnitSuite struct {
+	tester.Suite
+}
+
+func TestRestoreUnitSuite(t *testing.T) {
+	suite.Run(t, &RestoreUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+var postsCreated = time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+// backedUpPost produces the post with the given ID, as it appears in
+// postCollection.
+func backedUpPost(id string) models.Postable {
+	from := models.NewEmailAddress()
+	from.SetAddress(ptr.To("Sender@Example.com"))
+
+	recip := models.NewRecipient()
+	recip.SetEmailAddress(from)
+
+	post := models.NewPost()
+	post.SetId(ptr.To(id))
+	post.SetCreatedDateTime(ptr.To(postsCreated.Add(time.Duration(id[1]-'0') * time.Minute)))
+	post.SetFrom(recip)
+
+	body := models.NewItemBody()
+	body.SetContent(ptr.To("body of " + id))
+	post.SetBody(body)
+
+	return post
+}
+
+// restoredPost produces the post with the given ID as it appears after
+// being restored: with a new ID and creation time, and the original
+// values held in its extended properties.
+func restoredPost(id string) models.Postable {
+	orig := backedUpPost(id)
+
+	post := models.NewPost()
+	post.SetId(ptr.To("restored-" + id))
+	post.SetCreatedDateTime(ptr.To(time.Now()))
+	post.SetSingleValueExtendedProperties(postSVEPs(orig))
+
+	// graph drops the zero padding from the property tags.
+	for _, svep := range post.GetSingleValueExtendedProperties() {
+		svep.SetId(ptr.To(strings.Replace(ptr.Val(svep.GetId()), "0x00", "0x", 1)))
+	}
+
+	return post
+}
+
+func postCollection(t *testing.T) dataMock.Collection {
+	// posts are added out of order to check that the thread
+	// gets rebuilt in the order they were created.
+	return threadCollection(t, "convID", "threadID", "p2", "p1", "p3")
+}
+
+// threadCollection produces a collection holding one thread of a backed
+// up conversation with the topic "topic".
+func threadCollection(t *testing.T, convID, threadID string, postIDs ...string) dataMock.Collection {
+	p, err := path.Build(
+		"t",
+		"g",
+		path.GroupsService,
+		path.ConversationPostsCategory,
+		false,
+		convID,
+		threadID)
+	require.NoError(t, err, clues.ToCore(err))
+
+	coll := dataMock.Collection{
+		Path:     p,
+		AuxItems: map[string]data.Item{},
+	}
+
+	for _, id := range postIDs {
+		sw := kjson.NewJsonSerializationWriter()
+		require.NoError(t, sw.WriteObjectValue("", backedUpPost(id)))
+
+		bs, err := sw.GetSerializedContent()
+		require.NoError(t, err, clues.ToCore(err))
+
+		coll.ItemData = append(coll.ItemData, &dataMock.Item{
+			ItemID: id + ".data",
+			Reader: io.NopCloser(bytes.NewReader(bs)),
+		})
+
+		coll.AuxItems[id+".meta"] = &dataMock.Item{
+			ItemID: id + ".meta",
+			Reader: io.NopCloser(bytes.NewReader([]byte(`{"topic":"topic"}`))),
+		}
+	}
+
+	return coll
+}
+
+func (suite *RestoreUnitSuite) TestRestoreConversationCollection() {
+	type counts struct {
+		skip    int64
+		replace int64
+		new     int64
+	}
+
+	table := []struct {
+		name            string
+		collisionKeys   map[string]string
+		existing        map[string][]models.Postable
+		onCollision     control.CollisionPolicy
+		expectCreated   bool
+		expectRepliedTo []string
+		expectDeleted   []string
+		expectCounts    counts
+	}{
+		{
+			name:            "no collision",
+			collisionKeys:   map[string]string{},
+			onCollision:     control.Skip,
+			expectCreated:   true,
+			expectRepliedTo: []string{"new-conv", "new-conv"},
+			expectCounts:    counts{0, 0, 3},
+		},
+		{
+			name:            "collision, skip",
+			collisionKeys:   map[string]string{"topic": "conv"},
+			existing:        map[string][]models.Postable{"conv": {backedUpPost("p1")}},
+			onCollision:     control.Skip,
+			expectRepliedTo: []string{"conv", "conv"},
+			expectCounts:    counts{1, 0, 2},
+		},
+		{
+			name:          "collision with previously restored posts, skip",
+			collisionKeys: map[string]string{"topic": "conv"},
+			existing: map[string][]models.Postable{
+				"conv": {restoredPost("p1"), restoredPost("p2")},
+			},
+			onCollision:     control.Skip,
+			expectRepliedTo: []string{"conv"},
+			expectCounts:    counts{2, 0, 1},
+		},
+		{
+			name:            "collision, copy",
+			collisionKeys:   map[string]string{"topic": "conv"},
+			existing:        map[string][]models.Postable{"conv": {backedUpPost("p1")}},
+			onCollision:     control.Copy,
+			expectCreated:   true,
+			expectRepliedTo: []string{"new-conv", "new-conv"},
+			expectCounts:    counts{0, 0, 3},
+		},
+		{
+			name:            "collision, replace",
+			collisionKeys:   map[string]string{"topic": "conv"},
+			existing:        map[string][]models.Postable{"conv": {backedUpPost("p1")}},
+			onCollision:     control.Replace,
+			expectCreated:   true,
+			expectRepliedTo: []string{"new-conv", "new-conv"},
+			expectDeleted:   []string{"conv"},
+			expectCounts:    counts{0, 1, 2},
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			var (
+				cr    = &mockConversationRestorer{existing: test.existing}
+				deets = &details.Builder{}
+				ctr   = count.New()
+				rcc   = inject.RestoreConsumerConfig{
+					ProtectedResource: idname.NewProvider("gid", "gname"),
+					RestoreConfig:     control.RestoreConfig{OnCollision: test.onCollision},
+				}
+			)
+
+			metrics, err := RestoreConversationCollection(
+				ctx,
+				cr,
+				rcc,
+				postCollection(t),
+				NewConversationRestoreCache(test.collisionKeys),
+				deets,
+				fault.New(true),
+				ctr)
+			require.NoError(t, err, clues.ToCore(err))
+
+			if test.expectCreated {
+				require.Len(t, cr.created, 1)
+
+				conv := cr.created[0]
+				assert.Equal(t, "topic", ptr.Val(conv.GetTopic()))
+
+				posts := conv.GetThreads()[0].GetPosts()
+				require.Len(t, posts, 1)
+				assert.Equal(t, "body of p1", ptr.Val(posts[0].GetBody().GetContent()))
+				assert.NotEmpty(t, posts[0].GetSingleValueExtendedProperties(), "original timestamps")
+			} else {
+				assert.Empty(t, cr.created)
+			}
+
+			assert.Equal(t, test.expectRepliedTo, cr.repliedTo)
+			assert.Equal(t, test.expectDeleted, cr.deleted)
+			assert.Equal(t, test.expectCounts.skip, ctr.Get(count.CollisionSkip), "skips")
+			assert.Equal(t, test.expectCounts.replace, ctr.Get(count.CollisionReplace), "replaces")
+			assert.Equal(t, test.expectCounts.new, ctr.Get(count.NewItemCreated), "new items")
+
+			restored := int(test.expectCounts.replace + test.expectCounts.new)
+			assert.Equal(t, restored, metrics.Successes)
+			assert.Len(t, deets.Details().Items(), restored)
+		})
+	}
+}
+
+// threads are restored one collection at a time.  Threads from the same
+// backed up conversation, and conversations sharing a topic, must never
+// collide with the conversations created by the same restore.
+func (suite *RestoreUnitSuite) TestRestoreConversationCollection_multipleThreads() {
+	table := []struct {
+		name                 string
+		colls                func(t *testing.T) []data.RestoreCollection
+		collisionKeys        map[string]string
+		existing             map[string][]models.Postable
+		onCollision          control.CollisionPolicy
+		expectCreated        int
+		expectCreatedThreads []string
+		expectRepliedTo      []string
+		expectDeleted        []string
+		expectSkips          int64
+	}{
+		{
+			name: "two threads, replace",
+			colls: func(t *testing.T) []data.RestoreCollection {
+				return []data.RestoreCollection{
+					threadCollection(t, "convID", "thread1", "p1", "p2"),
+					threadCollection(t, "convID", "thread2", "p3", "p4"),
+				}
+			},
+			collisionKeys:        map[string]string{"topic": "conv"},
+			existing:             map[string][]models.Postable{"conv": {backedUpPost("p1")}},
+			onCollision:          control.Replace,
+			expectCreated:        1,
+			expectCreatedThreads: []string{"new-conv"},
+			expectRepliedTo:      []string{"new-conv", "new-conv"},
+			expectDeleted:        []string{"conv"},
+		},
+		{
+			name: "two threads, skip",
+			colls: func(t *testing.T) []data.RestoreCollection {
+				return []data.RestoreCollection{
+					threadCollection(t, "convID", "thread1", "p1", "p2"),
+					threadCollection(t, "convID", "thread2", "p3", "p4"),
+				}
+			},
+			collisionKeys:        map[string]string{"topic": "conv"},
+			existing:             map[string][]models.Postable{"conv": {backedUpPost("p1")}},
+			onCollision:          control.Skip,
+			expectCreatedThreads: []string{"conv"},
+			expectRepliedTo:      []string{"conv", "conv"},
+			expectSkips:          1,
+		},
+		{
+			name: "two threads, no collision, skip",
+			colls: func(t *testing.T) []data.RestoreCollection {
+				return []data.RestoreCollection{
+					threadCollection(t, "convID", "thread1", "p1", "p2"),
+					threadCollection(t, "convID", "thread2", "p3", "p4"),
+				}
+			},
+			collisionKeys:        map[string]string{},
+			onCollision:          control.Skip,
+			expectCreated:        1,
+			expectCreatedThreads: []string{"new-conv"},
+			expectRepliedTo:      []string{"new-conv", "new-conv"},
+		},
+		{
+			name: "same topic, replace",
+			colls: func(t *testing.T) []data.RestoreCollection {
+				return []data.RestoreCollection{
+					threadCollection(t, "conv1", "thread1", "p1", "p2"),
+					threadCollection(t, "conv2", "thread2", "p3", "p4"),
+				}
+			},
+			collisionKeys:   map[string]string{"topic": "conv"},
+			existing:        map[string][]models.Postable{"conv": {backedUpPost("p1")}},
+			onCollision:     control.Replace,
+			expectCreated:   2,
+			expectRepliedTo: []string{"new-conv", "new-conv-2"},
+			expectDeleted:   []string{"conv"},
+		},
+		{
+			name: "same topic, no collision, skip",
+			colls: func(t *testing.T) []data.RestoreCollection {
+				return []data.RestoreCollection{
+					threadCollection(t, "conv1", "thread1", "p1", "p2"),
+					threadCollection(t, "conv2", "thread2", "p3", "p4"),
+				}
+			},
+			collisionKeys:   map[string]string{},
+			onCollision:     control.Skip,
+			expectCreated:   2,
+			expectRepliedTo: []string{"new-conv", "new-conv-2"},
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			var (
+				cr    = &mockConversationRestorer{existing: test.existing}
+				cache = NewConversationRestoreCache(test.collisionKeys)
+				ctr   = count.New()
+				rcc   = inject.RestoreConsumerConfig{
+					ProtectedResource: idname.NewProvider("gid", "gname"),
+					RestoreConfig:     control.RestoreConfig{OnCollision: test.onCollision},
+				}
+			)
+
+			for _, dc := range test.colls(t) {
+				_, err := RestoreConversationCollection(
+					ctx,
+					cr,
+					rcc,
+					dc,
+					cache,
+					&details.Builder{},
+					fault.New(true),
+					ctr)
+				require.NoError(t, err, clues.ToCore(err))
+			}
+
+			assert.Len(t, cr.created, test.expectCreated, "created conversations")
+			assert.Equal(t, test.expectCreatedThreads, cr.createdThreads, "created threads")
+			assert.Equal(t, test.expectRepliedTo, cr.repliedTo, "replies")
+			assert.Equal(t, test.expectDeleted, cr.deleted, "deleted conversations")
+			assert.Equal(t, test.expectSkips, ctr.Get(count.CollisionSkip), "skips")
+		})
+	}
+}
+
+func (suite *RestoreUnitSuite) TestPlanConversationCollection() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	var (
+		cr   = &mockConversationRestorer{existing: map[string][]models.Postable{"conv": {restoredPost("p1")}}}
+		plan = restoreplan.New()
+		rcc  = inject.RestoreConsumerConfig{
+			ProtectedResource: idname.NewProvider("gid", "gname"),
+			RestoreConfig:     control.RestoreConfig{OnCollision: control.Skip},
+		}
+	)
+
+	err := PlanConversationCollection(
+		ctx,
+		cr,
+		rcc,
+		postCollection(t),
+		NewConversationRestoreCache(map[string]string{"topic": "conv"}),
+		plan,
+		fault.New(true))
+	require.NoError(t, err, clues.ToCore(err))
+
+	actions := map[string]restoreplan.Action{}
+
+	for _, item := range plan.Items() {
+		actions[item.Name] = item.Action
+		assert.Equal(t, "topic", item.ContainerPath)
+		assert.False(t, item.NewContainer)
+	}
+
+	expect := map[string]restoreplan.Action{
+		"p1": restoreplan.Skip,
+		"p2": restoreplan.Create,
+		"p3": restoreplan.Create,
+	}
+
+	assert.Equal(t, expect, actions)
+	assert.Empty(t, cr.created)
+}
+
+func (suite *RestoreUnitSuite) TestToRestorePost_droppedAttachments() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	file := models.NewFileAttachment()
+	file.SetId(ptr.To("file"))
+	file.SetName(ptr.To("file.txt"))
+	file.SetContentBytes([]byte("content"))
+
+	empty := models.NewFileAttachment()
+	empty.SetId(ptr.To("empty"))
+	empty.SetName(ptr.To("empty.txt"))
+
+	item := models.NewItemAttachment()
+	item.SetId(ptr.To("item"))
+	item.SetName(ptr.To("forwarded message"))
+
+	ref := models.NewReferenceAttachment()
+	ref.SetId(ptr.To("ref"))
+	ref.SetName(ptr.To("shared file"))
+
+	orig := backedUpPost("p1")
+	orig.SetAttachments([]models.Attachmentable{file, empty, item, ref})
+
+	var (
+		errs = fault.New(true)
+		ctr  = count.New()
+	)
+
+	post := toRestorePost(ctx, orig, errs, ctr)
+
+	require.Len(t, post.GetAttachments(), 1)
+	assert.Equal(t, "file.txt", ptr.Val(post.GetAttachments()[0].GetName()))
+
+	dropped := []string{}
+
+	for _, alert := range errs.Alerts() {
+		assert.Equal(t, fault.AlertDroppedPostAttachment, alert.Message)
+		assert.Equal(t, "p1", alert.Item.Additional["post_id"])
+
+		dropped = append(dropped, alert.Item.ID)
+	}
+
+	assert.ElementsMatch(t, []string{"empty", "item", "ref"}, dropped)
+	assert.Equal(t, int64(3), ctr.Get(count.PostAttachmentsDropped))
+	assert.NoError(t, errs.Failure(), clues.ToCore(errs.Failure()))
+}
@@ -0,0 +1,186 @@
+package onenote
+
+import (
+	"context"
+	"maps"
+
+	"github.com/alcionai/clues"
+
+	"github.com/alcionai/corso/src/internal/data"
+	"github.com/alcionai/corso/src/internal/m365/support"
+	"github.com/alcionai/corso/src/internal/operations/inject"
+	"github.com/alcionai/corso/src/pkg/backup/metadata"
+	"github.com/alcionai/corso/src/pkg/count"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/logger"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
+)
+
+// CreateCollections produces one collection for each section in the owner's
+// notebooks that matches the backup scope.  Collection paths are made of the
+// ids of the notebook, any section groups, and the section.  Graph provides
+// no delta queries for notebooks, so every backup enumerates all pages.  The
+// previous path of each section is kept in the backup metadata, so that
+// sections deleted since the previous backup get tombstoned.
+func CreateCollections(
+	ctx context.Context,
+	bpc inject.BackupProducerConfig,
+	bh BackupHandler,
+	tenantID string,
+	su support.StatusUpdater,
+	counter *count.Bus,
+	errs *fault.Bus,
+) ([]data.BackupCollection, bool, error) {
+	logger.Ctx(ctx).Debug("creating OneNote notebook collections")
+
+	var (
+		el          = errs.Local()
+		collections = []data.BackupCollection{}
+		currPaths   = map[string]string{}
+	)
+
+	prevPaths, canUsePreviousBackup, err := deserializeMetadata(ctx, bpc.MetadataCollections)
+	if err != nil {
+		return nil, false, err
+	}
+
+	h, err := getHierarchy(ctx, bh)
+	if err != nil {
+		return nil, false, clues.Wrap(err, "enumerating notebooks")
+	}
+
+	// any section in the previous backup that isn't backed up
+	// again gets tombstoned.
+	tombstones := maps.Clone(prevPaths)
+
+	for _, section := range h.sections {
+		if el.Failure() != nil {
+			break
+		}
+
+		var (
+			loc  = section.location()
+			ictx = clues.Add(
+				ctx,
+				"section_id", section.id,
+				"section_location", path.LoggableDir(loc.String()))
+		)
+
+		// sections that are no longer in scope get tombstoned as well.
+		if !bh.IncludesSection(loc.String()) {
+			counter.Inc(count.SkippedContainers)
+			continue
+		}
+
+		delete(tombstones, section.id)
+
+		counter.Inc(count.Containers)
+
+		pages, err := bh.GetPagesInSection(ictx, section.id)
+		if err != nil {
+			el.AddRecoverable(ictx, clues.Wrap(err, "getting section pages"))
+
+			// keep the section from the previous backup, rather
+			// than dropping it for a transient failure.
+			if prev, ok := prevPaths[section.id]; ok {
+				currPaths[section.id] = prev
+			}
+
+			continue
+		}
+
+		p, err := path.Build(
+			tenantID,
+			bpc.ProtectedResource.ID(),
+			bh.ServiceType(),
+			path.NotebooksCategory,
+			false,
+			section.ids...)
+		if err != nil {
+			el.AddRecoverable(ictx, clues.WrapWC(ictx, err, "creating section collection path").
+				Label(count.BadCollPath))
+			continue
+		}
+
+		var prevPath path.Path
+
+		if prev, ok := prevPaths[section.id]; ok {
+			prevPath, err = pathFromPrevString(prev)
+			if err != nil {
+				err := clues.StackWC(ictx, err).Label(count.BadPrevPath)
+				logger.CtxErr(ictx, err).Error("parsing section prev path")
+
+				prevPath = nil
+			}
+		}
+
+		currPaths[section.id] = p.String()
+
+		cl := counter.Local()
+		cl.Add(count.ItemsAdded, int64(len(pages)))
+
+		// all pages are enumerated, so items from the previous
+		// backup are never merged into the collection.
+		collections = append(
+			collections,
+			NewCollection(
+				data.NewBaseCollection(p, prevPath, loc, bpc.Options, true, cl),
+				bh,
+				pages,
+				su))
+	}
+
+	for id, p := range tombstones {
+		if el.Failure() != nil {
+			break
+		}
+
+		ictx := clues.Add(ctx, "tombstone_id", id)
+
+		prevPath, err := pathFromPrevString(p)
+		if err != nil {
+			err := clues.StackWC(ictx, err).Label(count.BadPrevPath)
+			logger.CtxErr(ictx, err).Error("parsing tombstone prev path")
+
+			continue
+		}
+
+		collections = append(collections, data.NewTombstoneCollection(prevPath, bpc.Options, counter.Local()))
+	}
+
+	pathPrefix, err := path.BuildMetadata(
+		tenantID,
+		bpc.ProtectedResource.ID(),
+		bh.ServiceType(),
+		path.NotebooksCategory,
+		false)
+	if err != nil {
+		return nil, false, clues.WrapWC(ctx, err, "making metadata path prefix").
+			Label(count.BadPathPrefix)
+	}
+
+	mdCol, err := graph.MakeMetadataCollection(
+		pathPrefix,
+		[]graph.MetadataCollectionEntry{
+			graph.NewMetadataEntry(metadata.PreviousPathFileName, currPaths),
+		},
+		su,
+		counter.Local())
+	if err != nil {
+		return nil, false, clues.WrapWC(ctx, err, "making metadata collection")
+	}
+
+	collections = append(collections, mdCol)
+
+	return collections, canUsePreviousBackup, el.Failure()
+}
+
+func pathFromPrevString(ps string) (path.Path, error) {
+	p, err := path.FromDataLayerPath(ps, false)
+	if err != nil {
+		return nil, clues.Wrap(err, "parsing previous path string")
+	}
+
+	return p, nil
+}
@@ -0,0 +1,157 @@
+package onenote
+
+import (
+	"testing"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/common/idname/mock"
+	"github.com/alcionai/corso/src/internal/data"
+	dataMock "github.com/alcionai/corso/src/internal/data/mock"
+	"github.com/alcionai/corso/src/internal/m365/support"
+	"github.com/alcionai/corso/src/internal/operations/inject"
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/backup/metadata"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/count"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
+)
+
+type BackupUnitSuite struct {
+	tester.Suite
+}
+
+func TestBackupUnitSuite(t *testing.T) {
+	suite.Run(t, &BackupUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func (suite *BackupUnitSuite) TestCreateCollections_incremental() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	var (
+		statusUpdater = func(*support.ControllerOperationStatus) {}
+		bpc           = inject.BackupProducerConfig{
+			Options:           control.DefaultOptions(),
+			ProtectedResource: mock.NewProvider("user", "user"),
+		}
+		bh = &mockBackupHandler{
+			mockRestoreHandler: mockRestoreHandler{
+				notebooks: []models.Notebookable{notebook("nb", "Notebook")},
+				sections: []models.OnenoteSectionable{
+					section("s1", "One", "nb", ""),
+					section("s2", "Two", "nb", ""),
+					section("s3", "Three", "nb", ""),
+				},
+				pages: map[string][]models.OnenotePageable{
+					"s1": {page("p1", "Page")},
+					"s2": {page("p2", "Page")},
+					"s3": {page("p3", "Page")},
+				},
+			},
+		}
+	)
+
+	// run creates the collections, keyed by the section id, along with
+	// the previous paths stored in the metadata.
+	run := func(
+		bpc inject.BackupProducerConfig,
+	) (map[string]data.BackupCollection, map[string]string) {
+		colls, canUsePreviousBackup, err := CreateCollections(
+			ctx,
+			bpc,
+			bh,
+			"tenant",
+			statusUpdater,
+			count.New(),
+			fault.New(true))
+		require.NoError(t, err, clues.ToCore(err))
+		assert.True(t, canUsePreviousBackup, "can use previous backup")
+
+		var (
+			byID   = map[string]data.BackupCollection{}
+			mdColl data.BackupCollection
+		)
+
+		for _, c := range colls {
+			p := c.FullPath()
+			if c.State() == data.DeletedState {
+				p = c.PreviousPath()
+			}
+
+			if p.Service() == path.OneDriveMetadataService {
+				mdColl = c
+				continue
+			}
+
+			byID[p.Elements().Last()] = c
+		}
+
+		require.NotNil(t, mdColl, "metadata collection")
+
+		prevPaths, ok, err := deserializeMetadata(
+			ctx,
+			[]data.RestoreCollection{
+				dataMock.NewUnversionedRestoreCollection(t, data.NoFetchRestoreCollection{Collection: mdColl}),
+			})
+		require.NoError(t, err, clues.ToCore(err))
+		require.True(t, ok, "metadata is readable")
+
+		return byID, prevPaths
+	}
+
+	colls, prevPaths := run(bpc)
+
+	require.Len(t, colls, 3)
+	assert.Len(t, prevPaths, 3)
+
+	for id, c := range colls {
+		assert.Equal(t, data.NewState, c.State(), "first backup state of %s", id)
+		assert.Equal(t, c.FullPath().String(), prevPaths[id], "previous path of %s", id)
+	}
+
+	// delete one section, and leave another out of scope.
+	bh.sections = bh.sections[:2]
+	bh.excluded = map[string]struct{}{
+		path.Builder{}.Append("Notebook", "Two").String(): {},
+	}
+
+	pathPrefix, err := path.BuildMetadata("tenant", "user", path.OneDriveService, path.NotebooksCategory, false)
+	require.NoError(t, err, clues.ToCore(err))
+
+	mdColl, err := graph.MakeMetadataCollection(
+		pathPrefix,
+		[]graph.MetadataCollectionEntry{
+			graph.NewMetadataEntry(metadata.PreviousPathFileName, prevPaths),
+		},
+		statusUpdater,
+		count.New())
+	require.NoError(t, err, clues.ToCore(err))
+
+	bpc.MetadataCollections = []data.RestoreCollection{
+		dataMock.NewUnversionedRestoreCollection(t, data.NoFetchRestoreCollection{Collection: mdColl}),
+	}
+
+	colls, nextPrevPaths := run(bpc)
+
+	require.Len(t, colls, 3)
+
+	assert.Equal(t, data.NotMovedState, colls["s1"].State())
+	assert.Equal(t, prevPaths["s1"], colls["s1"].PreviousPath().String())
+	assert.True(t, colls["s1"].DoNotMergeItems(), "all pages are enumerated")
+
+	for _, id := range []string{"s2", "s3"} {
+		assert.Equal(t, data.DeletedState, colls[id].State(), "tombstone for %s", id)
+		assert.Equal(t, prevPaths[id], colls[id].PreviousPath().String(), "tombstone path for %s", id)
+	}
+
+	assert.Equal(t, map[string]string{"s1": prevPaths["s1"]}, nextPrevPaths)
+}
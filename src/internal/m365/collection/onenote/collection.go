@@ -0,0 +1,199 @@
+package onenote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/data"
+	"github.com/alcionai/corso/src/internal/m365/support"
+	"github.com/alcionai/corso/src/internal/observe"
+	"github.com/alcionai/corso/src/pkg/errs/core"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/logger"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
+)
+
+var _ data.BackupCollection = &prefetchCollection{}
+
+const collectionChannelBufferSize = 1000
+
+// prefetchCollection holds the pages of a single section.  The content
+// and resources of each page are retrieved while streaming the items.
+type prefetchCollection struct {
+	data.BaseCollection
+
+	bh     BackupHandler
+	pages  []models.OnenotePageable
+	stream chan data.Item
+
+	statusUpdater support.StatusUpdater
+}
+
+func NewCollection(
+	baseCol data.BaseCollection,
+	bh BackupHandler,
+	pages []models.OnenotePageable,
+	statusUpdater support.StatusUpdater,
+) data.BackupCollection {
+	return &prefetchCollection{
+		BaseCollection: baseCol,
+		bh:             bh,
+		pages:          pages,
+		stream:         make(chan data.Item, collectionChannelBufferSize),
+		statusUpdater:  statusUpdater,
+	}
+}
+
+func (col *prefetchCollection) Items(
+	ctx context.Context,
+	errs *fault.Bus,
+) <-chan data.Item {
+	go col.streamItems(ctx, errs)
+	return col.stream
+}
+
+func (col *prefetchCollection) streamItems(ctx context.Context, errs *fault.Bus) {
+	var (
+		streamedItems   int64
+		totalBytes      int64
+		wg              sync.WaitGroup
+		progressMessage chan<- struct{}
+		el              = errs.Local()
+	)
+
+	ctx = clues.Add(ctx, "category", col.Category().String())
+
+	defer func() {
+		close(col.stream)
+		logger.Ctx(ctx).Infow(
+			"finished stream backup collection items",
+			"stats", col.Counter.Values())
+
+		status := support.CreateStatus(
+			ctx,
+			support.Backup,
+			1,
+			support.CollectionMetrics{
+				Objects:   len(col.pages),
+				Successes: int(streamedItems),
+				Bytes:     totalBytes,
+			},
+			col.FullPath().Folder(false))
+
+		logger.Ctx(ctx).Debugw("done streaming items", "status", status.String())
+
+		col.statusUpdater(status)
+	}()
+
+	if len(col.pages) > 0 {
+		progressMessage = observe.CollectionProgress(
+			ctx,
+			col.Category().HumanString(),
+			col.LocationPath().Elements())
+		defer close(progressMessage)
+	}
+
+	semaphoreCh := make(chan struct{}, col.Opts().Parallelism.ItemFetch)
+	defer close(semaphoreCh)
+
+	for _, page := range col.pages {
+		if el.Failure() != nil {
+			break
+		}
+
+		wg.Add(1)
+		semaphoreCh <- struct{}{}
+
+		go func(page models.OnenotePageable) {
+			defer wg.Done()
+			defer func() { <-semaphoreCh }()
+
+			ictx := clues.Add(
+				ctx,
+				"page_id", ptr.Val(page.GetId()),
+				"parent_path", path.LoggableDir(col.LocationPath().String()))
+
+			item, size, err := col.getPage(ictx, page)
+			if err != nil {
+				// pages deleted in flight don't need to fail the backup.
+				if clues.HasLabel(err, graph.LabelStatus(http.StatusNotFound)) || errors.Is(err, core.ErrNotFound) {
+					logger.CtxErr(ictx, err).Info("page deleted in flight. skipping")
+					return
+				}
+
+				el.AddRecoverable(ictx, clues.Stack(err).Label(fault.LabelForceNoBackupCreation))
+
+				return
+			}
+
+			col.stream <- item
+
+			atomic.AddInt64(&streamedItems, 1)
+			atomic.AddInt64(&totalBytes, size)
+
+			if progressMessage != nil {
+				progressMessage <- struct{}{}
+			}
+		}(page)
+	}
+
+	wg.Wait()
+}
+
+// getPage retrieves the html and resources of the page, producing the
+// serialized page as an item.
+func (col *prefetchCollection) getPage(
+	ctx context.Context,
+	page models.OnenotePageable,
+) (data.Item, int64, error) {
+	p := pageFromModel(page)
+
+	body, err := col.bh.GetPageContent(ctx, p.ID)
+	if err != nil {
+		return nil, 0, clues.Wrap(err, "getting page content")
+	}
+
+	p.HTML = string(body)
+
+	for _, id := range resourceIDs(p.HTML) {
+		content, err := col.bh.GetResourceContent(ctx, id)
+		if err != nil {
+			return nil, 0, clues.Wrap(err, "getting page resource").With("resource_id", id)
+		}
+
+		p.Resources = append(p.Resources, Resource{
+			ID:          id,
+			ContentType: http.DetectContentType(content),
+			Content:     content,
+		})
+	}
+
+	bs, err := json.Marshal(p)
+	if err != nil {
+		return nil, 0, clues.WrapWC(ctx, err, "serializing page")
+	}
+
+	size := int64(len(bs))
+	info := col.bh.PageInfo(p, col.LocationPath(), size)
+
+	item, err := data.NewPrefetchedItemWithInfo(
+		io.NopCloser(bytes.NewReader(bs)),
+		p.ID,
+		info)
+	if err != nil {
+		return nil, 0, clues.StackWC(ctx, err)
+	}
+
+	return item, size, nil
+}
@@ -0,0 +1,153 @@
+package onenote
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/alcionai/clues"
+
+	"github.com/alcionai/corso/src/internal/data"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/export"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/metrics"
+	"github.com/alcionai/corso/src/pkg/path"
+)
+
+const pageFileExtension = ".html"
+
+// NewExportCollection produces an export collection of the pages in the
+// backing collections.  Each page is exported as a standalone html file,
+// with its images and files embedded as data urls.
+func NewExportCollection(
+	baseDir string,
+	backingCollection []data.RestoreCollection,
+	backupVersion int,
+	stats *metrics.ExportStats,
+) export.Collectioner {
+	return export.BaseCollection{
+		BaseDir:           baseDir,
+		BackingCollection: backingCollection,
+		BackupVersion:     backupVersion,
+		Stream:            streamItems,
+		Stats:             stats,
+	}
+}
+
+func streamItems(
+	ctx context.Context,
+	drc []data.RestoreCollection,
+	backupVersion int,
+	config control.ExportConfig,
+	ch chan<- export.Item,
+	stats *metrics.ExportStats,
+) {
+	defer close(ch)
+
+	var (
+		errs = fault.New(false)
+		// page titles aren't unique within a section.
+		names = map[string]int{}
+	)
+
+	for _, rc := range drc {
+		for item := range rc.Items(ctx, errs) {
+			ictx := clues.Add(ctx, "page_id", item.ID())
+
+			reader := item.ToReader()
+			content, err := io.ReadAll(reader)
+
+			reader.Close()
+
+			if err != nil {
+				ch <- export.Item{
+					ID:    item.ID(),
+					Error: clues.WrapWC(ictx, err, "reading page bytes"),
+				}
+
+				continue
+			}
+
+			page, err := BytesToPage(content)
+			if err != nil {
+				ch <- export.Item{
+					ID:    item.ID(),
+					Error: clues.StackWC(ictx, err),
+				}
+
+				continue
+			}
+
+			body := formatPageHTML(page)
+
+			stats.UpdateResourceCount(path.NotebooksCategory)
+
+			ch <- export.Item{
+				ID:   item.ID(),
+				Name: uniqueName(names, page.Title, item.ID()) + pageFileExtension,
+				Body: metrics.ReaderWithStats(
+					io.NopCloser(strings.NewReader(body)),
+					path.NotebooksCategory,
+					stats),
+			}
+		}
+
+		items, recovered := errs.ItemsAndRecovered()
+
+		// Return all the items that we failed to source from the persistence layer
+		for _, item := range items {
+			ch <- export.Item{
+				ID:    item.ID,
+				Error: &item,
+			}
+		}
+
+		for _, err := range recovered {
+			ch <- export.Item{
+				Error: err,
+			}
+		}
+	}
+}
+
+// formatPageHTML produces the html of the page with each resource url
+// replaced by a data url holding the resource content.
+func formatPageHTML(page Page) string {
+	urls := map[string]string{}
+
+	for _, r := range page.Resources {
+		urls[r.ID] = fmt.Sprintf(
+			"data:%s;base64,%s",
+			r.ContentType,
+			base64.StdEncoding.EncodeToString(r.Content))
+	}
+
+	return replaceResourceURLs(page.HTML, func(id string) (string, bool) {
+		u, ok := urls[id]
+		return u, ok
+	})
+}
+
+// uniqueName produces a file name for the page from its title, falling back
+// to the page id for untitled pages, and numbering repeated titles.
+func uniqueName(names map[string]int, title, id string) string {
+	name := strings.TrimSpace(title)
+	if len(name) == 0 {
+		name = id
+	}
+
+	// titles can hold characters that aren't valid in file names.
+	name = strings.NewReplacer("/", "_", "\\", "_").Replace(name)
+
+	n := names[name]
+	names[name] = n + 1
+
+	if n > 0 {
+		name = fmt.Sprintf("%s (%d)", name, n)
+	}
+
+	return name
+}
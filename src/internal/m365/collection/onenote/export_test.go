@@ -0,0 +1,47 @@
+package onenote
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/tester"
+)
+
+type ExportUnitSuite struct {
+	tester.Suite
+}
+
+func TestExportUnitSuite(t *testing.T) {
+	suite.Run(t, &ExportUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func (suite *ExportUnitSuite) TestFormatPageHTML() {
+	t := suite.T()
+
+	html := formatPageHTML(Page{
+		HTML: pageHTML,
+		Resources: []Resource{
+			{ID: "0-a1!1-b2", ContentType: "image/png", Content: []byte("png")},
+			{ID: "0-c3!1-d4", ContentType: "application/pdf", Content: []byte("pdf")},
+		},
+	})
+
+	assert.NotContains(t, html, imgURL)
+	assert.NotContains(t, html, fileURL)
+	assert.Contains(t, html, `src="data:image/png;base64,cG5n"`)
+	assert.Contains(t, html, `data="data:application/pdf;base64,cGRm"`)
+}
+
+func (suite *ExportUnitSuite) TestUniqueName() {
+	var (
+		t     = suite.T()
+		names = map[string]int{}
+	)
+
+	assert.Equal(t, "Notes", uniqueName(names, "Notes", "id1"))
+	assert.Equal(t, "Notes (1)", uniqueName(names, "Notes", "id2"))
+	assert.Equal(t, "id3", uniqueName(names, "  ", "id3"))
+	assert.Equal(t, "a_b", uniqueName(names, "a/b", "id4"))
+}
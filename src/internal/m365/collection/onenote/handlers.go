@@ -0,0 +1,169 @@
+package onenote
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+)
+
+// BackupHandler contains the calls and service specific behavior needed
+// to back up the notebooks of a user or site.
+type BackupHandler interface {
+	enumerateNotebookser
+	getPageser
+	getPageContenter
+	itemInfoer
+
+	// ServiceType is the service of the paths produced for the notebooks.
+	ServiceType() path.ServiceType
+	// IncludesSection checks whether the section location, made of the
+	// notebook, section group, and section names, is in the backup scope.
+	IncludesSection(location string) bool
+}
+
+// RestoreHandler contains the calls and service specific behavior needed
+// to restore pages into the notebooks of a user or site.
+type RestoreHandler interface {
+	enumerateNotebookser
+	getPageser
+	containerCreator
+	pagePoster
+	pageDeleter
+	itemInfoer
+}
+
+type enumerateNotebookser interface {
+	EnumerateNotebooks(ctx context.Context) ([]models.Notebookable, error)
+	EnumerateSectionGroups(ctx context.Context) ([]models.SectionGroupable, error)
+	EnumerateSections(ctx context.Context) ([]models.OnenoteSectionable, error)
+}
+
+type getPageser interface {
+	GetPagesInSection(ctx context.Context, sectionID string) ([]models.OnenotePageable, error)
+}
+
+type getPageContenter interface {
+	GetPageContent(ctx context.Context, pageID string) ([]byte, error)
+	GetResourceContent(ctx context.Context, resourceID string) ([]byte, error)
+}
+
+type containerCreator interface {
+	CreateNotebook(ctx context.Context, name string) (models.Notebookable, error)
+	CreateSectionGroup(
+		ctx context.Context,
+		notebookID, parentGroupID, name string,
+	) (models.SectionGroupable, error)
+	CreateSection(
+		ctx context.Context,
+		notebookID, parentGroupID, name string,
+	) (models.OnenoteSectionable, error)
+}
+
+type pagePoster interface {
+	CreatePage(
+		ctx context.Context,
+		sectionID, html string,
+		parts []api.OneNotePagePart,
+	) (models.OnenotePageable, error)
+}
+
+type pageDeleter interface {
+	DeletePage(ctx context.Context, pageID string) error
+}
+
+type itemInfoer interface {
+	// PageInfo produces the service specific details for a page.
+	PageInfo(page Page, parentPath *path.Builder, size int64) details.ItemInfo
+}
+
+// ---------------------------------------------------------------------------
+// shared api calls
+// ---------------------------------------------------------------------------
+
+type baseNotebookHandler struct {
+	ac    api.OneNote
+	owner api.OneNoteOwner
+}
+
+func (h baseNotebookHandler) ServiceType() path.ServiceType {
+	return h.owner.Service
+}
+
+func (h baseNotebookHandler) EnumerateNotebooks(
+	ctx context.Context,
+) ([]models.Notebookable, error) {
+	return h.ac.EnumerateNotebooks(ctx, h.owner)
+}
+
+func (h baseNotebookHandler) EnumerateSectionGroups(
+	ctx context.Context,
+) ([]models.SectionGroupable, error) {
+	return h.ac.EnumerateSectionGroups(ctx, h.owner)
+}
+
+func (h baseNotebookHandler) EnumerateSections(
+	ctx context.Context,
+) ([]models.OnenoteSectionable, error) {
+	return h.ac.EnumerateSections(ctx, h.owner)
+}
+
+func (h baseNotebookHandler) GetPagesInSection(
+	ctx context.Context,
+	sectionID string,
+) ([]models.OnenotePageable, error) {
+	return h.ac.GetPagesInSection(ctx, h.owner, sectionID)
+}
+
+func (h baseNotebookHandler) GetPageContent(
+	ctx context.Context,
+	pageID string,
+) ([]byte, error) {
+	return h.ac.GetPageContent(ctx, h.owner, pageID)
+}
+
+func (h baseNotebookHandler) GetResourceContent(
+	ctx context.Context,
+	resourceID string,
+) ([]byte, error) {
+	return h.ac.GetResourceContent(ctx, h.owner, resourceID)
+}
+
+func (h baseNotebookHandler) CreateNotebook(
+	ctx context.Context,
+	name string,
+) (models.Notebookable, error) {
+	return h.ac.CreateNotebook(ctx, h.owner, name)
+}
+
+func (h baseNotebookHandler) CreateSectionGroup(
+	ctx context.Context,
+	notebookID, parentGroupID, name string,
+) (models.SectionGroupable, error) {
+	return h.ac.CreateSectionGroup(ctx, h.owner, notebookID, parentGroupID, name)
+}
+
+func (h baseNotebookHandler) CreateSection(
+	ctx context.Context,
+	notebookID, parentGroupID, name string,
+) (models.OnenoteSectionable, error) {
+	return h.ac.CreateSection(ctx, h.owner, notebookID, parentGroupID, name)
+}
+
+func (h baseNotebookHandler) CreatePage(
+	ctx context.Context,
+	sectionID, html string,
+	parts []api.OneNotePagePart,
+) (models.OnenotePageable, error) {
+	return h.ac.CreatePage(ctx, h.owner, sectionID, html, parts)
+}
+
+func (h baseNotebookHandler) DeletePage(
+	ctx context.Context,
+	pageID string,
+) error {
+	return h.ac.DeletePage(ctx, h.owner, pageID)
+}
@@ -0,0 +1,176 @@
+package onenote
+
+import (
+	"context"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+)
+
+func notebook(id, name string) models.Notebookable {
+	nb := models.NewNotebook()
+	nb.SetId(ptr.To(id))
+	nb.SetDisplayName(ptr.To(name))
+
+	return nb
+}
+
+func sectionGroup(id, name, notebookID, parentGroupID string) models.SectionGroupable {
+	sg := models.NewSectionGroup()
+	sg.SetId(ptr.To(id))
+	sg.SetDisplayName(ptr.To(name))
+	sg.SetParentNotebook(notebook(notebookID, ""))
+
+	if len(parentGroupID) > 0 {
+		parent := models.NewSectionGroup()
+		parent.SetId(ptr.To(parentGroupID))
+		sg.SetParentSectionGroup(parent)
+	}
+
+	return sg
+}
+
+func section(id, name, notebookID, parentGroupID string) models.OnenoteSectionable {
+	s := models.NewOnenoteSection()
+	s.SetId(ptr.To(id))
+	s.SetDisplayName(ptr.To(name))
+	s.SetParentNotebook(notebook(notebookID, ""))
+
+	if len(parentGroupID) > 0 {
+		parent := models.NewSectionGroup()
+		parent.SetId(ptr.To(parentGroupID))
+		s.SetParentSectionGroup(parent)
+	}
+
+	return s
+}
+
+func page(id, title string) models.OnenotePageable {
+	p := models.NewOnenotePage()
+	p.SetId(ptr.To(id))
+	p.SetTitle(ptr.To(title))
+
+	return p
+}
+
+var _ RestoreHandler = &mockRestoreHandler{}
+
+type mockRestoreHandler struct {
+	notebooks []models.Notebookable
+	groups    []models.SectionGroupable
+	sections  []models.OnenoteSectionable
+	pages     map[string][]models.OnenotePageable
+
+	// calls records each container created.
+	calls []string
+	// deleted records the id of each page deleted.
+	deleted []string
+	// posted records the html of each page created, by section id.
+	posted map[string][]string
+}
+
+func (h *mockRestoreHandler) EnumerateNotebooks(
+	context.Context,
+) ([]models.Notebookable, error) {
+	return h.notebooks, nil
+}
+
+func (h *mockRestoreHandler) EnumerateSectionGroups(
+	context.Context,
+) ([]models.SectionGroupable, error) {
+	return h.groups, nil
+}
+
+func (h *mockRestoreHandler) EnumerateSections(
+	context.Context,
+) ([]models.OnenoteSectionable, error) {
+	return h.sections, nil
+}
+
+func (h *mockRestoreHandler) GetPagesInSection(
+	_ context.Context,
+	sectionID string,
+) ([]models.OnenotePageable, error) {
+	return h.pages[sectionID], nil
+}
+
+func (h *mockRestoreHandler) CreateNotebook(
+	_ context.Context,
+	name string,
+) (models.Notebookable, error) {
+	h.calls = append(h.calls, "notebook:"+name)
+	return notebook("new-"+name, name), nil
+}
+
+func (h *mockRestoreHandler) CreateSectionGroup(
+	_ context.Context,
+	notebookID, parentGroupID, name string,
+) (models.SectionGroupable, error) {
+	h.calls = append(h.calls, "group:"+notebookID+":"+parentGroupID+":"+name)
+	return sectionGroup("new-"+name, name, notebookID, parentGroupID), nil
+}
+
+func (h *mockRestoreHandler) CreateSection(
+	_ context.Context,
+	notebookID, parentGroupID, name string,
+) (models.OnenoteSectionable, error) {
+	h.calls = append(h.calls, "section:"+notebookID+":"+parentGroupID+":"+name)
+	return section("new-"+name, name, notebookID, parentGroupID), nil
+}
+
+func (h *mockRestoreHandler) CreatePage(
+	_ context.Context,
+	sectionID, html string,
+	_ []api.OneNotePagePart,
+) (models.OnenotePageable, error) {
+	if h.posted == nil {
+		h.posted = map[string][]string{}
+	}
+
+	h.posted[sectionID] = append(h.posted[sectionID], html)
+
+	return page("new-page", ""), nil
+}
+
+func (h *mockRestoreHandler) DeletePage(_ context.Context, pageID string) error {
+	h.deleted = append(h.deleted, pageID)
+	return nil
+}
+
+func (h *mockRestoreHandler) PageInfo(
+	page Page,
+	parentPath *path.Builder,
+	size int64,
+) details.ItemInfo {
+	return userPageInfo(page, parentPath, size)
+}
+
+var _ BackupHandler = &mockBackupHandler{}
+
+type mockBackupHandler struct {
+	mockRestoreHandler
+
+	// excluded holds the locations of sections outside the backup scope.
+	excluded map[string]struct{}
+}
+
+func (h *mockBackupHandler) GetPageContent(context.Context, string) ([]byte, error) {
+	return []byte("<html></html>"), nil
+}
+
+func (h *mockBackupHandler) GetResourceContent(context.Context, string) ([]byte, error) {
+	return nil, nil
+}
+
+func (h *mockBackupHandler) ServiceType() path.ServiceType {
+	return path.OneDriveService
+}
+
+func (h *mockBackupHandler) IncludesSection(location string) bool {
+	_, ok := h.excluded[location]
+	return !ok
+}
@@ -0,0 +1,167 @@
+package onenote
+
+import (
+	"context"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/pkg/path"
+)
+
+// container is a notebook, section group, or section, along with the ids
+// and display names of every container from its notebook down to itself.
+type container struct {
+	id string
+	// ids holds the ids of the notebook, any section groups, and the container.
+	ids []string
+	// names holds the display names matching each of the ids.
+	names []string
+}
+
+func (c container) location() *path.Builder {
+	return path.Builder{}.Append(c.names...)
+}
+
+// hierarchy holds the containers of all of an owner's notebooks.
+type hierarchy struct {
+	notebooks []container
+	groups    []container
+	sections  []container
+}
+
+// getHierarchy enumerates the notebooks, section groups, and sections of
+// the owner.  Graph lists section groups and sections as flat sets, so the
+// chain of parents for each is assembled from the expanded parent fields.
+func getHierarchy(ctx context.Context, enh enumerateNotebookser) (hierarchy, error) {
+	h := hierarchy{}
+
+	notebooks, err := enh.EnumerateNotebooks(ctx)
+	if err != nil {
+		return h, clues.Stack(err)
+	}
+
+	groups, err := enh.EnumerateSectionGroups(ctx)
+	if err != nil {
+		return h, clues.Stack(err)
+	}
+
+	sections, err := enh.EnumerateSections(ctx)
+	if err != nil {
+		return h, clues.Stack(err)
+	}
+
+	var (
+		nbByID    = map[string]container{}
+		groupByID = map[string]models.SectionGroupable{}
+		resolved  = map[string]container{}
+	)
+
+	for _, nb := range notebooks {
+		id := ptr.Val(nb.GetId())
+		c := container{
+			id:    id,
+			ids:   []string{id},
+			names: []string{ptr.Val(nb.GetDisplayName())},
+		}
+
+		nbByID[id] = c
+		h.notebooks = append(h.notebooks, c)
+	}
+
+	for _, g := range groups {
+		groupByID[ptr.Val(g.GetId())] = g
+	}
+
+	// resolveGroup produces the container for the group, recursively
+	// resolving its parents.  Visited tracks the groups in the current
+	// chain so that malformed responses can't produce a cycle.
+	var resolveGroup func(id string, visited map[string]struct{}) (container, bool)
+
+	resolveGroup = func(id string, visited map[string]struct{}) (container, bool) {
+		if c, ok := resolved[id]; ok {
+			return c, true
+		}
+
+		g, ok := groupByID[id]
+		if !ok {
+			return container{}, false
+		}
+
+		if _, ok := visited[id]; ok {
+			return container{}, false
+		}
+
+		visited[id] = struct{}{}
+
+		parent, ok := parentContainer(g.GetParentSectionGroup(), g.GetParentNotebook(), nbByID, resolveGroup, visited)
+		if !ok {
+			return container{}, false
+		}
+
+		c := container{
+			id:    id,
+			ids:   append(append([]string{}, parent.ids...), id),
+			names: append(append([]string{}, parent.names...), ptr.Val(g.GetDisplayName())),
+		}
+
+		resolved[id] = c
+
+		return c, true
+	}
+
+	for _, g := range groups {
+		c, ok := resolveGroup(ptr.Val(g.GetId()), map[string]struct{}{})
+		if !ok {
+			return h, clues.NewWC(ctx, "section group parent not found").
+				With("section_group_id", ptr.Val(g.GetId()))
+		}
+
+		h.groups = append(h.groups, c)
+	}
+
+	for _, s := range sections {
+		id := ptr.Val(s.GetId())
+
+		parent, ok := parentContainer(
+			s.GetParentSectionGroup(),
+			s.GetParentNotebook(),
+			nbByID,
+			resolveGroup,
+			map[string]struct{}{})
+		if !ok {
+			return h, clues.NewWC(ctx, "section parent not found").With("section_id", id)
+		}
+
+		h.sections = append(h.sections, container{
+			id:    id,
+			ids:   append(append([]string{}, parent.ids...), id),
+			names: append(append([]string{}, parent.names...), ptr.Val(s.GetDisplayName())),
+		})
+	}
+
+	return h, nil
+}
+
+// parentContainer produces the container of a section group or section's
+// parent: the section group, if populated, or else the notebook.
+func parentContainer(
+	group models.SectionGroupable,
+	notebook models.Notebookable,
+	nbByID map[string]container,
+	resolveGroup func(string, map[string]struct{}) (container, bool),
+	visited map[string]struct{},
+) (container, bool) {
+	if group != nil && len(ptr.Val(group.GetId())) > 0 {
+		return resolveGroup(ptr.Val(group.GetId()), visited)
+	}
+
+	if notebook == nil {
+		return container{}, false
+	}
+
+	c, ok := nbByID[ptr.Val(notebook.GetId())]
+
+	return c, ok
+}
@@ -0,0 +1,123 @@
+package onenote
+
+import (
+	"testing"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/tester"
+)
+
+type HierarchyUnitSuite struct {
+	tester.Suite
+}
+
+func TestHierarchyUnitSuite(t *testing.T) {
+	suite.Run(t, &HierarchyUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func (suite *HierarchyUnitSuite) TestGetHierarchy() {
+	table := []struct {
+		name          string
+		h             *mockRestoreHandler
+		expectGroups  map[string][]string
+		expectSection map[string][]string
+		expectErr     assert.ErrorAssertionFunc
+	}{
+		{
+			name: "sections at the notebook root",
+			h: &mockRestoreHandler{
+				notebooks: []models.Notebookable{notebook("nb", "Notebook")},
+				sections: []models.OnenoteSectionable{
+					section("s1", "One", "nb", ""),
+					section("s2", "Two", "nb", ""),
+				},
+			},
+			expectGroups: map[string][]string{},
+			expectSection: map[string][]string{
+				"s1": {"Notebook", "One"},
+				"s2": {"Notebook", "Two"},
+			},
+			expectErr: assert.NoError,
+		},
+		{
+			name: "nested section groups",
+			h: &mockRestoreHandler{
+				notebooks: []models.Notebookable{notebook("nb", "Notebook")},
+				// children are listed before their parents.
+				groups: []models.SectionGroupable{
+					sectionGroup("g2", "Inner", "nb", "g1"),
+					sectionGroup("g1", "Outer", "nb", ""),
+				},
+				sections: []models.OnenoteSectionable{
+					section("s1", "One", "nb", "g2"),
+					section("s2", "Two", "nb", "g1"),
+				},
+			},
+			expectGroups: map[string][]string{
+				"g1": {"Notebook", "Outer"},
+				"g2": {"Notebook", "Outer", "Inner"},
+			},
+			expectSection: map[string][]string{
+				"s1": {"Notebook", "Outer", "Inner", "One"},
+				"s2": {"Notebook", "Outer", "Two"},
+			},
+			expectErr: assert.NoError,
+		},
+		{
+			name: "missing notebook",
+			h: &mockRestoreHandler{
+				sections: []models.OnenoteSectionable{section("s1", "One", "nb", "")},
+			},
+			expectErr: assert.Error,
+		},
+		{
+			name: "section group cycle",
+			h: &mockRestoreHandler{
+				notebooks: []models.Notebookable{notebook("nb", "Notebook")},
+				groups: []models.SectionGroupable{
+					sectionGroup("g1", "One", "nb", "g2"),
+					sectionGroup("g2", "Two", "nb", "g1"),
+				},
+			},
+			expectErr: assert.Error,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			h, err := getHierarchy(ctx, test.h)
+			test.expectErr(t, err, clues.ToCore(err))
+
+			if err != nil {
+				return
+			}
+
+			require.Len(t, h.notebooks, 1)
+			assert.Equal(t, []string{"Notebook"}, h.notebooks[0].names)
+
+			groups := map[string][]string{}
+			for _, g := range h.groups {
+				groups[g.id] = g.names
+				assert.Len(t, g.ids, len(g.names))
+			}
+
+			sections := map[string][]string{}
+			for _, s := range h.sections {
+				sections[s.id] = s.names
+				assert.Equal(t, s.id, s.ids[len(s.ids)-1])
+			}
+
+			assert.Equal(t, test.expectGroups, groups)
+			assert.Equal(t, test.expectSection, sections)
+		})
+	}
+}
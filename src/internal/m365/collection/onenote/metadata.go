@@ -0,0 +1,106 @@
+package onenote
+
+import (
+	"context"
+
+	"github.com/alcionai/clues"
+
+	"github.com/alcionai/corso/src/internal/data"
+	"github.com/alcionai/corso/src/internal/m365/collection/drive"
+	"github.com/alcionai/corso/src/pkg/backup/metadata"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/logger"
+	"github.com/alcionai/corso/src/pkg/path"
+)
+
+// MetadataFileNames contains the previous path of each section.  Graph
+// provides no delta queries for notebooks, so no delta links are kept.
+func MetadataFileNames() []string {
+	return []string{metadata.PreviousPathFileName}
+}
+
+// SplitMetadata separates the notebooks metadata from the metadata of
+// the other categories backed up by the same service.  The drive metadata
+// of files and libraries is keyed by drive, and can't be read along with
+// the previous paths of the sections.
+func SplitMetadata(
+	colls []data.RestoreCollection,
+) ([]data.RestoreCollection, []data.RestoreCollection) {
+	var (
+		mdColls       = make([]data.RestoreCollection, 0, len(colls))
+		notebookColls = []data.RestoreCollection{}
+	)
+
+	for _, coll := range colls {
+		fp := coll.FullPath()
+
+		if fp != nil && fp.Category() == path.NotebooksCategory {
+			notebookColls = append(notebookColls, coll)
+			continue
+		}
+
+		mdColls = append(mdColls, coll)
+	}
+
+	return mdColls, notebookColls
+}
+
+// deserializeMetadata produces the previous path of each section, keyed
+// by the section id.  Returns false if the metadata couldn't be read, in
+// which case the previous backup can't be used.
+func deserializeMetadata(
+	ctx context.Context,
+	colls []data.RestoreCollection,
+) (map[string]string, bool, error) {
+	var (
+		prevPaths = map[string]string{}
+		// metadata item reads should not fail backup
+		errs = fault.New(true)
+	)
+
+	for _, coll := range colls {
+		if errs.Failure() != nil {
+			break
+		}
+
+		if coll.FullPath().Category() != path.NotebooksCategory {
+			continue
+		}
+
+		items := coll.Items(ctx, errs)
+
+		for breakLoop := false; !breakLoop; {
+			select {
+			case <-ctx.Done():
+				return nil, false, clues.WrapWC(ctx, ctx.Err(), "deserializing previous notebooks metadata")
+
+			case item, ok := <-items:
+				if !ok {
+					breakLoop = true
+					break
+				}
+
+				if item.ID() != metadata.PreviousPathFileName {
+					logger.Ctx(ctx).Infow(
+						"skipping unknown metadata file",
+						"file_name", item.ID())
+
+					continue
+				}
+
+				if err := drive.DeserializeMap(item.ToReader(), prevPaths); err != nil {
+					logger.CtxErr(ctx, err).Info("deserializing notebooks previous paths")
+					return map[string]string{}, false, nil
+				}
+			}
+		}
+	}
+
+	// if reads from items failed, return empty but no error
+	if errs.Failure() != nil {
+		logger.CtxErr(ctx, errs.Failure()).Info("reading notebooks metadata collection items")
+		return map[string]string{}, false, nil
+	}
+
+	return prevPaths, true, nil
+}
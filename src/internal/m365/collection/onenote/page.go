@@ -0,0 +1,93 @@
+package onenote
+
+import (
+	"encoding/json"
+	"regexp"
+	"time"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+)
+
+// Page is the stored form of a OneNote page.  Graph only produces the page
+// body as html, with images and attached files referenced by url, so the
+// content of each of those resources is stored alongside the html.
+type Page struct {
+	ID        string     `json:"id"`
+	Title     string     `json:"title"`
+	Level     int32      `json:"level"`
+	Order     int32      `json:"order"`
+	Created   time.Time  `json:"created"`
+	Modified  time.Time  `json:"modified"`
+	HTML      string     `json:"html"`
+	Resources []Resource `json:"resources,omitempty"`
+}
+
+// Resource is an image or file embedded in a page.
+type Resource struct {
+	ID          string `json:"id"`
+	ContentType string `json:"contentType"`
+	Content     []byte `json:"content"`
+}
+
+func pageFromModel(page models.OnenotePageable) Page {
+	return Page{
+		ID:       ptr.Val(page.GetId()),
+		Title:    ptr.Val(page.GetTitle()),
+		Level:    ptr.Val(page.GetLevel()),
+		Order:    ptr.Val(page.GetOrder()),
+		Created:  ptr.Val(page.GetCreatedDateTime()),
+		Modified: ptr.OrNow(page.GetLastModifiedDateTime()),
+	}
+}
+
+// BytesToPage deserializes a page produced by a backup.
+func BytesToPage(bs []byte) (Page, error) {
+	p := Page{}
+
+	err := json.Unmarshal(bs, &p)
+
+	return p, clues.Wrap(err, "deserializing onenote page").OrNil()
+}
+
+// resourceURLRE matches the urls of page resources in the html of a page,
+// capturing the resource id.  Ex:
+// https://graph.microsoft.com/v1.0/users('id')/onenote/resources/0-8a!1-ab/$value
+var resourceURLRE = regexp.MustCompile(`https://[^"\s<>]+?/onenote/resources/([^/"\s<>]+)/(?:\$value|content)`)
+
+// resourceIDs produces the ids of all resources referenced by the html,
+// in the order they first appear.
+func resourceIDs(html string) []string {
+	var (
+		ids  = []string{}
+		seen = map[string]struct{}{}
+	)
+
+	for _, m := range resourceURLRE.FindAllStringSubmatch(html, -1) {
+		if _, ok := seen[m[1]]; ok {
+			continue
+		}
+
+		seen[m[1]] = struct{}{}
+		ids = append(ids, m[1])
+	}
+
+	return ids
+}
+
+// replaceResourceURLs replaces each resource url in the html with the value
+// produced by repl for the resource's id.  Urls for resources which repl
+// doesn't produce a value for are left as-is.
+func replaceResourceURLs(html string, repl func(id string) (string, bool)) string {
+	return resourceURLRE.ReplaceAllStringFunc(html, func(u string) string {
+		m := resourceURLRE.FindStringSubmatch(u)
+
+		if v, ok := repl(m[1]); ok {
+			return v
+		}
+
+		return u
+	})
+}
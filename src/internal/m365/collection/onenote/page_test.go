@@ -0,0 +1,78 @@
+package onenote
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/tester"
+)
+
+type PageUnitSuite struct {
+	tester.Suite
+}
+
+func TestPageUnitSuite(t *testing.T) {
+	suite.Run(t, &PageUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+const (
+	imgURL  = "https://graph.microsoft.com/v1.0/users('u')/onenote/resources/0-a1!1-b2/$value"
+	fileURL = "https://graph.microsoft.com/v1.0/users('u')/onenote/resources/0-c3!1-d4/content"
+)
+
+var pageHTML = `<html><body>` +
+	`<img src="` + imgURL + `" data-src-type="image/png" />` +
+	`<object data="` + fileURL + `" data-attachment="report.pdf" type="application/pdf" />` +
+	`<img src="` + imgURL + `" />` +
+	`<a href="https://example.com/onenote/other">link</a>` +
+	`</body></html>`
+
+func (suite *PageUnitSuite) TestResourceIDs() {
+	assert.Equal(
+		suite.T(),
+		[]string{"0-a1!1-b2", "0-c3!1-d4"},
+		resourceIDs(pageHTML))
+}
+
+func (suite *PageUnitSuite) TestReplaceResourceURLs() {
+	result := replaceResourceURLs(pageHTML, func(id string) (string, bool) {
+		if id == "0-a1!1-b2" {
+			return "name:img", true
+		}
+
+		return "", false
+	})
+
+	t := suite.T()
+
+	assert.NotContains(t, result, imgURL)
+	assert.Contains(t, result, `<img src="name:img" data-src-type="image/png" />`)
+	assert.Contains(t, result, fileURL, "resources without a replacement are left as-is")
+	assert.Contains(t, result, "https://example.com/onenote/other")
+}
+
+func (suite *PageUnitSuite) TestPageParts() {
+	t := suite.T()
+
+	html, parts := pageParts(Page{
+		HTML: pageHTML,
+		Resources: []Resource{
+			{ID: "0-a1!1-b2", ContentType: "image/png", Content: []byte("png")},
+			{ID: "0-c3!1-d4", ContentType: "application/pdf", Content: []byte("pdf")},
+		},
+	})
+
+	assert.NotContains(t, html, imgURL)
+	assert.NotContains(t, html, fileURL)
+	assert.Contains(t, html, `src="name:resource0"`)
+	assert.Contains(t, html, `data="name:resource1"`)
+
+	if assert.Len(t, parts, 2) {
+		assert.Equal(t, "resource0", parts[0].Name)
+		assert.Equal(t, "image/png", parts[0].ContentType)
+		assert.Equal(t, []byte("png"), parts[0].Content)
+		assert.Equal(t, "resource1", parts[1].Name)
+	}
+}
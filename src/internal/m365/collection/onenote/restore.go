@@ -0,0 +1,374 @@
+package onenote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"runtime/trace"
+
+	"github.com/alcionai/clues"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/data"
+	"github.com/alcionai/corso/src/internal/diagnostics"
+	"github.com/alcionai/corso/src/internal/m365/support"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/count"
+	"github.com/alcionai/corso/src/pkg/errs/core"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/logger"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+)
+
+// RestoreCaches tracks the notebooks, section groups, and sections in the
+// restore target, so that each container is only created once per restore.
+type RestoreCaches struct {
+	populated bool
+	// parentIDs maps the location of notebooks and section groups to their id.
+	parentIDs map[string]string
+	// sectionIDs maps the location of sections to their id.
+	sectionIDs map[string]string
+	// existingSections holds the locations of sections that existed
+	// before the restore began.
+	existingSections map[string]struct{}
+}
+
+func NewRestoreCaches() *RestoreCaches {
+	return &RestoreCaches{
+		parentIDs:        map[string]string{},
+		sectionIDs:       map[string]string{},
+		existingSections: map[string]struct{}{},
+	}
+}
+
+// Populate records the existing notebook containers of the restore target.
+// Only the first call makes any api calls.
+func (rc *RestoreCaches) Populate(ctx context.Context, enh enumerateNotebookser) error {
+	if rc.populated {
+		return nil
+	}
+
+	h, err := getHierarchy(ctx, enh)
+	if err != nil {
+		return clues.Wrap(err, "enumerating restore target notebooks")
+	}
+
+	for _, c := range append(h.notebooks, h.groups...) {
+		rc.parentIDs[c.location().String()] = c.id
+	}
+
+	for _, c := range h.sections {
+		loc := c.location().String()
+		rc.sectionIDs[loc] = c.id
+		rc.existingSections[loc] = struct{}{}
+	}
+
+	rc.populated = true
+
+	return nil
+}
+
+// restoreLocation produces the names of the notebook, section groups, and
+// section that the collection's pages get restored into.  Any restore
+// location becomes the name of a new notebook that holds the original
+// notebook as its top section group.
+func restoreLocation(
+	dc data.RestoreCollection,
+	restoreCfg control.RestoreConfig,
+) ([]string, error) {
+	elems := dc.FullPath().Folders()
+
+	if len(restoreCfg.Location) > 0 {
+		elems = append([]string{restoreCfg.Location}, elems...)
+	}
+
+	// at minimum, a notebook and a section are needed.
+	if len(elems) < 2 {
+		return nil, clues.New("notebook restore location is missing a section").
+			With("restore_location", path.LoggableDir(path.Builder{}.Append(elems...).String()))
+	}
+
+	return elems, nil
+}
+
+// getOrCreateSection produces the id of the section at the location, creating
+// the notebook, section groups, and section as needed.  The returned bool is
+// true if the section existed before the restore.
+func getOrCreateSection(
+	ctx context.Context,
+	cc containerCreator,
+	caches *RestoreCaches,
+	elems []string,
+) (string, bool, error) {
+	sectionLoc := path.Builder{}.Append(elems...).String()
+
+	if id, ok := caches.sectionIDs[sectionLoc]; ok {
+		_, existed := caches.existingSections[sectionLoc]
+		return id, existed, nil
+	}
+
+	var notebookID, parentGroupID string
+
+	for i, name := range elems[:len(elems)-1] {
+		loc := path.Builder{}.Append(elems[:i+1]...).String()
+		ictx := clues.Add(ctx, "container_location", path.LoggableDir(loc))
+
+		id, ok := caches.parentIDs[loc]
+		if !ok {
+			if i == 0 {
+				nb, err := cc.CreateNotebook(ictx, name)
+				if err != nil {
+					return "", false, clues.Stack(err)
+				}
+
+				id = ptr.Val(nb.GetId())
+			} else {
+				sg, err := cc.CreateSectionGroup(ictx, notebookID, parentGroupID, name)
+				if err != nil {
+					return "", false, clues.Stack(err)
+				}
+
+				id = ptr.Val(sg.GetId())
+			}
+
+			caches.parentIDs[loc] = id
+		}
+
+		if i == 0 {
+			notebookID = id
+		} else {
+			parentGroupID = id
+		}
+	}
+
+	section, err := cc.CreateSection(ctx, notebookID, parentGroupID, elems[len(elems)-1])
+	if err != nil {
+		return "", false, clues.Stack(err)
+	}
+
+	id := ptr.Val(section.GetId())
+	caches.sectionIDs[sectionLoc] = id
+
+	return id, false, nil
+}
+
+// pageTitlesToIDs maps the titles of the pages in an existing section to the
+// page ids, for collision checks.  Sections created by the restore are empty.
+func pageTitlesToIDs(
+	ctx context.Context,
+	gp getPageser,
+	sectionID string,
+	existed bool,
+) (map[string]string, error) {
+	titles := map[string]string{}
+
+	if !existed {
+		return titles, nil
+	}
+
+	pages, err := gp.GetPagesInSection(ctx, sectionID)
+	if err != nil {
+		return nil, clues.Wrap(err, "getting existing pages")
+	}
+
+	for _, p := range pages {
+		titles[ptr.Val(p.GetTitle())] = ptr.Val(p.GetId())
+	}
+
+	return titles, nil
+}
+
+// RestoreCollection restores the pages of a section backup into a section
+// at the same location in the restore target.
+func RestoreCollection(
+	ctx context.Context,
+	rh RestoreHandler,
+	dc data.RestoreCollection,
+	restoreCfg control.RestoreConfig,
+	caches *RestoreCaches,
+	deets *details.Builder,
+	ctr *count.Bus,
+	errs *fault.Bus,
+) (support.CollectionMetrics, error) {
+	ctx, end := diagnostics.Span(ctx, "m365:onenote:restoreCollection", diagnostics.Label("path", dc.FullPath()))
+	defer end()
+
+	var (
+		metrics = support.CollectionMetrics{}
+		el      = errs.Local()
+	)
+
+	trace.Log(ctx, "m365:onenote:restoreCollection", dc.FullPath().String())
+
+	elems, err := restoreLocation(dc, restoreCfg)
+	if err != nil {
+		return metrics, clues.StackWC(ctx, err)
+	}
+
+	if err := caches.Populate(ctx, rh); err != nil {
+		return metrics, clues.Stack(err)
+	}
+
+	sectionID, existed, err := getOrCreateSection(ctx, rh, caches, elems)
+	if err != nil {
+		return metrics, clues.Wrap(err, "creating restore section")
+	}
+
+	ctx = clues.Add(ctx, "restore_section_id", sectionID)
+
+	titleToID, err := pageTitlesToIDs(ctx, rh, sectionID, existed)
+	if err != nil {
+		return metrics, clues.Stack(err)
+	}
+
+	var (
+		loc   = path.Builder{}.Append(elems...)
+		items = dc.Items(ctx, errs)
+	)
+
+	for {
+		if el.Failure() != nil {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return metrics, clues.StackWC(ctx, ctx.Err())
+
+		case itemData, ok := <-items:
+			if !ok {
+				return metrics, el.Failure()
+			}
+
+			metrics.Objects++
+
+			ictx := clues.Add(ctx, "page_id", itemData.ID())
+
+			info, size, err := restorePage(
+				ictx,
+				rh,
+				itemData,
+				sectionID,
+				loc,
+				restoreCfg.OnCollision,
+				titleToID,
+				ctr)
+			if errors.Is(err, core.ErrAlreadyExists) {
+				continue
+			}
+
+			if err != nil {
+				el.AddRecoverable(ictx, clues.Wrap(err, "restoring page"))
+				continue
+			}
+
+			metrics.Bytes += size
+
+			itemPath, err := dc.FullPath().AppendItem(itemData.ID())
+			if err != nil {
+				el.AddRecoverable(ictx, clues.WrapWC(ictx, err, "appending item to full path"))
+				continue
+			}
+
+			err = deets.Add(itemPath, loc, info)
+			if err != nil {
+				// Not critical enough to need to stop restore operation.
+				logger.CtxErr(ictx, err).Info("adding restored page to details")
+			}
+
+			metrics.Successes++
+		}
+	}
+
+	return metrics, el.Failure()
+}
+
+func restorePage(
+	ctx context.Context,
+	rh RestoreHandler,
+	itemData data.Item,
+	sectionID string,
+	loc *path.Builder,
+	collisionPolicy control.CollisionPolicy,
+	titleToID map[string]string,
+	ctr *count.Bus,
+) (details.ItemInfo, int64, error) {
+	bs, err := io.ReadAll(itemData.ToReader())
+	if err != nil {
+		return details.ItemInfo{}, 0, clues.WrapWC(ctx, err, "reading backup data")
+	}
+
+	page, err := BytesToPage(bs)
+	if err != nil {
+		return details.ItemInfo{}, 0, clues.StackWC(ctx, err)
+	}
+
+	collisionID, collides := titleToID[page.Title]
+	if collides {
+		log := logger.Ctx(ctx).With("collision_key", clues.Hide(page.Title))
+		log.Debug("item collision")
+
+		if collisionPolicy == control.Skip {
+			ctr.Inc(count.CollisionSkip)
+			log.Debug("skipping item with collision")
+
+			return details.ItemInfo{}, 0, clues.Stack(core.ErrAlreadyExists)
+		}
+	}
+
+	html, parts := pageParts(page)
+
+	created, err := rh.CreatePage(ctx, sectionID, html, parts)
+	if err != nil {
+		return details.ItemInfo{}, 0, clues.Stack(err)
+	}
+
+	// pages are created before the collision is removed, so that a
+	// failure never leaves the target without either copy.
+	if collides && (collisionPolicy == control.Replace || collisionPolicy == control.Mirror) {
+		if err := rh.DeletePage(ctx, collisionID); err != nil {
+			return details.ItemInfo{}, 0, clues.Wrap(err, "deleting colliding page")
+		}
+
+		ctr.Inc(count.CollisionReplace)
+	}
+
+	restored := pageFromModel(created)
+	if len(restored.Title) == 0 {
+		restored.Title = page.Title
+	}
+
+	size := int64(len(bs))
+
+	return rh.PageInfo(restored, loc, size), size, nil
+}
+
+// pageParts produces the html of the page with each resource url replaced by
+// a reference to a part of the page creation request holding the resource.
+func pageParts(page Page) (string, []api.OneNotePagePart) {
+	var (
+		names = map[string]string{}
+		parts = make([]api.OneNotePagePart, 0, len(page.Resources))
+	)
+
+	for i, r := range page.Resources {
+		name := fmt.Sprintf("resource%d", i)
+		names[r.ID] = "name:" + name
+
+		parts = append(parts, api.OneNotePagePart{
+			Name:        name,
+			ContentType: r.ContentType,
+			Content:     r.Content,
+		})
+	}
+
+	html := replaceResourceURLs(page.HTML, func(id string) (string, bool) {
+		n, ok := names[id]
+		return n, ok
+	})
+
+	return html, parts
+}
@@ -0,0 +1,92 @@
+package onenote
+
+import (
+	"context"
+	"io"
+
+	"github.com/alcionai/clues"
+
+	"github.com/alcionai/corso/src/internal/data"
+	"github.com/alcionai/corso/src/internal/diagnostics"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/restoreplan"
+)
+
+// PlanCollection records the action that restoring each page in the
+// collection would take, without creating any containers or pages.
+func PlanCollection(
+	ctx context.Context,
+	rh RestoreHandler,
+	dc data.RestoreCollection,
+	restoreCfg control.RestoreConfig,
+	caches *RestoreCaches,
+	plan *restoreplan.Plan,
+	errs *fault.Bus,
+) error {
+	ctx, end := diagnostics.Span(ctx, "m365:onenote:planCollection", diagnostics.Label("path", dc.FullPath()))
+	defer end()
+
+	elems, err := restoreLocation(dc, restoreCfg)
+	if err != nil {
+		return clues.StackWC(ctx, err)
+	}
+
+	if err := caches.Populate(ctx, rh); err != nil {
+		return clues.Stack(err)
+	}
+
+	var (
+		el         = errs.Local()
+		loc        = path.Builder{}.Append(elems...).String()
+		category   = dc.FullPath().Category()
+		titleToID  = map[string]string{}
+		sectionID  string
+		newSection = true
+	)
+
+	if id, ok := caches.sectionIDs[loc]; ok {
+		sectionID = id
+		_, existed := caches.existingSections[loc]
+		newSection = !existed
+
+		titleToID, err = pageTitlesToIDs(ctx, rh, sectionID, existed)
+		if err != nil {
+			return clues.Stack(err)
+		}
+	}
+
+	for itemData := range dc.Items(ctx, errs) {
+		if el.Failure() != nil {
+			break
+		}
+
+		ictx := clues.Add(ctx, "page_id", itemData.ID())
+
+		bs, err := io.ReadAll(itemData.ToReader())
+		if err != nil {
+			el.AddRecoverable(ictx, clues.WrapWC(ictx, err, "reading backup data"))
+			continue
+		}
+
+		page, err := BytesToPage(bs)
+		if err != nil {
+			el.AddRecoverable(ictx, clues.StackWC(ictx, err))
+			continue
+		}
+
+		_, collides := titleToID[page.Title]
+
+		plan.Add(restoreplan.Item{
+			ItemID:        itemData.ID(),
+			Name:          page.Title,
+			Category:      category.HumanString(),
+			Action:        restoreplan.ActionFor(collides, restoreCfg.OnCollision),
+			ContainerPath: loc,
+			NewContainer:  newSection,
+		})
+	}
+
+	return el.Failure()
+}
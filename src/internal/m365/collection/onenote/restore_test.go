@@ -0,0 +1,211 @@
+package onenote
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/data"
+	dataMock "github.com/alcionai/corso/src/internal/data/mock"
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/count"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/path"
+)
+
+type RestoreUnitSuite struct {
+	tester.Suite
+}
+
+func TestRestoreUnitSuite(t *testing.T) {
+	suite.Run(t, &RestoreUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func existingNotebookHandler() *mockRestoreHandler {
+	return &mockRestoreHandler{
+		notebooks: []models.Notebookable{notebook("nb", "Notebook")},
+		groups:    []models.SectionGroupable{sectionGroup("g", "Group", "nb", "")},
+		sections:  []models.OnenoteSectionable{section("s", "Section", "nb", "g")},
+		pages: map[string][]models.OnenotePageable{
+			"s": {page("existing", "Title")},
+		},
+	}
+}
+
+func (suite *RestoreUnitSuite) TestGetOrCreateSection() {
+	table := []struct {
+		name        string
+		elems       []string
+		expectID    string
+		expectExist bool
+		expectCalls []string
+	}{
+		{
+			name:        "existing section",
+			elems:       []string{"Notebook", "Group", "Section"},
+			expectID:    "s",
+			expectExist: true,
+		},
+		{
+			name:        "new section in existing group",
+			elems:       []string{"Notebook", "Group", "Other"},
+			expectID:    "new-Other",
+			expectCalls: []string{"section:nb:g:Other"},
+		},
+		{
+			name:     "new notebook",
+			elems:    []string{"Restore", "Notebook", "Group", "Section"},
+			expectID: "new-Section",
+			expectCalls: []string{
+				"notebook:Restore",
+				"group:new-Restore::Notebook",
+				"group:new-Restore:new-Notebook:Group",
+				"section:new-Restore:new-Group:Section",
+			},
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			var (
+				h      = existingNotebookHandler()
+				caches = NewRestoreCaches()
+			)
+
+			err := caches.Populate(ctx, h)
+			require.NoError(t, err, clues.ToCore(err))
+
+			id, existed, err := getOrCreateSection(ctx, h, caches, test.elems)
+			require.NoError(t, err, clues.ToCore(err))
+
+			assert.Equal(t, test.expectID, id)
+			assert.Equal(t, test.expectExist, existed)
+			assert.Equal(t, test.expectCalls, h.calls)
+
+			// containers are only created once.
+			_, _, err = getOrCreateSection(ctx, h, caches, test.elems)
+			require.NoError(t, err, clues.ToCore(err))
+			assert.Equal(t, test.expectCalls, h.calls)
+		})
+	}
+}
+
+func (suite *RestoreUnitSuite) TestRestoreCollection() {
+	table := []struct {
+		name            string
+		location        string
+		policy          control.CollisionPolicy
+		expectSuccesses int
+		expectSection   string
+		expectDeleted   []string
+		expectCount     map[count.Key]int64
+	}{
+		{
+			name:            "new notebook",
+			location:        "Restore",
+			policy:          control.Skip,
+			expectSuccesses: 2,
+			expectSection:   "new-Section",
+		},
+		{
+			name:            "in place, skip",
+			policy:          control.Skip,
+			expectSuccesses: 1,
+			expectSection:   "s",
+			expectCount:     map[count.Key]int64{count.CollisionSkip: 1},
+		},
+		{
+			name:            "in place, copy",
+			policy:          control.Copy,
+			expectSuccesses: 2,
+			expectSection:   "s",
+		},
+		{
+			name:            "in place, replace",
+			policy:          control.Replace,
+			expectSuccesses: 2,
+			expectSection:   "s",
+			expectDeleted:   []string{"existing"},
+			expectCount:     map[count.Key]int64{count.CollisionReplace: 1},
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			p, err := path.Build(
+				"t", "u",
+				path.OneDriveService,
+				path.NotebooksCategory,
+				false,
+				"Notebook", "Group", "Section")
+			require.NoError(t, err, clues.ToCore(err))
+
+			var (
+				h     = existingNotebookHandler()
+				deets = &details.Builder{}
+				ctr   = count.New()
+				items = []data.Item{}
+			)
+
+			for _, title := range []string{"Title", "Other"} {
+				bs, err := json.Marshal(Page{ID: title + "-id", Title: title, HTML: "<html/>"})
+				require.NoError(t, err, clues.ToCore(err))
+
+				items = append(items, &dataMock.Item{
+					ItemID: title + "-id",
+					Reader: io.NopCloser(bytes.NewReader(bs)),
+				})
+			}
+
+			metrics, err := RestoreCollection(
+				ctx,
+				h,
+				dataMock.Collection{Path: p, ItemData: items},
+				control.RestoreConfig{
+					Location:    test.location,
+					OnCollision: test.policy,
+				},
+				NewRestoreCaches(),
+				deets,
+				ctr,
+				fault.New(true))
+			require.NoError(t, err, clues.ToCore(err))
+
+			assert.Equal(t, 2, metrics.Objects)
+			assert.Equal(t, test.expectSuccesses, metrics.Successes)
+			assert.Len(t, h.posted[test.expectSection], test.expectSuccesses)
+			assert.Equal(t, test.expectDeleted, h.deleted)
+
+			pages := 0
+
+			for _, ent := range deets.Details().Entries {
+				if ent.Folder == nil {
+					pages++
+				}
+			}
+
+			assert.Equal(t, test.expectSuccesses, pages)
+
+			for k, v := range test.expectCount {
+				assert.Equal(t, v, ctr.Get(k), k)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package onenote
+
+import (
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/selectors"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+)
+
+// ---------------------------------------------------------------------------
+// backup
+// ---------------------------------------------------------------------------
+
+var _ BackupHandler = &siteNotebookBackupHandler{}
+
+type siteNotebookBackupHandler struct {
+	baseNotebookHandler
+	scope selectors.SharePointScope
+}
+
+func NewSiteNotebookBackupHandler(
+	ac api.OneNote,
+	siteID string,
+	scope selectors.SharePointScope,
+) *siteNotebookBackupHandler {
+	return &siteNotebookBackupHandler{
+		baseNotebookHandler: baseNotebookHandler{
+			ac: ac,
+			owner: api.OneNoteOwner{
+				Service:    path.SharePointService,
+				ResourceID: siteID,
+			},
+		},
+		scope: scope,
+	}
+}
+
+func (h siteNotebookBackupHandler) IncludesSection(location string) bool {
+	return h.scope.Matches(selectors.SharePointNotebook, location)
+}
+
+func (h siteNotebookBackupHandler) PageInfo(
+	page Page,
+	parentPath *path.Builder,
+	size int64,
+) details.ItemInfo {
+	return sitePageInfo(h.owner.ResourceID, page, parentPath, size)
+}
+
+// ---------------------------------------------------------------------------
+// restore
+// ---------------------------------------------------------------------------
+
+var _ RestoreHandler = &siteNotebookRestoreHandler{}
+
+type siteNotebookRestoreHandler struct {
+	baseNotebookHandler
+}
+
+func NewSiteNotebookRestoreHandler(
+	ac api.OneNote,
+	siteID string,
+) *siteNotebookRestoreHandler {
+	return &siteNotebookRestoreHandler{
+		baseNotebookHandler: baseNotebookHandler{
+			ac: ac,
+			owner: api.OneNoteOwner{
+				Service:    path.SharePointService,
+				ResourceID: siteID,
+			},
+		},
+	}
+}
+
+func (h siteNotebookRestoreHandler) PageInfo(
+	page Page,
+	parentPath *path.Builder,
+	size int64,
+) details.ItemInfo {
+	return sitePageInfo(h.owner.ResourceID, page, parentPath, size)
+}
+
+func sitePageInfo(
+	siteID string,
+	page Page,
+	parentPath *path.Builder,
+	size int64,
+) details.ItemInfo {
+	var pps string
+
+	if parentPath != nil {
+		pps = parentPath.String()
+	}
+
+	return details.ItemInfo{
+		SharePoint: &details.SharePointInfo{
+			Created:    page.Created,
+			ItemName:   page.Title,
+			ItemType:   details.SharePointNotebookPage,
+			Modified:   page.Modified,
+			ParentPath: pps,
+			SiteID:     siteID,
+			Size:       size,
+		},
+	}
+}
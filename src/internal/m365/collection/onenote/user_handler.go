@@ -0,0 +1,104 @@
+package onenote
+
+import (
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/selectors"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+)
+
+// ---------------------------------------------------------------------------
+// backup
+// ---------------------------------------------------------------------------
+
+var _ BackupHandler = &userNotebookBackupHandler{}
+
+type userNotebookBackupHandler struct {
+	baseNotebookHandler
+	scope selectors.OneDriveScope
+}
+
+func NewUserNotebookBackupHandler(
+	ac api.OneNote,
+	userID string,
+	scope selectors.OneDriveScope,
+) *userNotebookBackupHandler {
+	return &userNotebookBackupHandler{
+		baseNotebookHandler: baseNotebookHandler{
+			ac: ac,
+			owner: api.OneNoteOwner{
+				Service:    path.OneDriveService,
+				ResourceID: userID,
+			},
+		},
+		scope: scope,
+	}
+}
+
+func (h userNotebookBackupHandler) IncludesSection(location string) bool {
+	return h.scope.Matches(selectors.OneDriveNotebook, location)
+}
+
+func (h userNotebookBackupHandler) PageInfo(
+	page Page,
+	parentPath *path.Builder,
+	size int64,
+) details.ItemInfo {
+	return userPageInfo(page, parentPath, size)
+}
+
+// ---------------------------------------------------------------------------
+// restore
+// ---------------------------------------------------------------------------
+
+var _ RestoreHandler = &userNotebookRestoreHandler{}
+
+type userNotebookRestoreHandler struct {
+	baseNotebookHandler
+}
+
+func NewUserNotebookRestoreHandler(
+	ac api.OneNote,
+	userID string,
+) *userNotebookRestoreHandler {
+	return &userNotebookRestoreHandler{
+		baseNotebookHandler: baseNotebookHandler{
+			ac: ac,
+			owner: api.OneNoteOwner{
+				Service:    path.OneDriveService,
+				ResourceID: userID,
+			},
+		},
+	}
+}
+
+func (h userNotebookRestoreHandler) PageInfo(
+	page Page,
+	parentPath *path.Builder,
+	size int64,
+) details.ItemInfo {
+	return userPageInfo(page, parentPath, size)
+}
+
+func userPageInfo(
+	page Page,
+	parentPath *path.Builder,
+	size int64,
+) details.ItemInfo {
+	var pps string
+
+	if parentPath != nil {
+		pps = parentPath.String()
+	}
+
+	return details.ItemInfo{
+		OneDrive: &details.OneDriveInfo{
+			Created:    page.Created,
+			ItemName:   page.Title,
+			ItemType:   details.OneDriveNotebookPage,
+			Modified:   page.Modified,
+			ParentPath: pps,
+			Size:       size,
+		},
+	}
+}
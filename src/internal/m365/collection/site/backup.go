@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	stdpath "path"
+	"slices"
 	"time"
 
 	"github.com/alcionai/clues"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"golang.org/x/exp/maps"
 
 	"github.com/alcionai/corso/src/internal/common/prefixmatcher"
 	"github.com/alcionai/corso/src/internal/common/ptr"
@@ -20,12 +22,14 @@ import (
 	"github.com/alcionai/corso/src/pkg/account"
 	"github.com/alcionai/corso/src/pkg/backup/metadata"
 	"github.com/alcionai/corso/src/pkg/count"
+	"github.com/alcionai/corso/src/pkg/dttm"
 	"github.com/alcionai/corso/src/pkg/fault"
 	"github.com/alcionai/corso/src/pkg/logger"
 	"github.com/alcionai/corso/src/pkg/path"
 	"github.com/alcionai/corso/src/pkg/selectors"
 	"github.com/alcionai/corso/src/pkg/services/m365/api"
 	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/pagers"
 )
 
 // CollectLibraries constructs a onedrive Collections struct and Get()s
@@ -154,6 +158,7 @@ func CollectLists(
 	ctx context.Context,
 	bh backupHandler,
 	bpc inject.BackupProducerConfig,
+	prevLists map[string]data.RestoreCollection,
 	ac api.Client,
 	tenantID string,
 	scope selectors.SharePointScope,
@@ -191,6 +196,7 @@ func CollectLists(
 		su,
 		lists,
 		dps,
+		prevLists,
 		counter,
 		el)
 	if err != nil {
@@ -214,6 +220,7 @@ func populateListsCollections(
 	su support.StatusUpdater,
 	lists []models.Listable,
 	dps metadata.DeltaPaths,
+	prevLists map[string]data.RestoreCollection,
 	counter *count.Bus,
 	el *fault.Bus,
 ) (map[string]data.BackupCollection, error) {
@@ -223,10 +230,19 @@ func populateListsCollections(
 		// collections: list-id -> backup-collection
 		collections = make(map[string]data.BackupCollection)
 		currPaths   = make(map[string]string)
+		deltaURLs   = make(map[string]string)
 		tombstones  = makeTombstones(dps)
+		itemConfig  = api.CallConfig{
+			CanMakeDeltaQueries: !bpc.Options.ToggleFeatures.DisableDelta,
+		}
 	)
 
 	counter.Add(count.Lists, int64(len(lists)))
+	counter.Add(count.PrevDeltas, int64(len(dps)))
+
+	if !itemConfig.CanMakeDeltaQueries {
+		counter.Inc(count.NoDeltaQueries)
+	}
 
 	for _, list := range lists {
 		if el.Failure() != nil {
@@ -242,7 +258,9 @@ func populateListsCollections(
 			storageDir  = path.Elements{listID}
 			dp          = dps[storageDir.String()]
 			prevPathStr = dp.Path
+			prevDelta   = dp.Delta
 			prevPath    path.Path
+			ictx        = clues.Add(ctx, "list_id", listID)
 		)
 
 		delete(tombstones, listID)
@@ -262,7 +280,50 @@ func populateListsCollections(
 			return nil, err
 		}
 
-		modTime := ptr.Val(list.GetLastModifiedDateTime())
+		addAndRem := pagers.AddedAndRemoved{DU: pagers.DeltaUpdate{Reset: true}}
+
+		switch {
+		// Without a previous delta all of the list's items get fetched anyway,
+		// so enumerating them is skipped and only the delta link for the next
+		// backup is produced.
+		case itemConfig.CanMakeDeltaQueries && len(prevDelta) == 0:
+			latest, err := bh.GetLatestItemsDeltaLink(ictx, listID)
+			if err != nil {
+				logger.CtxErr(ictx, err).Info("getting latest list item delta")
+			}
+
+			addAndRem.DU.URL = latest
+
+		case itemConfig.CanMakeDeltaQueries:
+			addAndRem, err = bh.GetAddedAndRemovedItemIDs(ictx, listID, prevDelta, itemConfig)
+			if err != nil {
+				logger.CtxErr(ictx, err).Info("enumerating list item delta")
+
+				addAndRem = pagers.AddedAndRemoved{DU: pagers.DeltaUpdate{Reset: true}}
+			}
+		}
+
+		if len(addAndRem.DU.URL) > 0 {
+			deltaURLs[storageDir.String()] = addAndRem.DU.URL
+		} else if !addAndRem.DU.Reset {
+			logger.Ctx(ictx).Info("missing delta url")
+			counter.Inc(count.MissingDelta)
+		}
+
+		var (
+			modTime   = listModTime(list, prevDelta, addAndRem)
+			unchanged = prevPath != nil &&
+				!addAndRem.DU.Reset &&
+				len(addAndRem.Added) == 0 &&
+				len(addAndRem.Removed) == 0
+			// the list from the previous backup only needs the changed
+			// items fetched, as long as the delta covers all changes.
+			prevList data.RestoreCollection
+		)
+
+		if prevPath != nil && !addAndRem.DU.Reset {
+			prevList = prevLists[prevPath.String()]
+		}
 
 		lazyFetchCol := NewLazyFetchCollection(
 			bh,
@@ -272,15 +333,16 @@ func populateListsCollections(
 			su,
 			counter.Local())
 
-		lazyFetchCol.AddItem(
-			ptr.Val(list.GetId()),
-			modTime)
-
 		collection = lazyFetchCol
 
-		// Always use lazyFetchCol.
+		switch {
+		// Nothing changed, and without a mod time kopia can't tell that on its
+		// own.  Leaving the collection empty keeps the list from the base backup.
+		case unchanged && modTime.IsZero():
+			counter.Inc(count.ListsUnchanged)
+
 		// In case we receive zero mod time from graph fallback to prefetchCol.
-		if modTime.IsZero() {
+		case modTime.IsZero():
 			prefetchCol := NewPrefetchCollection(
 				bh,
 				currPath,
@@ -292,11 +354,21 @@ func populateListsCollections(
 				bpc.Options,
 				counter.Local())
 
-			prefetchCol.AddItem(
-				ptr.Val(list.GetId()),
-				modTime)
+			prefetchCol.AddItem(listID, modTime)
 
 			collection = prefetchCol
+
+		// listModTime moves the mod time forward for changed lists, so kopia
+		// only reuses the list from the base backup when nothing changed.
+		// When kopia does fetch it, only the changed items are downloaded.
+		case prevList != nil:
+			added := maps.Keys(addAndRem.Added)
+			slices.Sort(added)
+
+			lazyFetchCol.AddItemWithChanges(listID, modTime, prevList, added, addAndRem.Removed)
+
+		default:
+			lazyFetchCol.AddItem(listID, modTime)
 		}
 
 		collections[storageDir.String()] = collection
@@ -305,6 +377,9 @@ func populateListsCollections(
 
 	handleTombstones(ctx, bpc, tombstones, collections, counter, el)
 
+	counter.Add(count.NewDeltas, int64(len(deltaURLs)))
+	counter.Add(count.NewPrevPaths, int64(len(currPaths)))
+
 	// Build metadata path
 	pathPrefix, err := path.BuildMetadata(
 		tenantID,
@@ -321,6 +396,7 @@ func populateListsCollections(
 		pathPrefix,
 		[]graph.MetadataCollectionEntry{
 			graph.NewMetadataEntry(metadata.PreviousPathFileName, currPaths),
+			graph.NewMetadataEntry(metadata.DeltaURLsFileName, deltaURLs),
 		},
 		su,
 		counter.Local())
@@ -333,6 +409,32 @@ func populateListsCollections(
 	return collections, nil
 }
 
+// listModTime produces the mod time of the list's backup item.  The list
+// is stored as a single item, and kopia reuses the item from the base
+// backup when its mod time is unchanged.  Changes to list items don't
+// always reach the list's own mod time, so when the delta reports deleted
+// items, or an expired delta forces a full enumeration, the current time
+// is used to make sure the list gets refreshed.
+func listModTime(
+	list models.Listable,
+	prevDelta string,
+	addAndRem pagers.AddedAndRemoved,
+) time.Time {
+	modTime := ptr.Val(list.GetLastModifiedDateTime())
+
+	if len(addAndRem.Removed) > 0 || (addAndRem.DU.Reset && len(prevDelta) > 0) {
+		return dttm.OrNow(time.Time{})
+	}
+
+	for _, itemModTime := range addAndRem.Added {
+		if !modTime.IsZero() && itemModTime.After(modTime) {
+			modTime = itemModTime
+		}
+	}
+
+	return modTime
+}
+
 func idAnd(ss ...string) []string {
 	id := []string{"id"}
 
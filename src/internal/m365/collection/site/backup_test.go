@@ -1,9 +1,12 @@
 package site
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/alcionai/clues"
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
@@ -12,6 +15,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/alcionai/corso/src/internal/common/idname/mock"
+	"github.com/alcionai/corso/src/internal/common/ptr"
 	"github.com/alcionai/corso/src/internal/data"
 	dataMock "github.com/alcionai/corso/src/internal/data/mock"
 	siteMock "github.com/alcionai/corso/src/internal/m365/collection/site/mock"
@@ -30,6 +34,7 @@ import (
 	"github.com/alcionai/corso/src/pkg/selectors"
 	"github.com/alcionai/corso/src/pkg/services/m365/api"
 	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/pagers"
 )
 
 type SharePointBackupUnitSuite struct {
@@ -115,6 +120,7 @@ func (suite *SharePointBackupUnitSuite) TestCollectLists() {
 				ctx,
 				test.mock,
 				bpc,
+				nil,
 				ac,
 				suite.creds.AzureTenantID,
 				sel.Lists(selectors.Any())[0],
@@ -295,6 +301,7 @@ func (suite *SharePointBackupUnitSuite) TestPopulateListsCollections_incremental
 				statusUpdater,
 				test.lists,
 				test.deltaPaths,
+				nil,
 				count.New(),
 				fault.New(false))
 
@@ -329,6 +336,334 @@ func (suite *SharePointBackupUnitSuite) TestPopulateListsCollections_incremental
 	}
 }
 
+func (suite *SharePointBackupUnitSuite) TestPopulateListsCollections_deltas() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	var (
+		statusUpdater = func(*support.ControllerOperationStatus) {}
+		siteID        = tconfig.M365SiteID(t)
+		sel           = selectors.NewSharePointBackup([]string{siteID})
+		modTime       = time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+		itemModTime   = modTime.Add(time.Minute)
+		lists         = siteMock.StubLists("unchanged", "unchanged-no-modtime", "removed", "added", "new")
+		dps           = metadata.DeltaPaths{}
+	)
+
+	ac, err := api.NewClient(
+		suite.creds,
+		control.DefaultOptions(),
+		count.New())
+	require.NoError(t, err, clues.ToCore(err))
+
+	for _, lst := range lists {
+		id := ptr.Val(lst.GetId())
+
+		if id != "unchanged-no-modtime" {
+			lst.SetLastModifiedDateTime(ptr.To(modTime))
+		}
+
+		if id == "new" {
+			continue
+		}
+
+		p, err := path.Build(
+			suite.creds.AzureTenantID,
+			siteID,
+			path.SharePointService,
+			path.ListsCategory,
+			false,
+			id)
+		require.NoError(t, err, clues.ToCore(err))
+
+		dps.AddPath(id, p.String())
+		dps.AddDelta(id, "prev-delta-"+id)
+	}
+
+	bh := siteMock.NewListHandler(lists, siteID, nil)
+	bh.ItemChanges["removed"] = pagers.AddedAndRemoved{
+		Removed: []string{"item"},
+		DU:      pagers.DeltaUpdate{URL: "delta-removed"},
+	}
+	bh.ItemChanges["added"] = pagers.AddedAndRemoved{
+		Added: map[string]time.Time{"item": itemModTime},
+		DU:    pagers.DeltaUpdate{URL: "delta-added"},
+	}
+
+	bpc := inject.BackupProducerConfig{
+		LastBackupVersion: version.NoBackup,
+		Options:           control.DefaultOptions(),
+		ProtectedResource: mock.NewProvider(siteID, siteID),
+	}
+
+	cs, err := populateListsCollections(
+		ctx,
+		bh,
+		bpc,
+		ac,
+		suite.creds.AzureTenantID,
+		sel.Lists(selectors.Any())[0],
+		statusUpdater,
+		lists,
+		dps,
+		nil,
+		count.New(),
+		fault.New(false))
+	require.NoError(t, err, clues.ToCore(err))
+
+	itemsOf := func(id string) map[string]time.Time {
+		col, ok := cs[id].(*lazyFetchCollection)
+		require.True(t, ok, "lazy fetch collection for %s", id)
+
+		return col.items
+	}
+
+	assert.Equal(t, map[string]time.Time{"unchanged": modTime}, itemsOf("unchanged"))
+	assert.Empty(t, itemsOf("unchanged-no-modtime"), "unchanged list without mod time is kept from the base")
+	assert.True(t, itemsOf("removed")["removed"].After(modTime), "removed items refresh the list")
+	assert.Equal(t, map[string]time.Time{"added": itemModTime}, itemsOf("added"))
+	assert.Equal(t, map[string]time.Time{"new": modTime}, itemsOf("new"))
+
+	assert.Equal(t, data.NotMovedState, cs["unchanged-no-modtime"].State())
+	assert.Equal(t, data.NewState, cs["new"].State())
+
+	// the delta links are persisted and read back by the next backup.
+	mdCol := cs["metadata"]
+
+	nextDPs, canUsePreviousBackup, err := parseListsMetadataCollections(
+		ctx,
+		path.ListsCategory,
+		[]data.RestoreCollection{
+			dataMock.NewUnversionedRestoreCollection(t, data.NoFetchRestoreCollection{Collection: mdCol}),
+		})
+	require.NoError(t, err, clues.ToCore(err))
+	assert.True(t, canUsePreviousBackup)
+
+	expectDeltas := map[string]string{
+		"unchanged":            "delta-unchanged",
+		"unchanged-no-modtime": "delta-unchanged-no-modtime",
+		"removed":              "delta-removed",
+		"added":                "delta-added",
+		"new":                  "latest-new",
+	}
+
+	require.Len(t, nextDPs, len(expectDeltas))
+
+	for id, delta := range expectDeltas {
+		assert.Equal(t, delta, nextDPs[id].Delta, "delta for %s", id)
+		assert.NotEmpty(t, nextDPs[id].Path, "path for %s", id)
+	}
+}
+
+func (suite *SharePointBackupUnitSuite) TestPopulateListsCollections_previousLists() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	var (
+		statusUpdater = func(*support.ControllerOperationStatus) {}
+		siteID        = tconfig.M365SiteID(t)
+		sel           = selectors.NewSharePointBackup([]string{siteID})
+		modTime       = time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+		lists         = siteMock.StubLists("changed", "reset", "not-loaded")
+		dps           = metadata.DeltaPaths{}
+		prevLists     = map[string]data.RestoreCollection{}
+	)
+
+	ac, err := api.NewClient(
+		suite.creds,
+		control.DefaultOptions(),
+		count.New())
+	require.NoError(t, err, clues.ToCore(err))
+
+	for _, lst := range lists {
+		id := ptr.Val(lst.GetId())
+
+		lst.SetLastModifiedDateTime(ptr.To(modTime))
+
+		p, err := path.Build(
+			suite.creds.AzureTenantID,
+			siteID,
+			path.SharePointService,
+			path.ListsCategory,
+			false,
+			id)
+		require.NoError(t, err, clues.ToCore(err))
+
+		dps.AddPath(id, p.String())
+		dps.AddDelta(id, "prev-delta-"+id)
+
+		if id == "not-loaded" {
+			continue
+		}
+
+		item := models.NewListItem()
+		item.SetId(ptr.To("kept"))
+
+		prev := models.NewList()
+		prev.SetId(ptr.To(id))
+		prev.SetItems([]models.ListItemable{item})
+
+		bs, err := serializeContent(ctx, prev)
+		require.NoError(t, err, clues.ToCore(err))
+
+		prevLists[p.String()] = dataMock.RestoreCollection{
+			Collection: dataMock.Collection{Path: p},
+			AuxItems: map[string]data.Item{
+				id: &dataMock.Item{
+					ItemID: id,
+					Reader: io.NopCloser(bytes.NewReader(bs)),
+				},
+			},
+		}
+	}
+
+	changes := pagers.AddedAndRemoved{
+		Added:   map[string]time.Time{"b": modTime, "a": modTime},
+		Removed: []string{"gone"},
+		DU:      pagers.DeltaUpdate{URL: "delta"},
+	}
+
+	bh := siteMock.NewListHandler(lists, siteID, nil)
+	bh.ItemChanges["changed"] = changes
+	bh.ItemChanges["not-loaded"] = changes
+	bh.ItemChanges["reset"] = pagers.AddedAndRemoved{
+		DU: pagers.DeltaUpdate{URL: "delta", Reset: true},
+	}
+
+	bpc := inject.BackupProducerConfig{
+		LastBackupVersion: version.NoBackup,
+		Options:           control.DefaultOptions(),
+		ProtectedResource: mock.NewProvider(siteID, siteID),
+	}
+
+	cs, err := populateListsCollections(
+		ctx,
+		bh,
+		bpc,
+		ac,
+		suite.creds.AzureTenantID,
+		sel.Lists(selectors.Any())[0],
+		statusUpdater,
+		lists,
+		dps,
+		prevLists,
+		count.New(),
+		fault.New(false))
+	require.NoError(t, err, clues.ToCore(err))
+
+	for _, id := range []string{"changed", "reset", "not-loaded"} {
+		errs := fault.New(true)
+
+		for item := range cs[id].Items(ctx, errs) {
+			_, err := io.ReadAll(item.ToReader())
+			require.NoError(t, err, clues.ToCore(err))
+		}
+
+		require.NoError(t, errs.Failure(), clues.ToCore(errs.Failure()))
+	}
+
+	// only the list with a previous copy and an intact delta fetches
+	// just its changes.
+	require.Len(t, bh.ChangeReads, 1)
+
+	read := bh.ChangeReads["changed"]
+	assert.Equal(t, []string{"a", "b"}, read.Added)
+	assert.Equal(t, []string{"gone"}, read.Removed)
+	require.NotNil(t, read.Prev)
+	require.Len(t, read.Prev.GetItems(), 1)
+	assert.Equal(t, "kept", ptr.Val(read.Prev.GetItems()[0].GetId()))
+}
+
+func (suite *SharePointBackupUnitSuite) TestListModTime() {
+	var (
+		modTime = time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+		later   = modTime.Add(time.Minute)
+		earlier = modTime.Add(-time.Minute)
+	)
+
+	table := []struct {
+		name        string
+		listModTime time.Time
+		prevDelta   string
+		aar         pagers.AddedAndRemoved
+		expect      func(t *testing.T, result time.Time)
+	}{
+		{
+			name:        "no changes",
+			listModTime: modTime,
+			prevDelta:   "delta",
+			expect: func(t *testing.T, result time.Time) {
+				assert.Equal(t, modTime, result)
+			},
+		},
+		{
+			name:        "first delta",
+			listModTime: modTime,
+			aar: pagers.AddedAndRemoved{
+				Added: map[string]time.Time{"a": earlier},
+				DU:    pagers.DeltaUpdate{Reset: true},
+			},
+			expect: func(t *testing.T, result time.Time) {
+				assert.Equal(t, modTime, result)
+			},
+		},
+		{
+			name:        "items modified after the list",
+			listModTime: modTime,
+			prevDelta:   "delta",
+			aar: pagers.AddedAndRemoved{
+				Added: map[string]time.Time{"a": earlier, "b": later},
+			},
+			expect: func(t *testing.T, result time.Time) {
+				assert.Equal(t, later, result)
+			},
+		},
+		{
+			name:        "items removed",
+			listModTime: modTime,
+			prevDelta:   "delta",
+			aar:         pagers.AddedAndRemoved{Removed: []string{"a"}},
+			expect: func(t *testing.T, result time.Time) {
+				assert.True(t, result.After(modTime))
+			},
+		},
+		{
+			name:        "expired delta",
+			listModTime: modTime,
+			prevDelta:   "delta",
+			aar:         pagers.AddedAndRemoved{DU: pagers.DeltaUpdate{Reset: true}},
+			expect: func(t *testing.T, result time.Time) {
+				assert.True(t, result.After(modTime))
+			},
+		},
+		{
+			name:      "no list mod time",
+			prevDelta: "delta",
+			aar: pagers.AddedAndRemoved{
+				Added: map[string]time.Time{"a": later},
+			},
+			expect: func(t *testing.T, result time.Time) {
+				assert.True(t, result.IsZero())
+			},
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			lst := models.NewList()
+
+			if !test.listModTime.IsZero() {
+				lst.SetLastModifiedDateTime(ptr.To(test.listModTime))
+			}
+
+			test.expect(suite.T(), listModTime(lst, test.prevDelta, test.aar))
+		})
+	}
+}
+
 type SharePointBackupIntgSuite struct {
 	tester.Suite
 	m365 its.M365IntgTestSetup
@@ -399,6 +734,7 @@ func (suite *SharePointBackupIntgSuite) TestCollectLists() {
 		ctx,
 		bh,
 		bpc,
+		nil,
 		suite.m365.AC,
 		suite.m365.Creds.AzureTenantID,
 		sel.Lists(selectors.Any())[0],
@@ -450,6 +786,45 @@ func (suite *SharePointBackupIntgSuite) TestParseListsMetadataCollections() {
 			canUsePreviousBackup: true,
 			expectError:          assert.NoError,
 		},
+		{
+			name:              "previous path and delta",
+			cat:               path.ListsCategory,
+			wantedCategorycat: path.ListsCategory,
+			data: []fileValues{
+				{metadata.PreviousPathFileName, "prev-path"},
+				{metadata.DeltaURLsFileName, "delta-link"},
+			},
+			expect: map[string]metadata.DeltaPath{
+				"key": {
+					Path:  "prev-path",
+					Delta: "delta-link",
+				},
+			},
+			canUsePreviousBackup: true,
+			expectError:          assert.NoError,
+		},
+		{
+			name:              "delta without previous path",
+			cat:               path.ListsCategory,
+			wantedCategorycat: path.ListsCategory,
+			data: []fileValues{
+				{metadata.DeltaURLsFileName, "delta-link"},
+			},
+			expect:               map[string]metadata.DeltaPath{},
+			canUsePreviousBackup: true,
+			expectError:          assert.NoError,
+		},
+		{
+			name:              "multiple deltas",
+			cat:               path.ListsCategory,
+			wantedCategorycat: path.ListsCategory,
+			data: []fileValues{
+				{metadata.DeltaURLsFileName, "delta-link"},
+				{metadata.DeltaURLsFileName, "delta-link-2"},
+			},
+			canUsePreviousBackup: false,
+			expectError:          assert.Error,
+		},
 		{
 			name:              "multiple previous paths",
 			cat:               path.ListsCategory,
@@ -518,6 +893,7 @@ func (suite *SharePointBackupIntgSuite) TestParseListsMetadataCollections() {
 
 				for k, v := range dps {
 					assert.Equal(t, v.Path, test.expect[k].Path, "path")
+					assert.Equal(t, v.Delta, test.expect[k].Delta, "delta")
 				}
 			}
 		})
@@ -567,3 +943,84 @@ func (suite *SharePointBackupIntgSuite) TestParseListsMetadataCollections_ReadFa
 	require.NoError(t, err)
 	require.False(t, canUsePreviousBackup)
 }
+
+// missingFileColl wraps a metadata collection and reports a file that
+// couldn't be found, the way kopia does for files missing from the base.
+type missingFileColl struct {
+	data.RestoreCollection
+}
+
+func (f missingFileColl) Items(ctx context.Context, errs *fault.Bus) <-chan data.Item {
+	ic := make(chan data.Item)
+
+	go func() {
+		defer close(ic)
+
+		for item := range f.RestoreCollection.Items(ctx, errs) {
+			ic <- item
+		}
+
+		errs.AddRecoverable(ctx, clues.Stack(data.ErrNotFound))
+	}()
+
+	return ic
+}
+
+func (suite *SharePointBackupUnitSuite) TestParseListsMetadataCollections_MissingFile() {
+	table := []struct {
+		name                 string
+		fileName             string
+		canUsePreviousBackup bool
+	}{
+		{
+			name:                 "missing delta file",
+			fileName:             metadata.PreviousPathFileName,
+			canUsePreviousBackup: true,
+		},
+		{
+			name:                 "missing previous path file",
+			fileName:             metadata.DeltaURLsFileName,
+			canUsePreviousBackup: false,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			pathPrefix, err := path.BuildMetadata(
+				"t", "u",
+				path.SharePointService,
+				path.ListsCategory,
+				false)
+			require.NoError(t, err, "path prefix")
+
+			coll, err := graph.MakeMetadataCollection(
+				pathPrefix,
+				[]graph.MetadataCollectionEntry{
+					graph.NewMetadataEntry(test.fileName, map[string]string{"key": "value"}),
+				},
+				func(cos *support.ControllerOperationStatus) {},
+				count.New())
+			require.NoError(t, err, clues.ToCore(err))
+
+			mfc := missingFileColl{
+				dataMock.NewUnversionedRestoreCollection(t, data.NoFetchRestoreCollection{Collection: coll}),
+			}
+
+			dps, canUsePreviousBackup, err := parseListsMetadataCollections(
+				ctx,
+				path.ListsCategory,
+				[]data.RestoreCollection{mfc})
+			require.NoError(t, err, clues.ToCore(err))
+			assert.Equal(t, test.canUsePreviousBackup, canUsePreviousBackup, "can use previous backup")
+
+			if test.canUsePreviousBackup {
+				assert.Equal(t, "value", dps["key"].Path)
+				assert.Empty(t, dps["key"].Delta)
+			}
+		})
+	}
+}
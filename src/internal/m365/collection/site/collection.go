@@ -381,15 +381,26 @@ type lazyFetchCollection struct {
 	fullPath, prevPath path.Path
 	locationPath       *path.Builder
 	// jobs contain the SharePoint.List.IDs and their last modified time
-	items         map[string]time.Time
+	items map[string]time.Time
+	// changes holds the item changes of the lists that can be merged
+	// with their copy in the previous backup.
+	changes       map[string]*listChanges
 	statusUpdater support.StatusUpdater
-	getter        getItemByIDer
+	getter        listGetter
 	counter       *count.Bus
 	state         data.CollectionState
 }
 
+// listChanges holds the list items that were added or removed since the
+// previous backup, and the collection that holds the list in that backup.
+type listChanges struct {
+	prev    data.FetchItemByNamer
+	added   []string
+	removed []string
+}
+
 func NewLazyFetchCollection(
-	getter getItemByIDer,
+	getter listGetter,
 	folderPath, prevPath path.Path,
 	locPb *path.Builder,
 	statusUpdater support.StatusUpdater,
@@ -400,6 +411,7 @@ func NewLazyFetchCollection(
 		prevPath:      prevPath,
 		locationPath:  locPb,
 		items:         make(map[string]time.Time),
+		changes:       make(map[string]*listChanges),
 		getter:        getter,
 		stream:        make(chan data.Item, collectionChannelBufferSize),
 		statusUpdater: statusUpdater,
@@ -415,6 +427,23 @@ func (lc *lazyFetchCollection) AddItem(itemID string, lastModifiedTime time.Time
 	lc.counter.Add(count.ItemsAdded, 1)
 }
 
+// AddItemWithChanges adds a list that only needs the items in added
+// fetched.  The rest of its items are read from the list's copy in prev.
+func (lc *lazyFetchCollection) AddItemWithChanges(
+	itemID string,
+	lastModifiedTime time.Time,
+	prev data.FetchItemByNamer,
+	added, removed []string,
+) {
+	lc.AddItem(itemID, lastModifiedTime)
+
+	lc.changes[itemID] = &listChanges{
+		prev:    prev,
+		added:   added,
+		removed: removed,
+	}
+}
+
 func (lc *lazyFetchCollection) FullPath() path.Path {
 	return lc.fullPath
 }
@@ -477,6 +506,7 @@ func (lc *lazyFetchCollection) streamItems(
 				itemID:  listID,
 				getter:  lc.getter,
 				modTime: modTime,
+				changes: lc.changes[listID],
 			},
 			listID,
 			modTime,
@@ -492,16 +522,33 @@ func (lc *lazyFetchCollection) streamItems(
 }
 
 type lazyItemGetter struct {
-	getter  getItemByIDer
+	getter  listGetter
 	itemID  string
 	modTime time.Time
+	changes *listChanges
 }
 
 func (lig *lazyItemGetter) GetData(
 	ctx context.Context,
 	el *fault.Bus,
 ) (io.ReadCloser, *details.ItemInfo, bool, error) {
-	list, info, err := lig.getter.GetItemByID(ctx, lig.itemID)
+	var (
+		list models.Listable
+		info *details.SharePointInfo
+		err  error
+	)
+
+	if prev := lig.previousList(ctx); prev != nil {
+		list, info, err = lig.getter.GetItemWithChanges(
+			ctx,
+			lig.itemID,
+			prev,
+			lig.changes.added,
+			lig.changes.removed)
+	} else {
+		list, info, err = lig.getter.GetItemByID(ctx, lig.itemID)
+	}
+
 	if err != nil {
 		if clues.HasLabel(err, graph.LabelStatus(http.StatusNotFound)) || errors.Is(err, core.ErrNotFound) {
 			logger.CtxErr(ctx, err).Info("item deleted in flight. skipping")
@@ -532,6 +579,37 @@ func (lig *lazyItemGetter) GetData(
 		nil
 }
 
+// previousList reads the list's copy from the previous backup.  Failures
+// are only logged, since the list can still be fetched in full.
+func (lig *lazyItemGetter) previousList(ctx context.Context) models.Listable {
+	if lig.changes == nil {
+		return nil
+	}
+
+	item, err := lig.changes.prev.FetchItemByName(ctx, lig.itemID)
+	if err != nil {
+		logger.CtxErr(ctx, err).Info("fetching list from previous backup")
+		return nil
+	}
+
+	rc := item.ToReader()
+	defer rc.Close()
+
+	bs, err := io.ReadAll(rc)
+	if err != nil {
+		logger.CtxErr(ctx, err).Info("reading list from previous backup")
+		return nil
+	}
+
+	prev, err := api.BytesToListable(bs)
+	if err != nil {
+		logger.CtxErr(ctx, err).Info("parsing list from previous backup")
+		return nil
+	}
+
+	return prev
+}
+
 func serializeContent(
 	ctx context.Context,
 	obj serialization.Parsable,
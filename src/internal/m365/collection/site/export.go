@@ -1,26 +1,36 @@
 package site
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
+	"io"
+	"strings"
 
+	"github.com/alcionai/clues"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
 	"github.com/alcionai/corso/src/internal/data"
 	"github.com/alcionai/corso/src/pkg/control"
 	"github.com/alcionai/corso/src/pkg/export"
 	"github.com/alcionai/corso/src/pkg/fault"
 	"github.com/alcionai/corso/src/pkg/metrics"
 	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
 )
 
 func NewExportCollection(
 	baseDir string,
 	backingCollection []data.RestoreCollection,
 	backupVersion int,
+	cec control.ExportConfig,
 	stats *metrics.ExportStats,
 ) export.Collectioner {
 	return export.BaseCollection{
 		BaseDir:           baseDir,
 		BackingCollection: backingCollection,
 		BackupVersion:     backupVersion,
+		Cfg:               cec,
 		Stream:            streamItems,
 		Stats:             stats,
 	}
@@ -40,10 +50,32 @@ func streamItems(
 
 	for _, rc := range drc {
 		for item := range rc.Items(ctx, errs) {
-			stats.UpdateResourceCount(path.ListsCategory)
-			body := metrics.ReaderWithStats(item.ToReader(), path.ListsCategory, stats)
+			var (
+				body io.ReadCloser = item.ToReader()
+				name               = item.ID() + ".json"
+			)
+
+			if config.Format == control.CSVFormat {
+				csvName, csvBody, err := formatListCSV(ctx, body)
+				if err != nil {
+					ch <- export.Item{
+						ID:    item.ID(),
+						Error: err,
+					}
+
+					continue
+				}
+
+				body = csvBody
+				name = csvName + ".csv"
+
+				if len(csvName) == 0 {
+					name = item.ID() + ".csv"
+				}
+			}
 
-			name := item.ID() + ".json"
+			stats.UpdateResourceCount(path.ListsCategory)
+			body = metrics.ReaderWithStats(body, path.ListsCategory, stats)
 
 			ch <- export.Item{
 				ID:   item.ID(),
@@ -69,3 +101,46 @@ func streamItems(
 		}
 	}
 }
+
+// formatListCSV converts the stored list into a csv file with one row
+// per list item.  Returns the list's name, for naming the file.
+//
+// List item attachments aren't backed up, since graph doesn't expose
+// them, so the csv export has no attachments to write alongside the list.
+func formatListCSV(ctx context.Context, rc io.ReadCloser) (string, io.ReadCloser, error) {
+	defer rc.Close()
+
+	bs, err := io.ReadAll(rc)
+	if err != nil {
+		return "", nil, clues.WrapWC(ctx, err, "reading list bytes")
+	}
+
+	list, err := api.BytesToListable(bs)
+	if err != nil {
+		return "", nil, clues.WrapWC(ctx, err, "deserializing list")
+	}
+
+	name := ptr.Val(list.GetDisplayName())
+	if len(name) == 0 {
+		name = ptr.Val(list.GetName())
+	}
+
+	// list names can't hold path separators, but guard against
+	// them splitting the file into folders anyways.
+	name = strings.NewReplacer("/", "_", "\\", "_").Replace(name)
+
+	header, rows := api.ListToTable(list)
+
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+
+	if err := w.Write(header); err != nil {
+		return "", nil, clues.WrapWC(ctx, err, "writing csv header")
+	}
+
+	if err := w.WriteAll(rows); err != nil {
+		return "", nil, clues.WrapWC(ctx, err, "writing csv rows")
+	}
+
+	return name, io.NopCloser(buf), nil
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/alcionai/corso/src/pkg/control"
 	"github.com/alcionai/corso/src/pkg/export"
 	"github.com/alcionai/corso/src/pkg/metrics"
+	"github.com/alcionai/corso/src/pkg/path"
 )
 
 type ExportUnitSuite struct {
@@ -116,6 +117,76 @@ func (suite *ExportUnitSuite) TestStreamItems() {
 	}
 }
 
+func (suite *ExportUnitSuite) TestStreamItems_csv() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	cd := models.NewColumnDefinition()
+	cd.SetName(ptr.To("Title"))
+	cd.SetDisplayName(ptr.To("Title"))
+	cd.SetText(models.NewTextColumn())
+
+	fields := models.NewFieldValueSet()
+	fields.SetAdditionalData(map[string]any{"Title": ptr.To("hello, world")})
+
+	li := models.NewListItem()
+	li.SetId(ptr.To("1"))
+	li.SetFields(fields)
+
+	list := models.NewList()
+	list.SetId(ptr.To("list1"))
+	list.SetDisplayName(ptr.To("Team/Tasks"))
+	list.SetColumns([]models.ColumnDefinitionable{cd})
+	list.SetItems([]models.ListItemable{li})
+
+	writer := kjson.NewJsonSerializationWriter()
+	defer writer.Close()
+
+	err := writer.WriteObjectValue("", list)
+	require.NoError(t, err, clues.ToCore(err))
+
+	bs, err := writer.GetSerializedContent()
+	require.NoError(t, err, clues.ToCore(err))
+
+	coll := dataMock.Collection{
+		ItemData: []data.Item{
+			&dataMock.Item{
+				ItemID: "list1",
+				Reader: io.NopCloser(bytes.NewReader(bs)),
+			},
+		},
+	}
+
+	ch := make(chan export.Item)
+	stats := &metrics.ExportStats{}
+
+	go streamItems(
+		ctx,
+		[]data.RestoreCollection{coll},
+		version.NoBackup,
+		control.ExportConfig{Format: control.CSVFormat},
+		ch,
+		stats)
+
+	items := []export.Item{}
+
+	for itm := range ch {
+		require.NoError(t, itm.Error, clues.ToCore(itm.Error))
+		items = append(items, itm)
+	}
+
+	require.Len(t, items, 1)
+	assert.Equal(t, "Team_Tasks.csv", items[0].Name)
+
+	result, err := io.ReadAll(items[0].Body)
+	require.NoError(t, err, clues.ToCore(err))
+
+	assert.Equal(t, "ID,Title\n1,\"hello, world\"\n", string(result))
+	assert.Equal(t, int64(len(result)), stats.GetStats()[path.ListsCategory].BytesRead)
+}
+
 func makeListJSONReader(t *testing.T, listName string) io.ReadCloser {
 	listBytes := getListBytes(t, listName)
 	return io.NopCloser(bytes.NewReader(listBytes))
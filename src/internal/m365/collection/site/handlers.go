@@ -9,14 +9,24 @@ import (
 	"github.com/alcionai/corso/src/pkg/fault"
 	"github.com/alcionai/corso/src/pkg/path"
 	"github.com/alcionai/corso/src/pkg/services/m365/api"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/pagers"
 )
 
 type backupHandler interface {
-	getItemByIDer
+	listGetter
 	getItemser
+	addedAndRemovedItemGetter
+	latestDeltaLinkGetter
 	canonicalPather
 }
 
+// listGetter fetches lists, either in full or by fetching only the
+// items that changed since the previous backup.
+type listGetter interface {
+	getItemByIDer
+	getItemWithChangeser
+}
+
 // canonicalPath constructs the service and category specific path for
 // the given builder.
 type canonicalPather interface {
@@ -30,10 +40,37 @@ type getItemByIDer interface {
 	GetItemByID(ctx context.Context, itemID string) (models.Listable, *details.SharePointInfo, error)
 }
 
+// getItemWithChangeser fetches a list, reusing the items of prev that
+// weren't added or removed since prev was backed up.
+type getItemWithChangeser interface {
+	GetItemWithChanges(
+		ctx context.Context,
+		itemID string,
+		prev models.Listable,
+		added, removed []string,
+	) (models.Listable, *details.SharePointInfo, error)
+}
+
 type getItemser interface {
 	GetItems(ctx context.Context, cc api.CallConfig) ([]models.Listable, error)
 }
 
+// addedAndRemovedItemGetter produces the items of a list that changed
+// since the previous delta link.
+type addedAndRemovedItemGetter interface {
+	GetAddedAndRemovedItemIDs(
+		ctx context.Context,
+		listID, prevDeltaLink string,
+		cc api.CallConfig,
+	) (pagers.AddedAndRemoved, error)
+}
+
+// latestDeltaLinkGetter produces a delta link for the current state of
+// a list, without enumerating its items.
+type latestDeltaLinkGetter interface {
+	GetLatestItemsDeltaLink(ctx context.Context, listID string) (string, error)
+}
+
 type restoreHandler interface {
 	PostLister
 	PatchLister
@@ -9,6 +9,7 @@ import (
 	"github.com/alcionai/corso/src/pkg/fault"
 	"github.com/alcionai/corso/src/pkg/path"
 	"github.com/alcionai/corso/src/pkg/services/m365/api"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/pagers"
 )
 
 var _ backupHandler = &listsBackupHandler{}
@@ -46,10 +47,34 @@ func (bh listsBackupHandler) GetItemByID(
 	return bh.ac.GetListByID(ctx, bh.protectedResource, itemID)
 }
 
+func (bh listsBackupHandler) GetItemWithChanges(
+	ctx context.Context,
+	itemID string,
+	prev models.Listable,
+	added, removed []string,
+) (models.Listable, *details.SharePointInfo, error) {
+	return bh.ac.GetListWithItemChanges(ctx, bh.protectedResource, itemID, prev, added, removed)
+}
+
 func (bh listsBackupHandler) GetItems(ctx context.Context, cc api.CallConfig) ([]models.Listable, error) {
 	return bh.ac.GetLists(ctx, bh.protectedResource, cc)
 }
 
+func (bh listsBackupHandler) GetAddedAndRemovedItemIDs(
+	ctx context.Context,
+	listID, prevDeltaLink string,
+	cc api.CallConfig,
+) (pagers.AddedAndRemoved, error) {
+	return bh.ac.GetAddedAndRemovedListItemIDs(ctx, bh.protectedResource, listID, prevDeltaLink, cc)
+}
+
+func (bh listsBackupHandler) GetLatestItemsDeltaLink(
+	ctx context.Context,
+	listID string,
+) (string, error) {
+	return bh.ac.GetLatestListItemsDeltaLink(ctx, bh.protectedResource, listID)
+}
+
 var _ restoreHandler = &listsRestoreHandler{}
 
 type listsRestoreHandler struct {
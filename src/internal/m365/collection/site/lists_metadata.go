@@ -3,6 +3,7 @@ package site
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/alcionai/clues"
 
@@ -13,6 +14,34 @@ import (
 	"github.com/alcionai/corso/src/pkg/path"
 )
 
+// SplitPreviousLists separates the lists of the previous backup from the
+// metadata collections.  The lists are loaded along with the metadata, so
+// that incremental backups only need to fetch the list items that changed.
+// Lists are keyed by the path of their collection.
+func SplitPreviousLists(
+	colls []data.RestoreCollection,
+) ([]data.RestoreCollection, map[string]data.RestoreCollection) {
+	var (
+		mdColls   = make([]data.RestoreCollection, 0, len(colls))
+		prevLists = map[string]data.RestoreCollection{}
+	)
+
+	for _, coll := range colls {
+		fp := coll.FullPath()
+
+		if fp != nil &&
+			fp.Service() == path.SharePointService &&
+			fp.Category() == path.ListsCategory {
+			prevLists[fp.String()] = coll
+			continue
+		}
+
+		mdColls = append(mdColls, coll)
+	}
+
+	return mdColls, prevLists
+}
+
 func parseListsMetadataCollections(
 	ctx context.Context,
 	cat path.CategoryType,
@@ -26,7 +55,8 @@ func parseListsMetadataCollections(
 		cat: {},
 	}
 
-	errs := fault.New(true)
+	// not fail-fast: a missing delta file is tolerated below.
+	errs := fault.New(false)
 
 	for _, coll := range colls {
 		var (
@@ -60,7 +90,8 @@ func parseListsMetadataCollections(
 					return nil, false, clues.WrapWC(ctx, err, "decoding metadata json")
 				}
 
-				if item.ID() == metadata.PreviousPathFileName {
+				switch item.ID() {
+				case metadata.PreviousPathFileName:
 					if _, ok := found[category][metadata.PathKey]; ok {
 						return nil, false, clues.WrapWC(ctx, err, "multiple versions of path metadata")
 					}
@@ -71,8 +102,19 @@ func parseListsMetadataCollections(
 
 					found[category][metadata.PathKey] = struct{}{}
 
-					cdp[category] = cdps
+				case metadata.DeltaURLsFileName:
+					if _, ok := found[category][metadata.DeltaKey]; ok {
+						return nil, false, clues.NewWC(ctx, "multiple versions of delta metadata")
+					}
+
+					for k, d := range m {
+						cdps.AddDelta(k, d)
+					}
+
+					found[category][metadata.DeltaKey] = struct{}{}
 				}
+
+				cdp[category] = cdps
 			}
 
 			if breakLoop {
@@ -81,8 +123,8 @@ func parseListsMetadataCollections(
 		}
 	}
 
-	if errs.Failure() != nil {
-		logger.CtxErr(ctx, errs.Failure()).Info("reading metadata collection items")
+	if err := metadataReadFailure(errs, found[cat]); err != nil {
+		logger.CtxErr(ctx, err).Info("reading metadata collection items")
 
 		return metadata.DeltaPaths{}, false, nil
 	}
@@ -98,6 +140,26 @@ func parseListsMetadataCollections(
 	return cdp[cat], true, nil
 }
 
+// metadataReadFailure returns the first error that prevents the use of the
+// previous metadata.  Backups made before list deltas were tracked have no
+// delta file.  That only causes a refresh of each list, so it's ignored as
+// long as the previous paths were read.
+func metadataReadFailure(errs *fault.Bus, found map[string]struct{}) error {
+	if errs.Failure() != nil {
+		return errs.Failure()
+	}
+
+	_, foundPaths := found[metadata.PathKey]
+
+	for _, err := range errs.Recovered() {
+		if !foundPaths || !errors.Is(err, data.ErrNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func pathFromPrevString(ps string) (path.Path, error) {
 	p, err := path.FromDataLayerPath(ps, false)
 	if err != nil {
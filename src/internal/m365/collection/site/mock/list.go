@@ -4,6 +4,7 @@ import (
 	"context"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/microsoftgraph/msgraph-sdk-go/models"
 	"github.com/stretchr/testify/assert"
@@ -14,6 +15,7 @@ import (
 	"github.com/alcionai/corso/src/pkg/fault"
 	"github.com/alcionai/corso/src/pkg/path"
 	"github.com/alcionai/corso/src/pkg/services/m365/api"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/pagers"
 )
 
 type ListHandler struct {
@@ -21,6 +23,18 @@ type ListHandler struct {
 	lists             []models.Listable
 	listsMap          map[string]models.Listable
 	err               error
+	// ItemChanges holds the delta results of each list, by list ID.
+	// Lists without an entry report no changes.
+	ItemChanges map[string]pagers.AddedAndRemoved
+	// ChangeReads holds the arguments of each GetItemWithChanges
+	// call, by list ID.
+	ChangeReads map[string]ListChanges
+}
+
+type ListChanges struct {
+	Prev    models.Listable
+	Added   []string
+	Removed []string
 }
 
 func NewListHandler(lists []models.Listable, protectedResource string, err error) ListHandler {
@@ -34,6 +48,8 @@ func NewListHandler(lists []models.Listable, protectedResource string, err error
 		lists:             lists,
 		listsMap:          lstMap,
 		err:               err,
+		ItemChanges:       map[string]pagers.AddedAndRemoved{},
+		ChangeReads:       map[string]ListChanges{},
 	}
 }
 
@@ -64,6 +80,21 @@ func (lh ListHandler) GetItemByID(
 	return ls, lstInfo, lh.err
 }
 
+func (lh ListHandler) GetItemWithChanges(
+	ctx context.Context,
+	itemID string,
+	prev models.Listable,
+	added, removed []string,
+) (models.Listable, *details.SharePointInfo, error) {
+	lh.ChangeReads[itemID] = ListChanges{
+		Prev:    prev,
+		Added:   added,
+		Removed: removed,
+	}
+
+	return lh.GetItemByID(ctx, itemID)
+}
+
 func (lh ListHandler) GetItems(
 	context.Context,
 	api.CallConfig,
@@ -71,6 +102,32 @@ func (lh ListHandler) GetItems(
 	return lh.lists, lh.err
 }
 
+func (lh ListHandler) GetAddedAndRemovedItemIDs(
+	_ context.Context,
+	listID, prevDeltaLink string,
+	_ api.CallConfig,
+) (pagers.AddedAndRemoved, error) {
+	aar, ok := lh.ItemChanges[listID]
+	if !ok {
+		aar = pagers.AddedAndRemoved{
+			Added: map[string]time.Time{},
+			DU: pagers.DeltaUpdate{
+				URL:   "delta-" + listID,
+				Reset: len(prevDeltaLink) == 0,
+			},
+		}
+	}
+
+	return aar, nil
+}
+
+func (lh ListHandler) GetLatestItemsDeltaLink(
+	_ context.Context,
+	listID string,
+) (string, error) {
+	return "latest-" + listID, nil
+}
+
 func (lh ListHandler) CanonicalPath(
 	storageDirFolders path.Elements,
 	tenantID string,
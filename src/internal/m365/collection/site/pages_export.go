@@ -0,0 +1,382 @@
+package site
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/alcionai/clues"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/common/sanitize"
+	"github.com/alcionai/corso/src/internal/data"
+	betaAPI "github.com/alcionai/corso/src/internal/m365/service/sharepoint/api"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/export"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/metrics"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph/betasdk/models"
+)
+
+const pageFileExtension = ".aspx"
+
+// Pages are exported as a static html rendering of the page canvas,
+// along with the raw json of the page as it was backed up.  Web parts
+// which need the SharePoint runtime to render (ex: news, list views)
+// are rendered using the content SharePoint pre-processed for them on
+// the server, with a placeholder when no such content exists.
+
+const pageTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{ .Title }}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+.section { display: flex; gap: 2em; margin: 1em 0; }
+.column { flex: 1; }
+.vertical-section { border-left: 1px solid #e1dfdd; padding-left: 1em; }
+.webpart { margin: 0.5em 0; }
+.webpart-placeholder { color: #605e5c; font-style: italic; }
+img { max-width: 100%; }
+</style>
+</head>
+<body>
+{{- if .TextAboveTitle }}
+<div class="text-above-title">{{ .TextAboveTitle }}</div>
+{{- end }}
+{{- if .TitleImage }}
+<img class="title-image" src="{{ .TitleImage }}" alt="">
+{{- end }}
+<h1>{{ .Title }}</h1>
+{{- range .Sections }}
+<div class="section">
+{{- range .Columns }}
+<div class="column">
+{{- range . }}
+<div class="webpart">{{ . }}</div>
+{{- end }}
+</div>
+{{- end }}
+</div>
+{{- end }}
+{{- if .VerticalSection }}
+<div class="vertical-section">
+{{- range .VerticalSection }}
+<div class="webpart">{{ . }}</div>
+{{- end }}
+</div>
+{{- end }}
+</body>
+</html>
+`
+
+const standardWebPartTemplate = `
+{{- if .Title }}<h3>{{ .Title }}</h3>{{ end }}
+{{- range .HTML }}
+<div>{{ . }}</div>
+{{- end }}
+{{- range .Images }}
+<img src="{{ . }}" alt="">
+{{- end }}
+{{- range .Text }}
+<p>{{ . }}</p>
+{{- end }}
+{{- if .Links }}
+<ul>
+{{- range .Links }}
+<li><a href="{{ . }}">{{ . }}</a></li>
+{{- end }}
+</ul>
+{{- end }}
+{{- if .Placeholder }}
+<div class="webpart-placeholder">{{ .Placeholder }}</div>
+{{- end }}`
+
+var (
+	pageTmpl            = template.Must(template.New("page").Parse(pageTemplate))
+	standardWebPartTmpl = template.Must(template.New("webpart").Parse(standardWebPartTemplate))
+)
+
+type (
+	htmlPage struct {
+		Title           string
+		TextAboveTitle  string
+		TitleImage      string
+		Sections        []htmlSection
+		VerticalSection []template.HTML
+	}
+
+	htmlSection struct {
+		Columns [][]template.HTML
+	}
+
+	htmlStandardWebPart struct {
+		Title       string
+		HTML        []template.HTML
+		Images      []string
+		Text        []string
+		Links       []string
+		Placeholder string
+	}
+)
+
+func NewPageExportCollection(
+	baseDir string,
+	backingCollection []data.RestoreCollection,
+	backupVersion int,
+	stats *metrics.ExportStats,
+) export.Collectioner {
+	return export.BaseCollection{
+		BaseDir:           baseDir,
+		BackingCollection: backingCollection,
+		BackupVersion:     backupVersion,
+		Stream:            streamPages,
+		Stats:             stats,
+	}
+}
+
+func streamPages(
+	ctx context.Context,
+	drc []data.RestoreCollection,
+	backupVersion int,
+	config control.ExportConfig,
+	ch chan<- export.Item,
+	stats *metrics.ExportStats,
+) {
+	defer close(ch)
+
+	errs := fault.New(false)
+
+	for _, rc := range drc {
+		for item := range rc.Items(ctx, errs) {
+			ictx := clues.Add(ctx, "page_id", item.ID())
+
+			reader := item.ToReader()
+			content, err := io.ReadAll(reader)
+
+			reader.Close()
+
+			if err != nil {
+				ch <- export.Item{
+					ID:    item.ID(),
+					Error: clues.WrapWC(ictx, err, "reading page bytes"),
+				}
+
+				continue
+			}
+
+			page, err := betaAPI.BytesToSitePageable(content)
+			if err != nil {
+				ch <- export.Item{
+					ID:    item.ID(),
+					Error: clues.WrapWC(ictx, err, "deserializing page"),
+				}
+
+				continue
+			}
+
+			body, err := formatPageHTML(page)
+			if err != nil {
+				ch <- export.Item{
+					ID:    item.ID(),
+					Error: clues.StackWC(ictx, err),
+				}
+
+				continue
+			}
+
+			name := strings.TrimSuffix(ptr.Val(page.GetName()), pageFileExtension)
+			if len(name) == 0 {
+				name = item.ID()
+			}
+
+			stats.UpdateResourceCount(path.PagesCategory)
+
+			ch <- export.Item{
+				ID:   item.ID(),
+				Name: name + ".html",
+				Body: metrics.ReaderWithStats(body, path.PagesCategory, stats),
+			}
+
+			ch <- export.Item{
+				ID:   item.ID(),
+				Name: name + ".json",
+				Body: metrics.ReaderWithStats(
+					io.NopCloser(bytes.NewReader(content)),
+					path.PagesCategory,
+					stats),
+			}
+		}
+
+		items, recovered := errs.ItemsAndRecovered()
+
+		// Return all the items that we failed to source from the persistence layer
+		for _, item := range items {
+			ch <- export.Item{
+				ID:    item.ID,
+				Error: &item,
+			}
+		}
+
+		for _, err := range recovered {
+			ch <- export.Item{
+				Error: err,
+			}
+		}
+	}
+}
+
+// formatPageHTML renders the page canvas as a static html document.
+func formatPageHTML(page models.SitePageable) (io.ReadCloser, error) {
+	hp := htmlPage{
+		Title: ptr.Val(page.GetTitle()),
+	}
+
+	if ta := page.GetTitleArea(); ta != nil {
+		if ptr.Val(ta.GetShowTextBlockAboveTitle()) {
+			hp.TextAboveTitle = ptr.Val(ta.GetTextAboveTitle())
+		}
+
+		hp.TitleImage = ptr.Val(ta.GetImageWebUrl())
+	}
+
+	if len(hp.Title) == 0 {
+		hp.Title = strings.TrimSuffix(ptr.Val(page.GetName()), pageFileExtension)
+	}
+
+	canvas := page.GetCanvasLayout()
+
+	switch {
+	case canvas != nil:
+		for _, hs := range canvas.GetHorizontalSections() {
+			section := htmlSection{}
+
+			for _, col := range hs.GetColumns() {
+				parts, err := renderWebParts(col.GetWebparts())
+				if err != nil {
+					return nil, err
+				}
+
+				section.Columns = append(section.Columns, parts)
+			}
+
+			hp.Sections = append(hp.Sections, section)
+		}
+
+		if vs := canvas.GetVerticalSection(); vs != nil {
+			parts, err := renderWebParts(vs.GetWebparts())
+			if err != nil {
+				return nil, err
+			}
+
+			hp.VerticalSection = parts
+		}
+
+	// pages without a canvas layout only list their web parts.
+	case len(page.GetWebParts()) > 0:
+		parts, err := renderWebParts(page.GetWebParts())
+		if err != nil {
+			return nil, err
+		}
+
+		hp.Sections = []htmlSection{{Columns: [][]template.HTML{parts}}}
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := pageTmpl.Execute(buf, hp); err != nil {
+		return nil, clues.Wrap(err, "rendering page html")
+	}
+
+	return io.NopCloser(buf), nil
+}
+
+func renderWebParts(wps []models.WebPartable) ([]template.HTML, error) {
+	parts := make([]template.HTML, 0, len(wps))
+
+	for _, wp := range wps {
+		part, err := renderWebPart(wp)
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, part)
+	}
+
+	return parts, nil
+}
+
+func renderWebPart(wp models.WebPartable) (template.HTML, error) {
+	switch part := wp.(type) {
+	case models.TextWebPartable:
+		return sanitizedHTML(ptr.Val(part.GetInnerHtml())), nil
+	case models.StandardWebPartable:
+		return renderStandardWebPart(part)
+	}
+
+	// web parts serialized without an odata type are deserialized as the
+	// base type, with the text content left in the additional data.
+	if ad, ok := wp.(interface{ GetAdditionalData() map[string]any }); ok {
+		if inner, ok := ad.GetAdditionalData()["innerHtml"].(*string); ok {
+			return sanitizedHTML(ptr.Val(inner)), nil
+		}
+	}
+
+	return "", nil
+}
+
+func renderStandardWebPart(wp models.StandardWebPartable) (template.HTML, error) {
+	hwp := htmlStandardWebPart{}
+
+	wpd := wp.GetData()
+	if wpd != nil {
+		hwp.Title = ptr.Val(wpd.GetTitle())
+
+		if spc := wpd.GetServerProcessedContent(); spc != nil {
+			for _, kv := range spc.GetHtmlStrings() {
+				hwp.HTML = append(hwp.HTML, sanitizedHTML(ptr.Val(kv.GetValue())))
+			}
+
+			for _, kv := range spc.GetImageSources() {
+				hwp.Images = append(hwp.Images, ptr.Val(kv.GetValue()))
+			}
+
+			for _, kv := range spc.GetSearchablePlainTexts() {
+				hwp.Text = append(hwp.Text, ptr.Val(kv.GetValue()))
+			}
+
+			for _, kv := range spc.GetLinks() {
+				hwp.Links = append(hwp.Links, ptr.Val(kv.GetValue()))
+			}
+		}
+	}
+
+	if len(hwp.HTML)+len(hwp.Images)+len(hwp.Text)+len(hwp.Links) == 0 {
+		hwp.Placeholder = "This web part can only be displayed in SharePoint"
+
+		if wpd != nil && len(ptr.Val(wpd.GetDescription())) > 0 {
+			hwp.Placeholder += ": " + ptr.Val(wpd.GetDescription())
+		}
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := standardWebPartTmpl.Execute(buf, hwp); err != nil {
+		return "", clues.Wrap(err, "rendering web part html")
+	}
+
+	//nolint:gosec // produced by the template, which escapes its values
+	return template.HTML(buf.String()), nil
+}
+
+// sanitizedHTML rebuilds web part html from an allow-list of elements
+// and attributes.  The html comes from the backup, and the exported
+// page gets opened in a browser.
+func sanitizedHTML(content string) template.HTML {
+	//nolint:gosec // sanitized against an allow-list
+	return template.HTML(sanitize.HTML(content))
+}
@@ -1,11 +1,18 @@
 package site
 
 import (
+	"io"
+	"strings"
 	"testing"
 
+	"github.com/alcionai/clues"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	betaAPI "github.com/alcionai/corso/src/internal/m365/service/sharepoint/api"
+	spMock "github.com/alcionai/corso/src/internal/m365/service/sharepoint/mock"
 	"github.com/alcionai/corso/src/internal/tester"
 	"github.com/alcionai/corso/src/pkg/backup/details"
 	"github.com/alcionai/corso/src/pkg/services/m365/api/graph/betasdk/models"
@@ -58,3 +65,130 @@ func (suite *PagesUnitSuite) TestSharePointInfo_Pages() {
 		})
 	}
 }
+
+func (suite *PagesUnitSuite) TestFormatPageHTML() {
+	t := suite.T()
+
+	text := models.NewTextWebPart()
+	text.SetInnerHtml(ptr.To("<p><b>Hello!</b></p>"))
+
+	image := models.NewStandardWebPart()
+	imageData := models.NewWebPartData()
+	imageData.SetTitle(ptr.To("Image"))
+
+	imageSource := models.NewMetaDataKeyStringPair()
+	imageSource.SetKey(ptr.To("imageSource"))
+	imageSource.SetValue(ptr.To("/images/hello.jpg"))
+
+	spc := models.NewServerProcessedContent()
+	spc.SetImageSources([]models.MetaDataKeyStringPairable{imageSource})
+	imageData.SetServerProcessedContent(spc)
+	image.SetData(imageData)
+
+	news := models.NewStandardWebPart()
+	newsData := models.NewWebPartData()
+	newsData.SetDescription(ptr.To("Show news"))
+	news.SetData(newsData)
+
+	column := models.NewHorizontalSectionColumn()
+	column.SetWebparts([]models.WebPartable{text, image})
+
+	section := models.NewHorizontalSection()
+	section.SetColumns([]models.HorizontalSectionColumnable{column})
+
+	vertical := models.NewVerticalSection()
+	vertical.SetWebparts([]models.WebPartable{news})
+
+	canvas := models.NewCanvasLayout()
+	canvas.SetHorizontalSections([]models.HorizontalSectionable{section})
+	canvas.SetVerticalSection(vertical)
+
+	page := models.NewSitePage()
+	page.SetName(ptr.To("Home.aspx"))
+	page.SetTitle(ptr.To("Home & Away"))
+	page.SetCanvasLayout(canvas)
+
+	rc, err := formatPageHTML(page)
+	require.NoError(t, err, clues.ToCore(err))
+
+	bs, err := io.ReadAll(rc)
+	require.NoError(t, err, clues.ToCore(err))
+
+	result := string(bs)
+
+	assert.Contains(t, result, "<title>Home &amp; Away</title>")
+	assert.Contains(t, result, "<p><b>Hello!</b></p>")
+	assert.Contains(t, result, "<h3>Image</h3>")
+	assert.Contains(t, result, `<img src="/images/hello.jpg" alt="">`)
+	assert.Contains(t, result, "This web part can only be displayed in SharePoint: Show news")
+	assert.Less(
+		t,
+		strings.Index(result, "Hello!"),
+		strings.Index(result, "/images/hello.jpg"),
+		"web parts are rendered in order")
+}
+
+func (suite *PagesUnitSuite) TestFormatPageHTML_stored() {
+	t := suite.T()
+
+	page, err := betaAPI.BytesToSitePageable(spMock.Page("Home"))
+	require.NoError(t, err, clues.ToCore(err))
+
+	rc, err := formatPageHTML(page)
+	require.NoError(t, err, clues.ToCore(err))
+
+	bs, err := io.ReadAll(rc)
+	require.NoError(t, err, clues.ToCore(err))
+
+	result := string(bs)
+
+	assert.Contains(t, result, "<h1>Home</h1>")
+	assert.Contains(t, result, "<p><b>Hello!</b></p>")
+}
+
+func (suite *PagesUnitSuite) TestFormatPageHTML_sanitized() {
+	t := suite.T()
+
+	text := models.NewTextWebPart()
+	text.SetInnerHtml(ptr.To(`<p>text</p><svg><style><img src=x onerror=alert(1)></style></svg>`))
+
+	htmlString := models.NewMetaDataKeyStringPair()
+	htmlString.SetKey(ptr.To("html"))
+	htmlString.SetValue(ptr.To(`<p onclick="alert(2)">standard</p><script>alert(3)</script>`))
+
+	spc := models.NewServerProcessedContent()
+	spc.SetHtmlStrings([]models.MetaDataKeyStringPairable{htmlString})
+
+	data := models.NewWebPartData()
+	data.SetServerProcessedContent(spc)
+
+	standard := models.NewStandardWebPart()
+	standard.SetData(data)
+
+	column := models.NewHorizontalSectionColumn()
+	column.SetWebparts([]models.WebPartable{text, standard})
+
+	section := models.NewHorizontalSection()
+	section.SetColumns([]models.HorizontalSectionColumnable{column})
+
+	canvas := models.NewCanvasLayout()
+	canvas.SetHorizontalSections([]models.HorizontalSectionable{section})
+
+	page := models.NewSitePage()
+	page.SetTitle(ptr.To("Page"))
+	page.SetCanvasLayout(canvas)
+
+	rc, err := formatPageHTML(page)
+	require.NoError(t, err, clues.ToCore(err))
+
+	bs, err := io.ReadAll(rc)
+	require.NoError(t, err, clues.ToCore(err))
+
+	result := string(bs)
+
+	assert.Contains(t, result, "<p>text</p>")
+	assert.Contains(t, result, "<p>standard</p>")
+	assert.NotContains(t, result, "alert(")
+	assert.NotContains(t, result, "<svg")
+	assert.NotContains(t, result, "<script")
+}
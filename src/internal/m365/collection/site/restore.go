@@ -52,6 +52,12 @@ func restoreListItem(
 
 	ctx = clues.Add(ctx, "list_item_id", itemID)
 
+	// mirroring only applies to drive items.  Lists in the restore
+	// target which aren't in the backup are left in place.
+	if collisionPolicy == control.Mirror {
+		collisionPolicy = control.Replace
+	}
+
 	bytes, err := io.ReadAll(itemData.ToReader())
 	if err != nil {
 		return dii, clues.WrapWC(ctx, err, "reading backup data")
@@ -0,0 +1,134 @@
+package site
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/alcionai/clues"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/data"
+	"github.com/alcionai/corso/src/internal/diagnostics"
+	betaAPI "github.com/alcionai/corso/src/internal/m365/service/sharepoint/api"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/restoreplan"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+)
+
+// PlanListCollection records how each list in the collection would get
+// restored, without writing any data.  Lists are restored into the root
+// of the site, so the site ID is reported as their container.
+func PlanListCollection(
+	ctx context.Context,
+	dc data.RestoreCollection,
+	restoreCfg control.RestoreConfig,
+	collisionKeyToItemID map[string]string,
+	plan *restoreplan.Plan,
+	errs *fault.Bus,
+) error {
+	ctx, end := diagnostics.Span(ctx, "m365:sharepoint:planListCollection", diagnostics.Label("path", dc.FullPath()))
+	defer end()
+
+	var (
+		el       = errs.Local()
+		category = dc.FullPath().Category()
+		siteID   = dc.FullPath().ProtectedResource()
+	)
+
+	for itemData := range dc.Items(ctx, errs) {
+		if el.Failure() != nil {
+			break
+		}
+
+		ictx := clues.Add(ctx, "list_item_id", itemData.ID())
+
+		bs, err := io.ReadAll(itemData.ToReader())
+		if err != nil {
+			el.AddRecoverable(ictx, clues.WrapWC(ictx, err, "reading backup data"))
+			continue
+		}
+
+		storedList, err := api.BytesToListable(bs)
+		if err != nil {
+			el.AddRecoverable(ictx, clues.WrapWC(ictx, err, "generating list from stored bytes"))
+			continue
+		}
+
+		_, collides := collisionKeyToItemID[api.ListCollisionKey(storedList)]
+		action := restoreplan.ActionFor(collides, restoreCfg.OnCollision)
+
+		// replaced lists get renamed to their original name after
+		// the collision is deleted.  All others keep the prefixed name.
+		name := formatListsRestoreDestination(restoreCfg.Location, itemData.ID(), storedList)
+		if action == restoreplan.Replace {
+			name = ptr.Val(storedList.GetDisplayName())
+		}
+
+		plan.Add(restoreplan.Item{
+			ItemID:        itemData.ID(),
+			Name:          name,
+			Category:      category.HumanString(),
+			Action:        action,
+			ContainerPath: siteID,
+		})
+	}
+
+	return el.Failure()
+}
+
+// PlanPageCollection records the pages in the collection as they would
+// get restored, without writing any data.  Pages don't collide with
+// existing pages, so every page is created under a prefixed name.
+func PlanPageCollection(
+	ctx context.Context,
+	dc data.RestoreCollection,
+	restoreContainerName string,
+	plan *restoreplan.Plan,
+	errs *fault.Bus,
+) error {
+	ctx, end := diagnostics.Span(ctx, "m365:sharepoint:planPageCollection", diagnostics.Label("path", dc.FullPath()))
+	defer end()
+
+	var (
+		el       = errs.Local()
+		category = dc.FullPath().Category()
+		siteID   = dc.FullPath().ProtectedResource()
+	)
+
+	for itemData := range dc.Items(ctx, errs) {
+		if el.Failure() != nil {
+			break
+		}
+
+		ictx := clues.Add(ctx, "page_id", itemData.ID())
+
+		bs, err := io.ReadAll(itemData.ToReader())
+		if err != nil {
+			el.AddRecoverable(ictx, clues.WrapWC(ictx, err, "reading sharepoint data"))
+			continue
+		}
+
+		page, err := betaAPI.BytesToSitePageable(bs)
+		if err != nil {
+			el.AddRecoverable(ictx, clues.WrapWC(ictx, err, "creating Page object"))
+			continue
+		}
+
+		pageName := itemData.ID()
+		if name, ok := ptr.ValOK(page.GetName()); ok {
+			pageName = name
+		}
+
+		plan.Add(restoreplan.Item{
+			ItemID:        itemData.ID(),
+			Name:          fmt.Sprintf("%s_%s", restoreContainerName, pageName),
+			Category:      category.HumanString(),
+			Action:        restoreplan.Create,
+			ContainerPath: siteID,
+		})
+	}
+
+	return el.Failure()
+}
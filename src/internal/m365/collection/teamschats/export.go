@@ -0,0 +1,285 @@
+package teamschats
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/alcionai/clues"
+	kjson "github.com/microsoft/kiota-serialization-json-go"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/data"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/export"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/metrics"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+)
+
+func NewExportCollection(
+	baseDir string,
+	backingCollections []data.RestoreCollection,
+	backupVersion int,
+	cec control.ExportConfig,
+	stats *metrics.ExportStats,
+) export.Collectioner {
+	return export.BaseCollection{
+		BaseDir:           baseDir,
+		BackingCollection: backingCollections,
+		BackupVersion:     backupVersion,
+		Cfg:               cec,
+		Stream:            streamChats,
+		Stats:             stats,
+	}
+}
+
+// streamChats streams the items in the backingCollection into the export stream chan
+func streamChats(
+	ctx context.Context,
+	drc []data.RestoreCollection,
+	backupVersion int,
+	cec control.ExportConfig,
+	ch chan<- export.Item,
+	stats *metrics.ExportStats,
+) {
+	defer close(ch)
+
+	errs := fault.New(false)
+
+	for _, rc := range drc {
+		for item := range rc.Items(ctx, errs) {
+			body, err := formatChat(cec, item.ToReader())
+			if err != nil {
+				ch <- export.Item{
+					ID:    item.ID(),
+					Error: err,
+				}
+			} else {
+				stats.UpdateResourceCount(path.ChatsCategory)
+				body = metrics.ReaderWithStats(body, path.ChatsCategory, stats)
+
+				// chats are exported as json and should be named as such
+				name := item.ID() + ".json"
+
+				ch <- export.Item{
+					ID:   item.ID(),
+					Name: name,
+					Body: body,
+				}
+			}
+		}
+
+		items, recovered := errs.ItemsAndRecovered()
+
+		// Return all the items that we failed to source from the persistence layer
+		for _, item := range items {
+			ch <- export.Item{
+				ID:    item.ID,
+				Error: &item,
+			}
+		}
+
+		for _, err := range recovered {
+			ch <- export.Item{
+				Error: err,
+			}
+		}
+	}
+}
+
+type (
+	minimumChat struct {
+		CreatedDateTime     time.Time            `json:"createdDateTime"`
+		LastUpdatedDateTime time.Time            `json:"lastUpdatedDateTime"`
+		Members             []string             `json:"members"`
+		Messages            []minimumChatMessage `json:"messages"`
+		Topic               string               `json:"topic"`
+	}
+
+	minimumChatMessage struct {
+		Attachments          []minimumAttachment    `json:"attachments"`
+		Content              string                 `json:"content"`
+		CreatedDateTime      time.Time              `json:"createdDateTime"`
+		From                 string                 `json:"from"`
+		HostedContents       []minimumHostedContent `json:"hostedContents,omitempty"`
+		LastModifiedDateTime time.Time              `json:"lastModifiedDateTime"`
+	}
+
+	minimumAttachment struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+
+	// hosted contents are the inline images and other blobs that
+	// are referenced from within the message body.  The bytes get
+	// base64 encoded by the json marshaller.
+	minimumHostedContent struct {
+		ID          string `json:"id"`
+		ContentType string `json:"contentType"`
+		Content     []byte `json:"content"`
+	}
+)
+
+func formatChat(
+	cec control.ExportConfig,
+	rc io.ReadCloser,
+) (io.ReadCloser, error) {
+	limited := !cec.MessagesCreatedAfter.IsZero() || !cec.MessagesCreatedBefore.IsZero()
+
+	if cec.Format == control.JSONFormat && !limited {
+		return rc, nil
+	}
+
+	bs, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, clues.Wrap(err, "reading item bytes")
+	}
+
+	defer rc.Close()
+
+	cfb, err := api.CreateFromBytes(bs, models.CreateChatFromDiscriminatorValue)
+	if err != nil {
+		return nil, clues.Wrap(err, "deserializing bytes to chat")
+	}
+
+	chat, ok := cfb.(models.Chatable)
+	if !ok {
+		return nil, clues.New("expected deserialized item to implement models.Chatable")
+	}
+
+	msgs := filterChatMessages(cec, chat.GetMessages())
+
+	if cec.Format == control.JSONFormat {
+		chat.SetMessages(msgs)
+		return serializeChat(chat)
+	}
+
+	members := chat.GetMembers()
+
+	mc := minimumChat{
+		CreatedDateTime:     ptr.Val(chat.GetCreatedDateTime()),
+		LastUpdatedDateTime: ptr.Val(chat.GetLastUpdatedDateTime()),
+		Members:             make([]string, 0, len(members)),
+		Messages:            make([]minimumChatMessage, 0, len(msgs)),
+		Topic:               ptr.Val(chat.GetTopic()),
+	}
+
+	for _, m := range members {
+		mc.Members = append(mc.Members, ptr.Val(m.GetDisplayName()))
+	}
+
+	for _, msg := range msgs {
+		mc.Messages = append(mc.Messages, makeMinimumChatMessage(msg))
+	}
+
+	bs, err = marshalJSONContainingHTML(mc)
+	if err != nil {
+		return nil, clues.Wrap(err, "serializing minimized chat")
+	}
+
+	return io.NopCloser(bytes.NewReader(bs)), nil
+}
+
+// filterChatMessages drops the messages created outside of the
+// export config's message bounds.
+func filterChatMessages(
+	cec control.ExportConfig,
+	msgs []models.ChatMessageable,
+) []models.ChatMessageable {
+	after, before := cec.MessagesCreatedAfter, cec.MessagesCreatedBefore
+
+	if after.IsZero() && before.IsZero() {
+		return msgs
+	}
+
+	filtered := make([]models.ChatMessageable, 0, len(msgs))
+
+	for _, msg := range msgs {
+		created := ptr.Val(msg.GetCreatedDateTime())
+
+		if !after.IsZero() && !created.After(after) {
+			continue
+		}
+
+		if !before.IsZero() && !created.Before(before) {
+			continue
+		}
+
+		filtered = append(filtered, msg)
+	}
+
+	return filtered
+}
+
+func serializeChat(chat models.Chatable) (io.ReadCloser, error) {
+	writer := kjson.NewJsonSerializationWriter()
+	defer writer.Close()
+
+	if err := writer.WriteObjectValue("", chat); err != nil {
+		return nil, clues.Wrap(err, "serializing chat")
+	}
+
+	bs, err := writer.GetSerializedContent()
+	if err != nil {
+		return nil, clues.Wrap(err, "serializing chat")
+	}
+
+	return io.NopCloser(bytes.NewReader(bs)), nil
+}
+
+// json.Marshal will replace many markup tags (ex: "<" and ">") with their unicode
+// equivalent.  In order to maintain parity with original content that contains html,
+// we have to use this alternative encoding behavior.
+func marshalJSONContainingHTML(a any) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+
+	err := encoder.Encode(a)
+
+	return buffer.Bytes(), clues.Stack(err).OrNil()
+}
+
+func makeMinimumChatMessage(item models.ChatMessageable) minimumChatMessage {
+	var content string
+
+	if item.GetBody() != nil {
+		content = ptr.Val(item.GetBody().GetContent())
+	}
+
+	attachments := item.GetAttachments()
+	minAttachments := make([]minimumAttachment, 0, len(attachments))
+
+	for _, a := range attachments {
+		minAttachments = append(minAttachments, minimumAttachment{
+			ID:   ptr.Val(a.GetId()),
+			Name: ptr.Val(a.GetName()),
+		})
+	}
+
+	hosted := item.GetHostedContents()
+	minHosted := make([]minimumHostedContent, 0, len(hosted))
+
+	for _, h := range hosted {
+		minHosted = append(minHosted, minimumHostedContent{
+			ID:          ptr.Val(h.GetId()),
+			ContentType: ptr.Val(h.GetContentType()),
+			Content:     h.GetContentBytes(),
+		})
+	}
+
+	return minimumChatMessage{
+		Attachments:          minAttachments,
+		Content:              content,
+		CreatedDateTime:      ptr.Val(item.GetCreatedDateTime()),
+		From:                 api.GetChatMessageFrom(item),
+		HostedContents:       minHosted,
+		LastModifiedDateTime: ptr.Val(item.GetLastModifiedDateTime()),
+	}
+}
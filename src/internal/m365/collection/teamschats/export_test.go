@@ -0,0 +1,262 @@
+package teamschats
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/alcionai/clues"
+	kjson "github.com/microsoft/kiota-serialization-json-go"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/data"
+	dataMock "github.com/alcionai/corso/src/internal/data/mock"
+	"github.com/alcionai/corso/src/internal/m365/collection/teamschats/testdata"
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/internal/version"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/export"
+	"github.com/alcionai/corso/src/pkg/metrics"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+)
+
+type ExportUnitSuite struct {
+	tester.Suite
+}
+
+func TestExportUnitSuite(t *testing.T) {
+	suite.Run(t, &ExportUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func (suite *ExportUnitSuite) TestStreamChats() {
+	makeBody := func() io.ReadCloser {
+		return io.NopCloser(bytes.NewReader([]byte("{}")))
+	}
+
+	table := []struct {
+		name        string
+		backingColl dataMock.Collection
+		expectName  string
+		expectErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "no errors",
+			backingColl: dataMock.Collection{
+				ItemData: []data.Item{
+					&dataMock.Item{
+						ItemID: "zim",
+						Reader: makeBody(),
+					},
+				},
+			},
+			expectName: "zim.json",
+			expectErr:  assert.NoError,
+		},
+		{
+			name: "only recoverable errors",
+			backingColl: dataMock.Collection{
+				ItemsRecoverableErrs: []error{
+					clues.New("The knowledge... it fills me! It is neat!"),
+				},
+			},
+			expectErr: assert.Error,
+		},
+		{
+			name: "items and recoverable errors",
+			backingColl: dataMock.Collection{
+				ItemData: []data.Item{
+					&dataMock.Item{
+						ItemID: "gir",
+						Reader: makeBody(),
+					},
+				},
+				ItemsRecoverableErrs: []error{
+					clues.New("I miss my cupcake."),
+				},
+			},
+			expectName: "gir.json",
+			expectErr:  assert.Error,
+		},
+	}
+
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			ch := make(chan export.Item)
+
+			go streamChats(
+				ctx,
+				[]data.RestoreCollection{test.backingColl},
+				version.NoBackup,
+				control.DefaultExportConfig(),
+				ch,
+				&metrics.ExportStats{})
+
+			var (
+				itm export.Item
+				err error
+			)
+
+			for i := range ch {
+				if i.Error == nil {
+					itm = i
+				} else {
+					err = i.Error
+				}
+			}
+
+			test.expectErr(t, err, clues.ToCore(err))
+
+			assert.Equal(t, test.expectName, itm.Name, "item name")
+		})
+	}
+}
+
+func (suite *ExportUnitSuite) TestFormatChat() {
+	t := suite.T()
+
+	chat := testdata.StubChats("fnords")[0]
+	msgs := testdata.StubChatMessages("hello", "world")
+
+	member := models.NewConversationMember()
+	member.SetDisplayName(ptr.To("smarf"))
+	chat.SetMembers([]models.ConversationMemberable{member})
+
+	hosted := models.NewChatMessageHostedContent()
+	hosted.SetId(ptr.To("hcid"))
+	hosted.SetContentType(ptr.To("image/png"))
+	hosted.SetContentBytes([]byte("png bytes"))
+	msgs[0].SetHostedContents([]models.ChatMessageHostedContentable{hosted})
+
+	chat.SetMessages(msgs)
+
+	sw := kjson.NewJsonSerializationWriter()
+	err := sw.WriteObjectValue("", chat)
+	require.NoError(t, err, clues.ToCore(err))
+
+	bs, err := sw.GetSerializedContent()
+	require.NoError(t, err, clues.ToCore(err))
+
+	rc, err := formatChat(
+		control.DefaultExportConfig(),
+		io.NopCloser(bytes.NewReader(bs)))
+	require.NoError(t, err, clues.ToCore(err))
+
+	result, err := io.ReadAll(rc)
+	require.NoError(t, err, clues.ToCore(err))
+
+	var mc minimumChat
+
+	err = json.Unmarshal(result, &mc)
+	require.NoError(t, err, clues.ToCore(err))
+
+	assert.Equal(t, "fnords", mc.Topic)
+	assert.Equal(t, []string{"smarf"}, mc.Members)
+	require.Len(t, mc.Messages, 2)
+	assert.Equal(t, "hello", mc.Messages[0].Content)
+	assert.Equal(t, "world", mc.Messages[1].Content)
+	require.Len(t, mc.Messages[0].HostedContents, 1)
+	assert.Equal(t, "image/png", mc.Messages[0].HostedContents[0].ContentType)
+	assert.Equal(t, []byte("png bytes"), mc.Messages[0].HostedContents[0].Content)
+	assert.Empty(t, mc.Messages[1].HostedContents)
+}
+
+func (suite *ExportUnitSuite) TestFormatChat_messageBounds() {
+	var (
+		now    = time.Now().UTC().Truncate(time.Second)
+		past   = now.Add(-time.Hour)
+		future = now.Add(time.Hour)
+	)
+
+	chat := testdata.StubChats("fnords")[0]
+	msgs := testdata.StubChatMessages("past", "now", "future")
+	msgs[0].SetCreatedDateTime(ptr.To(past))
+	msgs[1].SetCreatedDateTime(ptr.To(now))
+	msgs[2].SetCreatedDateTime(ptr.To(future))
+	chat.SetMessages(msgs)
+
+	sw := kjson.NewJsonSerializationWriter()
+	err := sw.WriteObjectValue("", chat)
+	require.NoError(suite.T(), err, clues.ToCore(err))
+
+	bs, err := sw.GetSerializedContent()
+	require.NoError(suite.T(), err, clues.ToCore(err))
+
+	table := []struct {
+		name   string
+		after  time.Time
+		before time.Time
+		expect []string
+	}{
+		{
+			name:   "no bounds",
+			expect: []string{"past", "now", "future"},
+		},
+		{
+			name:   "after",
+			after:  past,
+			expect: []string{"now", "future"},
+		},
+		{
+			name:   "before",
+			before: future,
+			expect: []string{"past", "now"},
+		},
+		{
+			name:   "after and before",
+			after:  past,
+			before: future,
+			expect: []string{"now"},
+		},
+	}
+	for _, test := range table {
+		for _, format := range []control.FormatType{control.DefaultFormat, control.JSONFormat} {
+			suite.Run(test.name+" "+string(format), func() {
+				t := suite.T()
+
+				cec := control.DefaultExportConfig()
+				cec.Format = format
+				cec.MessagesCreatedAfter = test.after
+				cec.MessagesCreatedBefore = test.before
+
+				rc, err := formatChat(cec, io.NopCloser(bytes.NewReader(bs)))
+				require.NoError(t, err, clues.ToCore(err))
+
+				result, err := io.ReadAll(rc)
+				require.NoError(t, err, clues.ToCore(err))
+
+				var contents []string
+
+				if format == control.JSONFormat {
+					parsed, err := api.CreateFromBytes(result, models.CreateChatFromDiscriminatorValue)
+					require.NoError(t, err, clues.ToCore(err))
+
+					for _, msg := range parsed.(models.Chatable).GetMessages() {
+						contents = append(contents, ptr.Val(msg.GetBody().GetContent()))
+					}
+				} else {
+					var mc minimumChat
+
+					err = json.Unmarshal(result, &mc)
+					require.NoError(t, err, clues.ToCore(err))
+
+					for _, msg := range mc.Messages {
+						contents = append(contents, msg.Content)
+					}
+				}
+
+				assert.Equal(t, test.expect, contents)
+			})
+		}
+	}
+}
@@ -102,6 +102,23 @@ func NewController(
 	return &ctrl, nil
 }
 
+// ForOperation returns a controller that shares the client connection and
+// credentials of ctrl, but keeps its own protected resource lookup and
+// operation status.  Operations that run concurrently need their own
+// controller, since the status of a controller tracks a single operation.
+func (ctrl *Controller) ForOperation() *Controller {
+	return &Controller{
+		AC:                 ctrl.AC,
+		IDNameLookup:       idname.NewCache(nil),
+		credentials:        ctrl.credentials,
+		tenant:             ctrl.tenant,
+		resourceHandler:    ctrl.resourceHandler,
+		wg:                 &sync.WaitGroup{},
+		backupDriveIDNames: idname.NewCache(nil),
+		backupSiteIDWebURL: idname.NewCache(nil),
+	}
+}
+
 func (ctrl *Controller) VerifyAccess(ctx context.Context) error {
 	return ctrl.AC.Access().GetToken(ctx)
 }
@@ -300,6 +300,34 @@ func (suite *ControllerUnitSuite) TestController_Wait() {
 	assert.Equal(t, int64(4), result.Bytes)
 }
 
+func (suite *ControllerUnitSuite) TestController_ForOperation() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	var (
+		ctrl = &Controller{
+			tenant:       "tenant",
+			IDNameLookup: idname.NewCache(map[string]string{"id": "name"}),
+			wg:           &sync.WaitGroup{},
+		}
+		metrics = support.CollectionMetrics{Objects: 2}
+		status  = support.CreateStatus(ctx, support.Backup, 1, metrics, "details")
+	)
+
+	opCtrl := ctrl.ForOperation()
+
+	assert.Equal(t, ctrl.tenant, opCtrl.tenant)
+	assert.Empty(t, opCtrl.IDNameLookup.IDs(), "resource lookup")
+
+	opCtrl.wg.Add(1)
+	opCtrl.UpdateStatus(status)
+
+	assert.Empty(t, ctrl.status, "original controller status")
+	assert.Equal(t, 2, opCtrl.Wait().Objects)
+}
+
 func (suite *ControllerUnitSuite) TestController_CacheItemInfo() {
 	var (
 		odid   = "od-id"
@@ -80,6 +80,30 @@ func (exchangeBackup) ProduceBackupCollections(
 			break
 		}
 
+		// mailbox settings aren't stored in folders, and have no delta
+		// queries, so they don't go through the container-based handlers.
+		if scope.Category().PathType() == path.MailboxSettingsCategory {
+			dcs, err := exchange.CreateMailboxSettingsCollections(
+				ctx,
+				bpc,
+				ac.MailboxSettings(),
+				tenantID,
+				scope,
+				su,
+				counter,
+				errs)
+			if err != nil {
+				el.AddRecoverable(ctx, err)
+				continue
+			}
+
+			categories[path.MailboxSettingsCategory] = struct{}{}
+
+			collections = append(collections, dcs...)
+
+			continue
+		}
+
 		dcs, err := exchange.CreateCollections(
 			ctx,
 			bpc,
@@ -61,10 +61,33 @@ func (h *baseExchangeHandler) ProduceExportCollections(
 		category := dc.FullPath().Category()
 
 		switch category {
-		case path.ContactsCategory, path.EmailCategory, path.EventsCategory:
+		case path.ContactsCategory,
+			path.EmailCategory,
+			path.EventsCategory,
+			path.TasksCategory,
+			path.MailboxSettingsCategory:
 			folders := dc.FullPath().Folders()
 			pth := path.Builder{}.Append(category.HumanString()).Append(folders...)
 
+			if category == path.EmailCategory && exportCfg.Format == control.MBOXFormat {
+				// Each folder is written as a single mbox file within its
+				// parent folder, which keeps the folder hierarchy intact.
+				pth = path.Builder{}.Append(category.HumanString())
+				if len(folders) > 0 {
+					pth = pth.Append(folders[:len(folders)-1]...)
+				}
+
+				ec = append(
+					ec,
+					exchange.NewMboxExportCollection(
+						pth.String(),
+						[]data.RestoreCollection{dc},
+						backupVersion,
+						stats))
+
+				continue
+			}
+
 			ec = append(
 				ec,
 				exchange.NewExportCollection(
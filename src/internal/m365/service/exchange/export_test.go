@@ -3,10 +3,12 @@ package exchange
 import (
 	"bytes"
 	"io"
+	"strings"
 	"testing"
 
 	"github.com/alcionai/clues"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/alcionai/corso/src/internal/converters/eml/testdata"
@@ -460,3 +462,125 @@ func (suite *ExportUnitSuite) TestExportRestoreCollections() {
 		})
 	}
 }
+
+func (suite *ExportUnitSuite) TestExportRestoreCollections_mbox() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	emailBodyBytes := []byte(testdata.EmailWithAttachments)
+
+	inbox, err := path.Builder{}.
+		Append("Inbox").
+		ToDataLayerPath("t", "r", path.ExchangeService, path.EmailCategory, false)
+	require.NoError(t, err, "build path")
+
+	sub, err := path.Builder{}.
+		Append("Inbox", "Sub").
+		ToDataLayerPath("t", "r", path.ExchangeService, path.EmailCategory, false)
+	require.NoError(t, err, "build path")
+
+	dcs := []data.RestoreCollection{
+		data.FetchRestoreCollection{
+			Collection: dataMock.Collection{
+				Path: inbox,
+				ItemData: []data.Item{
+					&dataMock.Item{
+						ItemID: "id1",
+						Reader: io.NopCloser(bytes.NewReader(emailBodyBytes)),
+					},
+					&dataMock.Item{
+						ItemID:  "id2",
+						ReadErr: assert.AnError,
+					},
+					&dataMock.Item{
+						ItemID: "id3",
+						Reader: io.NopCloser(bytes.NewReader(emailBodyBytes)),
+					},
+				},
+			},
+		},
+		data.FetchRestoreCollection{
+			Collection: dataMock.Collection{
+				Path: sub,
+				ItemData: []data.Item{
+					&dataMock.Item{
+						ItemID: "id4",
+						Reader: io.NopCloser(bytes.NewReader(emailBodyBytes)),
+					},
+				},
+			},
+		},
+	}
+
+	expected := []struct {
+		basePath string
+		name     string
+		messages int
+		errors   int
+	}{
+		{
+			basePath: "Emails",
+			name:     "Inbox.mbox",
+			messages: 2,
+			errors:   1,
+		},
+		{
+			basePath: "Emails/Inbox",
+			name:     "Sub.mbox",
+			messages: 1,
+		},
+	}
+
+	stats := metrics.NewExportStats()
+
+	ecs, err := NewExchangeHandler(api.Client{}, nil).
+		ProduceExportCollections(
+			ctx,
+			int(version.Backup),
+			control.ExportConfig{Format: control.MBOXFormat},
+			dcs,
+			stats,
+			fault.New(true))
+	require.NoError(t, err, "export collections error")
+	require.Len(t, ecs, len(expected), "num of collections")
+
+	for i, ec := range ecs {
+		assert.Equal(t, expected[i].basePath, ec.BasePath(), "base path")
+
+		var (
+			mboxes = 0
+			errs   = 0
+		)
+
+		for item := range ec.Items(ctx) {
+			if item.Error != nil {
+				errs++
+				continue
+			}
+
+			mboxes++
+
+			assert.Equal(t, expected[i].name, item.Name, "name")
+
+			b, err := io.ReadAll(item.Body)
+			require.NoError(t, err, clues.ToCore(err))
+
+			fromLines := 0
+
+			for _, line := range strings.Split(string(b), "\n") {
+				if strings.HasPrefix(line, "From ") {
+					fromLines++
+				}
+			}
+
+			assert.Equal(t, expected[i].messages, fromLines, "messages in mbox")
+		}
+
+		assert.Equal(t, 1, mboxes, "one mbox per folder")
+		assert.Equal(t, expected[i].errors, errs, "errors")
+	}
+
+	assert.Equal(t, int64(3), stats.GetStats()[path.EmailCategory].ResourceCount, "resource count")
+}
@@ -45,7 +45,7 @@ func (h *exchangeHandler) ConsumeRestoreCollections(
 		deets          = &details.Builder{}
 		resourceID     = rcc.ProtectedResource.ID()
 		directoryCache = make(map[path.CategoryType]graph.ContainerResolver)
-		handlers       = exchange.RestoreHandlers(h.apiClient)
+		handlers       = exchange.RestoreHandlers(h.apiClient, rcc.RestoreConfig)
 		metrics        support.CollectionMetrics
 		el             = errs.Local()
 	)
@@ -63,6 +63,44 @@ func (h *exchangeHandler) ConsumeRestoreCollections(
 				"restore_full_path", dc.FullPath())
 		)
 
+		// mailbox settings are applied to the mailbox itself, rather
+		// than restored into a container.
+		if category == path.MailboxSettingsCategory {
+			if rcc.RestoreConfig.DryRun {
+				err := exchange.PlanMailboxSettingsCollection(
+					ictx,
+					h.apiClient,
+					dc,
+					resourceID,
+					rcc.RestoreConfig.OnCollision,
+					rcc.Plan,
+					errs)
+				if err != nil {
+					el.AddRecoverable(ictx, err)
+				}
+
+				continue
+			}
+
+			temp, err := exchange.RestoreMailboxSettingsCollection(
+				ictx,
+				h.apiClient,
+				dc,
+				resourceID,
+				rcc.RestoreConfig.OnCollision,
+				deets,
+				errs,
+				ctr)
+
+			metrics = support.CombineMetrics(metrics, temp)
+
+			if err != nil {
+				el.AddRecoverable(ictx, err)
+			}
+
+			continue
+		}
+
 		handler, ok := handlers[category]
 		if !ok {
 			el.AddRecoverable(ictx, clues.NewWC(ictx, "unsupported restore path category"))
@@ -82,6 +120,34 @@ func (h *exchangeHandler) ConsumeRestoreCollections(
 
 		ictx = clues.Add(ictx, "restore_folder_path", restoreFolderPath)
 
+		if rcc.RestoreConfig.DryRun {
+			// look up the destination without creating it.
+			containerID, ok := directoryCache[category].LocationInCache(restoreFolderPath.String())
+			if handler.ShouldSetContainerToDefaultRoot(restoreFolderPath.String(), dc.FullPath()) {
+				containerID, ok = handler.DefaultRootContainer(), true
+			}
+
+			if !ok {
+				containerID = ""
+			}
+
+			err := exchange.PlanCollection(
+				ictx,
+				handler,
+				dc,
+				resourceID,
+				containerID,
+				restoreFolderPath,
+				rcc.RestoreConfig.OnCollision,
+				rcc.Plan,
+				errs)
+			if err != nil {
+				el.AddRecoverable(ictx, err)
+			}
+
+			continue
+		}
+
 		var containerID string
 
 		// Only attempt to create a new folder if it's not the default contacts
@@ -132,6 +132,7 @@ func (h *baseGroupsHandler) ProduceExportCollections(
 				baseDir.String(),
 				[]data.RestoreCollection{restoreColl},
 				backupVersion,
+				exportCfg,
 				stats)
 		default:
 			el.AddRecoverable(
@@ -11,6 +11,7 @@ import (
 	"github.com/alcionai/corso/src/internal/common/ptr"
 	"github.com/alcionai/corso/src/internal/data"
 	"github.com/alcionai/corso/src/internal/m365/collection/drive"
+	"github.com/alcionai/corso/src/internal/m365/collection/groups"
 	"github.com/alcionai/corso/src/internal/m365/support"
 	"github.com/alcionai/corso/src/internal/operations/inject"
 	"github.com/alcionai/corso/src/pkg/backup/details"
@@ -56,8 +57,14 @@ func (h *groupsHandler) ConsumeRestoreCollections(
 			rcc.Selector.PathService())
 		el                = errs.Local()
 		webURLToSiteNames = map[string]string{}
+		// tracks the conversations in the restore target.
+		// populated on first use.
+		convCache *groups.ConversationRestoreCache
 	)
 
+	caches.Principals = drive.NewPrincipalMap(rcc.RestoreConfig.PrincipalMap)
+	caches.AddBackupFolders(dcs)
+
 	// Reorder collections so that the parents directories are created
 	// before the child directories; a requirement for permissions.
 	data.SortRestoreCollections(dcs)
@@ -90,6 +97,15 @@ func (h *groupsHandler) ConsumeRestoreCollections(
 				logger.Ctx(ictx).With("site_id", siteID).Info("site weburl not found, using site id")
 			}
 
+			// libraries restored into a different group go into the
+			// root site of that group.
+			if rcc.ProtectedResource.ID() != dc.FullPath().ProtectedResource() {
+				siteID, webURL, err = getRootSite(ictx, h.apiClient.Groups(), rcc.ProtectedResource.ID())
+				if err != nil {
+					return nil, nil, clues.Wrap(err, "getting restore target root site")
+				}
+			}
+
 			siteName, err = getSiteName(ictx, siteID, webURL, h.apiClient.Sites(), webURLToSiteNames)
 			if err != nil {
 				el.AddRecoverable(ictx, clues.Wrap(err, "getting site").
@@ -107,6 +123,7 @@ func (h *groupsHandler) ConsumeRestoreCollections(
 				ProtectedResource: pr,
 				RestoreConfig:     rcc.RestoreConfig,
 				Selector:          rcc.Selector,
+				Plan:              rcc.Plan,
 			}
 
 			err = caches.Populate(ictx, h.apiClient.Users(), h.apiClient.Groups(), lrh, srcc.ProtectedResource.ID(), errs)
@@ -124,6 +141,39 @@ func (h *groupsHandler) ConsumeRestoreCollections(
 				control.DefaultRestoreContainerName(dttm.HumanReadableDriveItem),
 				errs,
 				ctr)
+		case path.ConversationPostsCategory:
+			if convCache == nil {
+				collisionKeys, err := h.apiClient.Conversations().
+					GetConversationsByCollisionKey(ictx, rcc.ProtectedResource.ID())
+				if err != nil {
+					return nil, nil, clues.Wrap(err, "generating map of conversation collision keys")
+				}
+
+				convCache = groups.NewConversationRestoreCache(collisionKeys)
+			}
+
+			if rcc.RestoreConfig.DryRun {
+				err = groups.PlanConversationCollection(
+					ictx,
+					h.apiClient.Conversations(),
+					rcc,
+					dc,
+					convCache,
+					rcc.Plan,
+					errs)
+
+				break
+			}
+
+			metrics, err = groups.RestoreConversationCollection(
+				ictx,
+				h.apiClient.Conversations(),
+				rcc,
+				dc,
+				convCache,
+				deets,
+				errs,
+				ctr)
 		case path.ChannelMessagesCategory:
 			// Message cannot be restored as of now using Graph API.
 			logger.Ctx(ictx).Debug("Skipping restore for channel messages")
@@ -153,6 +203,19 @@ func (h *groupsHandler) ConsumeRestoreCollections(
 	return deets.Details(), status.ToCollectionStats(), el.Failure()
 }
 
+func getRootSite(
+	ctx context.Context,
+	ac api.Groups,
+	groupID string,
+) (string, string, error) {
+	site, err := ac.GetRootSite(ctx, groupID)
+	if err != nil {
+		return "", "", clues.Stack(err)
+	}
+
+	return ptr.Val(site.GetId()), ptr.Val(site.GetWebUrl()), nil
+}
+
 func getSiteName(
 	ctx context.Context,
 	siteID string,
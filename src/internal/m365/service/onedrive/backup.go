@@ -9,6 +9,7 @@ import (
 	"github.com/alcionai/corso/src/internal/common/prefixmatcher"
 	"github.com/alcionai/corso/src/internal/data"
 	"github.com/alcionai/corso/src/internal/m365/collection/drive"
+	"github.com/alcionai/corso/src/internal/m365/collection/onenote"
 	"github.com/alcionai/corso/src/internal/m365/support"
 	"github.com/alcionai/corso/src/internal/observe"
 	"github.com/alcionai/corso/src/internal/operations/inject"
@@ -51,15 +52,44 @@ func (oneDriveBackup) ProduceBackupCollections(
 		collections          = []data.BackupCollection{}
 		ssmb                 = prefixmatcher.NewStringSetBuilder()
 		odcs                 []data.BackupCollection
-		canUsePreviousBackup bool
+		canUsePreviousBackup = true
+		canUseCategory       bool
+		notebooksBPC         = bpc
 	)
 
+	// the drive and the notebooks each read their own metadata.
+	bpc.MetadataCollections, notebooksBPC.MetadataCollections = onenote.SplitMetadata(bpc.MetadataCollections)
+
 	// for each scope that includes oneDrive items, get all
 	for _, scope := range odb.Scopes() {
 		if el.Failure() != nil {
 			break
 		}
 
+		if scope.Category().PathType() == path.NotebooksCategory {
+			odcs, canUseCategory, err = onenote.CreateCollections(
+				ctx,
+				notebooksBPC,
+				onenote.NewUserNotebookBackupHandler(ac.OneNote(), bpc.ProtectedResource.ID(), scope),
+				tenantID,
+				su,
+				counter,
+				errs)
+			if err != nil {
+				// notebooks are enumerated separately from the drive; failing to
+				// read them shouldn't keep the drive backup from being created.
+				el.AddRecoverable(ctx, clues.Stack(err))
+				continue
+			}
+
+			canUsePreviousBackup = canUsePreviousBackup && canUseCategory
+			categories[path.NotebooksCategory] = struct{}{}
+
+			collections = append(collections, odcs...)
+
+			continue
+		}
+
 		logger.Ctx(ctx).Debug("creating OneDrive collections")
 
 		nc := drive.NewCollections(
@@ -79,11 +109,13 @@ func (oneDriveBackup) ProduceBackupCollections(
 			path.FilesCategory.HumanString())
 		defer close(progressMessage)
 
-		odcs, canUsePreviousBackup, err = nc.Get(ctx, bpc.MetadataCollections, ssmb, errs)
+		odcs, canUseCategory, err = nc.Get(ctx, bpc.MetadataCollections, ssmb, errs)
 		if err != nil {
 			el.AddRecoverable(ctx, clues.Stack(err).Label(fault.LabelForceNoBackupCreation))
 		}
 
+		canUsePreviousBackup = canUsePreviousBackup && canUseCategory
+
 		categories[scope.Category().PathType()] = struct{}{}
 
 		collections = append(collections, odcs...)
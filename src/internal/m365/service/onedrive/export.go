@@ -8,6 +8,7 @@ import (
 	"github.com/alcionai/corso/src/internal/common/idname"
 	"github.com/alcionai/corso/src/internal/data"
 	"github.com/alcionai/corso/src/internal/m365/collection/drive"
+	"github.com/alcionai/corso/src/internal/m365/collection/onenote"
 	"github.com/alcionai/corso/src/internal/m365/resource"
 	"github.com/alcionai/corso/src/internal/operations/inject"
 	"github.com/alcionai/corso/src/pkg/backup/details"
@@ -68,6 +69,22 @@ func (h *baseOneDriveHandler) ProduceExportCollections(
 	)
 
 	for _, dc := range dcs {
+		if dc.FullPath().Category() == path.NotebooksCategory {
+			pth := path.Builder{}.
+				Append(path.NotebooksCategory.HumanString()).
+				Append(dc.FullPath().Folders()...)
+
+			ec = append(
+				ec,
+				onenote.NewExportCollection(
+					pth.String(),
+					[]data.RestoreCollection{dc},
+					backupVersion,
+					stats))
+
+			continue
+		}
+
 		drivePath, err := path.ToDrivePath(dc.FullPath())
 		if err != nil {
 			return nil, clues.WrapWC(ctx, err, "transforming path to drive path")
@@ -81,6 +98,7 @@ func (h *baseOneDriveHandler) ProduceExportCollections(
 				baseDir.String(),
 				[]data.RestoreCollection{dc},
 				backupVersion,
+				exportCfg,
 				stats))
 	}
 
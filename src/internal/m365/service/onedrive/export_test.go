@@ -253,6 +253,7 @@ func (suite *ExportUnitSuite) TestGetItems() {
 				"",
 				[]data.RestoreCollection{test.backingCollection},
 				test.version,
+				control.DefaultExportConfig(),
 				stats)
 
 			items := ec.Items(ctx)
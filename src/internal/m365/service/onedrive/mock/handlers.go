@@ -48,6 +48,10 @@ type BackupHandler[T any] struct {
 	GI  GetsItem
 	GIP GetsItemPermission
 
+	// item versions, newest first
+	Versions    []models.DriveItemVersionable
+	VersionsErr error
+
 	PathPrefixFn  pathPrefixer
 	PathPrefixErr error
 
@@ -237,6 +241,13 @@ func (h BackupHandler[T]) GetItemPermission(
 	return h.GIP.GetItemPermission(ctx, "", "")
 }
 
+func (h BackupHandler[T]) GetItemVersions(
+	context.Context,
+	string, string,
+) ([]models.DriveItemVersionable, error) {
+	return h.Versions, h.VersionsErr
+}
+
 type canonPather func(*path.Builder, string, string) (path.Path, error)
 
 var defaultOneDriveCanonPather = func(pb *path.Builder, tID, ro string) (path.Path, error) {
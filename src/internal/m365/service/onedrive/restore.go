@@ -9,6 +9,7 @@ import (
 
 	"github.com/alcionai/corso/src/internal/data"
 	"github.com/alcionai/corso/src/internal/m365/collection/drive"
+	"github.com/alcionai/corso/src/internal/m365/collection/onenote"
 	"github.com/alcionai/corso/src/internal/m365/support"
 	"github.com/alcionai/corso/src/internal/operations/inject"
 	"github.com/alcionai/corso/src/internal/version"
@@ -38,9 +39,18 @@ func (h *onedriveHandler) ConsumeRestoreCollections(
 	// that call isn't directly calling into this function even if we did
 	// initialize the rate limiter there it would be lost because it wouldn't get
 	// stored in an ancestor of the context passed to this function.
+	var (
+		crossService = rcc.RestoreConfig.IsCrossService(path.OneDriveService)
+		service      = path.OneDriveService
+	)
+
+	if crossService {
+		service = rcc.RestoreConfig.TargetService
+	}
+
 	ctx = graph.BindRateLimiterConfig(
 		ctx,
-		graph.LimiterCfg{Service: path.OneDriveService})
+		graph.LimiterCfg{Service: service})
 
 	var (
 		deets             = &details.Builder{}
@@ -48,9 +58,20 @@ func (h *onedriveHandler) ConsumeRestoreCollections(
 		el                = errs.Local()
 		caches            = drive.NewRestoreCaches(h.backupDriveIDNames)
 		fallbackDriveName = rcc.RestoreConfig.Location
-		rh                = drive.NewUserDriveRestoreHandler(h.apiClient)
+		rh                drive.RestoreHandler
+		nbrh              = onenote.NewUserNotebookRestoreHandler(h.apiClient.OneNote(), rcc.ProtectedResource.ID())
+		nbCaches          = onenote.NewRestoreCaches()
 	)
 
+	rh = drive.NewUserDriveRestoreHandler(h.apiClient)
+
+	if crossService {
+		rh = drive.NewSiteRestoreHandler(h.apiClient, service)
+	}
+
+	caches.Principals = drive.NewPrincipalMap(rcc.RestoreConfig.PrincipalMap)
+	caches.AddBackupFolders(dcs)
+
 	ctx = clues.Add(ctx, "backup_version", rcc.BackupVersion)
 
 	err := caches.Populate(ctx, h.apiClient.Users(), h.apiClient.Groups(), rh, rcc.ProtectedResource.ID(), errs)
@@ -58,6 +79,18 @@ func (h *onedriveHandler) ConsumeRestoreCollections(
 		return nil, nil, clues.Wrap(err, "initializing restore caches")
 	}
 
+	if crossService {
+		// the user's drive gets restored into the site's default library.
+		md, err := h.apiClient.Sites().GetDefaultDrive(ctx, rcc.ProtectedResource.ID())
+		if err != nil {
+			return nil, nil, clues.Wrap(err, "getting target drive")
+		}
+
+		if err := caches.SetCrossServiceTarget(ctx, md, rh, service); err != nil {
+			return nil, nil, clues.Wrap(err, "initializing cross-service restore")
+		}
+	}
+
 	// Reorder collections so that the parents directories are created
 	// before the child directories; a requirement for permissions.
 	data.SortRestoreCollections(dcs)
@@ -77,16 +110,37 @@ func (h *onedriveHandler) ConsumeRestoreCollections(
 				"full_path", dc.FullPath())
 		)
 
-		metrics, err = drive.RestoreCollection(
-			ictx,
-			rh,
-			rcc,
-			dc,
-			caches,
-			deets,
-			fallbackDriveName,
-			errs,
-			ctr.Local())
+		switch {
+		case dc.FullPath().Category() == path.NotebooksCategory && crossService:
+			err = clues.NewWC(ictx, "category not supported in cross-service restores")
+
+		case dc.FullPath().Category() == path.NotebooksCategory && rcc.RestoreConfig.DryRun:
+			err = onenote.PlanCollection(ictx, nbrh, dc, rcc.RestoreConfig, nbCaches, rcc.Plan, errs)
+
+		case dc.FullPath().Category() == path.NotebooksCategory:
+			metrics, err = onenote.RestoreCollection(
+				ictx,
+				nbrh,
+				dc,
+				rcc.RestoreConfig,
+				nbCaches,
+				deets,
+				ctr.Local(),
+				errs)
+
+		default:
+			metrics, err = drive.RestoreCollection(
+				ictx,
+				rh,
+				rcc,
+				dc,
+				caches,
+				deets,
+				fallbackDriveName,
+				errs,
+				ctr.Local())
+		}
+
 		if err != nil {
 			el.AddRecoverable(ctx, err)
 		}
@@ -4,17 +4,22 @@ import (
 	"context"
 
 	"github.com/alcionai/clues"
+	"github.com/kopia/kopia/repo/manifest"
 
 	"github.com/alcionai/corso/src/internal/common/prefixmatcher"
 	"github.com/alcionai/corso/src/internal/data"
+	kinject "github.com/alcionai/corso/src/internal/kopia/inject"
 	"github.com/alcionai/corso/src/internal/m365/collection/drive"
+	"github.com/alcionai/corso/src/internal/m365/collection/onenote"
 	"github.com/alcionai/corso/src/internal/m365/collection/site"
 	"github.com/alcionai/corso/src/internal/m365/support"
 	"github.com/alcionai/corso/src/internal/operations/inject"
 	"github.com/alcionai/corso/src/pkg/account"
+	"github.com/alcionai/corso/src/pkg/backup/identity"
 	"github.com/alcionai/corso/src/pkg/backup/metadata"
 	"github.com/alcionai/corso/src/pkg/count"
 	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/logger"
 	"github.com/alcionai/corso/src/pkg/path"
 	"github.com/alcionai/corso/src/pkg/services/m365/api"
 	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
@@ -48,8 +53,15 @@ func (sharePointBackup) ProduceBackupCollections(
 		categories           = map[path.CategoryType]struct{}{}
 		ssmb                 = prefixmatcher.NewStringSetBuilder()
 		canUsePreviousBackup bool
+		prevLists            map[string]data.RestoreCollection
+		notebooksBPC         = bpc
 	)
 
+	// only the lists backup reads the lists of the previous backup.
+	bpc.MetadataCollections, prevLists = site.SplitPreviousLists(bpc.MetadataCollections)
+	// the notebooks metadata can't be read along with the drive metadata.
+	bpc.MetadataCollections, notebooksBPC.MetadataCollections = onenote.SplitMetadata(bpc.MetadataCollections)
+
 	ctx = clues.Add(
 		ctx,
 		"site_id", clues.Hide(bpc.ProtectedResource.ID()),
@@ -70,6 +82,7 @@ func (sharePointBackup) ProduceBackupCollections(
 				ctx,
 				bh,
 				bpc,
+				prevLists,
 				ac,
 				creds.AzureTenantID,
 				scope,
@@ -115,9 +128,22 @@ func (sharePointBackup) ProduceBackupCollections(
 				continue
 			}
 
-			// Lists don't make use of previous metadata
-			// TODO: Revisit when we add support of pages
+			// Pages don't make use of previous metadata
 			canUsePreviousBackup = true
+
+		case path.NotebooksCategory:
+			spcs, canUsePreviousBackup, err = onenote.CreateCollections(
+				ctx,
+				notebooksBPC,
+				onenote.NewSiteNotebookBackupHandler(ac.OneNote(), bpc.ProtectedResource.ID(), scope),
+				creds.AzureTenantID,
+				su,
+				counter,
+				errs)
+			if err != nil {
+				el.AddRecoverable(ctx, err)
+				continue
+			}
 		}
 
 		collections = append(collections, spcs...)
@@ -146,9 +172,162 @@ func (sharePointBackup) ProduceBackupCollections(
 	return collections, ssmb.ToReader(), canUsePreviousBackup, el.Failure()
 }
 
-// ListsMetadataFileNames only contains PreviousPathFileName
-// and not DeltaURLsFileName because graph apis do not have delta support
-// for Sharepoint Lists
+// ListsMetadataFileNames contains the previous paths of each list, and
+// the delta link of each list's items.  Backups made before list deltas
+// were tracked only contain the previous paths.
 func ListsMetadataFileNames() []string {
-	return []string{metadata.PreviousPathFileName}
+	return []string{metadata.DeltaURLsFileName, metadata.PreviousPathFileName}
+}
+
+// PreviousListPaths produces the paths of the lists stored in the base
+// backup, so that they get loaded along with its metadata.  Incremental
+// backups use them to only fetch the list items that changed.  The lists
+// are an optimization, so failures only leave lists out of the paths.
+func PreviousListPaths(
+	ctx context.Context,
+	reason identity.Reasoner,
+	r kinject.RestoreProducer,
+	manID manifest.ID,
+) []path.RestorePaths {
+	pth, err := path.BuildMetadata(
+		reason.Tenant(),
+		reason.ProtectedResource(),
+		reason.Service(),
+		reason.Category(),
+		true,
+		metadata.PreviousPathFileName)
+	if err != nil {
+		logger.CtxErr(ctx, err).Info("building lists metadata path")
+		return nil
+	}
+
+	dir, err := pth.Dir()
+	if err != nil {
+		logger.CtxErr(ctx, err).Info("building lists metadata collection path")
+		return nil
+	}
+
+	dcs, err := r.ProduceRestoreCollections(
+		ctx,
+		string(manID),
+		[]path.RestorePaths{{StoragePath: pth, RestorePath: dir}},
+		nil,
+		fault.New(true))
+	if err != nil {
+		logger.CtxErr(ctx, err).Info("loading previous list paths")
+		return nil
+	}
+
+	prevPaths, err := deserializeListPaths(ctx, dcs)
+	if err != nil {
+		logger.CtxErr(ctx, err).Info("deserializing previous list paths")
+		return nil
+	}
+
+	var (
+		rps = make([]path.RestorePaths, 0, len(prevPaths))
+		// list ids, by the restore path of the list.
+		listIDs = make(map[string]string, len(prevPaths))
+	)
+
+	for listID, prev := range prevPaths {
+		ictx := clues.Add(ctx, "list_id", listID)
+
+		prevDir, err := path.FromDataLayerPath(prev, false)
+		if err != nil {
+			logger.CtxErr(ictx, err).Info("parsing previous list path")
+			continue
+		}
+
+		// lists are stored as a single item named after the list.
+		itemPath, err := prevDir.AppendItem(listID)
+		if err != nil {
+			logger.CtxErr(ictx, err).Info("building previous list path")
+			continue
+		}
+
+		rps = append(rps, path.RestorePaths{StoragePath: itemPath, RestorePath: prevDir})
+		listIDs[prevDir.String()] = listID
+	}
+
+	if len(rps) == 0 {
+		return nil
+	}
+
+	// A list's directory is missing from the base when the list had no
+	// item to store, such as when it was deleted during the backup.  The
+	// metadata is loaded all at once, so one missing directory would
+	// drop all of it.  All lists are loaded in a single pass without
+	// failing fast, and only the lists that could be read are kept.
+	dcs, err = r.ProduceRestoreCollections(
+		ctx,
+		string(manID),
+		rps,
+		nil,
+		fault.New(false))
+	if err != nil {
+		logger.CtxErr(ctx, err).Info("loading previous lists")
+		return nil
+	}
+
+	loaded := make(map[string]struct{}, len(dcs))
+
+	for _, dc := range dcs {
+		dir := dc.FullPath().String()
+
+		listID, ok := listIDs[dir]
+		if !ok {
+			continue
+		}
+
+		ictx := clues.Add(ctx, "list_id", listID)
+
+		item, err := dc.FetchItemByName(ictx, listID)
+		if err != nil {
+			logger.CtxErr(ictx, err).Info("loading previous list")
+			continue
+		}
+
+		if err := item.ToReader().Close(); err != nil {
+			logger.CtxErr(ictx, err).Info("closing previous list")
+		}
+
+		loaded[dir] = struct{}{}
+	}
+
+	paths := make([]path.RestorePaths, 0, len(loaded))
+
+	for _, rp := range rps {
+		if _, ok := loaded[rp.RestorePath.String()]; ok {
+			paths = append(paths, rp)
+		}
+	}
+
+	return paths
+}
+
+// deserializeListPaths reads the previous path of each list, keyed by
+// list ID, from the lists metadata.
+func deserializeListPaths(
+	ctx context.Context,
+	cols []data.RestoreCollection,
+) (map[string]string, error) {
+	var (
+		prevPaths = map[string]string{}
+		errs      = fault.New(true) // metadata item reads should not fail backup
+	)
+
+	for _, col := range cols {
+		for item := range col.Items(ctx, errs) {
+			if item.ID() != metadata.PreviousPathFileName {
+				continue
+			}
+
+			if err := drive.DeserializeMap(item.ToReader(), prevPaths); err != nil {
+				return nil, clues.StackWC(ctx, err)
+			}
+		}
+	}
+
+	return prevPaths, clues.Stack(errs.Failure()).OrNil()
 }
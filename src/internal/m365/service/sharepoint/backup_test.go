@@ -1,6 +1,9 @@
 package sharepoint
 
 import (
+	"context"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/alcionai/clues"
@@ -10,10 +13,15 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/alcionai/corso/src/internal/common/idname"
+	"github.com/alcionai/corso/src/internal/data"
+	dataMock "github.com/alcionai/corso/src/internal/data/mock"
+	"github.com/alcionai/corso/src/internal/kopia"
 	"github.com/alcionai/corso/src/internal/m365/collection/drive"
 	odConsts "github.com/alcionai/corso/src/internal/m365/service/onedrive/consts"
 	"github.com/alcionai/corso/src/internal/m365/service/onedrive/mock"
 	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/backup/identity"
+	"github.com/alcionai/corso/src/pkg/backup/metadata"
 	"github.com/alcionai/corso/src/pkg/control"
 	"github.com/alcionai/corso/src/pkg/count"
 	"github.com/alcionai/corso/src/pkg/fault"
@@ -173,3 +181,167 @@ func driveRootItem(id string) models.DriveItemable {
 
 	return item
 }
+
+type ListsBackupUnitSuite struct {
+	tester.Suite
+}
+
+func TestListsBackupUnitSuite(t *testing.T) {
+	suite.Run(t, &ListsBackupUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+type mockRestoreProducer struct {
+	md  []data.RestoreCollection
+	err error
+	// directories missing from the base, by restore path.
+	missing map[string]struct{}
+	// calls counts the loads from the base.
+	calls *int
+}
+
+func (mr mockRestoreProducer) ProduceRestoreCollections(
+	ctx context.Context,
+	snapshotID string,
+	paths []path.RestorePaths,
+	bc kopia.ByteCounter,
+	errs *fault.Bus,
+) ([]data.RestoreCollection, error) {
+	if mr.calls != nil {
+		*mr.calls++
+	}
+
+	if mr.err != nil {
+		return nil, mr.err
+	}
+
+	if paths[0].StoragePath.Item() == metadata.PreviousPathFileName {
+		return mr.md, nil
+	}
+
+	colls := make([]data.RestoreCollection, 0, len(paths))
+
+	for _, p := range paths {
+		coll := dataMock.Collection{
+			Path: p.RestorePath,
+			AuxItems: map[string]data.Item{
+				p.StoragePath.Item(): &dataMock.Item{
+					ItemID: p.StoragePath.Item(),
+					Reader: io.NopCloser(strings.NewReader("{}")),
+				},
+			},
+		}
+
+		// like kopia, missing directories produce an empty collection,
+		// and a recoverable error.
+		if _, ok := mr.missing[p.RestorePath.String()]; ok {
+			coll.AuxItems = nil
+
+			errs.AddRecoverable(ctx, clues.Stack(data.ErrNotFound))
+		}
+
+		colls = append(colls, coll)
+	}
+
+	return colls, errs.Failure()
+}
+
+func (suite *ListsBackupUnitSuite) TestPreviousListPaths() {
+	var (
+		t      = suite.T()
+		reason = identity.NewReason("tenant", "site", path.SharePointService, path.ListsCategory)
+	)
+
+	listDir := func(id string) path.Path {
+		p, err := path.Build("tenant", "site", path.SharePointService, path.ListsCategory, false, id)
+		require.NoError(t, err, clues.ToCore(err))
+
+		return p
+	}
+
+	mdColls := func(prevPaths string) []data.RestoreCollection {
+		return []data.RestoreCollection{
+			data.NoFetchRestoreCollection{
+				Collection: dataMock.Collection{
+					ItemData: []data.Item{
+						&dataMock.Item{
+							ItemID: metadata.PreviousPathFileName,
+							Reader: io.NopCloser(strings.NewReader(prevPaths)),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	restorePaths := func(ids ...string) []path.RestorePaths {
+		rps := make([]path.RestorePaths, 0, len(ids))
+
+		for _, id := range ids {
+			dir := listDir(id)
+
+			item, err := dir.AppendItem(id)
+			require.NoError(t, err, clues.ToCore(err))
+
+			rps = append(rps, path.RestorePaths{StoragePath: item, RestorePath: dir})
+		}
+
+		return rps
+	}
+
+	prevPaths := `{"l1": "` + listDir("l1").String() + `", "l2": "` + listDir("l2").String() + `"}`
+
+	tests := []struct {
+		name        string
+		r           mockRestoreProducer
+		expect      []path.RestorePaths
+		expectCalls int
+	}{
+		{
+			name:        "error",
+			r:           mockRestoreProducer{err: assert.AnError},
+			expectCalls: 1,
+		},
+		{
+			name:        "no previous paths",
+			r:           mockRestoreProducer{md: mdColls(`{}`)},
+			expectCalls: 1,
+		},
+		{
+			name:        "bad previous paths",
+			r:           mockRestoreProducer{md: mdColls(`not json`)},
+			expectCalls: 1,
+		},
+		{
+			name:        "multiple lists",
+			r:           mockRestoreProducer{md: mdColls(prevPaths)},
+			expect:      restorePaths("l1", "l2"),
+			expectCalls: 2,
+		},
+		{
+			name: "missing list directory",
+			r: mockRestoreProducer{
+				md:      mdColls(prevPaths),
+				missing: map[string]struct{}{listDir("l2").String(): {}},
+			},
+			expect:      restorePaths("l1"),
+			expectCalls: 2,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			calls := 0
+			test.r.calls = &calls
+
+			res := PreviousListPaths(ctx, reason, test.r, "manifestID")
+			assert.ElementsMatch(t, test.expect, res)
+			// the lists are loaded together, rather than once per list.
+			assert.Equal(t, test.expectCalls, calls, "loads from the base")
+		})
+	}
+}
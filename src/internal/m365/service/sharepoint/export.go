@@ -8,6 +8,7 @@ import (
 	"github.com/alcionai/corso/src/internal/common/idname"
 	"github.com/alcionai/corso/src/internal/data"
 	"github.com/alcionai/corso/src/internal/m365/collection/drive"
+	"github.com/alcionai/corso/src/internal/m365/collection/onenote"
 	"github.com/alcionai/corso/src/internal/m365/collection/site"
 	"github.com/alcionai/corso/src/internal/m365/resource"
 	"github.com/alcionai/corso/src/internal/operations/inject"
@@ -100,6 +101,7 @@ func (h *baseSharePointHandler) ProduceExportCollections(
 				baseDir.String(),
 				[]data.RestoreCollection{dc},
 				backupVersion,
+				exportCfg,
 				stats)
 
 			ec = append(ec, coll)
@@ -110,6 +112,36 @@ func (h *baseSharePointHandler) ProduceExportCollections(
 			ec = append(
 				ec,
 				site.NewExportCollection(
+					pth.String(),
+					[]data.RestoreCollection{dc},
+					backupVersion,
+					exportCfg,
+					stats))
+		case path.PagesCategory:
+			// the last folder is the page itself, which is exported as
+			// a pair of files named after the page.
+			folders := dc.FullPath().Folders()
+			if len(folders) > 0 {
+				folders = folders[:len(folders)-1]
+			}
+
+			pth := path.Builder{}.Append(path.PagesCategory.HumanString()).Append(folders...)
+
+			ec = append(
+				ec,
+				site.NewPageExportCollection(
+					pth.String(),
+					[]data.RestoreCollection{dc},
+					backupVersion,
+					stats))
+		case path.NotebooksCategory:
+			pth := path.Builder{}.
+				Append(path.NotebooksCategory.HumanString()).
+				Append(dc.FullPath().Folders()...)
+
+			ec = append(
+				ec,
+				onenote.NewExportCollection(
 					pth.String(),
 					[]data.RestoreCollection{dc},
 					backupVersion,
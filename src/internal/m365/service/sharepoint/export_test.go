@@ -14,6 +14,7 @@ import (
 	"github.com/alcionai/corso/src/internal/data"
 	dataMock "github.com/alcionai/corso/src/internal/data/mock"
 	odConsts "github.com/alcionai/corso/src/internal/m365/service/onedrive/consts"
+	spMock "github.com/alcionai/corso/src/internal/m365/service/sharepoint/mock"
 	"github.com/alcionai/corso/src/internal/tester"
 	"github.com/alcionai/corso/src/internal/version"
 	"github.com/alcionai/corso/src/pkg/backup/details"
@@ -243,3 +244,61 @@ func (suite *ExportUnitSuite) TestExportRestoreCollections() {
 		})
 	}
 }
+
+func (suite *ExportUnitSuite) TestExportRestoreCollections_pages() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	p, err := path.Build(
+		"t",
+		"u",
+		path.SharePointService,
+		path.PagesCategory,
+		false,
+		"Home")
+	require.NoError(t, err, clues.ToCore(err))
+
+	dcs := []data.RestoreCollection{
+		data.FetchRestoreCollection{
+			Collection: dataMock.Collection{
+				Path: p,
+				ItemData: []data.Item{
+					&dataMock.Item{
+						ItemID: "pageid1",
+						Reader: io.NopCloser(bytes.NewReader(spMock.Page("Home"))),
+					},
+				},
+			},
+		},
+	}
+
+	handler := NewSharePointHandler(api.Client{}, nil)
+	stats := metrics.NewExportStats()
+
+	ecs, err := handler.ProduceExportCollections(
+		ctx,
+		int(version.Backup),
+		control.ExportConfig{},
+		dcs,
+		stats,
+		fault.New(true))
+	require.NoError(t, err, clues.ToCore(err))
+	require.Len(t, ecs, 1, "num of collections")
+	assert.Equal(t, path.PagesCategory.HumanString(), ecs[0].BasePath(), "base dir")
+
+	names := []string{}
+
+	for item := range ecs[0].Items(ctx) {
+		require.NoError(t, item.Error, clues.ToCore(item.Error))
+
+		_, err := io.ReadAll(item.Body)
+		require.NoError(t, err, clues.ToCore(err))
+
+		names = append(names, item.Name)
+	}
+
+	assert.Equal(t, []string{"Home.html", "Home.json"}, names)
+	assert.Equal(t, int64(1), stats.GetStats()[path.PagesCategory].ResourceCount)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/alcionai/corso/src/internal/data"
 	"github.com/alcionai/corso/src/internal/m365/collection/drive"
+	"github.com/alcionai/corso/src/internal/m365/collection/onenote"
 	"github.com/alcionai/corso/src/internal/m365/collection/site"
 	"github.com/alcionai/corso/src/internal/m365/support"
 	"github.com/alcionai/corso/src/internal/operations/inject"
@@ -44,21 +45,45 @@ func (h *sharepointHandler) ConsumeRestoreCollections(
 		graph.LimiterCfg{Service: path.SharePointService})
 
 	var (
-		deets = &details.Builder{}
-		lrh   = drive.NewSiteRestoreHandler(
-			h.apiClient,
-			rcc.Selector.PathService())
-		listsRh = site.NewListsRestoreHandler(
+		crossService = rcc.RestoreConfig.IsCrossService(path.SharePointService)
+		deets        = &details.Builder{}
+		lrh          drive.RestoreHandler
+		listsRh      = site.NewListsRestoreHandler(
 			rcc.ProtectedResource.ID(),
 			h.apiClient.Lists())
+		nbrh = onenote.NewSiteNotebookRestoreHandler(
+			h.apiClient.OneNote(),
+			rcc.ProtectedResource.ID())
 		restoreMetrics support.CollectionMetrics
 
-		caches = drive.NewRestoreCaches(h.backupDriveIDNames)
+		caches   = drive.NewRestoreCaches(h.backupDriveIDNames)
+		nbCaches = onenote.NewRestoreCaches()
 
 		el = errs.Local()
 		cl = ctr.Local()
 	)
 
+	lrh = drive.NewSiteRestoreHandler(h.apiClient, rcc.Selector.PathService())
+
+	if crossService {
+		lrh = drive.NewUserDriveRestoreHandler(h.apiClient)
+	}
+
+	caches.Principals = drive.NewPrincipalMap(rcc.RestoreConfig.PrincipalMap)
+	caches.AddBackupFolders(dcs)
+
+	if crossService {
+		// the site's libraries get restored into the user's drive.
+		md, err := h.apiClient.Users().GetDefaultDrive(ctx, rcc.ProtectedResource.ID())
+		if err != nil {
+			return nil, nil, clues.Wrap(err, "getting target drive")
+		}
+
+		if err := caches.SetCrossServiceTarget(ctx, md, lrh, rcc.RestoreConfig.TargetService); err != nil {
+			return nil, nil, clues.Wrap(err, "initializing cross-service restore")
+		}
+	}
+
 	// Reorder collections so that the parents directories are created
 	// before the child directories; a requirement for permissions.
 	data.SortRestoreCollections(dcs)
@@ -81,6 +106,12 @@ func (h *sharepointHandler) ConsumeRestoreCollections(
 			collisionKeyToItemID map[string]string
 		)
 
+		// only document libraries can be restored into a user's drive.
+		if crossService && category != path.LibrariesCategory {
+			el.AddRecoverable(ictx, clues.NewWC(ictx, "category not supported in cross-service restores"))
+			continue
+		}
+
 		switch dc.FullPath().Category() {
 		case path.LibrariesCategory:
 			err = caches.Populate(ctx, h.apiClient.Users(), h.apiClient.Groups(), lrh, rcc.ProtectedResource.ID(), errs)
@@ -106,6 +137,11 @@ func (h *sharepointHandler) ConsumeRestoreCollections(
 				continue
 			}
 
+			if rcc.RestoreConfig.DryRun {
+				err = site.PlanListCollection(ictx, dc, rcc.RestoreConfig, collisionKeyToItemID, rcc.Plan, errs)
+				break
+			}
+
 			metrics, err = site.RestoreListCollection(
 				ictx,
 				listsRh,
@@ -117,6 +153,11 @@ func (h *sharepointHandler) ConsumeRestoreCollections(
 				errs)
 
 		case path.PagesCategory:
+			if rcc.RestoreConfig.DryRun {
+				err = site.PlanPageCollection(ictx, dc, rcc.RestoreConfig.Location, rcc.Plan, errs)
+				break
+			}
+
 			metrics, err = site.RestorePageCollection(
 				ictx,
 				h.apiClient.Stable,
@@ -125,6 +166,22 @@ func (h *sharepointHandler) ConsumeRestoreCollections(
 				deets,
 				errs)
 
+		case path.NotebooksCategory:
+			if rcc.RestoreConfig.DryRun {
+				err = onenote.PlanCollection(ictx, nbrh, dc, rcc.RestoreConfig, nbCaches, rcc.Plan, errs)
+				break
+			}
+
+			metrics, err = onenote.RestoreCollection(
+				ictx,
+				nbrh,
+				dc,
+				rcc.RestoreConfig,
+				nbCaches,
+				deets,
+				cl,
+				errs)
+
 		default:
 			return nil, nil, clues.Wrap(clues.New(category.String()), "category not supported").With("category", category)
 		}
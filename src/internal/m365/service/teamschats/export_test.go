@@ -0,0 +1,121 @@
+package teamschats
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/alcionai/clues"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/data"
+	dataMock "github.com/alcionai/corso/src/internal/data/mock"
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/internal/version"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/metrics"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api"
+)
+
+type ExportUnitSuite struct {
+	tester.Suite
+}
+
+func TestExportUnitSuite(t *testing.T) {
+	suite.Run(t, &ExportUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func (suite *ExportUnitSuite) TestExportRestoreCollections() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	p, err := path.BuildPrefix("t", "r", path.TeamsChatsService, path.ChatsCategory)
+	require.NoError(t, err, clues.ToCore(err))
+
+	p2, err := path.Builder{}.
+		Append("Inbox").
+		ToDataLayerPath("t", "r", path.ExchangeService, path.EmailCategory, false)
+	require.NoError(t, err, clues.ToCore(err))
+
+	makeBody := func() io.ReadCloser {
+		return io.NopCloser(bytes.NewReader([]byte("{}")))
+	}
+
+	table := []struct {
+		name        string
+		dcs         []data.RestoreCollection
+		expectNames []string
+		expectErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "chats",
+			dcs: []data.RestoreCollection{
+				data.FetchRestoreCollection{
+					Collection: dataMock.Collection{
+						Path: p,
+						ItemData: []data.Item{
+							&dataMock.Item{
+								ItemID: "id1",
+								Reader: makeBody(),
+							},
+							&dataMock.Item{
+								ItemID: "id2",
+								Reader: makeBody(),
+							},
+						},
+					},
+				},
+			},
+			expectNames: []string{"id1.json", "id2.json"},
+			expectErr:   assert.NoError,
+		},
+		{
+			name: "collection without chats category",
+			dcs: []data.RestoreCollection{
+				data.FetchRestoreCollection{
+					Collection: dataMock.Collection{
+						Path: p2,
+					},
+				},
+			},
+			expectErr: assert.Error,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ecs, err := NewTeamsChatsHandler(api.Client{}, nil).
+				ProduceExportCollections(
+					ctx,
+					int(version.Backup),
+					control.DefaultExportConfig(),
+					test.dcs,
+					metrics.NewExportStats(),
+					fault.New(true))
+			test.expectErr(t, err, clues.ToCore(err))
+
+			if err != nil {
+				return
+			}
+
+			require.Len(t, ecs, 1)
+			assert.Equal(t, path.ChatsCategory.HumanString(), ecs[0].BasePath())
+
+			names := []string{}
+
+			for item := range ecs[0].Items(ctx) {
+				require.NoError(t, item.Error, clues.ToCore(item.Error))
+				names = append(names, item.Name)
+			}
+
+			assert.ElementsMatch(t, test.expectNames, names)
+		})
+	}
+}
@@ -214,8 +214,10 @@ func (op *BackupOperation) Run(ctx context.Context) (err error) {
 	// For exchange, rate limits are enforced on a mailbox level. Reset the
 	// rate limiter so that it doesn't accidentally throttle following mailboxes.
 	// This is a no-op if we are using token bucket limiter since it refreshes
-	// tokens on a fixed per second basis.
-	defer graph.ResetLimiter(ctx)
+	// tokens on a fixed per second basis.  Backups that run concurrently share
+	// the limiter, which only gets reset once all of them complete.
+	releaseLimiter := graph.AcquireLimiter(ctx)
+	defer releaseLimiter()
 
 	// Check if the protected resource has the service enabled in order for us
 	// to run a backup.
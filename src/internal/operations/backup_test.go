@@ -386,8 +386,12 @@ func (suite *BackupOpUnitSuite) TestNewBackupOperation_configuredOptionsMatchInp
 	}
 
 	opts := control.Options{
-		DeltaPageSize:        42,
-		DisableMetrics:       true,
+		DeltaPageSize:  42,
+		DisableMetrics: true,
+		DriveItemVersions: control.DriveItemVersions{
+			MaxCount: 5,
+			MaxAge:   time.Hour,
+		},
 		FailureHandling:      control.FailAfterRecovery,
 		ItemExtensionFactory: slices.Clone(ext),
 		Parallelism: control.Parallelism{
@@ -21,6 +21,7 @@ import (
 	"github.com/alcionai/corso/src/internal/stats"
 	"github.com/alcionai/corso/src/internal/streamstore"
 	"github.com/alcionai/corso/src/pkg/account"
+	"github.com/alcionai/corso/src/pkg/backup/details"
 	"github.com/alcionai/corso/src/pkg/control"
 	"github.com/alcionai/corso/src/pkg/count"
 	"github.com/alcionai/corso/src/pkg/dttm"
@@ -75,7 +76,7 @@ func NewExportOperation(
 		Selectors: sel,
 		Version:   "v0",
 		ec:        ec,
-		stats:     metrics.ExportStats{},
+		stats:     *metrics.NewExportStats(),
 	}
 	if err := op.validate(); err != nil {
 		return ExportOperation{}, err
@@ -277,13 +278,23 @@ func (op *ExportOperation) do(
 		return nil, clues.Stack(err)
 	}
 
+	// record the provenance and hash of every exported file so
+	// that the export can be verified later on.
+	manifestName := export.ManifestFileName
+	if op.ExportCfg.ManifestPerBackup {
+		manifestName = export.ManifestFileNameFor(string(op.BackupID))
+	}
+
+	mr := export.NewManifestRecorder(string(op.BackupID), manifestName, manifestSources(paths, deets))
+	expCollections = mr.Wrap(expCollections)
+
 	if op.ExportCfg.Archive {
-		zc, err := archive.ZipExportCollection(ctx, expCollections)
+		ac, err := archive.ExportCollection(ctx, expCollections, op.ExportCfg)
 		if err != nil {
-			return nil, clues.Wrap(err, "zipping export collections")
+			return nil, clues.Wrap(err, "archiving export collections")
 		}
 
-		return []export.Collectioner{zc}, nil
+		return []export.Collectioner{ac}, nil
 	}
 
 	return expCollections, nil
@@ -325,6 +336,67 @@ func (op *ExportOperation) GetStats() map[path.CategoryType]metrics.KindStats {
 	return op.stats.GetStats()
 }
 
+// Stats returns the stats of the export operation, for use by the
+// consumer of the export collections.
+func (op *ExportOperation) Stats() *metrics.ExportStats {
+	return &op.stats
+}
+
+// manifestSources indexes the details entries of the exported items by
+// the restore collection they are read from and their storage name,
+// which is the ID given to the export items produced from them.  Each
+// restore collection is also indexed on its own, for exports that
+// write a single file per collection.
+func manifestSources(
+	paths []path.RestorePaths,
+	deets *details.Details,
+) map[string]export.ItemSource {
+	var (
+		sources = map[string]export.ItemSource{}
+		entries = make(map[string]details.Entry, len(deets.Entries))
+	)
+
+	for _, ent := range deets.Entries {
+		entries[ent.RepoRef] = ent
+	}
+
+	for _, rp := range paths {
+		if rp.StoragePath == nil || rp.RestorePath == nil {
+			continue
+		}
+
+		ent, ok := entries[rp.StoragePath.String()]
+		if !ok {
+			continue
+		}
+
+		ref := rp.RestorePath.ShortRef()
+
+		sources[export.ItemSourceKey(ref, rp.StoragePath.Item())] = export.ItemSource{
+			RepoRef:     ent.RepoRef,
+			LocationRef: ent.LocationRef,
+			ItemRef:     ent.ItemRef,
+		}
+
+		collKey := export.ItemSourceKey(ref, "")
+		if _, ok := sources[collKey]; ok {
+			continue
+		}
+
+		dir, err := rp.StoragePath.Dir()
+		if err != nil {
+			continue
+		}
+
+		sources[collKey] = export.ItemSource{
+			RepoRef:     dir.String(),
+			LocationRef: ent.LocationRef,
+		}
+	}
+
+	return sources
+}
+
 // ---------------------------------------------------------------------------
 // Exporter funcs
 // ---------------------------------------------------------------------------
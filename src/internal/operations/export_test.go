@@ -23,8 +23,10 @@ import (
 	"github.com/alcionai/corso/src/internal/stats"
 	"github.com/alcionai/corso/src/internal/tester"
 	"github.com/alcionai/corso/src/pkg/account"
+	"github.com/alcionai/corso/src/pkg/backup/details"
 	"github.com/alcionai/corso/src/pkg/control"
 	"github.com/alcionai/corso/src/pkg/export"
+	"github.com/alcionai/corso/src/pkg/path"
 	"github.com/alcionai/corso/src/pkg/selectors"
 	"github.com/alcionai/corso/src/pkg/store"
 )
@@ -326,3 +328,91 @@ func (suite *ExportUnitSuite) TestZipExports() {
 		})
 	}
 }
+
+func (suite *ExportUnitSuite) TestManifestSources() {
+	t := suite.T()
+
+	storagePath := func(ref string) path.Path {
+		p, err := path.FromDataLayerPath(ref, true)
+		require.NoError(t, err, clues.ToCore(err))
+
+		return p
+	}
+
+	restorePath := func(folder string) path.Path {
+		p, err := path.Build("tid", "uid", path.ExchangeService, path.EmailCategory, false, folder)
+		require.NoError(t, err, clues.ToCore(err))
+
+		return p
+	}
+
+	var (
+		inbox    = restorePath("Inbox")
+		archived = restorePath("Archive")
+		deets    = &details.Details{
+			DetailsModel: details.DetailsModel{
+				Entries: []details.Entry{
+					{
+						RepoRef:     "tid/exchange/uid/email/inbox-id/id1",
+						LocationRef: "Inbox",
+						ItemRef:     "inbox-item",
+					},
+					{
+						RepoRef:     "tid/exchange/uid/email/archive-id/id1",
+						LocationRef: "Archive",
+						ItemRef:     "archive-item",
+					},
+					{
+						RepoRef:     "tid/exchange/uid/email/archive-id/id2",
+						LocationRef: "Archive",
+						ItemRef:     "id2",
+					},
+				},
+			},
+		}
+		paths = []path.RestorePaths{
+			{
+				StoragePath: storagePath("tid/exchange/uid/email/inbox-id/id1"),
+				RestorePath: inbox,
+			},
+			{
+				StoragePath: storagePath("tid/exchange/uid/email/archive-id/id1"),
+				RestorePath: archived,
+			},
+			{
+				StoragePath: storagePath("tid/exchange/uid/email/archive-id/id2"),
+				RestorePath: archived,
+			},
+			// paths which failed to transform are left empty
+			{},
+		}
+	)
+
+	expect := map[string]export.ItemSource{
+		export.ItemSourceKey(inbox.ShortRef(), "id1"): {
+			RepoRef:     "tid/exchange/uid/email/inbox-id/id1",
+			LocationRef: "Inbox",
+			ItemRef:     "inbox-item",
+		},
+		export.ItemSourceKey(inbox.ShortRef(), ""): {
+			RepoRef:     "tid/exchange/uid/email/inbox-id",
+			LocationRef: "Inbox",
+		},
+		export.ItemSourceKey(archived.ShortRef(), "id1"): {
+			RepoRef:     "tid/exchange/uid/email/archive-id/id1",
+			LocationRef: "Archive",
+			ItemRef:     "archive-item",
+		},
+		export.ItemSourceKey(archived.ShortRef(), "id2"): {
+			RepoRef:     "tid/exchange/uid/email/archive-id/id2",
+			LocationRef: "Archive",
+			ItemRef:     "id2",
+		},
+		export.ItemSourceKey(archived.ShortRef(), ""): {
+			RepoRef:     "tid/exchange/uid/email/archive-id",
+			LocationRef: "Archive",
+		},
+	}
+
+	assert.Equal(t, expect, manifestSources(paths, deets))
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/alcionai/corso/src/internal/common/idname"
 	"github.com/alcionai/corso/src/internal/data"
 	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/restoreplan"
 	"github.com/alcionai/corso/src/pkg/selectors"
 )
 
@@ -16,6 +17,9 @@ type RestoreConsumerConfig struct {
 	ProtectedResource idname.Provider
 	RestoreConfig     control.RestoreConfig
 	Selector          selectors.Selector
+	// Plan collects the planned item restores when the restore
+	// config is a dry run.
+	Plan *restoreplan.Plan
 }
 
 // BackupProducerConfig is a container-of-things for holding options and
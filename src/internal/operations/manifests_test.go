@@ -11,6 +11,7 @@ import (
 	"github.com/kopia/kopia/repo/manifest"
 	"github.com/kopia/kopia/snapshot"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/alcionai/corso/src/internal/data"
@@ -131,6 +132,18 @@ func (suite *OperationsManifestsUnitSuite) TestGetMetadataPaths() {
 			path.SharePointService,
 			ro,
 			path.ListsCategory)
+		odFilesPath = makeMetadataBasePath(
+			suite.T(),
+			tid,
+			path.OneDriveService,
+			ro,
+			path.FilesCategory)
+		notebooksPath = makeMetadataBasePath(
+			suite.T(),
+			tid,
+			path.OneDriveService,
+			ro,
+			path.NotebooksCategory)
 	)
 
 	groupLibsSitesPath, err := groupLibsPath.Append(false, odConsts.SitesPathDir)
@@ -211,6 +224,36 @@ func (suite *OperationsManifestsUnitSuite) TestGetMetadataPaths() {
 				getRestorePaths(t, emailPath, metadata.AllMetadataFileNames()),
 				getRestorePaths(t, contactPath, metadata.AllMetadataFileNames())...),
 		},
+		{
+			name:  "mail and mailbox settings reasons",
+			manID: "mail-and-settings",
+			reasons: []identity.Reasoner{
+				identity.NewReason(tid, ro, path.ExchangeService, path.EmailCategory),
+				identity.NewReason(tid, ro, path.ExchangeService, path.MailboxSettingsCategory),
+			},
+			preFetchPaths: []string{},
+			expectPaths: func(t *testing.T, files []string) []path.Path {
+				return []path.Path{}
+			},
+			// mailbox settings don't write metadata, so only mail's files are requested.
+			restorePaths: getRestorePaths(t, emailPath, metadata.AllMetadataFileNames()),
+		},
+		{
+			name:  "files and notebooks reasons",
+			manID: "files-and-notebooks",
+			reasons: []identity.Reasoner{
+				identity.NewReason(tid, ro, path.OneDriveService, path.FilesCategory),
+				identity.NewReason(tid, ro, path.OneDriveService, path.NotebooksCategory),
+			},
+			preFetchPaths: []string{},
+			expectPaths: func(t *testing.T, files []string) []path.Path {
+				return []path.Path{}
+			},
+			// notebooks have no delta links, only the previous paths are requested.
+			restorePaths: append(
+				getRestorePaths(t, odFilesPath, metadata.AllMetadataFileNames()),
+				getRestorePaths(t, notebooksPath, []string{metadata.PreviousPathFileName})...),
+		},
 		{
 			name:  "single reason sp libraries",
 			manID: "single-sp-libraries",
@@ -613,6 +656,65 @@ func (suite *OperationsManifestsUnitSuite) TestProduceManifestsAndMetadata() {
 	}
 }
 
+// a base holding mailbox settings alongside mail must still hand back the
+// mail metadata.  Kopia fails the whole load with ErrNotFound when any
+// requested path is missing, which would turn every incremental into a
+// full backup.
+func (suite *OperationsManifestsUnitSuite) TestProduceManifestsAndMetadata_MailboxSettings() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	var (
+		ro             = "resourceowner"
+		tid            = "tenantid"
+		emailReason    = identity.NewReason(tid, ro, path.ExchangeService, path.EmailCategory)
+		settingsReason = identity.NewReason(tid, ro, path.ExchangeService, path.MailboxSettingsCategory)
+		base           = kopia.NewBackupBaseBuilder("", 1).
+				WithReasons(emailReason, settingsReason).
+				Build()
+		bf = &mockBackupFinder{
+			data: map[string]kopia.BackupBases{
+				ro: kopia.NewMockBackupBases().WithMergeBases(base),
+			},
+		}
+		mdColl = mockColl{id: "mail-metadata"}
+	)
+
+	rp := mockRestoreProducer{
+		onRestore: func(id string, ps []path.RestorePaths) ([]data.RestoreCollection, error) {
+			for _, p := range ps {
+				// the base never wrote metadata for mailbox settings.
+				if p.StoragePath.Category() == path.MailboxSettingsCategory {
+					return nil, clues.Stack(data.ErrNotFound)
+				}
+			}
+
+			return []data.RestoreCollection{data.NoFetchRestoreCollection{Collection: mdColl}}, nil
+		},
+	}
+
+	_, dcs, useMergeBases, err := produceManifestsAndMetadata(
+		ctx,
+		bf,
+		&m365.Controller{},
+		&rp,
+		[]identity.Reasoner{emailReason, settingsReason},
+		nil,
+		tid,
+		true,
+		false)
+	require.NoError(t, err, clues.ToCore(err))
+	assert.True(t, useMergeBases)
+	require.Len(t, dcs, 1, "metadata collections")
+	assert.Equal(t, mdColl, dcs[0].(data.NoFetchRestoreCollection).Collection)
+
+	for _, p := range rp.gotPaths {
+		assert.Equal(t, path.EmailCategory, p.Category(), "requested metadata category")
+	}
+}
+
 func (suite *OperationsManifestsUnitSuite) TestProduceManifestsAndMetadata_FallbackReasons() {
 	var (
 		ro   = "resourceowner"
@@ -141,6 +141,10 @@ func makeRestorePathsForEntry(
 	//   * Exchange Email/Contacts
 	//   * OneDrive/SharePoint (needs drive information)
 	switch true {
+	case (ent.OneDrive != nil && ent.OneDrive.ItemType == details.OneDriveNotebookPage) ||
+		(ent.SharePoint != nil && ent.SharePoint.ItemType == details.SharePointNotebookPage):
+		// Notebooks aren't stored in a drive, so their location is used as-is.
+		res.RestorePath, err = basicLocationPath(repoRef, locRef)
 	case ent.Exchange != nil ||
 		(ent.Groups != nil && ent.Groups.ItemType == details.GroupsChannelMessage) ||
 		(ent.Groups != nil && ent.Groups.ItemType == details.GroupsConversationPost) ||
@@ -54,6 +54,25 @@ func (suite *RestorePathTransformerUnitSuite) TestGetPaths() {
 		SharePointRootItemPath = testdata.SharePointRootPath.MustAppend(extraItemName, true)
 		SharePointListItemPath = testdata.SharePointListPath.MustAppend(listName, true)
 		GroupsRootItemPath     = testdata.GroupsRootPath.MustAppend(extraItemName, true)
+		notebookLoc            = path.Builder{}.Append("notebook", "group", "section")
+	)
+
+	notebookPagePath := func(service path.ServiceType, resource string) path.Path {
+		p, err := path.Build(
+			"tenant-id",
+			resource,
+			service,
+			path.NotebooksCategory,
+			true,
+			"notebook-id", "group-id", "section-id", "page-id")
+		require.NoError(suite.T(), err, clues.ToCore(err))
+
+		return p
+	}
+
+	var (
+		oneDriveNotebookPagePath   = notebookPagePath(path.OneDriveService, "user-id")
+		sharePointNotebookPagePath = notebookPagePath(path.SharePointService, "site-id")
 	)
 
 	table := []struct {
@@ -281,6 +300,50 @@ func (suite *RestorePathTransformerUnitSuite) TestGetPaths() {
 				},
 			},
 		},
+		{
+			name:          "OneDrive Notebook page",
+			backupVersion: version.Backup,
+			input: []*details.Entry{
+				{
+					RepoRef:     oneDriveNotebookPagePath.String(),
+					LocationRef: notebookLoc.String(),
+					ItemInfo: details.ItemInfo{
+						OneDrive: &details.OneDriveInfo{
+							ItemType: details.OneDriveNotebookPage,
+						},
+					},
+				},
+			},
+			expectErr: assert.NoError,
+			expected: []expectPaths{
+				{
+					storage: oneDriveNotebookPagePath.String(),
+					restore: toRestore(oneDriveNotebookPagePath, notebookLoc.Elements()...),
+				},
+			},
+		},
+		{
+			name:          "SharePoint Notebook page",
+			backupVersion: version.Backup,
+			input: []*details.Entry{
+				{
+					RepoRef:     sharePointNotebookPagePath.String(),
+					LocationRef: notebookLoc.String(),
+					ItemInfo: details.ItemInfo{
+						SharePoint: &details.SharePointInfo{
+							ItemType: details.SharePointNotebookPage,
+						},
+					},
+				},
+			},
+			expectErr: assert.NoError,
+			expected: []expectPaths{
+				{
+					storage: sharePointNotebookPagePath.String(),
+					restore: toRestore(sharePointNotebookPagePath, notebookLoc.Elements()...),
+				},
+			},
+		},
 		{
 			name:          "Exchange Email, extra / in path",
 			backupVersion: version.All8MigrateUserPNToID,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/alcionai/clues"
@@ -15,6 +16,7 @@ import (
 	"github.com/alcionai/corso/src/internal/diagnostics"
 	"github.com/alcionai/corso/src/internal/events"
 	"github.com/alcionai/corso/src/internal/kopia"
+	"github.com/alcionai/corso/src/internal/m365/collection/exchange"
 	"github.com/alcionai/corso/src/internal/m365/service/onedrive"
 	"github.com/alcionai/corso/src/internal/model"
 	"github.com/alcionai/corso/src/internal/observe"
@@ -22,6 +24,7 @@ import (
 	"github.com/alcionai/corso/src/internal/operations/pathtransformer"
 	"github.com/alcionai/corso/src/internal/stats"
 	"github.com/alcionai/corso/src/internal/streamstore"
+	"github.com/alcionai/corso/src/internal/version"
 	"github.com/alcionai/corso/src/pkg/account"
 	"github.com/alcionai/corso/src/pkg/backup/details"
 	"github.com/alcionai/corso/src/pkg/control"
@@ -31,6 +34,7 @@ import (
 	"github.com/alcionai/corso/src/pkg/fault"
 	"github.com/alcionai/corso/src/pkg/logger"
 	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/restoreplan"
 	"github.com/alcionai/corso/src/pkg/selectors"
 	"github.com/alcionai/corso/src/pkg/store"
 )
@@ -45,6 +49,14 @@ type RestoreOperation struct {
 	RestoreCfg control.RestoreConfig
 	Version    string
 
+	// SourceDir, when set, restores the files in the directory
+	// in place of the items in a backup.
+	SourceDir string
+
+	// Plan holds the planned item restores of a dry run.
+	// Nil unless the restore config is a dry run.
+	Plan *restoreplan.Plan
+
 	acct account.Account
 	rc   inject.RestoreConsumer
 }
@@ -85,14 +97,139 @@ func NewRestoreOperation(
 	return op, nil
 }
 
+// NewFileRestoreOperation constructs and validates a restore operation
+// that restores the files in sourceDir, in place of a backup.  Every file
+// in sourceDir gets restored; the selector only picks the service, since
+// the files have no backup details to filter.
+func NewFileRestoreOperation(
+	ctx context.Context,
+	opts control.Options,
+	kw *kopia.Wrapper,
+	sw store.BackupStorer,
+	rc inject.RestoreConsumer,
+	acct account.Account,
+	sourceDir string,
+	sel selectors.Selector,
+	restoreCfg control.RestoreConfig,
+	bus events.Eventer,
+	ctr *count.Bus,
+) (RestoreOperation, error) {
+	op := RestoreOperation{
+		operation:  newOperation(opts, bus, ctr, kw, sw),
+		acct:       acct,
+		RestoreCfg: control.EnsureRestoreConfigDefaults(ctx, restoreCfg),
+		Selectors:  sel,
+		SourceDir:  sourceDir,
+		Version:    "v0",
+		rc:         rc,
+	}
+	if err := op.validate(); err != nil {
+		return RestoreOperation{}, err
+	}
+
+	return op, nil
+}
+
 func (op RestoreOperation) validate() error {
 	if op.rc == nil {
 		return clues.New("missing restore consumer")
 	}
 
+	if op.RestoreCfg.OnCollision == control.Mirror &&
+		op.Selectors.PathService() == path.ExchangeService {
+		return clues.New("mirror collision policy is not supported for exchange restores")
+	}
+
+	// mirroring a subset of a folder's files would remove the rest of them.
+	if op.RestoreCfg.OnCollision == control.Mirror && op.Selectors.SelectsPartialFolders() {
+		return clues.New("mirror collision policy can't be used when restoring a subset of a folder's files")
+	}
+
+	if len(op.SourceDir) > 0 {
+		if op.Selectors.PathService() != path.ExchangeService {
+			return clues.New("only exchange data can be restored from files")
+		}
+
+		if len(op.RestoreCfg.ProtectedResource) == 0 {
+			return clues.New("restoring from files requires a target protected resource")
+		}
+	}
+
+	if op.RestoreCfg.IsCrossService(op.Selectors.PathService()) {
+		if err := validateCrossServiceRestore(op.Selectors.PathService(), op.RestoreCfg); err != nil {
+			return err
+		}
+	}
+
+	if len(op.RestoreCfg.TargetTenantID) > 0 {
+		if err := validateCrossTenantRestore(op.acct.ID(), op.RestoreCfg); err != nil {
+			return err
+		}
+	}
+
 	return op.operation.validate()
 }
 
+// validateCrossServiceRestore ensures the restore config can put data
+// from the backup service into the target service.  Only drive data
+// can move between services.
+func validateCrossServiceRestore(
+	backupService path.ServiceType,
+	restoreCfg control.RestoreConfig,
+) error {
+	isDrive := func(s path.ServiceType) bool {
+		return s == path.OneDriveService || s == path.SharePointService
+	}
+
+	if !isDrive(backupService) || !isDrive(restoreCfg.TargetService) {
+		return clues.New("cross-service restores are only supported between onedrive and sharepoint").
+			With(
+				"backup_service", backupService.String(),
+				"target_service", restoreCfg.TargetService.String())
+	}
+
+	if len(restoreCfg.ProtectedResource) == 0 {
+		return clues.New("cross-service restores require a target protected resource")
+	}
+
+	if restoreCfg.OnCollision == control.Mirror {
+		return clues.New("mirror collision policy is not supported for cross-service restores")
+	}
+
+	return nil
+}
+
+// validateCrossTenantRestore ensures the restore config unambiguously
+// identifies where data from the backup tenant lands in the target tenant.
+func validateCrossTenantRestore(
+	backupTenantID string,
+	restoreCfg control.RestoreConfig,
+) error {
+	if !restoreCfg.IsCrossTenant(backupTenantID) {
+		return clues.New("target tenant is the same as the backup tenant; " +
+			"restore with the repository's account instead")
+	}
+
+	// principals get matched to the map without regard to case, so
+	// sources that only differ by case must map to the same target.
+	seen := map[string]string{}
+
+	for src, tgt := range restoreCfg.PrincipalMap {
+		key := strings.ToLower(src)
+
+		if prev, ok := seen[key]; ok && !strings.EqualFold(prev, tgt) {
+			return clues.New("principal map has conflicting targets for the same principal").
+				With(
+					"principal_source", clues.Hide(src),
+					"principal_targets", clues.Hide([]string{prev, tgt}))
+		}
+
+		seen[key] = tgt
+	}
+
+	return nil
+}
+
 // aggregates stats from the restore.Run().
 // primarily used so that the defer can take in a
 // pointer wrapping the values, while those values
@@ -217,6 +354,10 @@ func (op *RestoreOperation) do(
 		With("control_options", op.Options, "selectors", op.Selectors).
 		Info("restoring selection")
 
+	if len(op.SourceDir) > 0 {
+		return op.doFromFiles(ctx, opStats)
+	}
+
 	bup, deets, err := getBackupAndDetailsFromID(
 		ctx,
 		op.BackupID,
@@ -301,6 +442,10 @@ func (op *RestoreOperation) do(
 	opStats.resourceCount = 1
 	opStats.cs = dcs
 
+	if op.RestoreCfg.DryRun {
+		op.Plan = restoreplan.New()
+	}
+
 	deets, colStats, err := consumeRestoreCollections(
 		ctx,
 		op.rc,
@@ -309,6 +454,7 @@ func (op *RestoreOperation) do(
 		op.Selectors,
 		op.RestoreCfg,
 		op.Options,
+		op.Plan,
 		dcs,
 		op.Errors,
 		op.Counter)
@@ -323,6 +469,81 @@ func (op *RestoreOperation) do(
 	return deets, nil
 }
 
+// doFromFiles restores the files in the operation's source directory,
+// in place of the items in a backup.
+func (op *RestoreOperation) doFromFiles(
+	ctx context.Context,
+	opStats *restoreStats,
+) (*details.Details, error) {
+	restoreToProtectedResource, err := op.rc.PopulateProtectedResourceIDAndName(
+		ctx,
+		op.RestoreCfg.ProtectedResource,
+		nil)
+	if err != nil {
+		return nil, clues.Wrap(err, "getting destination protected resource")
+	}
+
+	ctx = clues.Add(
+		ctx,
+		"restore_protected_resource_id", restoreToProtectedResource.ID(),
+		"restore_protected_resource_name", clues.Hide(restoreToProtectedResource.Name()))
+
+	enabled, err := op.rc.IsServiceEnabled(ctx, restoreToProtectedResource.ID())
+	if err != nil {
+		return nil, clues.Wrap(err, "verifying service restore is enabled")
+	}
+
+	if !enabled {
+		return nil, clues.StackWC(ctx, core.ErrServiceNotEnabled)
+	}
+
+	observe.Message(
+		ctx,
+		observe.ProgressCfg{
+			NewSection:        true,
+			SectionIdentifier: clues.Hide(restoreToProtectedResource.Name()),
+		},
+		"Restoring from files")
+
+	dcs, err := exchange.ProduceImportCollections(
+		ctx,
+		op.SourceDir,
+		op.acct.ID(),
+		restoreToProtectedResource.ID())
+	if err != nil {
+		return nil, clues.Wrap(err, "producing collections from files")
+	}
+
+	ctx = clues.Add(ctx, "coll_count", len(dcs))
+
+	opStats.resourceCount = 1
+	opStats.cs = dcs
+
+	if op.RestoreCfg.DryRun {
+		op.Plan = restoreplan.New()
+	}
+
+	deets, colStats, err := consumeRestoreCollections(
+		ctx,
+		op.rc,
+		version.Backup,
+		restoreToProtectedResource,
+		op.Selectors,
+		op.RestoreCfg,
+		op.Options,
+		op.Plan,
+		dcs,
+		op.Errors,
+		op.Counter)
+	if err != nil {
+		return nil, clues.Stack(err)
+	}
+
+	opStats.ctrl = colStats
+
+	return deets, nil
+}
+
 // persists details and statistics about the restore operation.
 func (op *RestoreOperation) persistResults(
 	ctx context.Context,
@@ -347,7 +568,9 @@ func (op *RestoreOperation) persistResults(
 		return clues.New("restoration never completed")
 	}
 
-	if op.Status != Failed && opStats.ctrl.IsZero() {
+	// dry runs don't write anything, but still completed
+	// if they produced a plan.
+	if op.Status != Failed && opStats.ctrl.IsZero() && len(op.Plan.Items()) == 0 {
 		op.Status = NoData
 	}
 
@@ -362,6 +585,10 @@ func chooseRestoreResource(
 	restoreCfg control.RestoreConfig,
 	orig idname.Provider,
 ) (idname.Provider, error) {
+	if len(restoreCfg.TargetTenantID) > 0 {
+		return chooseCrossTenantResource(ctx, pprian, restoreCfg, orig)
+	}
+
 	if len(restoreCfg.ProtectedResource) == 0 {
 		return orig, nil
 	}
@@ -374,6 +601,46 @@ func chooseRestoreResource(
 	return resource, clues.Stack(err).OrNil()
 }
 
+// chooseCrossTenantResource resolves the protected resource within the
+// target tenant.  IDs from the backup tenant don't exist in the target
+// tenant, so the backed up resource can only be matched by its name.
+func chooseCrossTenantResource(
+	ctx context.Context,
+	pprian inject.PopulateProtectedResourceIDAndNamer,
+	restoreCfg control.RestoreConfig,
+	orig idname.Provider,
+) (idname.Provider, error) {
+	lookup := restoreCfg.ProtectedResource
+
+	if len(lookup) == 0 {
+		lookup = orig.Name()
+
+		if len(lookup) == 0 || lookup == orig.ID() {
+			return nil, clues.NewWC(ctx, "backup has no resource name to match in the target tenant; "+
+				"specify the protected resource to restore to")
+		}
+	}
+
+	if strings.EqualFold(lookup, orig.ID()) {
+		return nil, clues.NewWC(ctx, "protected resource IDs from the backup tenant "+
+			"can't identify a resource in the target tenant")
+	}
+
+	resource, err := pprian.PopulateProtectedResourceIDAndName(ctx, lookup, nil)
+	if err != nil {
+		return nil, clues.Wrap(err, "resolving protected resource in target tenant")
+	}
+
+	// the same ID in both tenants means the lookup went to the
+	// backup tenant, not the target.
+	if resource.ID() == orig.ID() {
+		return nil, clues.NewWC(ctx, "protected resource resolved to the backed up resource; "+
+			"verify the target tenant connection")
+	}
+
+	return resource, nil
+}
+
 // ---------------------------------------------------------------------------
 // Restorer funcs
 // ---------------------------------------------------------------------------
@@ -386,6 +653,7 @@ func consumeRestoreCollections(
 	sel selectors.Selector,
 	restoreCfg control.RestoreConfig,
 	opts control.Options,
+	plan *restoreplan.Plan,
 	dcs []data.RestoreCollection,
 	errs *fault.Bus,
 	ctr *count.Bus,
@@ -406,6 +674,7 @@ func consumeRestoreCollections(
 		ProtectedResource: toProtectedResource,
 		RestoreConfig:     restoreCfg,
 		Selector:          sel,
+		Plan:              plan,
 	}
 
 	ctx = clues.Add(ctx, "restore_config", rcc.RestoreConfig)
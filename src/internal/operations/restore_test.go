@@ -29,6 +29,7 @@ import (
 	"github.com/alcionai/corso/src/pkg/control/testdata"
 	"github.com/alcionai/corso/src/pkg/count"
 	"github.com/alcionai/corso/src/pkg/dttm"
+	"github.com/alcionai/corso/src/pkg/path"
 	"github.com/alcionai/corso/src/pkg/selectors"
 	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
 	storeTD "github.com/alcionai/corso/src/pkg/storage/testdata"
@@ -206,6 +207,330 @@ func (suite *RestoreOpUnitSuite) TestChooseRestoreResource() {
 	}
 }
 
+func (suite *RestoreOpUnitSuite) TestRestoreOperation_validateMirror() {
+	allFiles := selectors.NewOneDriveRestore(selectors.Any())
+	allFiles.Include(allFiles.AllData())
+
+	someFiles := selectors.NewOneDriveRestore(selectors.Any())
+	someFiles.Include(someFiles.Items(selectors.Any(), []string{"file"}))
+
+	table := []struct {
+		name      string
+		sel       selectors.Selector
+		policy    control.CollisionPolicy
+		expectErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "mirror all files",
+			sel:       allFiles.Selector,
+			policy:    control.Mirror,
+			expectErr: assert.NoError,
+		},
+		{
+			name:      "mirror some files",
+			sel:       someFiles.Selector,
+			policy:    control.Mirror,
+			expectErr: assert.Error,
+		},
+		{
+			name:      "replace some files",
+			sel:       someFiles.Selector,
+			policy:    control.Replace,
+			expectErr: assert.NoError,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			cfg := control.DefaultRestoreConfig(dttm.HumanReadable)
+			cfg.OnCollision = test.policy
+
+			op := RestoreOperation{
+				operation: operation{
+					kopia: &kopia.Wrapper{},
+					store: store.NewWrapper(&kopia.ModelStore{}),
+				},
+				Selectors:  test.sel,
+				RestoreCfg: cfg,
+				rc:         &mock.RestoreConsumer{},
+			}
+
+			err := op.validate()
+			test.expectErr(suite.T(), err, clues.ToCore(err))
+		})
+	}
+}
+
+func (suite *RestoreOpUnitSuite) TestRestoreOperation_validateSourceDir() {
+	table := []struct {
+		name      string
+		sel       selectors.Selector
+		resource  string
+		expectErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "exchange",
+			sel:       selectors.NewExchangeRestore(selectors.Any()).Selector,
+			resource:  "rid",
+			expectErr: assert.NoError,
+		},
+		{
+			name:      "exchange without a protected resource",
+			sel:       selectors.NewExchangeRestore(selectors.Any()).Selector,
+			expectErr: assert.Error,
+		},
+		{
+			name:      "onedrive",
+			sel:       selectors.NewOneDriveRestore(selectors.Any()).Selector,
+			resource:  "rid",
+			expectErr: assert.Error,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			cfg := control.DefaultRestoreConfig(dttm.HumanReadable)
+			cfg.ProtectedResource = test.resource
+
+			op := RestoreOperation{
+				operation: operation{
+					kopia: &kopia.Wrapper{},
+					store: store.NewWrapper(&kopia.ModelStore{}),
+				},
+				Selectors:  test.sel,
+				SourceDir:  "dir",
+				RestoreCfg: cfg,
+				rc:         &mock.RestoreConsumer{},
+			}
+
+			err := op.validate()
+			test.expectErr(suite.T(), err, clues.ToCore(err))
+		})
+	}
+}
+
+func (suite *RestoreOpUnitSuite) TestValidateCrossServiceRestore() {
+	table := []struct {
+		name          string
+		backupService path.ServiceType
+		cfg           control.RestoreConfig
+		expectErr     assert.ErrorAssertionFunc
+	}{
+		{
+			name:          "onedrive to sharepoint",
+			backupService: path.OneDriveService,
+			cfg: control.RestoreConfig{
+				OnCollision:       control.Skip,
+				ProtectedResource: "site",
+				TargetService:     path.SharePointService,
+			},
+			expectErr: assert.NoError,
+		},
+		{
+			name:          "sharepoint to onedrive",
+			backupService: path.SharePointService,
+			cfg: control.RestoreConfig{
+				OnCollision:       control.Replace,
+				ProtectedResource: "user",
+				TargetService:     path.OneDriveService,
+			},
+			expectErr: assert.NoError,
+		},
+		{
+			name:          "exchange to onedrive",
+			backupService: path.ExchangeService,
+			cfg: control.RestoreConfig{
+				ProtectedResource: "user",
+				TargetService:     path.OneDriveService,
+			},
+			expectErr: assert.Error,
+		},
+		{
+			name:          "onedrive to groups",
+			backupService: path.OneDriveService,
+			cfg: control.RestoreConfig{
+				ProtectedResource: "group",
+				TargetService:     path.GroupsService,
+			},
+			expectErr: assert.Error,
+		},
+		{
+			name:          "missing protected resource",
+			backupService: path.OneDriveService,
+			cfg: control.RestoreConfig{
+				TargetService: path.SharePointService,
+			},
+			expectErr: assert.Error,
+		},
+		{
+			name:          "mirror",
+			backupService: path.OneDriveService,
+			cfg: control.RestoreConfig{
+				OnCollision:       control.Mirror,
+				ProtectedResource: "site",
+				TargetService:     path.SharePointService,
+			},
+			expectErr: assert.Error,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			err := validateCrossServiceRestore(test.backupService, test.cfg)
+			test.expectErr(t, err, clues.ToCore(err))
+		})
+	}
+}
+
+func (suite *RestoreOpUnitSuite) TestValidateCrossTenantRestore() {
+	table := []struct {
+		name      string
+		cfg       control.RestoreConfig
+		expectErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "different tenant",
+			cfg:       control.RestoreConfig{TargetTenantID: "target"},
+			expectErr: assert.NoError,
+		},
+		{
+			name:      "same tenant",
+			cfg:       control.RestoreConfig{TargetTenantID: "BACKUP"},
+			expectErr: assert.Error,
+		},
+		{
+			name: "principal map",
+			cfg: control.RestoreConfig{
+				TargetTenantID: "target",
+				PrincipalMap: map[string]string{
+					"adele@backup.com": "adele@target.com",
+					"Adele@Backup.com": "ADELE@target.com",
+					"grady@backup.com": "adele@target.com",
+				},
+			},
+			expectErr: assert.NoError,
+		},
+		{
+			name: "ambiguous principal map",
+			cfg: control.RestoreConfig{
+				TargetTenantID: "target",
+				PrincipalMap: map[string]string{
+					"adele@backup.com": "adele@target.com",
+					"Adele@Backup.com": "grady@target.com",
+				},
+			},
+			expectErr: assert.Error,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			err := validateCrossTenantRestore("backup", test.cfg)
+			test.expectErr(t, err, clues.ToCore(err))
+		})
+	}
+}
+
+func (suite *RestoreOpUnitSuite) TestChooseRestoreResource_crossTenant() {
+	table := []struct {
+		name       string
+		cfg        control.RestoreConfig
+		ctrl       *mock.Controller
+		orig       idname.Provider
+		expectErr  assert.ErrorAssertionFunc
+		expectID   string
+		expectName string
+	}{
+		{
+			name: "resolve backup resource by name",
+			cfg:  control.RestoreConfig{TargetTenantID: "target"},
+			ctrl: &mock.Controller{
+				ProtectedResourceID:   "tid",
+				ProtectedResourceName: "tname",
+			},
+			orig:       idname.NewProvider("oid", "oname"),
+			expectErr:  assert.NoError,
+			expectID:   "tid",
+			expectName: "tname",
+		},
+		{
+			name: "resolve configured resource",
+			cfg: control.RestoreConfig{
+				TargetTenantID:    "target",
+				ProtectedResource: "tname",
+			},
+			ctrl: &mock.Controller{
+				ProtectedResourceID:   "tid",
+				ProtectedResourceName: "tname",
+			},
+			orig:       idname.NewProvider("oid", "oname"),
+			expectErr:  assert.NoError,
+			expectID:   "tid",
+			expectName: "tname",
+		},
+		{
+			name: "backup resource has no name",
+			cfg:  control.RestoreConfig{TargetTenantID: "target"},
+			ctrl: &mock.Controller{
+				ProtectedResourceID:   "tid",
+				ProtectedResourceName: "tname",
+			},
+			orig:      idname.NewProvider("oid", "oid"),
+			expectErr: assert.Error,
+		},
+		{
+			name: "configured resource is a backup tenant ID",
+			cfg: control.RestoreConfig{
+				TargetTenantID:    "target",
+				ProtectedResource: "OID",
+			},
+			ctrl: &mock.Controller{
+				ProtectedResourceID:   "tid",
+				ProtectedResourceName: "tname",
+			},
+			orig:      idname.NewProvider("oid", "oname"),
+			expectErr: assert.Error,
+		},
+		{
+			name: "resolved to the backed up resource",
+			cfg:  control.RestoreConfig{TargetTenantID: "target"},
+			ctrl: &mock.Controller{
+				ProtectedResourceID:   "oid",
+				ProtectedResourceName: "oname",
+			},
+			orig:      idname.NewProvider("oid", "oname"),
+			expectErr: assert.Error,
+		},
+		{
+			name: "not found in target tenant",
+			cfg:  control.RestoreConfig{TargetTenantID: "target"},
+			ctrl: &mock.Controller{
+				ProtectedResourceErr: assert.AnError,
+			},
+			orig:      idname.NewProvider("oid", "oname"),
+			expectErr: assert.Error,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			result, err := chooseRestoreResource(ctx, test.ctrl, test.cfg, test.orig)
+			test.expectErr(t, err, clues.ToCore(err))
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, test.expectID, result.ID())
+			assert.Equal(t, test.expectName, result.Name())
+		})
+	}
+}
+
 // ---------------------------------------------------------------------------
 // integration
 // ---------------------------------------------------------------------------
@@ -0,0 +1,96 @@
+package details
+
+import "github.com/alcionai/corso/src/cli/print"
+
+// ChangeType describes how an entry differs between two backups.
+type ChangeType string
+
+const (
+	EntryAdded    ChangeType = "added"
+	EntryRemoved  ChangeType = "removed"
+	EntryModified ChangeType = "modified"
+)
+
+// EntryChange is an entry that was added, removed, or modified between two
+// backups.  Removed changes hold the entry from the older backup, all others
+// hold the entry from the newer backup.
+type EntryChange struct {
+	Change ChangeType `json:"change"`
+	Entry
+}
+
+// interface compliance checks
+var _ print.Printable = &EntryChange{}
+
+// MinimumPrintable is a passthrough func, because no
+// reduction is needed for the json output.
+func (ec EntryChange) MinimumPrintable() any {
+	return ec
+}
+
+// Headers returns the human-readable names of properties in an EntryChange
+// for printing out to a terminal in a columnar display.
+func (ec EntryChange) Headers(skipID bool) []string {
+	return append([]string{"Change"}, ec.Entry.Headers(skipID)...)
+}
+
+// Values returns the values matching the Headers list.
+func (ec EntryChange) Values(skipID bool) []string {
+	return append([]string{string(ec.Change)}, ec.Entry.Values(skipID)...)
+}
+
+// DiffMailboxSettings compares the exchange mailbox settings entries of two
+// backups.  Entries are matched by their location and item ID.  Matching
+// entries are modified if the hashes of their content differ.  Entries of
+// other types are ignored.
+func DiffMailboxSettings(before, after []Entry) []EntryChange {
+	var (
+		changes = []EntryChange{}
+		prev    = map[string]Entry{}
+		seen    = map[string]struct{}{}
+	)
+
+	for _, ent := range before {
+		if isMailboxSetting(ent) {
+			prev[settingKey(ent)] = ent
+		}
+	}
+
+	for _, ent := range after {
+		if !isMailboxSetting(ent) {
+			continue
+		}
+
+		key := settingKey(ent)
+		seen[key] = struct{}{}
+
+		old, ok := prev[key]
+
+		switch {
+		case !ok:
+			changes = append(changes, EntryChange{Change: EntryAdded, Entry: ent})
+		case old.Exchange.SettingHash != ent.Exchange.SettingHash:
+			changes = append(changes, EntryChange{Change: EntryModified, Entry: ent})
+		}
+	}
+
+	for _, ent := range before {
+		if !isMailboxSetting(ent) {
+			continue
+		}
+
+		if _, ok := seen[settingKey(ent)]; !ok {
+			changes = append(changes, EntryChange{Change: EntryRemoved, Entry: ent})
+		}
+	}
+
+	return changes
+}
+
+func isMailboxSetting(ent Entry) bool {
+	return ent.Exchange != nil && ent.Exchange.ItemType == ExchangeMailboxSetting
+}
+
+func settingKey(ent Entry) string {
+	return ent.LocationRef + "/" + ent.ItemRef
+}
@@ -0,0 +1,123 @@
+package details_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+)
+
+type DiffUnitSuite struct {
+	tester.Suite
+}
+
+func TestDiffUnitSuite(t *testing.T) {
+	suite.Run(t, &DiffUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func settingEntry(loc, id, name, hash string) details.Entry {
+	return details.Entry{
+		LocationRef: loc,
+		ItemRef:     id,
+		ItemInfo: details.ItemInfo{
+			Exchange: &details.ExchangeInfo{
+				ItemType:    details.ExchangeMailboxSetting,
+				SettingName: name,
+				SettingHash: hash,
+			},
+		},
+	}
+}
+
+func (suite *DiffUnitSuite) TestDiffMailboxSettings() {
+	var (
+		kept     = settingEntry("InboxRules", "r1", "kept", "h1")
+		changed  = settingEntry("InboxRules", "r2", "changed", "h2")
+		changed2 = settingEntry("InboxRules", "r2", "changed", "h2-new")
+		removed  = settingEntry("InboxRules", "r3", "removed", "h3")
+		added    = settingEntry("InboxRules", "r4", "added", "h4")
+		tz       = settingEntry("Settings", "timeZone", "timeZone", "h5")
+		tz2      = settingEntry("Settings", "timeZone", "timeZone", "h6")
+		mail     = details.Entry{
+			LocationRef: "Inbox",
+			ItemRef:     "m1",
+			ItemInfo: details.ItemInfo{
+				Exchange: &details.ExchangeInfo{ItemType: details.ExchangeMail},
+			},
+		}
+	)
+
+	table := []struct {
+		name   string
+		before []details.Entry
+		after  []details.Entry
+		expect []details.EntryChange
+	}{
+		{
+			name:   "no entries",
+			expect: []details.EntryChange{},
+		},
+		{
+			name:   "unchanged",
+			before: []details.Entry{kept, tz},
+			after:  []details.Entry{kept, tz},
+			expect: []details.EntryChange{},
+		},
+		{
+			name:   "other item types are ignored",
+			before: []details.Entry{kept},
+			after:  []details.Entry{kept, mail},
+			expect: []details.EntryChange{},
+		},
+		{
+			name:   "added, modified, and removed",
+			before: []details.Entry{kept, changed, removed, tz},
+			after:  []details.Entry{kept, added, changed2, tz2},
+			expect: []details.EntryChange{
+				{Change: details.EntryAdded, Entry: added},
+				{Change: details.EntryModified, Entry: changed2},
+				{Change: details.EntryModified, Entry: tz2},
+				{Change: details.EntryRemoved, Entry: removed},
+			},
+		},
+		{
+			name:   "same item id in different folders",
+			before: []details.Entry{settingEntry("Settings", "r1", "r1", "h1")},
+			after:  []details.Entry{kept},
+			expect: []details.EntryChange{
+				{Change: details.EntryAdded, Entry: kept},
+				{Change: details.EntryRemoved, Entry: settingEntry("Settings", "r1", "r1", "h1")},
+			},
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			result := details.DiffMailboxSettings(test.before, test.after)
+			assert.Equal(suite.T(), test.expect, result)
+		})
+	}
+}
+
+func (suite *DiffUnitSuite) TestEntryChange_Printable() {
+	t := suite.T()
+
+	ec := details.EntryChange{
+		Change: details.EntryRemoved,
+		Entry:  settingEntry("InboxRules", "r1", "Forward invoices", "h1"),
+	}
+	ec.Exchange.ParentPath = "InboxRules"
+	ec.Exchange.SettingState = "enabled"
+	ec.Exchange.ForwardsTo = []string{"a@example.com", "b@example.com"}
+
+	assert.Equal(
+		t,
+		[]string{"Change", "Folder", "Name", "State", "Forwards To"},
+		ec.Headers(true))
+	assert.Equal(
+		t,
+		[]string{"removed", "InboxRules", "Forward invoices", "enabled", "a@example.com, b@example.com"},
+		ec.Values(true))
+}
@@ -2,6 +2,7 @@ package details
 
 import (
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/alcionai/clues"
@@ -31,20 +32,26 @@ func NewExchangeLocationIDer(
 
 // ExchangeInfo describes an exchange item
 type ExchangeInfo struct {
-	ItemType    ItemType  `json:"itemType,omitempty"`
-	Sender      string    `json:"sender,omitempty"`
-	Subject     string    `json:"subject,omitempty"`
-	Recipient   []string  `json:"recipient,omitempty"`
-	ParentPath  string    `json:"parentPath,omitempty"`
-	Received    time.Time `json:"received,omitempty"`
-	EventStart  time.Time `json:"eventStart,omitempty"`
-	EventEnd    time.Time `json:"eventEnd,omitempty"`
-	Organizer   string    `json:"organizer,omitempty"`
-	ContactName string    `json:"contactName,omitempty"`
-	EventRecurs bool      `json:"eventRecurs,omitempty"`
-	Created     time.Time `json:"created,omitempty"`
-	Modified    time.Time `json:"modified,omitempty"`
-	Size        int64     `json:"size,omitempty"`
+	ItemType     ItemType  `json:"itemType,omitempty"`
+	Sender       string    `json:"sender,omitempty"`
+	Subject      string    `json:"subject,omitempty"`
+	Recipient    []string  `json:"recipient,omitempty"`
+	ParentPath   string    `json:"parentPath,omitempty"`
+	Received     time.Time `json:"received,omitempty"`
+	EventStart   time.Time `json:"eventStart,omitempty"`
+	EventEnd     time.Time `json:"eventEnd,omitempty"`
+	Organizer    string    `json:"organizer,omitempty"`
+	ContactName  string    `json:"contactName,omitempty"`
+	EventRecurs  bool      `json:"eventRecurs,omitempty"`
+	TaskStatus   string    `json:"taskStatus,omitempty"`
+	TaskDue      time.Time `json:"taskDue,omitempty"`
+	SettingName  string    `json:"settingName,omitempty"`
+	SettingState string    `json:"settingState,omitempty"`
+	SettingHash  string    `json:"settingHash,omitempty"`
+	ForwardsTo   []string  `json:"forwardsTo,omitempty"`
+	Created      time.Time `json:"created,omitempty"`
+	Modified     time.Time `json:"modified,omitempty"`
+	Size         int64     `json:"size,omitempty"`
 }
 
 // Headers returns the human-readable names of properties in an ExchangeInfo
@@ -59,6 +66,12 @@ func (i ExchangeInfo) Headers() []string {
 
 	case ExchangeMail:
 		return []string{"Sender", "Folder", "Subject", "Received"}
+
+	case ExchangeTask:
+		return []string{"Task List", "Title", "Status", "Due"}
+
+	case ExchangeMailboxSetting:
+		return []string{"Folder", "Name", "State", "Forwards To"}
 	}
 
 	return []string{}
@@ -85,6 +98,22 @@ func (i ExchangeInfo) Values() []string {
 			i.Sender, i.ParentPath, i.Subject,
 			dttm.FormatToTabularDisplay(i.Received),
 		}
+
+	case ExchangeTask:
+		due := ""
+		if !i.TaskDue.IsZero() {
+			due = dttm.FormatToTabularDisplay(i.TaskDue)
+		}
+
+		return []string{i.ParentPath, i.Subject, i.TaskStatus, due}
+
+	case ExchangeMailboxSetting:
+		return []string{
+			i.ParentPath,
+			i.SettingName,
+			i.SettingState,
+			strings.Join(i.ForwardsTo, ", "),
+		}
 	}
 
 	return []string{}
@@ -104,6 +133,10 @@ func (i *ExchangeInfo) uniqueLocation(baseLoc *path.Builder) (*uniqueLoc, error)
 		category = path.ContactsCategory
 	case ExchangeMail:
 		category = path.EmailCategory
+	case ExchangeTask:
+		category = path.TasksCategory
+	case ExchangeMailboxSetting:
+		category = path.MailboxSettingsCategory
 	}
 
 	loc, err := NewExchangeLocationIDer(category, baseLoc.Elements()...)
@@ -113,7 +146,7 @@ func (i *ExchangeInfo) uniqueLocation(baseLoc *path.Builder) (*uniqueLoc, error)
 
 func (i *ExchangeInfo) updateFolder(f *FolderInfo) error {
 	switch i.ItemType {
-	case ExchangeContact, ExchangeEvent, ExchangeMail:
+	case ExchangeContact, ExchangeEvent, ExchangeMail, ExchangeTask, ExchangeMailboxSetting:
 	default:
 		return clues.New("unsupported non-Exchange ItemType").
 			With("item_type", i.ItemType)
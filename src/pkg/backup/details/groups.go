@@ -58,6 +58,9 @@ type GroupsInfo struct {
 	SiteID     string    `json:"siteID,omitempty"`
 	Size       int64     `json:"size,omitempty"`
 	WebURL     string    `json:"webURL,omitempty"`
+	// Versions lists the previous versions of a library file which
+	// were backed up alongside its current content, oldest first.
+	Versions []DriveItemVersion `json:"versions,omitempty"`
 }
 
 type ConversationPostInfo struct {
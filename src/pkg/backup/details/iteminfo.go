@@ -26,14 +26,22 @@ const (
 	ExchangeContact ItemType = 1
 	ExchangeEvent   ItemType = 2
 	ExchangeMail    ItemType = 3
+	ExchangeTask    ItemType = 4
+	// ExchangeMailboxSetting is an inbox rule, or another piece of
+	// mailbox configuration such as automatic replies.
+	ExchangeMailboxSetting ItemType = 5
 
 	// SharePoint (10x)
 	SharePointLibrary ItemType = 101 // also used for groups
 	SharePointList    ItemType = 102
 	SharePointPage    ItemType = 103
+	// SharePointNotebookPage is a OneNote page in a site notebook.
+	SharePointNotebookPage ItemType = 104
 
 	// OneDrive (20x)
 	OneDriveItem ItemType = 205
+	// OneDriveNotebookPage is a OneNote page in a user's notebook.
+	OneDriveNotebookPage ItemType = 206
 
 	// Folder Management(30x)
 	FolderItem ItemType = 306
@@ -79,8 +87,20 @@ type ItemInfo struct {
 	TeamsChats *TeamsChatsInfo `json:"teamsChats,omitempty"`
 	// Optional item extension data
 	Extension *ExtensionData `json:"extension,omitempty"`
+	// Removal is only set in restore details, on items which a mirror
+	// restore removed from the restore target because they weren't in
+	// the backup.
+	Removal RemovalType `json:"removal,omitempty"`
 }
 
+// RemovalType describes how a mirror restore removed an item.
+type RemovalType string
+
+const (
+	RemovalDeleted     RemovalType = "deleted"
+	RemovalQuarantined RemovalType = "quarantined"
+)
+
 // typedInfo should get embedded in each sesrvice type to track
 // the type of item it stores for multi-item service support.
 
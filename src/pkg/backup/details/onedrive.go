@@ -26,6 +26,19 @@ func NewOneDriveLocationIDer(
 	}
 }
 
+// NewOneDriveNotebookLocationIDer builds a LocationIDer for the notebook,
+// section group, and section path of a OneNote page.
+func NewOneDriveNotebookLocationIDer(escapedFolders ...string) uniqueLoc {
+	pb := path.Builder{}.
+		Append(path.NotebooksCategory.String()).
+		Append(escapedFolders...)
+
+	return uniqueLoc{
+		pb:          pb,
+		prefixElems: 1,
+	}
+}
+
 // OneDriveInfo describes a oneDrive item
 type OneDriveInfo struct {
 	Created    time.Time `json:"created,omitempty"`
@@ -38,6 +51,16 @@ type OneDriveInfo struct {
 	Owner      string    `json:"owner,omitempty"`
 	ParentPath string    `json:"parentPath"`
 	Size       int64     `json:"size,omitempty"`
+	// Versions lists the previous versions of the file which were
+	// backed up alongside its current content, oldest first.
+	Versions []DriveItemVersion `json:"versions,omitempty"`
+}
+
+// DriveItemVersion describes a previous version of a drive file.
+type DriveItemVersion struct {
+	ID       string    `json:"id"`
+	Modified time.Time `json:"modified,omitempty"`
+	Size     int64     `json:"size,omitempty"`
 }
 
 // Headers returns the human-readable names of properties in a OneDriveInfo
@@ -60,10 +83,21 @@ func (i OneDriveInfo) Values() []string {
 }
 
 func (i *OneDriveInfo) UpdateParentPath(newLocPath *path.Builder) {
+	// notebook locations don't have a drive root to trim.
+	if i.ItemType == OneDriveNotebookPage {
+		i.ParentPath = newLocPath.String()
+		return
+	}
+
 	i.ParentPath = newLocPath.PopFront().String()
 }
 
 func (i *OneDriveInfo) uniqueLocation(baseLoc *path.Builder) (*uniqueLoc, error) {
+	if i.ItemType == OneDriveNotebookPage {
+		loc := NewOneDriveNotebookLocationIDer(baseLoc.Elements()...)
+		return &loc, nil
+	}
+
 	if len(i.DriveID) == 0 {
 		return nil, clues.New("empty drive ID")
 	}
@@ -74,5 +108,10 @@ func (i *OneDriveInfo) uniqueLocation(baseLoc *path.Builder) (*uniqueLoc, error)
 }
 
 func (i *OneDriveInfo) updateFolder(f *FolderInfo) error {
+	if i.ItemType == OneDriveNotebookPage {
+		f.DataType = i.ItemType
+		return nil
+	}
+
 	return updateFolderWithinDrive(OneDriveItem, i.DriveName, i.DriveID, f)
 }
@@ -49,6 +49,9 @@ type SharePointInfo struct {
 	WebURL     string    `json:"webUrl,omitempty"`
 	SiteID     string    `json:"siteID,omitempty"`
 	List       *ListInfo `json:"list,omitempty"`
+	// Versions lists the previous versions of a library file which
+	// were backed up alongside its current content, oldest first.
+	Versions []DriveItemVersion `json:"versions,omitempty"`
 }
 
 type ListInfo struct {
@@ -65,6 +68,8 @@ func (i SharePointInfo) Headers() []string {
 		return []string{"ItemName", "Library", "ParentPath", "Size", "Owner", "Created", "Modified"}
 	case SharePointList:
 		return []string{"List", "Items", "Created", "Modified"}
+	case SharePointNotebookPage:
+		return []string{"ItemName", "ParentPath", "Size", "Created", "Modified"}
 	}
 
 	return []string{}
@@ -91,12 +96,26 @@ func (i SharePointInfo) Values() []string {
 			dttm.FormatToTabularDisplay(i.Created),
 			dttm.FormatToTabularDisplay(i.Modified),
 		}
+	case SharePointNotebookPage:
+		return []string{
+			i.ItemName,
+			i.ParentPath,
+			humanize.Bytes(uint64(i.Size)),
+			dttm.FormatToTabularDisplay(i.Created),
+			dttm.FormatToTabularDisplay(i.Modified),
+		}
 	}
 
 	return []string{}
 }
 
 func (i *SharePointInfo) UpdateParentPath(newLocPath *path.Builder) {
+	// notebook locations don't have a drive root to trim.
+	if i.ItemType == SharePointNotebookPage {
+		i.ParentPath = newLocPath.String()
+		return
+	}
+
 	i.ParentPath = newLocPath.PopFront().String()
 }
 
@@ -108,6 +127,8 @@ func (i *SharePointInfo) uniqueLocation(baseLoc *path.Builder) (*uniqueLoc, erro
 		loc = NewSharePointLocationIDer(path.LibrariesCategory, i.DriveID, baseLoc.Elements()...)
 	case SharePointList:
 		loc = NewSharePointLocationIDer(path.ListsCategory, "", baseLoc.Elements()...)
+	case SharePointNotebookPage:
+		loc = NewSharePointLocationIDer(path.NotebooksCategory, "", baseLoc.Elements()...)
 	}
 
 	return &loc, nil
@@ -119,7 +140,7 @@ func (i *SharePointInfo) updateFolder(f *FolderInfo) error {
 	switch i.ItemType {
 	case OneDriveItem, SharePointLibrary:
 		return updateFolderWithinDrive(SharePointLibrary, i.DriveName, i.DriveID, f)
-	case SharePointList:
+	case SharePointList, SharePointNotebookPage:
 		return nil
 	}
 
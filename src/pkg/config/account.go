@@ -133,3 +133,52 @@ func GetM365(m365Cfg account.M365Config) credentials.M365 {
 		AzureOnBehalfOfServiceSecret: AzureOnBehalfOfServiceSecret,
 	}
 }
+
+// envvar names for the credentials of a separate target tenant.
+const (
+	TargetAzureTenantID     = "TARGET_" + account.AzureTenantID
+	TargetAzureClientID     = "TARGET_" + credentials.AzureClientID
+	TargetAzureClientSecret = "TARGET_" + credentials.AzureClientSecret
+)
+
+// GetTargetAccount builds the account of a separate target tenant from
+// flag and env_var values.  Returns false if no target tenant is
+// configured, in which case the repository's account is the target.
+func GetTargetAccount() (account.Account, bool, error) {
+	m365Cfg := account.M365Config{
+		M365: credentials.M365{
+			AzureClientID: str.First(
+				flags.TargetAzureClientIDFV,
+				os.Getenv(TargetAzureClientID)),
+			AzureClientSecret: str.First(
+				flags.TargetAzureClientSecretFV,
+				os.Getenv(TargetAzureClientSecret)),
+		},
+		AzureTenantID: str.First(
+			flags.TargetAzureClientTenantFV,
+			os.Getenv(TargetAzureTenantID)),
+	}
+
+	if len(m365Cfg.AzureTenantID) == 0 &&
+		len(m365Cfg.AzureClientID) == 0 &&
+		len(m365Cfg.AzureClientSecret) == 0 {
+		return account.Account{}, false, nil
+	}
+
+	// a partial config can't be told apart from a typo, so it
+	// isn't allowed to fall back to the repository's account.
+	if err := requireProps(map[string]string{
+		TargetAzureTenantID:     m365Cfg.AzureTenantID,
+		TargetAzureClientID:     m365Cfg.AzureClientID,
+		TargetAzureClientSecret: m365Cfg.AzureClientSecret,
+	}); err != nil {
+		return account.Account{}, false, clues.Wrap(err, "configuring target account")
+	}
+
+	acct, err := account.NewAccount(account.ProviderM365, m365Cfg)
+	if err != nil {
+		return account.Account{}, false, clues.Wrap(err, "retrieving target m365 account configuration")
+	}
+
+	return acct, true, nil
+}
@@ -73,6 +73,75 @@ func (suite *ConfigSuite) TestRequireProps() {
 	}
 }
 
+func (suite *ConfigSuite) TestGetTargetAccount() {
+	table := []struct {
+		name      string
+		tenantID  string
+		clientID  string
+		secret    string
+		expectOK  bool
+		expectErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "no target tenant",
+			expectErr: assert.NoError,
+		},
+		{
+			name:      "target tenant",
+			tenantID:  "target-tenant",
+			clientID:  "target-client",
+			secret:    "target-secret",
+			expectOK:  true,
+			expectErr: assert.NoError,
+		},
+		{
+			name:      "missing secret",
+			tenantID:  "target-tenant",
+			clientID:  "target-client",
+			expectErr: assert.Error,
+		},
+		{
+			name:      "missing tenant",
+			clientID:  "target-client",
+			secret:    "target-secret",
+			expectErr: assert.Error,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			t.Setenv(TargetAzureTenantID, "")
+			t.Setenv(TargetAzureClientID, "")
+			t.Setenv(TargetAzureClientSecret, "")
+
+			flags.TargetAzureClientTenantFV = test.tenantID
+			flags.TargetAzureClientIDFV = test.clientID
+			flags.TargetAzureClientSecretFV = test.secret
+
+			t.Cleanup(func() {
+				flags.TargetAzureClientTenantFV = ""
+				flags.TargetAzureClientIDFV = ""
+				flags.TargetAzureClientSecretFV = ""
+			})
+
+			acct, ok, err := GetTargetAccount()
+			test.expectErr(t, err, clues.ToCore(err))
+			assert.Equal(t, test.expectOK, ok)
+
+			if !test.expectOK {
+				return
+			}
+
+			m365, err := acct.M365Config()
+			require.NoError(t, err, clues.ToCore(err))
+			assert.Equal(t, test.tenantID, acct.ID())
+			assert.Equal(t, test.clientID, m365.AzureClientID)
+			assert.Equal(t, test.secret, m365.AzureClientSecret)
+		})
+	}
+}
+
 func (suite *ConfigSuite) TestReadRepoConfigBasic() {
 	var (
 		t   = suite.T()
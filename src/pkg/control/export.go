@@ -1,5 +1,7 @@
 package control
 
+import "time"
+
 // ExportConfig contains config for exports
 type ExportConfig struct {
 	// Archive decides if we should create an archive from the data
@@ -8,6 +10,14 @@ type ExportConfig struct {
 	// the archive.
 	Archive bool
 
+	// ArchiveFormat is the type of archive to create when Archive is
+	// set.  Defaults to zip.
+	ArchiveFormat ArchiveFormatType
+
+	// ArchiveVolumeSize, if greater than zero, splits the archive into
+	// numbered parts of at most this many bytes.
+	ArchiveVolumeSize int64
+
 	// DataFormat
 	// TODO: Enable once we support outlook exports
 	// DataFormat string
@@ -16,6 +26,23 @@ type ExportConfig struct {
 	// ex: html vs pst vs other.
 	// Default format is decided on a per-service or per-data basis.
 	Format FormatType
+
+	// ItemVersion picks which version of each drive file gets exported.
+	// Accepts a version ID, or AllItemVersions to export every version
+	// that was backed up alongside the current content.
+	// If empty, exports the current content of each file.
+	ItemVersion string
+
+	// MessagesCreatedAfter and MessagesCreatedBefore, when non-zero,
+	// limit the messages exported from each chat to those created
+	// within the bounds.
+	MessagesCreatedAfter  time.Time
+	MessagesCreatedBefore time.Time
+
+	// ManifestPerBackup names the export manifest after the backup, so
+	// that exports of several backups into the same location each keep
+	// their own manifest.
+	ManifestPerBackup bool
 }
 
 type FormatType string
@@ -25,6 +52,22 @@ var (
 	DefaultFormat FormatType
 	// export the data as raw, unmodified json
 	JSONFormat FormatType = "json"
+	// export emails as mbox files, with one file per mail folder
+	MBOXFormat FormatType = "mbox"
+	// export messages as human readable html transcripts
+	HTMLFormat FormatType = "html"
+	// export tabular data, such as lists, as csv files
+	CSVFormat FormatType = "csv"
+)
+
+type ArchiveFormatType string
+
+var (
+	DefaultArchiveFormat ArchiveFormatType
+	ZipArchiveFormat     ArchiveFormatType = "zip"
+	TarArchiveFormat     ArchiveFormatType = "tar"
+	// tar archive compressed with zstandard
+	TarZstdArchiveFormat ArchiveFormatType = "tar.zst"
 )
 
 func DefaultExportConfig() ExportConfig {
@@ -1,6 +1,8 @@
 package control
 
 import (
+	"time"
+
 	"github.com/alcionai/corso/src/pkg/control/repository"
 	"github.com/alcionai/corso/src/pkg/extensions"
 )
@@ -9,8 +11,11 @@ import (
 type Options struct {
 	// DeltaPageSize controls the quantity of items fetched in each page
 	// during multi-page queries, such as graph api delta endpoints.
-	DeltaPageSize        int32                              `json:"deltaPageSize"`
-	DisableMetrics       bool                               `json:"disableMetrics"`
+	DeltaPageSize  int32 `json:"deltaPageSize"`
+	DisableMetrics bool  `json:"disableMetrics"`
+	// DriveItemVersions limits the previous versions of each drive file
+	// that get backed up alongside its current content.
+	DriveItemVersions    DriveItemVersions                  `json:"driveItemVersions,omitempty"`
 	FailureHandling      FailurePolicy                      `json:"failureHandling"`
 	ItemExtensionFactory []extensions.CreateItemExtensioner `json:"-"`
 	Parallelism          Parallelism                        `json:"parallelism"`
@@ -40,6 +45,23 @@ type RateLimiter struct {
 	DisableSlidingWindowLimiter bool `json:"disableSlidingWindowLimiter"`
 }
 
+// DriveItemVersions bounds the version history backed up for each drive
+// file.  Versions are kept newest first, until either limit is reached.
+// History is only backed up when at least one limit is set.
+type DriveItemVersions struct {
+	// MaxCount is the most previous versions kept per file.
+	// Zero places no limit on the count.
+	MaxCount int `json:"maxCount,omitempty"`
+	// MaxAge drops versions last modified longer ago than the duration.
+	// Zero places no limit on the age.
+	MaxAge time.Duration `json:"maxAge,omitempty"`
+}
+
+// Enabled is true when previous versions should get backed up.
+func (div DriveItemVersions) Enabled() bool {
+	return div.MaxCount > 0 || div.MaxAge > 0
+}
+
 type FailurePolicy string
 
 const (
@@ -25,11 +25,15 @@ const (
 	Skip    CollisionPolicy = "skip"
 	Copy    CollisionPolicy = "copy"
 	Replace CollisionPolicy = "replace"
+	// Mirror replaces colliding items like Replace, and also removes any
+	// items in the restore target which weren't in the backup, so that the
+	// target matches the backup.  Only supported by drive-based restores.
+	Mirror CollisionPolicy = "mirror"
 )
 
 func IsValidCollisionPolicy(cp CollisionPolicy) bool {
 	switch cp {
-	case Skip, Copy, Replace:
+	case Skip, Copy, Replace, Mirror:
 		return true
 	}
 
@@ -38,6 +42,35 @@ func IsValidCollisionPolicy(cp CollisionPolicy) bool {
 
 const RootLocation = "/"
 
+// AttendeePolicy describes how restored calendar events handle the
+// attendees, including rooms and other resources, of the backed up event.
+type AttendeePolicy string
+
+const (
+	// AttendeesInBody lists the attendees as plain text at the start of
+	// the event body, without inviting anyone.
+	AttendeesInBody AttendeePolicy = "body"
+	// AttendeesStrip drops the attendees from the event.
+	AttendeesStrip AttendeePolicy = "strip"
+	// AttendeesRemap invites the attendees found in the attendee map at
+	// their mapped address.  Attendees missing from the map get listed in
+	// the event body instead.
+	AttendeesRemap AttendeePolicy = "remap"
+)
+
+func IsValidAttendeePolicy(ap AttendeePolicy) bool {
+	switch ap {
+	case AttendeesInBody, AttendeesStrip, AttendeesRemap:
+		return true
+	}
+
+	return false
+}
+
+// AllItemVersions restores or exports the full version history of
+// each drive file, oldest first, ahead of its current content.
+const AllItemVersions = "all"
+
 // RestoreConfig contains
 type RestoreConfig struct {
 	// Defines the per-item collision handling policy.
@@ -64,6 +97,67 @@ type RestoreConfig struct {
 	// IncludePermissions toggles whether the restore will include the original
 	// folder- and item-level permissions.
 	IncludePermissions bool `json:"includePermissions"`
+
+	// DryRun produces a plan of how each item would be restored, without
+	// writing any data.
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// PrincipalMap maps the ID or UPN of users and groups in the backup to
+	// the ID or UPN of the users and groups that get their permissions and
+	// link shares on restore.  Only used when restoring permissions.
+	// If empty, permissions are restored to the original principals.
+	PrincipalMap map[string]string `json:"principalMap,omitempty"`
+
+	// MirrorQuarantine names a folder, at the root of the drive, into which
+	// a Mirror restore moves the items that weren't in the backup.  If empty,
+	// those items are deleted instead.
+	MirrorQuarantine string `json:"mirrorQuarantine,omitempty"`
+
+	// TargetService restores drive data into a protected resource of a
+	// different service than the one that was backed up, such as a user's
+	// OneDrive into a site's document library.  ProtectedResource must
+	// identify a resource of the target service.
+	// If unknown, restores to the same service that was backed up.
+	TargetService path.ServiceType `json:"targetService,omitempty"`
+
+	// TargetTenantID identifies the tenant receiving the restore, when it
+	// differs from the tenant that was backed up.  Resource IDs don't carry
+	// over between tenants, so the protected resource gets resolved by name
+	// within the target tenant.
+	// If empty, restores to the same tenant that was backed up.
+	TargetTenantID string `json:"targetTenantID,omitempty"`
+
+	// ItemVersion picks which version of each drive file gets restored.
+	// Accepts a version ID, or AllItemVersions to recreate the version
+	// history that was backed up.
+	// If empty, restores the current content of each file.
+	ItemVersion string `json:"itemVersion,omitempty"`
+
+	// Attendees controls how restored calendar events handle the attendees
+	// and rooms of the backed up event.
+	// If empty, defaults to AttendeesInBody.
+	Attendees AttendeePolicy `json:"attendees,omitempty"`
+
+	// AttendeeMap maps the email addresses of attendees and rooms in the
+	// backup to the addresses used by the restored event.  Only used with
+	// AttendeesRemap.
+	AttendeeMap map[string]string `json:"attendeeMap,omitempty"`
+
+	// SuppressInvites keeps restored events from sending meeting invitations.
+	// Remapped attendees get listed in the event body instead of invited.
+	SuppressInvites bool `json:"suppressInvites,omitempty"`
+}
+
+// IsCrossTenant is true when the config restores data backed up
+// from the tenant into a different tenant.
+func (rc RestoreConfig) IsCrossTenant(backupTenantID string) bool {
+	return len(rc.TargetTenantID) > 0 && !strings.EqualFold(rc.TargetTenantID, backupTenantID)
+}
+
+// IsCrossService is true when the config restores data backed up
+// from the service into a different service.
+func (rc RestoreConfig) IsCrossService(backupService path.ServiceType) bool {
+	return rc.TargetService != path.UnknownService && rc.TargetService != backupService
 }
 
 func DefaultRestoreConfig(timeFormat dttm.TimeFormat) RestoreConfig {
@@ -93,6 +187,16 @@ func EnsureRestoreConfigDefaults(
 		rc.OnCollision = Skip
 	}
 
+	if len(rc.Attendees) > 0 && !IsValidAttendeePolicy(rc.Attendees) {
+		logger.Ctx(ctx).
+			With(
+				"bad_attendee_policy", rc.Attendees,
+				"default_attendee_policy", AttendeesInBody).
+			Info("setting attendee policy to default")
+
+		rc.Attendees = AttendeesInBody
+	}
+
 	rc.Location = strings.TrimPrefix(strings.TrimSpace(rc.Location), "/")
 
 	return rc
@@ -124,7 +228,30 @@ func (rc RestoreConfig) concealed() RestoreConfig {
 		Location:           path.LoggableDir(rc.Location),
 		Drive:              clues.Conceal(rc.Drive),
 		IncludePermissions: rc.IncludePermissions,
+		DryRun:             rc.DryRun,
+		PrincipalMap:       concealPrincipalMap(rc.PrincipalMap),
+		MirrorQuarantine:   path.LoggableDir(rc.MirrorQuarantine),
+		TargetService:      rc.TargetService,
+		TargetTenantID:     clues.Conceal(rc.TargetTenantID),
+		ItemVersion:        rc.ItemVersion,
+		Attendees:          rc.Attendees,
+		AttendeeMap:        concealPrincipalMap(rc.AttendeeMap),
+		SuppressInvites:    rc.SuppressInvites,
+	}
+}
+
+func concealPrincipalMap(pm map[string]string) map[string]string {
+	if len(pm) == 0 {
+		return nil
 	}
+
+	concealed := make(map[string]string, len(pm))
+
+	for src, tgt := range pm {
+		concealed[clues.Conceal(src)] = clues.Conceal(tgt)
+	}
+
+	return concealed
 }
 
 // Conceal produces a concealed representation of the config, suitable for
@@ -89,6 +89,17 @@ func (suite *RestoreUnitSuite) TestEnsureRestoreConfigDefaults() {
 				Drive:             "",
 			},
 		},
+		{
+			name: "invalid attendee policy",
+			input: control.RestoreConfig{
+				OnCollision: control.Copy,
+				Attendees:   control.AttendeePolicy("batman"),
+			},
+			expect: control.RestoreConfig{
+				OnCollision: control.Copy,
+				Attendees:   control.AttendeesInBody,
+			},
+		},
 	}
 	for _, test := range table {
 		suite.Run(test.name, func() {
@@ -141,6 +152,30 @@ func (suite *RestoreUnitSuite) TestRestoreConfig_piiHandling() {
 			expectPlain: `{"onCollision":"copy","protectedResource":"snoob","location":"tid/exchange/ro/email/foo/bar/baz",` +
 				`"drive":"somedriveid","includePermissions":true}`,
 		},
+		{
+			name: "principal map",
+			rc: control.RestoreConfig{
+				IncludePermissions: true,
+				PrincipalMap:       map[string]string{"src@example.com": "tgt@example.com"},
+			},
+			expectSafe: `{"onCollision":"","protectedResource":"","location":"","drive":"",` +
+				`"includePermissions":true,"principalMap":{"***":"***"}}`,
+			expectPlain: `{"onCollision":"","protectedResource":"","location":"","drive":"",` +
+				`"includePermissions":true,"principalMap":{"src@example.com":"tgt@example.com"}}`,
+		},
+		{
+			name: "attendee map",
+			rc: control.RestoreConfig{
+				Attendees:       control.AttendeesRemap,
+				AttendeeMap:     map[string]string{"src@example.com": "tgt@example.com"},
+				SuppressInvites: true,
+			},
+			expectSafe: `{"onCollision":"","protectedResource":"","location":"","drive":"",` +
+				`"includePermissions":false,"attendees":"remap","attendeeMap":{"***":"***"},"suppressInvites":true}`,
+			expectPlain: `{"onCollision":"","protectedResource":"","location":"","drive":"",` +
+				`"includePermissions":false,"attendees":"remap","attendeeMap":{"src@example.com":"tgt@example.com"},` +
+				`"suppressInvites":true}`,
+		},
 	}
 	for _, test := range table {
 		suite.Run(test.name, func() {
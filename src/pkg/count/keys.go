@@ -66,6 +66,7 @@ const (
 	PreviousPathMetadataCollision Key = "previous-path-metadata-collision"
 	Sites                         Key = "sites"
 	Lists                         Key = "lists"
+	ListsUnchanged                Key = "lists-unchanged"
 	SkippedContainers             Key = "skipped-containers"
 	SkippedItems                  Key = "skipped-items"
 	StreamBytesAdded              Key = "stream-bytes-added"
@@ -129,4 +130,14 @@ const (
 	// non-meta item creation counting.  IE: use it specifically
 	// for counting new items (no collision) or copied items.
 	NewItemCreated Key = "new-item-created"
+	// count of items removed from the restore target by a mirror
+	// restore, because they weren't in the backup.
+	MirrorDeleted     Key = "mirror-deleted"
+	MirrorQuarantined Key = "mirror-quarantined"
+	// count of attachments nested within an attached item that were
+	// dropped, because the attached item was too large to restore with them.
+	NestedAttachmentsDropped Key = "nested-attachments-dropped"
+	// count of group post attachments that were dropped during restore,
+	// because they couldn't be sent along with the post.
+	PostAttachmentsDropped Key = "post-attachments-dropped"
 )
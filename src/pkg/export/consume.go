@@ -2,27 +2,33 @@ package export
 
 import (
 	"context"
-	"io"
-	"os"
-	"path/filepath"
+	"crypto/sha256"
+	"encoding/hex"
+	"path"
 
 	"github.com/alcionai/clues"
 
 	"github.com/alcionai/corso/src/internal/observe"
 	"github.com/alcionai/corso/src/pkg/fault"
 	"github.com/alcionai/corso/src/pkg/logger"
+	"github.com/alcionai/corso/src/pkg/metrics"
 )
 
+// ConsumeExportCollections writes the items of each collection to the
+// sink.  When a journal is provided, items it records as complete are
+// skipped, and newly written items are added to it.
 func ConsumeExportCollections(
 	ctx context.Context,
-	exportLocation string,
+	sink Sink,
+	journal *Journal,
 	expColl []Collectioner,
+	stats *metrics.ExportStats,
 	errs *fault.Bus,
 ) error {
 	el := errs.Local()
 	counted := 0
 	log := logger.Ctx(ctx).
-		With("export_location", exportLocation,
+		With("export_location", sink.Location(),
 			"collection_count", len(expColl))
 
 	for _, col := range expColl {
@@ -30,7 +36,7 @@ func ConsumeExportCollections(
 			break
 		}
 
-		folder := filepath.Join(exportLocation, col.BasePath())
+		folder := col.BasePath()
 		ictx := clues.Add(ctx, "dir_name", folder)
 
 		for item := range col.Items(ictx) {
@@ -46,11 +52,24 @@ func ConsumeExportCollections(
 				continue
 			}
 
-			if err := writeItem(ictx, item, folder); err != nil {
+			relPath := path.Join(folder, item.Name)
+
+			if entry, ok := journal.Completed(relPath, item.ID); ok {
+				skipItem(item, entry)
+				stats.UpdateItemSkipped()
+
+				continue
+			}
+
+			if err := writeItem(ictx, sink, journal, item, relPath); err != nil {
 				el.AddRecoverable(
 					ictx,
 					clues.Wrap(err, "writing item").With("file_name", item.Name))
+
+				continue
 			}
+
+			stats.UpdateItemWritten()
 		}
 	}
 
@@ -59,36 +78,62 @@ func ConsumeExportCollections(
 	return el.Failure()
 }
 
-// writeItem writes an ExportItem to disk in the specified folder.
-func writeItem(ctx context.Context, item Item, folder string) error {
-	name := item.Name
-	fpath := filepath.Join(folder, name)
+// writeItem writes an ExportItem to the sink at the specified path.
+func writeItem(
+	ctx context.Context,
+	sink Sink,
+	journal *Journal,
+	item Item,
+	relPath string,
+) error {
+	var (
+		body   = item.Body
+		hasher *hashingReader
+	)
+
+	defer item.Body.Close()
+
+	if journal != nil {
+		hasher = &hashingReader{
+			ReadCloser: body,
+			hash:       sha256.New(),
+			done:       func(int64, string) {},
+		}
+		body = hasher
+	}
 
 	progReader := observe.ItemSpinner(
 		ctx,
-		item.Body,
+		body,
 		observe.ItemExportMsg,
-		clues.Hide(name))
+		clues.Hide(item.Name))
 
-	defer item.Body.Close()
 	defer progReader.Close()
 
-	err := os.MkdirAll(folder, os.ModePerm)
-	if err != nil {
-		return clues.WrapWC(ctx, err, "creating directory")
+	if err := sink.Write(ctx, relPath, progReader); err != nil {
+		return err
 	}
 
-	// In case the user tries to restore to a non-clean
-	// directory, we might run into collisions an fail.
-	f, err := os.Create(fpath)
-	if err != nil {
-		return clues.WrapWC(ctx, err, "creating file")
+	if hasher == nil {
+		return nil
 	}
 
-	_, err = io.Copy(f, progReader)
-	if err != nil {
-		return clues.WrapWC(ctx, err, "writing data")
+	err := journal.Record(ctx, JournalEntry{
+		Path:   relPath,
+		ID:     item.ID,
+		Size:   hasher.size,
+		SHA256: hex.EncodeToString(hasher.hash.Sum(nil)),
+	})
+
+	return clues.Stack(err).OrNil()
+}
+
+// skipItem releases an item that a previous export already wrote.
+func skipItem(item Item, entry JournalEntry) {
+	// let the manifest, if any, know about the previously written file.
+	if hr, ok := item.Body.(*hashingReader); ok {
+		hr.skipped(entry.Size, entry.SHA256)
 	}
 
-	return nil
+	item.Body.Close()
 }
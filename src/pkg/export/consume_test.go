@@ -14,6 +14,7 @@ import (
 
 	"github.com/alcionai/corso/src/internal/tester"
 	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/metrics"
 )
 
 type ExportE2ESuite struct {
@@ -182,7 +183,13 @@ func (suite *ExportE2ESuite) TestConsumeExportCollection() {
 			require.NoError(t, err)
 			defer os.RemoveAll(dir)
 
-			err = ConsumeExportCollections(ctx, dir, ecs, fault.New(true))
+			err = ConsumeExportCollections(
+				ctx,
+				NewLocalSink(dir),
+				nil,
+				ecs,
+				metrics.NewExportStats(),
+				fault.New(true))
 			if test.hasError {
 				require.Error(t, err)
 				return
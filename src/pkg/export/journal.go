@@ -0,0 +1,146 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/alcionai/clues"
+)
+
+// JournalFileName is the name of the journal kept in the root of local
+// export directories.
+const JournalFileName = ".corso_export_journal.jsonl"
+
+// JournalEntry records an item which was completely written by an
+// export.
+type JournalEntry struct {
+	// Path is the location of the file relative to the export root.
+	Path   string `json:"path"`
+	ID     string `json:"id"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Journal tracks the items written to a local export directory so that
+// an interrupted export can be resumed without re-fetching them.
+type Journal struct {
+	dir       string
+	f         *os.File
+	completed map[string]JournalEntry
+}
+
+// OpenJournal opens the journal in the export directory.  When resuming,
+// entries from the previous export are loaded.  Otherwise any existing
+// journal is discarded.
+func OpenJournal(ctx context.Context, dir string, resume bool) (*Journal, error) {
+	var (
+		fpath = filepath.Join(dir, JournalFileName)
+		j     = &Journal{
+			dir:       dir,
+			completed: map[string]JournalEntry{},
+		}
+	)
+
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, clues.WrapWC(ctx, err, "creating export directory")
+	}
+
+	flag := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+
+	if resume {
+		flag = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+
+		if err := j.load(ctx, fpath); err != nil {
+			return nil, err
+		}
+	}
+
+	f, err := os.OpenFile(fpath, flag, 0o600)
+	if err != nil {
+		return nil, clues.WrapWC(ctx, err, "opening export journal")
+	}
+
+	j.f = f
+
+	return j, nil
+}
+
+func (j *Journal) load(ctx context.Context, fpath string) error {
+	f, err := os.Open(fpath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return clues.WrapWC(ctx, err, "opening export journal")
+	}
+
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		entry := JournalEntry{}
+
+		// the last entry may have been cut short if the previous
+		// export was interrupted.  Its item gets written again.
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+
+		j.completed[entry.Path] = entry
+	}
+
+	return clues.WrapWC(ctx, scanner.Err(), "reading export journal").OrNil()
+}
+
+// Completed returns the journal entry for the item if a previous export
+// completely wrote it, and the file is unchanged since.  Items without
+// an ID are never considered complete.
+func (j *Journal) Completed(relPath, id string) (JournalEntry, bool) {
+	if j == nil || len(id) == 0 {
+		return JournalEntry{}, false
+	}
+
+	entry, ok := j.completed[relPath]
+	if !ok || entry.ID != id {
+		return JournalEntry{}, false
+	}
+
+	size, sum, err := hashFile(filepath.Join(j.dir, filepath.FromSlash(relPath)))
+	if err != nil || size != entry.Size || sum != entry.SHA256 {
+		return JournalEntry{}, false
+	}
+
+	return entry, true
+}
+
+// Record adds the completely written item to the journal.
+func (j *Journal) Record(ctx context.Context, entry JournalEntry) error {
+	if j == nil || len(entry.ID) == 0 {
+		return nil
+	}
+
+	bs, err := json.Marshal(entry)
+	if err != nil {
+		return clues.WrapWC(ctx, err, "serializing journal entry")
+	}
+
+	if _, err := j.f.Write(append(bs, '\n')); err != nil {
+		return clues.WrapWC(ctx, err, "writing journal entry")
+	}
+
+	return nil
+}
+
+func (j *Journal) Close() error {
+	if j == nil || j.f == nil {
+		return nil
+	}
+
+	return clues.Stack(j.f.Close()).OrNil()
+}
@@ -0,0 +1,204 @@
+package export
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alcionai/clues"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/metrics"
+)
+
+type JournalUnitSuite struct {
+	tester.Suite
+}
+
+func TestJournalUnitSuite(t *testing.T) {
+	suite.Run(t, &JournalUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+// trackedBody records whether the item body was read.
+type trackedBody struct {
+	io.Reader
+	read bool
+}
+
+func (tb *trackedBody) Read(p []byte) (int, error) {
+	tb.read = true
+	return tb.Reader.Read(p)
+}
+
+func (tb *trackedBody) Close() error { return nil }
+
+func journalTestCollections() ([]Collectioner, map[string]*trackedBody) {
+	bodies := map[string]*trackedBody{
+		"id1": {Reader: bytes.NewBufferString("body1")},
+		"id2": {Reader: bytes.NewBufferString("body2")},
+	}
+
+	colls := []Collectioner{
+		mockExportCollection{
+			path:  "",
+			items: []Item{{ID: "id1", Name: "name1", Body: bodies["id1"]}},
+		},
+		mockExportCollection{
+			path:  "folder",
+			items: []Item{{ID: "id2", Name: "name2", Body: bodies["id2"]}},
+		},
+	}
+
+	return colls, bodies
+}
+
+func (suite *JournalUnitSuite) TestResume() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	dir := t.TempDir()
+
+	// initial export
+	journal, err := OpenJournal(ctx, dir, false)
+	require.NoError(t, err, clues.ToCore(err))
+
+	colls, _ := journalTestCollections()
+	stats := metrics.NewExportStats()
+
+	err = ConsumeExportCollections(ctx, NewLocalSink(dir), journal, colls, stats, fault.New(true))
+	require.NoError(t, err, clues.ToCore(err))
+	require.NoError(t, journal.Close())
+
+	assert.Equal(t, metrics.ItemCounts{Written: 2}, stats.GetItemCounts())
+
+	// simulate an interruption while writing the second item, after
+	// it was journaled.
+	err = os.WriteFile(filepath.Join(dir, "folder", "name2"), []byte("bo"), 0o600)
+	require.NoError(t, err, clues.ToCore(err))
+
+	f, err := os.OpenFile(filepath.Join(dir, JournalFileName), os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err, clues.ToCore(err))
+
+	_, err = f.WriteString(`{"path":"na`)
+	require.NoError(t, err, clues.ToCore(err))
+	require.NoError(t, f.Close())
+
+	// resumed export
+	journal, err = OpenJournal(ctx, dir, true)
+	require.NoError(t, err, clues.ToCore(err))
+
+	defer journal.Close()
+
+	colls, bodies := journalTestCollections()
+	stats = metrics.NewExportStats()
+	mr := NewManifestRecorder("bid", ManifestFileName, nil)
+
+	err = ConsumeExportCollections(ctx, NewLocalSink(dir), journal, mr.Wrap(colls), stats, fault.New(true))
+	require.NoError(t, err, clues.ToCore(err))
+
+	assert.Equal(t, metrics.ItemCounts{Written: 3, Skipped: 1}, stats.GetItemCounts(), "includes manifest files")
+	assert.False(t, bodies["id1"].read, "complete item is not fetched")
+	assert.True(t, bodies["id2"].read, "partial item is fetched")
+
+	content, err := os.ReadFile(filepath.Join(dir, "folder", "name2"))
+	require.NoError(t, err, clues.ToCore(err))
+	assert.Equal(t, "body2", string(content))
+
+	// skipped items are still included in the manifest
+	results, err := VerifyManifest(ctx, dir)
+	require.NoError(t, err, clues.ToCore(err))
+	assert.Equal(
+		t,
+		VerifyResults{
+			Manifests: []string{ManifestFileName},
+			Verified:  2,
+		},
+		results)
+}
+
+func (suite *JournalUnitSuite) TestOpenJournal_noResume() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	dir := t.TempDir()
+
+	journal, err := OpenJournal(ctx, dir, false)
+	require.NoError(t, err, clues.ToCore(err))
+
+	colls, _ := journalTestCollections()
+
+	err = ConsumeExportCollections(ctx, NewLocalSink(dir), journal, colls, metrics.NewExportStats(), fault.New(true))
+	require.NoError(t, err, clues.ToCore(err))
+	require.NoError(t, journal.Close())
+
+	// without resuming, the previous journal is discarded and
+	// everything is written again.
+	journal, err = OpenJournal(ctx, dir, false)
+	require.NoError(t, err, clues.ToCore(err))
+
+	defer journal.Close()
+
+	_, ok := journal.Completed("name1", "id1")
+	assert.False(t, ok, "completed")
+
+	colls, bodies := journalTestCollections()
+	stats := metrics.NewExportStats()
+
+	err = ConsumeExportCollections(ctx, NewLocalSink(dir), journal, colls, stats, fault.New(true))
+	require.NoError(t, err, clues.ToCore(err))
+
+	assert.Equal(t, metrics.ItemCounts{Written: 2}, stats.GetItemCounts())
+	assert.True(t, bodies["id1"].read, "item is fetched")
+}
+
+func (suite *JournalUnitSuite) TestCompleted() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	dir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(dir, "name"), []byte("body"), 0o600)
+	require.NoError(t, err, clues.ToCore(err))
+
+	journal, err := OpenJournal(ctx, dir, false)
+	require.NoError(t, err, clues.ToCore(err))
+
+	err = journal.Record(ctx, JournalEntry{Path: "name", ID: "id", Size: 4, SHA256: sha("body")})
+	require.NoError(t, err, clues.ToCore(err))
+	require.NoError(t, journal.Close())
+
+	journal, err = OpenJournal(ctx, dir, true)
+	require.NoError(t, err, clues.ToCore(err))
+
+	defer journal.Close()
+
+	_, ok := journal.Completed("name", "id")
+	assert.True(t, ok, "matching item")
+
+	_, ok = journal.Completed("name", "other")
+	assert.False(t, ok, "different item id")
+
+	_, ok = journal.Completed("name", "")
+	assert.False(t, ok, "missing item id")
+
+	_, ok = journal.Completed("missing", "id")
+	assert.False(t, ok, "unknown path")
+
+	err = os.WriteFile(filepath.Join(dir, "name"), []byte("bodz"), 0o600)
+	require.NoError(t, err, clues.ToCore(err))
+
+	_, ok = journal.Completed("name", "id")
+	assert.False(t, ok, "modified file")
+}
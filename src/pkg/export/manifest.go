@@ -0,0 +1,450 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"hash"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alcionai/clues"
+)
+
+const (
+	// ManifestFileName is the name of the chain-of-custody manifest
+	// written at the root of every export.
+	ManifestFileName = "corso_export_manifest.json"
+	// ManifestChecksumFileName holds the SHA-256 of the manifest, in the
+	// format produced by sha256sum.
+	ManifestChecksumFileName = ManifestFileName + ChecksumFileSuffix
+	// ChecksumFileSuffix is appended to a manifest's file name to name
+	// the file holding its checksum.
+	ChecksumFileSuffix = ".sha256"
+
+	manifestFilePrefix = "corso_export_manifest_"
+	manifestFileSuffix = ".json"
+)
+
+// ManifestFileNameFor is the name of the manifest of a single backup,
+// used when one export writes the data of several backups into the
+// same location.
+func ManifestFileNameFor(backupID string) string {
+	return manifestFilePrefix + backupID + manifestFileSuffix
+}
+
+func isManifestFileName(name string) bool {
+	return name == ManifestFileName ||
+		(strings.HasPrefix(name, manifestFilePrefix) && strings.HasSuffix(name, manifestFileSuffix))
+}
+
+// ItemSourceKey identifies the source of an export item by the short
+// ref of the restore collection the item was read from, along with the
+// item's storage name.  Items exported from a whole collection, such as
+// mbox files, are keyed with an empty item ID.
+func ItemSourceKey(collectionShortRef, itemID string) string {
+	return collectionShortRef + "/" + itemID
+}
+
+// ItemSource describes where an exported item came from in the backup.
+type ItemSource struct {
+	RepoRef     string
+	LocationRef string
+	ItemRef     string
+}
+
+// ManifestEntry records the provenance and content hash of a single
+// exported file.
+type ManifestEntry struct {
+	// Path is the location of the file relative to the export root.
+	Path        string `json:"path"`
+	BackupID    string `json:"backupID"`
+	ItemID      string `json:"itemID"`
+	RepoRef     string `json:"repoRef,omitempty"`
+	LocationRef string `json:"locationRef,omitempty"`
+	Size        int64  `json:"size"`
+	SHA256      string `json:"sha256"`
+}
+
+// Manifest maps each file written by an export to its source in the
+// backup, along with the size and SHA-256 of the bytes written.
+type Manifest struct {
+	BackupID  string          `json:"backupID"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Entries   []ManifestEntry `json:"entries"`
+}
+
+// ---------------------------------------------------------------------------
+// recording
+// ---------------------------------------------------------------------------
+
+// ManifestRecorder hashes the items of export collections as they are
+// consumed and produces the manifest describing them.
+type ManifestRecorder struct {
+	backupID string
+	fileName string
+	sources  map[string]ItemSource
+
+	mu      sync.Mutex
+	entries []ManifestEntry
+}
+
+// NewManifestRecorder creates a recorder for an export of the backup.
+// The manifest gets written to fileName at the root of the export.
+// Sources are keyed by ItemSourceKey.
+func NewManifestRecorder(
+	backupID, fileName string,
+	sources map[string]ItemSource,
+) *ManifestRecorder {
+	return &ManifestRecorder{
+		backupID: backupID,
+		fileName: fileName,
+		sources:  sources,
+	}
+}
+
+// Wrap returns the collections with every item body hashed as it is
+// read, followed by a collection holding the manifest and its checksum.
+// Collections must be consumed in order, with each item body read to
+// completion, for the manifest to include every file.
+func (mr *ManifestRecorder) Wrap(colls []Collectioner) []Collectioner {
+	wrapped := make([]Collectioner, 0, len(colls)+1)
+
+	for _, c := range colls {
+		wrapped = append(wrapped, hashingCollection{
+			Collectioner: c,
+			mr:           mr,
+		})
+	}
+
+	return append(wrapped, manifestCollection{mr: mr})
+}
+
+// Manifest returns the manifest for all items read so far.
+func (mr *ManifestRecorder) Manifest() Manifest {
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+
+	entries := make([]ManifestEntry, len(mr.entries))
+	copy(entries, mr.entries)
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Path < entries[j].Path
+	})
+
+	return Manifest{
+		BackupID:  mr.backupID,
+		CreatedAt: time.Now().UTC(),
+		Entries:   entries,
+	}
+}
+
+// source looks up the source of an item produced by the collection.
+// Only collections built on restore collections can be traced back to
+// the backup.  Items are matched within each of the backing collections,
+// and an item which carries the collection's short ref as its ID is
+// matched to the collection as a whole.  Returns the zero value if the
+// source is unknown.
+func (mr *ManifestRecorder) source(c Collectioner, itemID string) ItemSource {
+	bc, ok := c.(BaseCollection)
+	if !ok {
+		return ItemSource{}
+	}
+
+	for _, rc := range bc.BackingCollection {
+		ref := rc.FullPath().ShortRef()
+
+		if src, ok := mr.sources[ItemSourceKey(ref, itemID)]; ok {
+			return src
+		}
+
+		if itemID == ref {
+			return mr.sources[ItemSourceKey(ref, "")]
+		}
+	}
+
+	return ItemSource{}
+}
+
+func (mr *ManifestRecorder) record(
+	itemID, relPath string,
+	src ItemSource,
+	size int64,
+	sum string,
+) {
+	entry := ManifestEntry{
+		Path:     relPath,
+		BackupID: mr.backupID,
+		ItemID:   itemID,
+		Size:     size,
+		SHA256:   sum,
+	}
+
+	if len(src.RepoRef) > 0 {
+		entry.RepoRef = src.RepoRef
+		entry.LocationRef = src.LocationRef
+
+		if len(src.ItemRef) > 0 {
+			entry.ItemID = src.ItemRef
+		}
+	}
+
+	mr.mu.Lock()
+	defer mr.mu.Unlock()
+
+	mr.entries = append(mr.entries, entry)
+}
+
+type hashingCollection struct {
+	Collectioner
+	mr *ManifestRecorder
+}
+
+func (hc hashingCollection) Items(ctx context.Context) <-chan Item {
+	ch := make(chan Item)
+
+	go func() {
+		defer close(ch)
+
+		for item := range hc.Collectioner.Items(ctx) {
+			if item.Error == nil && item.Body != nil {
+				var (
+					id      = item.ID
+					relPath = path.Join(hc.BasePath(), item.Name)
+					src     = hc.mr.source(hc.Collectioner, id)
+				)
+
+				item.Body = &hashingReader{
+					ReadCloser: item.Body,
+					hash:       sha256.New(),
+					done: func(size int64, sum string) {
+						hc.mr.record(id, relPath, src, size, sum)
+					},
+				}
+			}
+
+			ch <- item
+		}
+	}()
+
+	return ch
+}
+
+// hashingReader hashes the body as it is read, and reports the result
+// once the body has been read to completion.
+type hashingReader struct {
+	io.ReadCloser
+	hash     hash.Hash
+	size     int64
+	done     func(size int64, sum string)
+	reported bool
+}
+
+func (hr *hashingReader) Read(p []byte) (int, error) {
+	n, err := hr.ReadCloser.Read(p)
+
+	hr.hash.Write(p[:n])
+	hr.size += int64(n)
+
+	if errors.Is(err, io.EOF) && !hr.reported {
+		hr.reported = true
+		hr.done(hr.size, hex.EncodeToString(hr.hash.Sum(nil)))
+	}
+
+	return n, err
+}
+
+// skipped reports an item that was not read because a previous export
+// already wrote it, using the size and hash from that export.
+func (hr *hashingReader) skipped(size int64, sum string) {
+	if !hr.reported {
+		hr.reported = true
+		hr.done(size, sum)
+	}
+}
+
+type manifestCollection struct {
+	mr *ManifestRecorder
+}
+
+func (mc manifestCollection) BasePath() string {
+	return ""
+}
+
+func (mc manifestCollection) Items(ctx context.Context) <-chan Item {
+	ch := make(chan Item, 2)
+
+	defer close(ch)
+
+	name := mc.mr.fileName
+
+	bs, err := json.MarshalIndent(mc.mr.Manifest(), "", "  ")
+	if err != nil {
+		ch <- Item{
+			ID:    name,
+			Error: clues.WrapWC(ctx, err, "serializing export manifest"),
+		}
+
+		return ch
+	}
+
+	// the manifest items are left without an ID, as they don't
+	// originate from the backup and must always be rewritten.
+	ch <- Item{
+		Name: name,
+		Body: io.NopCloser(bytes.NewReader(bs)),
+	}
+
+	ch <- Item{
+		Name: name + ChecksumFileSuffix,
+		Body: io.NopCloser(strings.NewReader(checksumLine(bs, name))),
+	}
+
+	return ch
+}
+
+func checksumLine(manifest []byte, name string) string {
+	sum := sha256.Sum256(manifest)
+	return hex.EncodeToString(sum[:]) + "  " + name + "\n"
+}
+
+// ---------------------------------------------------------------------------
+// verification
+// ---------------------------------------------------------------------------
+
+// VerifyResults describes the outcome of checking an export against
+// its manifests.
+type VerifyResults struct {
+	// Manifests holds the names of the manifests which were checked.
+	Manifests []string
+	// Verified is the count of files which matched the manifest.
+	Verified int
+	// Missing holds the paths of files in the manifest which do not exist.
+	Missing []string
+	// Mismatched holds the paths of files whose size or hash differs
+	// from the manifest.
+	Mismatched []string
+	// ModifiedManifests holds the names of the manifests which do not
+	// match their checksum file.
+	ModifiedManifests []string
+	// UnsignedManifests holds the names of the manifests whose checksum
+	// file is missing.
+	UnsignedManifests []string
+}
+
+// OK is true when every file matches the manifest and the manifest
+// matches its checksum.
+func (vr VerifyResults) OK() bool {
+	return len(vr.Missing) == 0 &&
+		len(vr.Mismatched) == 0 &&
+		len(vr.ModifiedManifests) == 0 &&
+		len(vr.UnsignedManifests) == 0
+}
+
+// VerifyManifest re-hashes the files in a local export directory and
+// compares them against the manifests written by the export.  Exports
+// of several backups hold one manifest per backup, all of which are
+// checked.
+func VerifyManifest(ctx context.Context, dir string) (VerifyResults, error) {
+	results := VerifyResults{}
+
+	des, err := os.ReadDir(dir)
+	if err != nil {
+		return results, clues.WrapWC(ctx, err, "reading export directory")
+	}
+
+	for _, de := range des {
+		if de.IsDir() || !isManifestFileName(de.Name()) {
+			continue
+		}
+
+		if err := verifyManifestFile(ctx, dir, de.Name(), &results); err != nil {
+			return results, clues.Stack(err)
+		}
+	}
+
+	if len(results.Manifests) == 0 {
+		return results, clues.NewWC(ctx, "no export manifest found")
+	}
+
+	return results, nil
+}
+
+// verifyManifestFile checks the files listed in a single manifest,
+// adding the outcome to the results.
+func verifyManifestFile(
+	ctx context.Context,
+	dir, name string,
+	results *VerifyResults,
+) error {
+	ctx = clues.Add(ctx, "manifest_file_name", name)
+
+	bs, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return clues.WrapWC(ctx, err, "reading export manifest")
+	}
+
+	results.Manifests = append(results.Manifests, name)
+
+	checksum, err := os.ReadFile(filepath.Join(dir, name+ChecksumFileSuffix))
+
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		results.UnsignedManifests = append(results.UnsignedManifests, name)
+	case err != nil:
+		return clues.WrapWC(ctx, err, "reading export manifest checksum")
+	case string(checksum) != checksumLine(bs, name):
+		results.ModifiedManifests = append(results.ModifiedManifests, name)
+	}
+
+	manifest := Manifest{}
+
+	if err := json.Unmarshal(bs, &manifest); err != nil {
+		return clues.WrapWC(ctx, err, "deserializing export manifest")
+	}
+
+	for _, entry := range manifest.Entries {
+		size, sum, err := hashFile(filepath.Join(dir, filepath.FromSlash(entry.Path)))
+
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			results.Missing = append(results.Missing, entry.Path)
+		case err != nil:
+			return clues.WrapWC(ctx, err, "hashing exported file").
+				With("file_path", clues.Hide(entry.Path))
+		case size != entry.Size || sum != entry.SHA256:
+			results.Mismatched = append(results.Mismatched, entry.Path)
+		default:
+			results.Verified++
+		}
+	}
+
+	return nil
+}
+
+func hashFile(fpath string) (int64, string, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return 0, "", err
+	}
+
+	defer f.Close()
+
+	h := sha256.New()
+
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return size, hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,392 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alcionai/clues"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/data"
+	dataMock "github.com/alcionai/corso/src/internal/data/mock"
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/metrics"
+	"github.com/alcionai/corso/src/pkg/path"
+)
+
+type ManifestUnitSuite struct {
+	tester.Suite
+}
+
+func TestManifestUnitSuite(t *testing.T) {
+	suite.Run(t, &ManifestUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func sha(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func manifestTestCollections() []Collectioner {
+	return []Collectioner{
+		mockExportCollection{
+			path: "",
+			items: []Item{
+				{
+					ID:   "id1.data",
+					Name: "name1",
+					Body: io.NopCloser(bytes.NewBufferString("body1")),
+				},
+				{
+					ID:    "id2.data",
+					Error: assert.AnError,
+				},
+			},
+		},
+		mockExportCollection{
+			path: "folder",
+			items: []Item{
+				{
+					ID:   "id3",
+					Name: "name3",
+					Body: io.NopCloser(bytes.NewBufferString("body3")),
+				},
+			},
+		},
+	}
+}
+
+func (suite *ManifestUnitSuite) TestManifestRecorder() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	mr := NewManifestRecorder("bid", ManifestFileName, nil)
+
+	colls := mr.Wrap(manifestTestCollections())
+	require.Len(t, colls, 3)
+
+	dir := t.TempDir()
+
+	errs := fault.New(false)
+
+	err := ConsumeExportCollections(
+		ctx,
+		NewLocalSink(dir),
+		nil,
+		colls,
+		metrics.NewExportStats(),
+		errs)
+	require.NoError(t, err, clues.ToCore(err))
+	assert.Len(t, errs.Recovered(), 1, "item error is reported")
+
+	expect := []ManifestEntry{
+		{
+			Path:     "folder/name3",
+			BackupID: "bid",
+			ItemID:   "id3",
+			Size:     5,
+			SHA256:   sha("body3"),
+		},
+		{
+			Path:     "name1",
+			BackupID: "bid",
+			ItemID:   "id1.data",
+			Size:     5,
+			SHA256:   sha("body1"),
+		},
+	}
+
+	m := mr.Manifest()
+	assert.Equal(t, "bid", m.BackupID)
+	assert.Equal(t, expect, m.Entries)
+
+	_, err = os.Stat(filepath.Join(dir, ManifestFileName))
+	assert.NoError(t, err, "manifest written", clues.ToCore(err))
+
+	_, err = os.Stat(filepath.Join(dir, ManifestChecksumFileName))
+	assert.NoError(t, err, "manifest checksum written", clues.ToCore(err))
+}
+
+func (suite *ManifestUnitSuite) TestManifestRecorder_sources() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	inbox, err := path.Build("tid", "uid", path.ExchangeService, path.EmailCategory, false, "inbox")
+	require.NoError(t, err, clues.ToCore(err))
+
+	archive, err := path.Build("tid", "uid", path.ExchangeService, path.EmailCategory, false, "archive")
+	require.NoError(t, err, clues.ToCore(err))
+
+	streamItems := func(items ...Item) ItemStreamer {
+		return func(
+			_ context.Context,
+			_ []data.RestoreCollection,
+			_ int,
+			_ control.ExportConfig,
+			ch chan<- Item,
+			_ *metrics.ExportStats,
+		) {
+			defer close(ch)
+
+			for _, item := range items {
+				ch <- item
+			}
+		}
+	}
+
+	body := func(s string) io.ReadCloser {
+		return io.NopCloser(bytes.NewBufferString(s))
+	}
+
+	// both folders hold an item with the same storage name, and the
+	// second collection is exported as a single file.
+	colls := []Collectioner{
+		BaseCollection{
+			BaseDir:           "inbox",
+			BackingCollection: []data.RestoreCollection{dataMock.Collection{Path: inbox}},
+			Stream:            streamItems(Item{ID: "id1", Name: "name1.eml", Body: body("inbox")}),
+		},
+		BaseCollection{
+			BaseDir:           "archive",
+			BackingCollection: []data.RestoreCollection{dataMock.Collection{Path: archive}},
+			Stream: streamItems(
+				Item{ID: "id1", Name: "name1.eml", Body: body("archive")},
+				Item{ID: archive.ShortRef(), Name: "archive.mbox", Body: body("mbox")}),
+		},
+	}
+
+	mr := NewManifestRecorder("bid", ManifestFileName, map[string]ItemSource{
+		ItemSourceKey(inbox.ShortRef(), "id1"): {
+			RepoRef:     "tid/exchange/uid/email/inbox-id/id1",
+			LocationRef: "Inbox",
+			ItemRef:     "inbox-item",
+		},
+		ItemSourceKey(archive.ShortRef(), "id1"): {
+			RepoRef:     "tid/exchange/uid/email/archive-id/id1",
+			LocationRef: "Archive",
+			ItemRef:     "archive-item",
+		},
+		ItemSourceKey(archive.ShortRef(), ""): {
+			RepoRef:     "tid/exchange/uid/email/archive-id",
+			LocationRef: "Archive",
+		},
+	})
+
+	errs := fault.New(false)
+
+	err = ConsumeExportCollections(
+		ctx,
+		NewLocalSink(t.TempDir()),
+		nil,
+		mr.Wrap(colls),
+		metrics.NewExportStats(),
+		errs)
+	require.NoError(t, err, clues.ToCore(err))
+
+	expect := []ManifestEntry{
+		{
+			Path:        "archive/archive.mbox",
+			BackupID:    "bid",
+			ItemID:      archive.ShortRef(),
+			RepoRef:     "tid/exchange/uid/email/archive-id",
+			LocationRef: "Archive",
+			Size:        4,
+			SHA256:      sha("mbox"),
+		},
+		{
+			Path:        "archive/name1.eml",
+			BackupID:    "bid",
+			ItemID:      "archive-item",
+			RepoRef:     "tid/exchange/uid/email/archive-id/id1",
+			LocationRef: "Archive",
+			Size:        7,
+			SHA256:      sha("archive"),
+		},
+		{
+			Path:        "inbox/name1.eml",
+			BackupID:    "bid",
+			ItemID:      "inbox-item",
+			RepoRef:     "tid/exchange/uid/email/inbox-id/id1",
+			LocationRef: "Inbox",
+			Size:        5,
+			SHA256:      sha("inbox"),
+		},
+	}
+
+	assert.Equal(t, expect, mr.Manifest().Entries)
+}
+
+func (suite *ManifestUnitSuite) TestVerifyManifest() {
+	table := []struct {
+		name      string
+		modify    func(t *testing.T, dir string)
+		expectOK  bool
+		expect    VerifyResults
+		expectErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "unmodified",
+			modify:   func(t *testing.T, dir string) {},
+			expectOK: true,
+			expect: VerifyResults{
+				Manifests: []string{ManifestFileName},
+				Verified:  2,
+			},
+			expectErr: assert.NoError,
+		},
+		{
+			name: "missing file",
+			modify: func(t *testing.T, dir string) {
+				require.NoError(t, os.Remove(filepath.Join(dir, "name1")))
+			},
+			expect: VerifyResults{
+				Manifests: []string{ManifestFileName},
+				Verified:  1,
+				Missing:   []string{"name1"},
+			},
+			expectErr: assert.NoError,
+		},
+		{
+			name: "modified file",
+			modify: func(t *testing.T, dir string) {
+				err := os.WriteFile(filepath.Join(dir, "folder", "name3"), []byte("body4"), 0o600)
+				require.NoError(t, err, clues.ToCore(err))
+			},
+			expect: VerifyResults{
+				Manifests:  []string{ManifestFileName},
+				Verified:   1,
+				Mismatched: []string{"folder/name3"},
+			},
+			expectErr: assert.NoError,
+		},
+		{
+			name: "modified manifest",
+			modify: func(t *testing.T, dir string) {
+				f, err := os.OpenFile(filepath.Join(dir, ManifestFileName), os.O_APPEND|os.O_WRONLY, 0o600)
+				require.NoError(t, err, clues.ToCore(err))
+
+				_, err = f.WriteString("\n")
+				require.NoError(t, err, clues.ToCore(err))
+				require.NoError(t, f.Close())
+			},
+			expect: VerifyResults{
+				Manifests:         []string{ManifestFileName},
+				Verified:          2,
+				ModifiedManifests: []string{ManifestFileName},
+			},
+			expectErr: assert.NoError,
+		},
+		{
+			name: "missing checksum",
+			modify: func(t *testing.T, dir string) {
+				require.NoError(t, os.Remove(filepath.Join(dir, ManifestChecksumFileName)))
+			},
+			expect: VerifyResults{
+				Manifests:         []string{ManifestFileName},
+				Verified:          2,
+				UnsignedManifests: []string{ManifestFileName},
+			},
+			expectErr: assert.NoError,
+		},
+		{
+			name: "missing manifest",
+			modify: func(t *testing.T, dir string) {
+				require.NoError(t, os.Remove(filepath.Join(dir, ManifestFileName)))
+			},
+			expectErr: assert.Error,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			dir := t.TempDir()
+			mr := NewManifestRecorder("bid", ManifestFileName, nil)
+
+			err := ConsumeExportCollections(
+				ctx,
+				NewLocalSink(dir),
+				nil,
+				mr.Wrap(manifestTestCollections()),
+				metrics.NewExportStats(),
+				fault.New(false))
+			require.NoError(t, err, clues.ToCore(err))
+
+			test.modify(t, dir)
+
+			results, err := VerifyManifest(ctx, dir)
+			test.expectErr(t, err, clues.ToCore(err))
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, test.expect, results)
+			assert.Equal(t, test.expectOK, results.OK())
+		})
+	}
+}
+
+func (suite *ManifestUnitSuite) TestVerifyManifest_perBackup() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	var (
+		dir   = t.TempDir()
+		names = []string{}
+	)
+
+	// two backups exported into the same directory keep their own manifests.
+	for _, bid := range []string{"bid1", "bid2"} {
+		name := ManifestFileNameFor(bid)
+		names = append(names, name)
+
+		mr := NewManifestRecorder(bid, name, nil)
+
+		err := ConsumeExportCollections(
+			ctx,
+			NewLocalSink(dir),
+			nil,
+			mr.Wrap(manifestTestCollections()),
+			metrics.NewExportStats(),
+			fault.New(false))
+		require.NoError(t, err, clues.ToCore(err))
+
+		_, err = os.Stat(filepath.Join(dir, name+ChecksumFileSuffix))
+		assert.NoError(t, err, "manifest checksum written", clues.ToCore(err))
+	}
+
+	_, err := os.Stat(filepath.Join(dir, ManifestFileName))
+	assert.ErrorIs(t, err, os.ErrNotExist, "no shared manifest")
+
+	results, err := VerifyManifest(ctx, dir)
+	require.NoError(t, err, clues.ToCore(err))
+
+	assert.Equal(
+		t,
+		VerifyResults{
+			Manifests: names,
+			Verified:  4,
+		},
+		results)
+	assert.True(t, results.OK())
+}
@@ -0,0 +1,164 @@
+package export
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/alcionai/clues"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"github.com/alcionai/corso/src/internal/common"
+	"github.com/alcionai/corso/src/pkg/storage"
+)
+
+// Sink is the destination to which export items are written.
+type Sink interface {
+	// Write stores the body under the path, which is relative to the
+	// root of the sink and always uses `/` as the separator.
+	Write(ctx context.Context, relPath string, body io.Reader) error
+	// Location is a human readable description of the root of the sink.
+	Location() string
+}
+
+// ---------------------------------------------------------------------------
+// local directory
+// ---------------------------------------------------------------------------
+
+type localSink struct {
+	dir string
+}
+
+// NewLocalSink returns a sink which writes items as files within the
+// directory.
+func NewLocalSink(dir string) Sink {
+	return localSink{dir: dir}
+}
+
+func (ls localSink) Location() string {
+	return ls.dir
+}
+
+func (ls localSink) Write(ctx context.Context, relPath string, body io.Reader) error {
+	fpath := filepath.Join(ls.dir, filepath.FromSlash(relPath))
+
+	err := os.MkdirAll(filepath.Dir(fpath), os.ModePerm)
+	if err != nil {
+		return clues.WrapWC(ctx, err, "creating directory")
+	}
+
+	// In case the user tries to restore to a non-clean
+	// directory, we might run into collisions an fail.
+	f, err := os.Create(fpath)
+	if err != nil {
+		return clues.WrapWC(ctx, err, "creating file")
+	}
+
+	defer f.Close()
+
+	_, err = io.Copy(f, body)
+	if err != nil {
+		return clues.WrapWC(ctx, err, "writing data")
+	}
+
+	return nil
+}
+
+// ---------------------------------------------------------------------------
+// s3
+// ---------------------------------------------------------------------------
+
+// S3SinkPartSize is the size of each part in the multipart uploads
+// used to stream items to s3.  Only one part is held in memory at a
+// time.  S3 allows up to 10,000 parts, which caps a single item at
+// ~640GB.
+const S3SinkPartSize = 64 * 1024 * 1024
+
+const defaultS3Endpoint = "s3.amazonaws.com"
+
+type s3Sink struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+// NewS3Sink returns a sink which uploads items as objects within the
+// bucket, under the prefix.  Credentials are taken from the config,
+// falling back to the AWS env vars and then the instance's IAM role.
+func NewS3Sink(cfg storage.S3Config) (Sink, error) {
+	bucket := common.NormalizeBucket(cfg.Bucket)
+	if len(bucket) == 0 {
+		return nil, clues.New("missing bucket name")
+	}
+
+	endpoint := cfg.Endpoint
+	if len(endpoint) == 0 {
+		endpoint = defaultS3Endpoint
+	}
+
+	creds := credentials.NewChainCredentials(
+		[]credentials.Provider{
+			&credentials.Static{
+				Value: credentials.Value{
+					AccessKeyID:     cfg.AccessKey,
+					SecretAccessKey: cfg.SecretKey,
+					SessionToken:    cfg.SessionToken,
+					SignerType:      credentials.SignatureV4,
+				},
+			},
+			&credentials.EnvAWS{},
+			&credentials.IAM{
+				Client: &http.Client{
+					Transport: http.DefaultTransport,
+				},
+			},
+		})
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.DoNotVerifyTLS {
+		//nolint:gosec // explicitly requested by the user
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:     creds,
+		Secure:    !cfg.DoNotUseTLS,
+		Transport: transport,
+	})
+	if err != nil {
+		return nil, clues.Wrap(err, "creating s3 client")
+	}
+
+	return s3Sink{
+		client: client,
+		bucket: bucket,
+		prefix: common.NormalizePrefix(cfg.Prefix),
+	}, nil
+}
+
+func (ss s3Sink) Location() string {
+	return "s3://" + ss.bucket + "/" + ss.prefix
+}
+
+func (ss s3Sink) Write(ctx context.Context, relPath string, body io.Reader) error {
+	key := ss.prefix + relPath
+
+	// a size of -1 streams the body as a multipart upload without
+	// needing to know its length ahead of time.
+	_, err := ss.client.PutObject(
+		ctx,
+		ss.bucket,
+		key,
+		body,
+		-1,
+		minio.PutObjectOptions{PartSize: S3SinkPartSize})
+	if err != nil {
+		return clues.WrapWC(ctx, err, "uploading object").With("object_key", clues.Hide(key))
+	}
+
+	return nil
+}
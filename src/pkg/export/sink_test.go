@@ -0,0 +1,167 @@
+package export
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/alcionai/clues"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/storage"
+)
+
+type SinkUnitSuite struct {
+	tester.Suite
+}
+
+func TestSinkUnitSuite(t *testing.T) {
+	suite.Run(t, &SinkUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func (suite *SinkUnitSuite) TestLocalSink() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	dir := t.TempDir()
+	sink := NewLocalSink(dir)
+
+	assert.Equal(t, dir, sink.Location())
+
+	err := sink.Write(ctx, "a/b/c.txt", strings.NewReader("body"))
+	require.NoError(t, err, clues.ToCore(err))
+
+	content, err := os.ReadFile(filepath.Join(dir, "a", "b", "c.txt"))
+	require.NoError(t, err, clues.ToCore(err))
+	assert.Equal(t, "body", string(content))
+}
+
+func (suite *SinkUnitSuite) TestNewS3Sink() {
+	table := []struct {
+		name      string
+		cfg       storage.S3Config
+		expectErr assert.ErrorAssertionFunc
+		expectLoc string
+	}{
+		{
+			name:      "missing bucket",
+			cfg:       storage.S3Config{Prefix: "prefix"},
+			expectErr: assert.Error,
+		},
+		{
+			name:      "bucket only",
+			cfg:       storage.S3Config{Bucket: "bucket"},
+			expectErr: assert.NoError,
+			expectLoc: "s3://bucket/",
+		},
+		{
+			name: "bucket and prefix",
+			cfg: storage.S3Config{
+				Bucket:   "s3://bucket",
+				Prefix:   "some/prefix",
+				Endpoint: "localhost:9000",
+			},
+			expectErr: assert.NoError,
+			expectLoc: "s3://bucket/some/prefix/",
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			sink, err := NewS3Sink(test.cfg)
+			test.expectErr(t, err, clues.ToCore(err))
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, test.expectLoc, sink.Location())
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// s3 compatible store
+// ---------------------------------------------------------------------------
+
+// The s3 sink tests run against any s3 compatible store (ex: a local
+// MinIO server).  Credentials are read from the standard AWS env vars.
+const (
+	exportS3EndpointEnv = "CORSO_EXPORT_S3_ENDPOINT"
+	exportS3BucketEnv   = "CORSO_EXPORT_S3_BUCKET"
+	exportS3NoTLSEnv    = "CORSO_EXPORT_S3_DISABLE_TLS"
+)
+
+type S3SinkIntgSuite struct {
+	tester.Suite
+	cfg storage.S3Config
+}
+
+func TestS3SinkIntgSuite(t *testing.T) {
+	suite.Run(t, &S3SinkIntgSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func (suite *S3SinkIntgSuite) SetupSuite() {
+	t := suite.T()
+
+	endpoint := os.Getenv(exportS3EndpointEnv)
+	bucket := os.Getenv(exportS3BucketEnv)
+
+	if len(endpoint) == 0 || len(bucket) == 0 {
+		t.Skipf("%s and %s are required to run s3 sink tests", exportS3EndpointEnv, exportS3BucketEnv)
+	}
+
+	suite.cfg = storage.S3Config{
+		Bucket:      bucket,
+		Endpoint:    endpoint,
+		Prefix:      "corso-export-test/" + tester.LogTimeOfTest(t),
+		DoNotUseTLS: len(os.Getenv(exportS3NoTLSEnv)) > 0,
+	}
+}
+
+func (suite *S3SinkIntgSuite) TestWrite() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	sink, err := NewS3Sink(suite.cfg)
+	require.NoError(t, err, clues.ToCore(err))
+
+	body := bytes.Repeat([]byte("corso"), 1024)
+
+	err = sink.Write(ctx, "folder/item.txt", bytes.NewReader(body))
+	require.NoError(t, err, clues.ToCore(err))
+
+	ss := sink.(s3Sink)
+
+	obj, err := ss.client.GetObject(
+		ctx,
+		ss.bucket,
+		ss.prefix+"folder/item.txt",
+		minio.GetObjectOptions{})
+	require.NoError(t, err, clues.ToCore(err))
+
+	defer obj.Close()
+
+	content, err := io.ReadAll(obj)
+	require.NoError(t, err, clues.ToCore(err))
+	assert.Equal(t, body, content)
+
+	err = ss.client.RemoveObject(
+		ctx,
+		ss.bucket,
+		ss.prefix+"folder/item.txt",
+		minio.RemoveObjectOptions{})
+	assert.NoError(t, err, clues.ToCore(err))
+}
@@ -6,6 +6,29 @@ import (
 
 const (
 	AlertPreviousPathCollision = "previous_path_collision"
+	// AlertUnmappedPrincipal is raised when a restore uses a principal
+	// map, and a restored permission grants access to a user or group
+	// that the map does not include.
+	AlertUnmappedPrincipal = "unmapped_principal"
+	// AlertUnresolvedPrincipalTarget is raised when a principal map
+	// target can't be found among the users and groups of the tenant.
+	AlertUnresolvedPrincipalTarget = "unresolved_principal_target"
+	// AlertUntransferablePermission is raised when a cross-service
+	// restore drops a permission granted to a principal that only
+	// exists within the backed up site, such as a site group.
+	AlertUntransferablePermission = "untransferable_permission"
+	// AlertDroppedRuleAction is raised when a restored inbox rule moves
+	// or copies mail to a folder that doesn't exist in the mailbox, and
+	// that action gets removed from the rule.
+	AlertDroppedRuleAction = "dropped_rule_action"
+	// AlertDroppedNestedAttachment is raised when an attached item is too
+	// large to restore along with its own attachments, and those nested
+	// attachments get dropped.
+	AlertDroppedNestedAttachment = "dropped_nested_attachment"
+	// AlertDroppedPostAttachment is raised when a restored group post
+	// has an attachment that can't be sent along with the post, such as
+	// an attached item or reference, and that attachment gets dropped.
+	AlertDroppedPostAttachment = "dropped_post_attachment"
 )
 
 var _ print.Printable = &Alert{}
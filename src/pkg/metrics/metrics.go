@@ -13,19 +13,33 @@ type KindStats struct {
 	ResourceCount int64
 }
 
+// ItemCounts describes what the export consumer did with the items
+// it received.
+type ItemCounts struct {
+	Written int64
+	Skipped int64
+}
+
 var (
-	bytesRead count.Key = "bytes-read"
-	resources count.Key = "resources"
+	bytesRead    count.Key = "bytes-read"
+	resources    count.Key = "resources"
+	itemsWritten count.Key = "items-written"
+	itemsSkipped count.Key = "items-skipped"
 )
 
 type ExportStats struct {
 	// data is kept private so that we can enforce atomic int updates
 	data syncd.MapOf[path.CategoryType, *count.Bus]
+	// items counts the results of consuming the export, which
+	// span all categories.  Only counted by stats created with
+	// NewExportStats.
+	items *count.Bus
 }
 
 func NewExportStats() *ExportStats {
 	return &ExportStats{
-		data: syncd.NewMapOf[path.CategoryType, *count.Bus](),
+		data:  syncd.NewMapOf[path.CategoryType, *count.Bus](),
+		items: count.New(),
 	}
 }
 
@@ -37,6 +51,17 @@ func (es *ExportStats) UpdateResourceCount(kind path.CategoryType) {
 	es.getCB(kind).Inc(resources)
 }
 
+// UpdateItemWritten counts an item written to the export destination.
+func (es *ExportStats) UpdateItemWritten() {
+	es.items.Inc(itemsWritten)
+}
+
+// UpdateItemSkipped counts an item which was not written because a
+// previous export already wrote it to the destination.
+func (es *ExportStats) UpdateItemSkipped() {
+	es.items.Inc(itemsSkipped)
+}
+
 func (es *ExportStats) getCB(kind path.CategoryType) *count.Bus {
 	es.data.LazyInit()
 
@@ -62,6 +87,17 @@ func (es *ExportStats) GetStats() map[path.CategoryType]KindStats {
 	return toKindStats
 }
 
+func (es *ExportStats) GetItemCounts() ItemCounts {
+	if es.items == nil {
+		return ItemCounts{}
+	}
+
+	return ItemCounts{
+		Written: es.items.Get(itemsWritten),
+		Skipped: es.items.Get(itemsSkipped),
+	}
+}
+
 type statsReader struct {
 	io.ReadCloser
 	kind  path.CategoryType
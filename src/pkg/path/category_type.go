@@ -29,6 +29,9 @@ const (
 	ChannelMessagesCategory   CategoryType = 9  // channelMessages
 	ConversationPostsCategory CategoryType = 10 // conversationPosts
 	ChatsCategory             CategoryType = 11 // chats
+	TasksCategory             CategoryType = 12 // tasks
+	NotebooksCategory         CategoryType = 13 // notebooks
+	MailboxSettingsCategory   CategoryType = 14 // mailboxSettings
 )
 
 var strToCat = map[string]CategoryType{
@@ -43,6 +46,9 @@ var strToCat = map[string]CategoryType{
 	strings.ToLower(ChannelMessagesCategory.String()):   ChannelMessagesCategory,
 	strings.ToLower(ConversationPostsCategory.String()): ConversationPostsCategory,
 	strings.ToLower(ChatsCategory.String()):             ChatsCategory,
+	strings.ToLower(TasksCategory.String()):             TasksCategory,
+	strings.ToLower(NotebooksCategory.String()):         NotebooksCategory,
+	strings.ToLower(MailboxSettingsCategory.String()):   MailboxSettingsCategory,
 }
 
 func ToCategoryType(s string) CategoryType {
@@ -66,6 +72,9 @@ var catToHuman = map[CategoryType]string{
 	ChannelMessagesCategory:   "Messages",
 	ConversationPostsCategory: "Posts",
 	ChatsCategory:             "Chats",
+	TasksCategory:             "Tasks",
+	NotebooksCategory:         "Notebooks",
+	MailboxSettingsCategory:   "Mailbox Settings",
 }
 
 // HumanString produces a more human-readable string version of the category.
@@ -86,17 +95,21 @@ func (cat CategoryType) HumanString() string {
 // non-metadata paths.
 var serviceCategories = map[ServiceType]map[CategoryType]struct{}{
 	ExchangeService: {
-		EmailCategory:    {},
-		ContactsCategory: {},
-		EventsCategory:   {},
+		EmailCategory:           {},
+		ContactsCategory:        {},
+		EventsCategory:          {},
+		TasksCategory:           {},
+		MailboxSettingsCategory: {},
 	},
 	OneDriveService: {
-		FilesCategory: {},
+		FilesCategory:     {},
+		NotebooksCategory: {},
 	},
 	SharePointService: {
 		LibrariesCategory: {},
 		ListsCategory:     {},
 		PagesCategory:     {},
+		NotebooksCategory: {},
 	},
 	GroupsService: {
 		ChannelMessagesCategory:   {},
@@ -20,11 +20,14 @@ func _() {
 	_ = x[ChannelMessagesCategory-9]
 	_ = x[ConversationPostsCategory-10]
 	_ = x[ChatsCategory-11]
+	_ = x[TasksCategory-12]
+	_ = x[NotebooksCategory-13]
+	_ = x[MailboxSettingsCategory-14]
 }
 
-const _CategoryType_name = "UnknownCategoryemailcontactseventsfileslistslibrariespagesdetailschannelMessagesconversationPostschats"
+const _CategoryType_name = "UnknownCategoryemailcontactseventsfileslistslibrariespagesdetailschannelMessagesconversationPostschatstasksnotebooksmailboxSettings"
 
-var _CategoryType_index = [...]uint8{0, 15, 20, 28, 34, 39, 44, 53, 58, 65, 80, 97, 102}
+var _CategoryType_index = [...]uint8{0, 15, 20, 28, 34, 39, 44, 53, 58, 65, 80, 97, 102, 107, 116, 131}
 
 func (i CategoryType) String() string {
 	if i < 0 || i >= CategoryType(len(_CategoryType_index)-1) {
@@ -25,10 +25,13 @@ var piiSafePathElems = pii.MapWithPlurals(
 	EmailCategory.String(),
 	ContactsCategory.String(),
 	EventsCategory.String(),
+	TasksCategory.String(),
+	MailboxSettingsCategory.String(),
 	FilesCategory.String(),
 	ListsCategory.String(),
 	LibrariesCategory.String(),
 	PagesCategory.String(),
+	NotebooksCategory.String(),
 	DetailsCategory.String(),
 
 	// other internal values
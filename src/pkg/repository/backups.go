@@ -2,21 +2,29 @@ package repository
 
 import (
 	"context"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/alcionai/clues"
 	"github.com/kopia/kopia/repo/manifest"
 	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 
 	"github.com/alcionai/corso/src/internal/common/idname"
 	"github.com/alcionai/corso/src/internal/data"
 	"github.com/alcionai/corso/src/internal/kopia"
+	"github.com/alcionai/corso/src/internal/m365"
 	"github.com/alcionai/corso/src/internal/model"
 	"github.com/alcionai/corso/src/internal/operations"
 	"github.com/alcionai/corso/src/internal/streamstore"
 	"github.com/alcionai/corso/src/internal/version"
 	"github.com/alcionai/corso/src/pkg/backup"
 	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/backup/identity"
 	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/path"
 	"github.com/alcionai/corso/src/pkg/selectors"
 	"github.com/alcionai/corso/src/pkg/services/m365/api/graph/metadata"
 	"github.com/alcionai/corso/src/pkg/store"
@@ -28,6 +36,11 @@ type BackupGetter interface {
 	Backup(ctx context.Context, id string) (*backup.Backup, error)
 	Backups(ctx context.Context, ids []string) ([]*backup.Backup, *fault.Bus)
 	BackupsByTag(ctx context.Context, fs ...store.FilterOption) ([]*backup.Backup, error)
+	BackupsAsOf(
+		ctx context.Context,
+		sel selectors.Selector,
+		asOf time.Time,
+	) ([]BackupAsOf, error)
 	GetBackupDetails(
 		ctx context.Context,
 		backupID string,
@@ -76,7 +89,14 @@ func (r repository) NewBackupWithLookup(
 		return operations.BackupOperation{}, clues.Wrap(err, "connecting to m365")
 	}
 
-	resource, err := r.Provider.PopulateProtectedResourceIDAndName(ctx, sel.DiscreteOwner, ins)
+	// each backup gets its own controller, so that backups of separate
+	// protected resources can run concurrently on the same repository.
+	provider := r.Provider
+	if ctrl, ok := provider.(*m365.Controller); ok {
+		provider = ctrl.ForOperation()
+	}
+
+	resource, err := provider.PopulateProtectedResourceIDAndName(ctx, sel.DiscreteOwner, ins)
 	if err != nil {
 		return operations.BackupOperation{}, clues.Wrap(err, "resolving resource owner details")
 	}
@@ -89,7 +109,7 @@ func (r repository) NewBackupWithLookup(
 		r.Opts,
 		r.dataLayer,
 		store.NewWrapper(r.modelStore),
-		r.Provider,
+		provider,
 		r.Account,
 		sel,
 		sel, // the selector acts as an IDNamer for its discrete resource owner.
@@ -173,6 +193,153 @@ func backupsByTag(
 	return res, nil
 }
 
+// BackupAsOf is a backup picked by BackupsAsOf, along with the selector
+// that restores the protected resource and categories it was picked for.
+type BackupAsOf struct {
+	Backup   *backup.Backup
+	Selector selectors.Selector
+}
+
+// BackupsAsOf finds, for each protected resource and category within the
+// selector, the newest complete backup created before asOf.  Categories of
+// a protected resource can get picked from different backups, in which case
+// each backup's selector is limited to the categories it was picked for.
+func (r repository) BackupsAsOf(
+	ctx context.Context,
+	sel selectors.Selector,
+	asOf time.Time,
+) ([]BackupAsOf, error) {
+	sw := store.NewWrapper(r.modelStore)
+
+	bf, err := r.dataLayer.NewBaseFinder(sw)
+	if err != nil {
+		return nil, clues.Stack(err)
+	}
+
+	return backupsAsOf(ctx, bf, sw, r.Account.ID(), sel, asOf)
+}
+
+type asOfBaseFinder interface {
+	FindBasesAsOf(
+		ctx context.Context,
+		reasons []identity.Reasoner,
+		tags map[string]string,
+		asOf time.Time,
+	) kopia.BackupBases
+}
+
+func backupsAsOf(
+	ctx context.Context,
+	bf asOfBaseFinder,
+	sw store.BackupWrapper,
+	tenantID string,
+	sel selectors.Selector,
+	asOf time.Time,
+) ([]BackupAsOf, error) {
+	ctx = clues.Add(ctx, "as_of", asOf)
+
+	// the protected resources in the service's backups stand in for
+	// selectors that include any protected resource.
+	bups, err := backupsByTag(ctx, sw, []store.FilterOption{store.Service(sel.PathService())})
+	if err != nil {
+		return nil, clues.Wrap(err, "listing backups")
+	}
+
+	var (
+		resources = map[string]struct{}{}
+		// the selector may name its protected resources by their name (ex: the
+		// user's UPN) instead of their id.  Reasons are keyed by id, so names
+		// get resolved to the ids recorded in the backups.
+		nameToID = map[string]string{}
+	)
+
+	for _, bup := range bups {
+		id := backupResourceID(bup)
+		resources[id] = struct{}{}
+
+		if name := backupResourceName(bup); len(id) > 0 && len(name) > 0 {
+			nameToID[strings.ToLower(name)] = id
+		}
+	}
+
+	delete(resources, "")
+
+	ids := maps.Keys(resources)
+	slices.Sort(ids)
+
+	var (
+		res  = []BackupAsOf{}
+		tags = map[string]string{kopia.TagBackupCategory: ""}
+	)
+
+	for _, rsel := range sel.SplitByProtectedResource(ids) {
+		if id, ok := nameToID[strings.ToLower(rsel.DiscreteOwner)]; ok {
+			rsel = rsel.SetDiscreteOwnerIDName(id, rsel.DiscreteOwner)
+		}
+
+		rctx := clues.Add(ctx, "protected_resource", clues.Hide(rsel.ID()))
+
+		reasons, err := rsel.Reasons(tenantID, false)
+		if err != nil {
+			return nil, clues.WrapWC(rctx, err, "getting selector reasons")
+		}
+
+		for _, base := range bf.FindBasesAsOf(rctx, reasons, tags, asOf).MergeBases() {
+			cats := make([]path.CategoryType, 0, len(base.Reasons))
+
+			for _, reason := range base.Reasons {
+				cats = append(cats, reason.Category())
+			}
+
+			csel, err := rsel.LimitPathCategories(cats...)
+			if err != nil {
+				return nil, clues.WrapWC(rctx, err, "limiting selector categories")
+			}
+
+			res = append(res, BackupAsOf{
+				Backup:   base.Backup,
+				Selector: csel,
+			})
+		}
+	}
+
+	if len(res) == 0 {
+		return nil, clues.WrapWC(ctx, data.ErrNotFound, "no complete backups before the provided time")
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Backup.CreationTime.Before(res[j].Backup.CreationTime)
+	})
+
+	return res, nil
+}
+
+// backupResourceID returns the ID of the protected resource in the backup.
+func backupResourceID(bup *backup.Backup) string {
+	if len(bup.ProtectedResourceID) > 0 {
+		return bup.ProtectedResourceID
+	}
+
+	if len(bup.ResourceOwnerID) > 0 {
+		return bup.ResourceOwnerID
+	}
+
+	return bup.Selector.DiscreteOwner
+}
+
+// backupResourceName returns the name of the protected resource in the backup.
+func backupResourceName(bup *backup.Backup) string {
+	if len(bup.ProtectedResourceName) > 0 {
+		return bup.ProtectedResourceName
+	}
+
+	if len(bup.ResourceOwnerName) > 0 {
+		return bup.ResourceOwnerName
+	}
+
+	return bup.Selector.DiscreteOwnerName
+}
+
 // BackupDetails returns the specified backup.Details
 func (r repository) GetBackupDetails(
 	ctx context.Context,
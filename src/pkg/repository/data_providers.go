@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/alcionai/clues"
 
@@ -11,6 +12,8 @@ import (
 	"github.com/alcionai/corso/src/internal/observe"
 	"github.com/alcionai/corso/src/internal/operations/inject"
 	"github.com/alcionai/corso/src/pkg/account"
+	"github.com/alcionai/corso/src/pkg/control"
+	"github.com/alcionai/corso/src/pkg/count"
 	"github.com/alcionai/corso/src/pkg/path"
 	"github.com/alcionai/corso/src/pkg/store"
 )
@@ -39,12 +42,41 @@ type DataProviderConnector interface {
 	) error
 	// DataProvider retrieves the data provider.
 	DataProvider() DataProvider
+	// ConnectTargetDataProvider establishes the client connection
+	// with the data provider of a separate target account.  Restores
+	// and exports interact with the target account, instead of the
+	// repository's account, once connected.
+	ConnectTargetDataProvider(
+		ctx context.Context,
+		acct account.Account,
+		pst path.ServiceType,
+	) error
 }
 
 func (r *repository) DataProvider() DataProvider {
 	return r.Provider
 }
 
+// targetProvider returns the data provider which restores and exports
+// interact with.
+func (r repository) targetProvider() DataProvider {
+	if r.TargetProvider != nil {
+		return r.TargetProvider
+	}
+
+	return r.Provider
+}
+
+// targetTenantID returns the ID of the target account's tenant, if
+// a separate target account is connected.
+func (r repository) targetTenantID() string {
+	if r.TargetProvider == nil {
+		return ""
+	}
+
+	return r.TargetAccount.ID()
+}
+
 func (r *repository) ConnectDataProvider(
 	ctx context.Context,
 	pst path.ServiceType,
@@ -74,6 +106,52 @@ func (r *repository) ConnectDataProvider(
 	return nil
 }
 
+func (r *repository) ConnectTargetDataProvider(
+	ctx context.Context,
+	acct account.Account,
+	pst path.ServiceType,
+) error {
+	ctx = clues.Add(
+		ctx,
+		"target_acct_provider", acct.Provider.String(),
+		"target_acct_id", clues.Hide(acct.ID()))
+
+	if acct.Provider != r.Account.Provider {
+		return clues.NewWC(ctx, "target account provider does not match the repository account provider")
+	}
+
+	// the same tenant would be reachable through two accounts,
+	// leaving it unclear which one restores should use.
+	if strings.EqualFold(acct.ID(), r.Account.ID()) {
+		return clues.NewWC(ctx, "target account belongs to the repository's tenant")
+	}
+
+	var (
+		provider DataProvider
+		err      error
+	)
+
+	switch acct.Provider {
+	case account.ProviderM365:
+		provider, err = newM365Controller(ctx, acct, pst, r.Opts, r.counter)
+	default:
+		err = clues.NewWC(ctx, "unrecognized provider")
+	}
+
+	if err != nil {
+		return clues.Wrap(err, "connecting target data provider")
+	}
+
+	if err := provider.VerifyAccess(ctx); err != nil {
+		return clues.Wrap(err, fmt.Sprintf("verifying target %s account connection", acct.Provider))
+	}
+
+	r.TargetAccount = acct
+	r.TargetProvider = provider
+
+	return nil
+}
+
 func connectToM365(
 	ctx context.Context,
 	r repository,
@@ -90,15 +168,25 @@ func connectToM365(
 		return ctrl, nil
 	}
 
+	return newM365Controller(ctx, r.Account, pst, r.Opts, r.counter)
+}
+
+func newM365Controller(
+	ctx context.Context,
+	acct account.Account,
+	pst path.ServiceType,
+	opts control.Options,
+	counter *count.Bus,
+) (*m365.Controller, error) {
 	progressMessage := observe.MessageWithCompletion(ctx, observe.DefaultCfg(), "Connecting to M365")
 	defer close(progressMessage)
 
 	ctrl, err := m365.NewController(
 		ctx,
-		r.Account,
+		acct,
 		pst,
-		r.Opts,
-		r.counter)
+		opts,
+		counter)
 	if err != nil {
 		return nil, clues.Wrap(err, "creating m365 client controller")
 	}
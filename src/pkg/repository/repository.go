@@ -79,6 +79,11 @@ type repository struct {
 	Opts     control.Options
 	Provider DataProvider // the client controller used for external user data CRUD
 
+	// the account, and its client controller, which restores and exports
+	// interact with in place of the repository's account.  Optional.
+	TargetAccount  account.Account
+	TargetProvider DataProvider
+
 	counter    *count.Bus
 	Bus        events.Eventer
 	dataLayer  *kopia.Wrapper
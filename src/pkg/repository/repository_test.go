@@ -103,6 +103,37 @@ func (suite *RepositoryUnitSuite) TestInitialize() {
 	}
 }
 
+// connecting to a target tenant involves communication with m365, therefore
+// this only tests the cases rejected before connecting.
+func (suite *RepositoryUnitSuite) TestConnectTargetDataProvider() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	st, err := storage.NewStorage(storage.ProviderUnknown)
+	require.NoError(t, err, clues.ToCore(err))
+
+	acct := tconfig.NewFakeM365Account(t)
+
+	r, err := New(
+		ctx,
+		acct,
+		st,
+		control.DefaultOptions(),
+		NewRepoID)
+	require.NoError(t, err, clues.ToCore(err))
+
+	err = r.ConnectTargetDataProvider(ctx, acct, path.OneDriveService)
+	assert.Error(t, err, "same tenant", clues.ToCore(err))
+
+	err = r.ConnectTargetDataProvider(ctx, account.Account{}, path.OneDriveService)
+	assert.Error(t, err, "unknown provider", clues.ToCore(err))
+
+	assert.Nil(t, r.TargetProvider)
+	assert.Empty(t, r.targetTenantID())
+}
+
 // repository.Connect involves end-to-end communication with kopia, therefore this only
 // tests expected error cases
 func (suite *RepositoryUnitSuite) TestConnect() {
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/alcionai/clues"
 	"github.com/google/uuid"
@@ -23,6 +24,7 @@ import (
 	"github.com/alcionai/corso/src/internal/version"
 	"github.com/alcionai/corso/src/pkg/backup"
 	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/backup/identity"
 	rep "github.com/alcionai/corso/src/pkg/control/repository"
 	"github.com/alcionai/corso/src/pkg/fault"
 	"github.com/alcionai/corso/src/pkg/path"
@@ -322,6 +324,147 @@ func (suite *RepositoryBackupsUnitSuite) TestBackupsByTag() {
 	}
 }
 
+type mockAsOfBaseFinder struct {
+	t     *testing.T
+	asOf  time.Time
+	bases map[string][]kopia.BackupBase
+}
+
+func (mf mockAsOfBaseFinder) FindBasesAsOf(
+	_ context.Context,
+	reasons []identity.Reasoner,
+	_ map[string]string,
+	asOf time.Time,
+) kopia.BackupBases {
+	require.NotEmpty(mf.t, reasons)
+	assert.True(mf.t, mf.asOf.Equal(asOf), "as-of time")
+
+	return kopia.NewMockBackupBases().
+		WithMergeBases(mf.bases[reasons[0].ProtectedResource()]...)
+}
+
+func (suite *RepositoryBackupsUnitSuite) TestBackupsAsOf() {
+	var (
+		tenant = "tenant"
+		asOf   = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+		bups   = []*backup.Backup{
+			{ProtectedResourceID: "user1", ProtectedResourceName: "user1@example.com"},
+			{ResourceOwnerID: "user2", ResourceOwnerName: "user2@example.com"},
+		}
+	)
+
+	mailBup := &backup.Backup{
+		BaseModel:    model.BaseModel{ID: "mail"},
+		CreationTime: asOf.Add(-2 * time.Hour),
+	}
+	eventsBup := &backup.Backup{
+		BaseModel:    model.BaseModel{ID: "events"},
+		CreationTime: asOf.Add(-time.Hour),
+	}
+
+	user1Bases := []kopia.BackupBase{
+		{
+			Backup: eventsBup,
+			Reasons: []identity.Reasoner{
+				identity.NewReason(tenant, "user1", path.ExchangeService, path.EventsCategory),
+			},
+		},
+		{
+			Backup: mailBup,
+			Reasons: []identity.Reasoner{
+				identity.NewReason(tenant, "user1", path.ExchangeService, path.EmailCategory),
+			},
+		},
+	}
+
+	table := []struct {
+		name       string
+		resources  []string
+		bases      map[string][]kopia.BackupBase
+		expectIDs  []model.StableID
+		expectCats [][]path.CategoryType
+		expectErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "bases per category",
+			resources: selectors.Any(),
+			bases:     map[string][]kopia.BackupBase{"user1": user1Bases},
+			expectIDs: []model.StableID{"mail", "events"},
+			expectCats: [][]path.CategoryType{
+				{path.EmailCategory},
+				{path.EventsCategory},
+			},
+			expectErr: assert.NoError,
+		},
+		{
+			name:      "discrete resource",
+			resources: []string{"user1"},
+			bases:     map[string][]kopia.BackupBase{"user1": user1Bases},
+			expectIDs: []model.StableID{"mail", "events"},
+			expectCats: [][]path.CategoryType{
+				{path.EmailCategory},
+				{path.EventsCategory},
+			},
+			expectErr: assert.NoError,
+		},
+		{
+			name:      "resource name",
+			resources: []string{"User1@example.com"},
+			bases:     map[string][]kopia.BackupBase{"user1": user1Bases},
+			expectIDs: []model.StableID{"mail", "events"},
+			expectCats: [][]path.CategoryType{
+				{path.EmailCategory},
+				{path.EventsCategory},
+			},
+			expectErr: assert.NoError,
+		},
+		{
+			name:      "no bases",
+			resources: selectors.Any(),
+			bases:     map[string][]kopia.BackupBase{},
+			expectErr: assert.Error,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			ctx, flush := tester.NewContext(t)
+			defer flush()
+
+			sel := selectors.NewExchangeRestore(test.resources)
+			sel.Include(
+				sel.MailFolders(selectors.Any()),
+				sel.EventCalendars(selectors.Any()))
+
+			mf := mockAsOfBaseFinder{
+				t:     t,
+				asOf:  asOf,
+				bases: test.bases,
+			}
+
+			res, err := backupsAsOf(ctx, mf, mockBackupList{backups: bups}, tenant, sel.Selector, asOf)
+			test.expectErr(t, err, clues.ToCore(err))
+
+			if err != nil {
+				assert.ErrorIs(t, err, data.ErrNotFound, clues.ToCore(err))
+				return
+			}
+
+			require.Len(t, res, len(test.expectIDs))
+
+			for i, r := range res {
+				assert.Equal(t, test.expectIDs[i], r.Backup.ID)
+				assert.Equal(t, "user1", r.Selector.DiscreteOwner)
+
+				pcs, err := r.Selector.PathCategories()
+				require.NoError(t, err, clues.ToCore(err))
+				assert.ElementsMatch(t, test.expectCats[i], pcs.Includes)
+			}
+		})
+	}
+}
+
 type getRes struct {
 	bup *backup.Backup
 	err error
@@ -5,8 +5,10 @@ import (
 
 	"github.com/alcionai/clues"
 
+	"github.com/alcionai/corso/src/internal/common/idname"
 	"github.com/alcionai/corso/src/internal/model"
 	"github.com/alcionai/corso/src/internal/operations"
+	"github.com/alcionai/corso/src/internal/operations/inject"
 	"github.com/alcionai/corso/src/pkg/control"
 	"github.com/alcionai/corso/src/pkg/count"
 	"github.com/alcionai/corso/src/pkg/selectors"
@@ -20,6 +22,12 @@ type Restorer interface {
 		sel selectors.Selector,
 		restoreCfg control.RestoreConfig,
 	) (operations.RestoreOperation, error)
+	NewFileRestore(
+		ctx context.Context,
+		sourceDir string,
+		sel selectors.Selector,
+		restoreCfg control.RestoreConfig,
+	) (operations.RestoreOperation, error)
 }
 
 // NewRestore generates a restoreOperation runner.
@@ -29,11 +37,27 @@ func (r repository) NewRestore(
 	sel selectors.Selector,
 	restoreCfg control.RestoreConfig,
 ) (operations.RestoreOperation, error) {
-	handler, err := r.Provider.NewServiceHandler(sel.PathService())
+	handler, err := r.targetProvider().NewServiceHandler(sel.PathService())
 	if err != nil {
 		return operations.RestoreOperation{}, clues.Stack(err)
 	}
 
+	if restoreCfg.IsCrossService(sel.PathService()) {
+		target, err := r.targetProvider().NewServiceHandler(restoreCfg.TargetService)
+		if err != nil {
+			return operations.RestoreOperation{}, clues.Wrap(err, "getting target service handler")
+		}
+
+		handler = crossServiceHandler{
+			ServiceHandler: handler,
+			target:         target,
+		}
+	}
+
+	if tid := r.targetTenantID(); len(tid) > 0 {
+		restoreCfg.TargetTenantID = tid
+	}
+
 	return operations.NewRestoreOperation(
 		ctx,
 		r.Opts,
@@ -47,3 +71,58 @@ func (r repository) NewRestore(
 		r.Bus,
 		count.New())
 }
+
+// NewFileRestore generates a restoreOperation runner that restores the
+// files in sourceDir, such as those written by an export, in place of
+// the items in a backup.
+func (r repository) NewFileRestore(
+	ctx context.Context,
+	sourceDir string,
+	sel selectors.Selector,
+	restoreCfg control.RestoreConfig,
+) (operations.RestoreOperation, error) {
+	handler, err := r.targetProvider().NewServiceHandler(sel.PathService())
+	if err != nil {
+		return operations.RestoreOperation{}, clues.Stack(err)
+	}
+
+	if tid := r.targetTenantID(); len(tid) > 0 {
+		restoreCfg.TargetTenantID = tid
+	}
+
+	return operations.NewFileRestoreOperation(
+		ctx,
+		r.Opts,
+		r.dataLayer,
+		store.NewWrapper(r.modelStore),
+		handler,
+		r.Account,
+		sourceDir,
+		sel,
+		restoreCfg,
+		r.Bus,
+		count.New())
+}
+
+// crossServiceHandler restores the backup with the handler of the backed up
+// service, while looking up the protected resource that receives the data
+// with the handler of the target service.
+type crossServiceHandler struct {
+	inject.ServiceHandler
+	target inject.ServiceHandler
+}
+
+func (h crossServiceHandler) IsServiceEnabled(
+	ctx context.Context,
+	resourceID string,
+) (bool, error) {
+	return h.target.IsServiceEnabled(ctx, resourceID)
+}
+
+func (h crossServiceHandler) PopulateProtectedResourceIDAndName(
+	ctx context.Context,
+	resourceID string,
+	ins idname.Cacher,
+) (idname.Provider, error) {
+	return h.target.PopulateProtectedResourceIDAndName(ctx, resourceID, ins)
+}
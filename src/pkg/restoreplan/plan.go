@@ -0,0 +1,155 @@
+// Package restoreplan describes what a restore would do to each item,
+// without performing any writes.  Plans are produced by dry-run restores.
+package restoreplan
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/alcionai/corso/src/pkg/control"
+)
+
+// Action is the outcome a restore would have for a single item.
+type Action string
+
+const (
+	// Create restores the item without colliding with existing data.
+	Create Action = "create"
+	// Skip leaves the existing item in place and does not restore.
+	Skip Action = "skip"
+	// Copy restores the item alongside the existing one, under a new name.
+	Copy Action = "copy-rename"
+	// Replace restores the item, then removes the existing one.
+	Replace Action = "replace"
+	// Remove deletes an existing item which isn't in the backup.  Only
+	// produced by mirror restores.
+	Remove Action = "remove"
+	// Quarantine moves an existing item which isn't in the backup into
+	// the mirror quarantine folder.
+	Quarantine Action = "quarantine"
+)
+
+// ActionFor returns the action that the collision policy produces for an
+// item, depending on whether it collides with an item in the target
+// container.
+func ActionFor(collides bool, policy control.CollisionPolicy) Action {
+	if !collides {
+		return Create
+	}
+
+	switch policy {
+	case control.Copy:
+		return Copy
+	case control.Replace, control.Mirror:
+		return Replace
+	default:
+		return Skip
+	}
+}
+
+// Item is the planned restore of a single item.
+type Item struct {
+	// ItemID is the ID of the item in the backup.  Items removed by a
+	// mirror restore hold the ID of the existing item instead.
+	ItemID   string `json:"itemID"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Action   Action `json:"action"`
+	// ContainerPath is the display path of the container the item would
+	// be restored into.
+	ContainerPath string `json:"containerPath"`
+	// NewContainer is true when the container doesn't exist yet, and
+	// would be created by the restore.
+	NewContainer bool `json:"newContainer"`
+}
+
+func (i Item) MinimumPrintable() any {
+	return i
+}
+
+// Headers returns the human-readable names of properties in an Item
+// for printing out to a terminal in a columnar display.
+func (i Item) Headers(skipID bool) []string {
+	hs := []string{"Action", "Name", "Category", "Container", "New Container"}
+
+	if skipID {
+		return hs
+	}
+
+	return append([]string{"ID"}, hs...)
+}
+
+// Values returns the values matching the Headers list.
+func (i Item) Values(skipID bool) []string {
+	vs := []string{
+		string(i.Action),
+		i.Name,
+		i.Category,
+		i.ContainerPath,
+		strconv.FormatBool(i.NewContainer),
+	}
+
+	if skipID {
+		return vs
+	}
+
+	return append([]string{i.ItemID}, vs...)
+}
+
+// Plan collects the planned restore of every selected item.  It is safe
+// for concurrent use.
+type Plan struct {
+	mu    sync.Mutex
+	items []Item
+}
+
+func New() *Plan {
+	return &Plan{}
+}
+
+// Add records the planned restore of an item.  No-op on a nil plan.
+func (p *Plan) Add(item Item) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.items = append(p.items, item)
+}
+
+// Items returns the planned items, ordered by container and name.
+func (p *Plan) Items() []Item {
+	if p == nil {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	items := make([]Item, len(p.items))
+	copy(items, p.items)
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].ContainerPath != items[j].ContainerPath {
+			return items[i].ContainerPath < items[j].ContainerPath
+		}
+
+		return items[i].Name < items[j].Name
+	})
+
+	return items
+}
+
+// Counts tallies the planned items by action.
+func (p *Plan) Counts() map[Action]int {
+	counts := map[Action]int{}
+
+	for _, item := range p.Items() {
+		counts[item.Action]++
+	}
+
+	return counts
+}
@@ -0,0 +1,113 @@
+package restoreplan
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/control"
+)
+
+type PlanUnitSuite struct {
+	tester.Suite
+}
+
+func TestPlanUnitSuite(t *testing.T) {
+	suite.Run(t, &PlanUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func (suite *PlanUnitSuite) TestActionFor() {
+	table := []struct {
+		name     string
+		collides bool
+		policy   control.CollisionPolicy
+		expect   Action
+	}{
+		{
+			name:     "no collision",
+			collides: false,
+			policy:   control.Replace,
+			expect:   Create,
+		},
+		{
+			name:     "skip",
+			collides: true,
+			policy:   control.Skip,
+			expect:   Skip,
+		},
+		{
+			name:     "copy",
+			collides: true,
+			policy:   control.Copy,
+			expect:   Copy,
+		},
+		{
+			name:     "replace",
+			collides: true,
+			policy:   control.Replace,
+			expect:   Replace,
+		},
+		{
+			name:     "mirror",
+			collides: true,
+			policy:   control.Mirror,
+			expect:   Replace,
+		},
+		{
+			name:     "unknown policy",
+			collides: true,
+			policy:   control.Unknown,
+			expect:   Skip,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			assert.Equal(suite.T(), test.expect, ActionFor(test.collides, test.policy))
+		})
+	}
+}
+
+func (suite *PlanUnitSuite) TestPlan() {
+	var (
+		t    = suite.T()
+		p    = New()
+		wg   sync.WaitGroup
+		adds = []Item{
+			{ItemID: "3", Name: "b", ContainerPath: "inbox", Action: Skip},
+			{ItemID: "1", Name: "z", ContainerPath: "archive", Action: Create},
+			{ItemID: "2", Name: "a", ContainerPath: "inbox", Action: Create},
+		}
+	)
+
+	for _, item := range adds {
+		wg.Add(1)
+
+		go func(item Item) {
+			defer wg.Done()
+			p.Add(item)
+		}(item)
+	}
+
+	wg.Wait()
+
+	ids := []string{}
+
+	for _, item := range p.Items() {
+		ids = append(ids, item.ItemID)
+	}
+
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+	assert.Equal(t, map[Action]int{Create: 2, Skip: 1}, p.Counts())
+}
+
+func (suite *PlanUnitSuite) TestPlan_nil() {
+	var p *Plan
+
+	p.Add(Item{ItemID: "1"})
+
+	assert.Empty(suite.T(), p.Items())
+	assert.Empty(suite.T(), p.Counts())
+}
@@ -42,9 +42,10 @@ type (
 )
 
 var (
-	_ Reducer        = &ExchangeRestore{}
-	_ pathCategorier = &ExchangeRestore{}
-	_ reasoner       = &ExchangeRestore{}
+	_ Reducer             = &ExchangeRestore{}
+	_ pathCategorier      = &ExchangeRestore{}
+	_ reasoner            = &ExchangeRestore{}
+	_ pathCategoryLimiter = &ExchangeRestore{}
 )
 
 // NewExchange produces a new Selector with the service set to ServiceExchange.
@@ -71,7 +72,7 @@ func (s Selector) ToExchangeBackup() (*ExchangeBackup, error) {
 }
 
 func (s ExchangeBackup) SplitByResourceOwner(users []string) []ExchangeBackup {
-	sels := splitByProtectedResource[ExchangeScope](s.Selector, users, ExchangeUser)
+	sels := s.Selector.SplitByProtectedResource(users)
 
 	ss := make([]ExchangeBackup, 0, len(sels))
 	for _, sel := range sels {
@@ -105,7 +106,7 @@ func (s Selector) ToExchangeRestore() (*ExchangeRestore, error) {
 }
 
 func (sr ExchangeRestore) SplitByResourceOwner(users []string) []ExchangeRestore {
-	sels := splitByProtectedResource[ExchangeScope](sr.Selector, users, ExchangeUser)
+	sels := sr.Selector.SplitByProtectedResource(users)
 
 	ss := make([]ExchangeRestore, 0, len(sels))
 	for _, sel := range sels {
@@ -124,6 +125,12 @@ func (s exchange) PathCategories() selectorPathCategories {
 	}
 }
 
+// LimitPathCategories produces a clone of the selector which only keeps the
+// scopes within the provided path categories.
+func (s exchange) LimitPathCategories(cats []path.CategoryType) Selector {
+	return limitPathCategories[ExchangeScope, exchangeCategory](s.Selector, cats)
+}
+
 // Reasons returns a deduplicated set of the backup reasons produced
 // using the selector's discrete owner and each scopes' service and
 // category types.
@@ -318,8 +325,87 @@ func (s *exchange) MailFolders(folders []string, opts ...option) []ExchangeScope
 	return scopes
 }
 
+// Produces one or more exchange task scopes.
+// If any slice contains selectors.Any, that slice is reduced to [selectors.Any]
+// If any slice contains selectors.None, that slice is reduced to [selectors.None]
+// If any slice is empty, it defaults to [selectors.None]
+// options are only applied to the task list scopes.
+func (s *exchange) Tasks(lists, tasks []string, opts ...option) []ExchangeScope {
+	scopes := []ExchangeScope{}
+
+	scopes = append(
+		scopes,
+		makeScope[ExchangeScope](ExchangeTask, tasks, defaultItemOptions(s.Cfg)...).
+			set(ExchangeTaskList, lists, opts...))
+
+	return scopes
+}
+
+// Produces one or more exchange task list scopes.
+// Task lists act as folders to contain Tasks
+// If any slice contains selectors.Any, that slice is reduced to [selectors.Any]
+// If any slice contains selectors.None, that slice is reduced to [selectors.None]
+// If any slice is empty, it defaults to [selectors.None]
+// options are only applied to the task list scopes.
+func (s *exchange) TaskLists(lists []string, opts ...option) []ExchangeScope {
+	var (
+		scopes = []ExchangeScope{}
+		os     = append([]option{pathComparator()}, opts...)
+	)
+
+	scopes = append(
+		scopes,
+		makeScope[ExchangeScope](ExchangeTaskList, lists, os...))
+
+	return scopes
+}
+
+// Mailbox settings are kept in two folders: one for inbox rules, and one
+// for all other mailbox configuration, such as automatic replies.
+const (
+	MailboxSettingsRulesFolder  = "InboxRules"
+	MailboxSettingsConfigFolder = "Settings"
+)
+
+// Produces one or more exchange mailbox setting scopes.
+// If any slice contains selectors.Any, that slice is reduced to [selectors.Any]
+// If any slice contains selectors.None, that slice is reduced to [selectors.None]
+// If any slice is empty, it defaults to [selectors.None]
+// options are only applied to the folder scopes.
+func (s *exchange) MailboxSettings(folders, settings []string, opts ...option) []ExchangeScope {
+	scopes := []ExchangeScope{}
+
+	scopes = append(
+		scopes,
+		makeScope[ExchangeScope](ExchangeMailboxSetting, settings, defaultItemOptions(s.Cfg)...).
+			set(ExchangeMailboxSettingFolder, folders, opts...))
+
+	return scopes
+}
+
+// Produces one or more exchange inbox rule scopes.
+// Rules can be matched by either their ID or their name.
+// If any slice contains selectors.Any, that slice is reduced to [selectors.Any]
+// If any slice contains selectors.None, that slice is reduced to [selectors.None]
+// If any slice is empty, it defaults to [selectors.None]
+func (s *exchange) InboxRules(rules []string) []ExchangeScope {
+	return s.MailboxSettings([]string{MailboxSettingsRulesFolder}, rules)
+}
+
+// Produces one or more scopes for mailbox configuration other than inbox
+// rules, such as automaticReplies, workingHours, timeZone, or categories.
+// If any slice contains selectors.Any, that slice is reduced to [selectors.Any]
+// If any slice contains selectors.None, that slice is reduced to [selectors.None]
+// If any slice is empty, it defaults to [selectors.None]
+func (s *exchange) MailboxConfig(settings []string) []ExchangeScope {
+	return s.MailboxSettings([]string{MailboxSettingsConfigFolder}, settings)
+}
+
 // Retrieves all exchange data.
-// Each user id generates three scopes, one for each data type: contact, event, and mail.
+// Each user id generates three scopes, one for each data type: contact, event,
+// and mail.  Tasks and mailbox settings are opt-in, and must be selected
+// with TaskLists and MailboxSettings.  Tasks need the Tasks.ReadWrite.All
+// permission, which not every tenant grants.
 // If any slice contains selectors.Any, that slice is reduced to [selectors.Any]
 // If any slice contains selectors.None, that slice is reduced to [selectors.None]
 // If any slice is empty, it defaults to [selectors.None]
@@ -483,6 +569,21 @@ func (sr *ExchangeRestore) MailSubject(subject string) []ExchangeScope {
 	}
 }
 
+// TaskTitle produces one or more exchange task title info scopes.
+// Matches any task whose title contains one of the provided strings.
+// If any slice contains selectors.Any, that slice is reduced to [selectors.Any]
+// If any slice contains selectors.None, that slice is reduced to [selectors.None]
+// If any slice is empty, it defaults to [selectors.None]
+func (sr *ExchangeRestore) TaskTitle(title string) []ExchangeScope {
+	return []ExchangeScope{
+		makeInfoScope[ExchangeScope](
+			ExchangeTask,
+			ExchangeInfoTaskTitle,
+			[]string{title},
+			filters.In),
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Categories
 // ---------------------------------------------------------------------------
@@ -504,8 +605,13 @@ const (
 	ExchangeEventCalendar exchangeCategory = "ExchangeEventCalendar"
 	ExchangeMail          exchangeCategory = "ExchangeMail"
 	ExchangeMailFolder    exchangeCategory = "ExchangeMailFolder"
+	ExchangeTask          exchangeCategory = "ExchangeTask"
+	ExchangeTaskList      exchangeCategory = "ExchangeTaskList"
 	ExchangeUser          exchangeCategory = "ExchangeUser"
 
+	ExchangeMailboxSetting       exchangeCategory = "ExchangeMailboxSetting"
+	ExchangeMailboxSettingFolder exchangeCategory = "ExchangeMailboxSettingFolder"
+
 	// data contained within details.ItemInfo
 	ExchangeInfoMailSender         exchangeCategory = "ExchangeInfoMailSender"
 	ExchangeInfoMailSubject        exchangeCategory = "ExchangeInfoMailSubject"
@@ -517,6 +623,7 @@ const (
 	ExchangeInfoEventStartsAfter   exchangeCategory = "ExchangeInfoEventStartsAfter"
 	ExchangeInfoEventStartsBefore  exchangeCategory = "ExchangeInfoEventStartsBefore"
 	ExchangeInfoEventSubject       exchangeCategory = "ExchangeInfoEventSubject"
+	ExchangeInfoTaskTitle          exchangeCategory = "ExchangeInfoTaskTitle"
 )
 
 // exchangeLeafProperties describes common metadata of the leaf categories
@@ -533,6 +640,14 @@ var exchangeLeafProperties = map[categorizer]leafProperty{
 		pathKeys: []categorizer{ExchangeMailFolder, ExchangeMail},
 		pathType: path.EmailCategory,
 	},
+	ExchangeTask: {
+		pathKeys: []categorizer{ExchangeTaskList, ExchangeTask},
+		pathType: path.TasksCategory,
+	},
+	ExchangeMailboxSetting: {
+		pathKeys: []categorizer{ExchangeMailboxSettingFolder, ExchangeMailboxSetting},
+		pathType: path.MailboxSettingsCategory,
+	},
 	ExchangeUser: { // the root category must be represented, even though it isn't a leaf
 		pathKeys: []categorizer{ExchangeUser},
 		pathType: path.UnknownCategory,
@@ -563,6 +678,12 @@ func (ec exchangeCategory) leafCat() categorizer {
 	case ExchangeMail, ExchangeMailFolder, ExchangeInfoMailReceivedAfter,
 		ExchangeInfoMailReceivedBefore, ExchangeInfoMailSender, ExchangeInfoMailSubject:
 		return ExchangeMail
+
+	case ExchangeTask, ExchangeTaskList, ExchangeInfoTaskTitle:
+		return ExchangeTask
+
+	case ExchangeMailboxSetting, ExchangeMailboxSettingFolder:
+		return ExchangeMailboxSetting
 	}
 
 	return ec
@@ -583,7 +704,8 @@ func (ec exchangeCategory) isUnion() bool {
 	return ec == ec.rootCat()
 }
 
-// isLeaf is true if the category is a mail, event, or contact category.
+// isLeaf is true if the category is a mail, event, contact, task, or
+// mailbox setting category.
 func (ec exchangeCategory) isLeaf() bool {
 	return ec == ec.leafCat()
 }
@@ -610,6 +732,12 @@ func (ec exchangeCategory) pathValues(
 	case ExchangeMail:
 		folderCat, itemCat = ExchangeMailFolder, ExchangeMail
 
+	case ExchangeTask:
+		folderCat, itemCat = ExchangeTaskList, ExchangeTask
+
+	case ExchangeMailboxSetting:
+		folderCat, itemCat = ExchangeMailboxSettingFolder, ExchangeMailboxSetting
+
 	default:
 		return nil, clues.New("bad exchanageCategory").With("category", ec)
 	}
@@ -628,6 +756,12 @@ func (ec exchangeCategory) pathValues(
 		items = []string{ent.ShortRef}
 	}
 
+	// mailbox settings are the exception, since each has a name.  Rule
+	// IDs are opaque, so rules can always be selected by name.
+	if ec == ExchangeMailboxSetting && ent.Exchange != nil {
+		items = append(items, ent.Exchange.SettingName)
+	}
+
 	// Will hit the if-condition when we're at a top-level folder, but we'll get
 	// the same result when we extract from the RepoRef.
 	folder := ent.LocationRef
@@ -710,15 +844,17 @@ func (s ExchangeScope) Get(cat exchangeCategory) []string {
 // sets a value by category to the scope.  Only intended for internal use.
 func (s ExchangeScope) set(cat exchangeCategory, v []string, opts ...option) ExchangeScope {
 	os := []option{}
-	if cat == ExchangeContactFolder || cat == ExchangeEventCalendar || cat == ExchangeMailFolder {
+	if cat == ExchangeContactFolder || cat == ExchangeEventCalendar || cat == ExchangeMailFolder ||
+		cat == ExchangeTaskList || cat == ExchangeMailboxSettingFolder {
 		os = append(os, pathComparator())
 	}
 
 	return set(s, cat, v, append(os, opts...)...)
 }
 
-// setDefaults ensures that contact folder, mail folder, and user category
-// scopes all express `AnyTgt` for their child category types.
+// setDefaults ensures that contact folder, mail folder, task list, mailbox
+// setting folder, and user category scopes all express `AnyTgt` for their
+// child category types.
 func (s ExchangeScope) setDefaults() {
 	switch s.Category() {
 	case ExchangeContactFolder:
@@ -730,12 +866,22 @@ func (s ExchangeScope) setDefaults() {
 	case ExchangeMailFolder:
 		s[ExchangeMail.String()] = passAny
 
+	case ExchangeTaskList:
+		s[ExchangeTask.String()] = passAny
+
+	case ExchangeMailboxSettingFolder:
+		s[ExchangeMailboxSetting.String()] = passAny
+
 	case ExchangeUser:
 		s[ExchangeContactFolder.String()] = passAny
 		s[ExchangeContact.String()] = passAny
 		s[ExchangeEvent.String()] = passAny
 		s[ExchangeMailFolder.String()] = passAny
 		s[ExchangeMail.String()] = passAny
+		s[ExchangeTaskList.String()] = passAny
+		s[ExchangeTask.String()] = passAny
+		s[ExchangeMailboxSettingFolder.String()] = passAny
+		s[ExchangeMailboxSetting.String()] = passAny
 	}
 }
 
@@ -755,9 +901,11 @@ func (s exchange) Reduce(
 		deets,
 		s.Selector,
 		map[path.CategoryType]exchangeCategory{
-			path.ContactsCategory: ExchangeContact,
-			path.EventsCategory:   ExchangeEvent,
-			path.EmailCategory:    ExchangeMail,
+			path.ContactsCategory:        ExchangeContact,
+			path.EventsCategory:          ExchangeEvent,
+			path.EmailCategory:           ExchangeMail,
+			path.TasksCategory:           ExchangeTask,
+			path.MailboxSettingsCategory: ExchangeMailboxSetting,
 		},
 		errs)
 }
@@ -796,6 +944,8 @@ func (s ExchangeScope) matchesInfo(dii details.ItemInfo) bool {
 		i = info.Subject
 	case ExchangeInfoMailReceivedAfter, ExchangeInfoMailReceivedBefore:
 		i = dttm.Format(info.Received)
+	case ExchangeInfoTaskTitle:
+		i = info.Subject
 	}
 
 	return s.Matches(infoCat, i)
@@ -812,6 +962,10 @@ func exchangeCategoryFromItemType(pct details.ItemType) exchangeCategory {
 		return ExchangeMail
 	case details.ExchangeEvent:
 		return ExchangeEvent
+	case details.ExchangeTask:
+		return ExchangeTask
+	case details.ExchangeMailboxSetting:
+		return ExchangeMailboxSetting
 	}
 
 	return ExchangeCategoryUnknown
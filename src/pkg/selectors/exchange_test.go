@@ -252,6 +252,84 @@ func (suite *ExchangeSelectorSuite) TestExchangeSelector_Include_EventCalendars(
 		})
 }
 
+func (suite *ExchangeSelectorSuite) TestExchangeSelector_Include_Tasks() {
+	t := suite.T()
+
+	const (
+		user = "user"
+		t1   = "t1"
+		t2   = "t2"
+		l1   = "l1"
+	)
+
+	sel := NewExchangeBackup([]string{user})
+	sel.Include(sel.Tasks([]string{l1}, []string{t1, t2}))
+	scopes := sel.Includes
+	require.Len(t, scopes, 1)
+
+	scopeMustHave(
+		t,
+		ExchangeScope(scopes[0]),
+		map[categorizer][]string{
+			ExchangeTaskList: {l1},
+			ExchangeTask:     {t1, t2},
+		})
+}
+
+func (suite *ExchangeSelectorSuite) TestExchangeSelector_Include_TaskLists() {
+	t := suite.T()
+
+	const (
+		user = "user"
+		l1   = "l1"
+		l2   = "l2"
+	)
+
+	sel := NewExchangeBackup([]string{user})
+	sel.Include(sel.TaskLists([]string{l1, l2}))
+	scopes := sel.Includes
+	require.Len(t, scopes, 1)
+
+	scopeMustHave(
+		t,
+		ExchangeScope(scopes[0]),
+		map[categorizer][]string{
+			ExchangeTaskList: {l1, l2},
+			ExchangeTask:     Any(),
+		})
+}
+
+func (suite *ExchangeSelectorSuite) TestExchangeSelector_Include_MailboxSettings() {
+	t := suite.T()
+
+	const (
+		user = "user"
+		r1   = "r1"
+		s1   = "s1"
+	)
+
+	sel := NewExchangeBackup([]string{user})
+	sel.Include(sel.InboxRules([]string{r1}), sel.MailboxConfig([]string{s1}))
+	scopes := sel.Includes
+	require.Len(t, scopes, 2)
+
+	scopeMustHave(
+		t,
+		ExchangeScope(scopes[0]),
+		map[categorizer][]string{
+			ExchangeMailboxSettingFolder: {MailboxSettingsRulesFolder},
+			ExchangeMailboxSetting:       {r1},
+		})
+
+	scopeMustHave(
+		t,
+		ExchangeScope(scopes[1]),
+		map[categorizer][]string{
+			ExchangeMailboxSettingFolder: {MailboxSettingsConfigFolder},
+			ExchangeMailboxSetting:       {s1},
+		})
+}
+
 func (suite *ExchangeSelectorSuite) TestExchangeSelector_Exclude_Mails() {
 	t := suite.T()
 
@@ -679,6 +757,11 @@ func (suite *ExchangeSelectorSuite) TestExchangeScope_MatchesInfo() {
 		{"contact with a different name", details.ExchangeContact, es.ContactName("blarps"), assert.False},
 		{"contact with the same name", details.ExchangeContact, es.ContactName(name), assert.True},
 		{"contact with a subname search", details.ExchangeContact, es.ContactName(name[2:5]), assert.True},
+		{"task with any title", details.ExchangeTask, es.TaskTitle(AnyTgt), assert.True},
+		{"task with none title", details.ExchangeTask, es.TaskTitle(NoneTgt), assert.False},
+		{"task with a different title", details.ExchangeTask, es.TaskTitle("fancy"), assert.False},
+		{"task with the matching title", details.ExchangeTask, es.TaskTitle(subject), assert.True},
+		{"mail doesn't match a task title", details.ExchangeMail, es.TaskTitle(subject), assert.False},
 	}
 	for _, test := range table {
 		suite.Run(test.name, func() {
@@ -1463,6 +1546,9 @@ func (suite *ExchangeSelectorSuite) TestExchangeCategory_leafCat() {
 		{ExchangeMail, ExchangeMail},
 		{ExchangeContactFolder, ExchangeContact},
 		{ExchangeEvent, ExchangeEvent},
+		{ExchangeTaskList, ExchangeTask},
+		{ExchangeInfoTaskTitle, ExchangeTask},
+		{ExchangeMailboxSettingFolder, ExchangeMailboxSetting},
 	}
 	for _, test := range table {
 		suite.Run(test.cat.String(), func() {
@@ -1546,6 +1632,33 @@ func (suite *ExchangeSelectorSuite) TestExchangeCategory_PathValues() {
 	}
 }
 
+func (suite *ExchangeSelectorSuite) TestExchangeCategory_PathValues_mailboxSettings() {
+	t := suite.T()
+
+	p := stubPath(t, "u", []string{MailboxSettingsRulesFolder, "ruleid"}, path.MailboxSettingsCategory)
+	ent := details.Entry{
+		RepoRef:     p.String(),
+		ShortRef:    "rule-short",
+		LocationRef: p.Folder(true),
+		ItemRef:     p.Item(),
+		ItemInfo: details.ItemInfo{
+			Exchange: &details.ExchangeInfo{
+				ItemType:    details.ExchangeMailboxSetting,
+				SettingName: "Forward invoices",
+			},
+		},
+	}
+
+	pvs, err := ExchangeMailboxSetting.pathValues(p, ent, Config{})
+	require.NoError(t, err, clues.ToCore(err))
+	assert.ElementsMatch(t, []string{MailboxSettingsRulesFolder}, pvs[ExchangeMailboxSettingFolder])
+	assert.ElementsMatch(t, []string{"rule-short", "ruleid", "Forward invoices"}, pvs[ExchangeMailboxSetting])
+
+	pvs, err = ExchangeMailboxSetting.pathValues(p, ent, Config{OnlyMatchItemNames: true})
+	require.NoError(t, err, clues.ToCore(err))
+	assert.ElementsMatch(t, []string{"rule-short", "Forward invoices"}, pvs[ExchangeMailboxSetting])
+}
+
 func (suite *ExchangeSelectorSuite) TestExchangeCategory_PathKeys() {
 	contact := []categorizer{ExchangeContactFolder, ExchangeContact}
 	event := []categorizer{ExchangeEventCalendar, ExchangeEvent}
@@ -1592,6 +1705,16 @@ func (suite *ExchangeSelectorSuite) TestCategoryFromItemType() {
 			input:  details.ExchangeMail,
 			expect: ExchangeMail,
 		},
+		{
+			name:   "task",
+			input:  details.ExchangeTask,
+			expect: ExchangeTask,
+		},
+		{
+			name:   "mailbox setting",
+			input:  details.ExchangeMailboxSetting,
+			expect: ExchangeMailboxSetting,
+		},
 		{
 			name:   "unknown",
 			input:  details.UnknownType,
@@ -1631,6 +1754,11 @@ func (suite *ExchangeSelectorSuite) TestCategory_PathType() {
 		{ExchangeInfoEventStartsAfter, path.EventsCategory},
 		{ExchangeInfoEventStartsBefore, path.EventsCategory},
 		{ExchangeInfoEventSubject, path.EventsCategory},
+		{ExchangeTask, path.TasksCategory},
+		{ExchangeTaskList, path.TasksCategory},
+		{ExchangeInfoTaskTitle, path.TasksCategory},
+		{ExchangeMailboxSetting, path.MailboxSettingsCategory},
+		{ExchangeMailboxSettingFolder, path.MailboxSettingsCategory},
 	}
 	for _, test := range table {
 		suite.Run(test.cat.String(), func() {
@@ -41,9 +41,10 @@ type (
 )
 
 var (
-	_ Reducer        = &GroupsRestore{}
-	_ pathCategorier = &GroupsRestore{}
-	_ reasoner       = &GroupsRestore{}
+	_ Reducer             = &GroupsRestore{}
+	_ pathCategorier      = &GroupsRestore{}
+	_ reasoner            = &GroupsRestore{}
+	_ pathCategoryLimiter = &GroupsRestore{}
 )
 
 // NewGroupsBackup produces a new Selector with the service set to ServiceGroups.
@@ -70,7 +71,7 @@ func (s Selector) ToGroupsBackup() (*GroupsBackup, error) {
 }
 
 func (s GroupsBackup) SplitByResourceOwner(resources []string) []GroupsBackup {
-	sels := splitByProtectedResource[GroupsScope](s.Selector, resources, GroupsGroup)
+	sels := s.Selector.SplitByProtectedResource(resources)
 
 	ss := make([]GroupsBackup, 0, len(sels))
 	for _, sel := range sels {
@@ -104,7 +105,7 @@ func (s Selector) ToGroupsRestore() (*GroupsRestore, error) {
 }
 
 func (s GroupsRestore) SplitByResourceOwner(resources []string) []GroupsRestore {
-	sels := splitByProtectedResource[GroupsScope](s.Selector, resources, GroupsGroup)
+	sels := s.Selector.SplitByProtectedResource(resources)
 
 	ss := make([]GroupsRestore, 0, len(sels))
 	for _, sel := range sels {
@@ -123,6 +124,12 @@ func (s groups) PathCategories() selectorPathCategories {
 	}
 }
 
+// LimitPathCategories produces a clone of the selector which only keeps the
+// scopes within the provided path categories.
+func (s groups) LimitPathCategories(cats []path.CategoryType) Selector {
+	return limitPathCategories[GroupsScope, groupsCategory](s.Selector, cats)
+}
+
 // Reasons returns a deduplicated set of the backup reasons produced
 // using the selector's discrete owner and each scopes' service and
 // category types.
@@ -41,9 +41,10 @@ type (
 )
 
 var (
-	_ Reducer        = &OneDriveRestore{}
-	_ pathCategorier = &OneDriveRestore{}
-	_ reasoner       = &OneDriveRestore{}
+	_ Reducer             = &OneDriveRestore{}
+	_ pathCategorier      = &OneDriveRestore{}
+	_ reasoner            = &OneDriveRestore{}
+	_ pathCategoryLimiter = &OneDriveRestore{}
 )
 
 // NewOneDriveBackup produces a new Selector with the service set to ServiceOneDrive.
@@ -70,7 +71,7 @@ func (s Selector) ToOneDriveBackup() (*OneDriveBackup, error) {
 }
 
 func (s OneDriveBackup) SplitByResourceOwner(users []string) []OneDriveBackup {
-	sels := splitByProtectedResource[OneDriveScope](s.Selector, users, OneDriveUser)
+	sels := s.Selector.SplitByProtectedResource(users)
 
 	ss := make([]OneDriveBackup, 0, len(sels))
 	for _, sel := range sels {
@@ -104,7 +105,7 @@ func (s Selector) ToOneDriveRestore() (*OneDriveRestore, error) {
 }
 
 func (s OneDriveRestore) SplitByResourceOwner(users []string) []OneDriveRestore {
-	sels := splitByProtectedResource[OneDriveScope](s.Selector, users, OneDriveUser)
+	sels := s.Selector.SplitByProtectedResource(users)
 
 	ss := make([]OneDriveRestore, 0, len(sels))
 	for _, sel := range sels {
@@ -123,6 +124,12 @@ func (s oneDrive) PathCategories() selectorPathCategories {
 	}
 }
 
+// LimitPathCategories produces a clone of the selector which only keeps the
+// scopes within the provided path categories.
+func (s oneDrive) LimitPathCategories(cats []path.CategoryType) Selector {
+	return limitPathCategories[OneDriveScope, oneDriveCategory](s.Selector, cats)
+}
+
 // Reasons returns a deduplicated set of the backup reasons produced
 // using the selector's discrete owner and each scopes' service and
 // category types.
@@ -208,6 +215,7 @@ func (s *oneDrive) Scopes() []OneDriveScope {
 
 // Retrieves all OneDrive data.
 // One scope is created per user entry.
+// Notebooks are not included; use Notebooks() to select them.
 // If any slice contains selectors.Any, that slice is reduced to [selectors.Any]
 // If any slice contains selectors.None, that slice is reduced to [selectors.None]
 // If any slice is empty, it defaults to [selectors.None]
@@ -253,6 +261,41 @@ func (s *oneDrive) Items(folders, items []string, opts ...option) []OneDriveScop
 	return scopes
 }
 
+// Notebooks produces one or more OneNote notebook scopes.  Notebooks are
+// matched by their location: the notebook, section group, and section names.
+// If any slice contains selectors.Any, that slice is reduced to [selectors.Any]
+// If any slice contains selectors.None, that slice is reduced to [selectors.None]
+// If any slice is empty, it defaults to [selectors.None]
+// options are only applied to the notebook scopes.
+func (s *oneDrive) Notebooks(notebooks []string, opts ...option) []OneDriveScope {
+	var (
+		scopes = []OneDriveScope{}
+		os     = append([]option{pathComparator()}, opts...)
+	)
+
+	scopes = append(
+		scopes,
+		makeScope[OneDriveScope](OneDriveNotebook, notebooks, os...))
+
+	return scopes
+}
+
+// NotebookPages produces one or more OneNote page scopes.
+// If any slice contains selectors.Any, that slice is reduced to [selectors.Any]
+// If any slice contains selectors.None, that slice is reduced to [selectors.None]
+// If any slice is empty, it defaults to [selectors.None]
+// options are only applied to the notebook scopes.
+func (s *oneDrive) NotebookPages(notebooks, pages []string, opts ...option) []OneDriveScope {
+	scopes := []OneDriveScope{}
+
+	scopes = append(
+		scopes,
+		makeScope[OneDriveScope](OneDriveNotebookPage, pages, defaultItemOptions(s.Cfg)...).
+			set(OneDriveNotebook, notebooks, opts...))
+
+	return scopes
+}
+
 // -------------------
 // Filter Factories
 
@@ -331,6 +374,10 @@ const (
 	OneDriveItem   oneDriveCategory = "OneDriveItem"
 	OneDriveFolder oneDriveCategory = "OneDriveFolder"
 
+	// OneNote notebooks in OneDrive
+	OneDriveNotebook     oneDriveCategory = "OneDriveNotebook"
+	OneDriveNotebookPage oneDriveCategory = "OneDriveNotebookPage"
+
 	// details.ItemInfo comparables
 	FileInfoCreatedAfter   oneDriveCategory = "FileInfoCreatedAfter"
 	FileInfoCreatedBefore  oneDriveCategory = "FileInfoCreatedBefore"
@@ -344,6 +391,10 @@ var oneDriveLeafProperties = map[categorizer]leafProperty{
 		pathKeys: []categorizer{OneDriveFolder, OneDriveItem},
 		pathType: path.FilesCategory,
 	},
+	OneDriveNotebookPage: {
+		pathKeys: []categorizer{OneDriveNotebook, OneDriveNotebookPage},
+		pathType: path.NotebooksCategory,
+	},
 	OneDriveUser: { // the root category must be represented, even though it isn't a leaf
 		pathKeys: []categorizer{OneDriveUser},
 		pathType: path.UnknownCategory,
@@ -365,6 +416,8 @@ func (c oneDriveCategory) leafCat() categorizer {
 		FileInfoCreatedAfter, FileInfoCreatedBefore,
 		FileInfoModifiedAfter, FileInfoModifiedBefore:
 		return OneDriveItem
+	case OneDriveNotebook, OneDriveNotebookPage:
+		return OneDriveNotebookPage
 	}
 
 	return c
@@ -385,10 +438,10 @@ func (c oneDriveCategory) isUnion() bool {
 	return c == c.rootCat()
 }
 
-// isLeaf is true if the category is a OneDriveItem category.
+// isLeaf is true if the category is a OneDriveItem or OneDriveNotebookPage category.
 func (c oneDriveCategory) isLeaf() bool {
 	// return c == c.leafCat()??
-	return c == OneDriveItem
+	return c == OneDriveItem || c == OneDriveNotebookPage
 }
 
 // pathValues transforms the two paths to maps of identified properties.
@@ -405,9 +458,17 @@ func (c oneDriveCategory) pathValues(
 		return nil, clues.New("no OneDrive ItemInfo in details")
 	}
 
+	folderCat, itemCat := OneDriveFolder, OneDriveItem
+
 	// Ignore `drives/<driveID>/root:` for folder comparison
 	rFld := ent.OneDrive.ParentPath
 
+	// notebook pages have no drive root, so their location is used as-is.
+	if c == OneDriveNotebook || c == OneDriveNotebookPage {
+		folderCat, itemCat = OneDriveNotebook, OneDriveNotebookPage
+		rFld = ent.LocationRef
+	}
+
 	item := ent.ItemRef
 	if len(item) == 0 {
 		item = repo.Item()
@@ -418,11 +479,11 @@ func (c oneDriveCategory) pathValues(
 	}
 
 	result := map[categorizer][]string{
-		OneDriveFolder: {rFld},
-		OneDriveItem:   {item, ent.ShortRef},
+		folderCat: {rFld},
+		itemCat:   {item, ent.ShortRef},
 	}
 
-	if len(ent.LocationRef) > 0 {
+	if folderCat == OneDriveFolder && len(ent.LocationRef) > 0 {
 		result[OneDriveFolder] = append(result[OneDriveFolder], ent.LocationRef)
 	}
 
@@ -497,7 +558,7 @@ func (s OneDriveScope) Get(cat oneDriveCategory) []string {
 // sets a value by category to the scope.  Only intended for internal use.
 func (s OneDriveScope) set(cat oneDriveCategory, v []string, opts ...option) OneDriveScope {
 	os := []option{}
-	if cat == OneDriveFolder {
+	if cat == OneDriveFolder || cat == OneDriveNotebook {
 		os = append(os, pathComparator())
 	}
 
@@ -510,8 +571,12 @@ func (s OneDriveScope) setDefaults() {
 	case OneDriveUser:
 		s[OneDriveFolder.String()] = passAny
 		s[OneDriveItem.String()] = passAny
+		s[OneDriveNotebook.String()] = passAny
+		s[OneDriveNotebookPage.String()] = passAny
 	case OneDriveFolder:
 		s[OneDriveItem.String()] = passAny
+	case OneDriveNotebook:
+		s[OneDriveNotebookPage.String()] = passAny
 	}
 }
 
@@ -531,7 +596,8 @@ func (s oneDrive) Reduce(
 		deets,
 		s.Selector,
 		map[path.CategoryType]oneDriveCategory{
-			path.FilesCategory: OneDriveItem,
+			path.FilesCategory:     OneDriveItem,
+			path.NotebooksCategory: OneDriveNotebookPage,
 		},
 		errs)
 }
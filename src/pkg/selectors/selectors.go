@@ -92,6 +92,10 @@ type pathCategorier interface {
 	PathCategories() selectorPathCategories
 }
 
+type pathCategoryLimiter interface {
+	LimitPathCategories(cats []path.CategoryType) Selector
+}
+
 type pathServicer interface {
 	PathService() path.ServiceType
 }
@@ -230,24 +234,21 @@ func isNoneProtectedResource(s Selector) bool {
 	return s.ResourceOwners.Comparator == filters.Fails
 }
 
-// splitByProtectedResource makes one shallow clone for each resourceOwner in the
-// selector, specifying a new DiscreteOwner for each one.
+// SplitByProtectedResource makes one shallow clone of the selector for each
+// of its protected resources, specifying a new DiscreteOwner for each one.
 // If the original selector already specified a discrete slice of resource owners,
 // only those owners are used in the result.
-// If the original selector allowed Any() resource owner, the allOwners parameter
-// is used to populate the slice.  allOwners is assumed to be the complete slice of
+// If the original selector allowed Any() resource owner, the allResources parameter
+// is used to populate the slice.  allResources is assumed to be the complete slice of
 // resourceOwners in the tenant for the given service.
 // If the original selector specified None(), thus failing all resource owners,
 // an empty slice is returned.
-//
-// temporarily, clones all scopes in each selector and replaces the owners with
-// the discrete owner.
-func splitByProtectedResource[T scopeT, C categoryT](s Selector, allOwners []string, rootCat C) []Selector {
+func (s Selector) SplitByProtectedResource(allResources []string) []Selector {
 	if isNoneProtectedResource(s) {
 		return []Selector{}
 	}
 
-	targets := allOwners
+	targets := allResources
 
 	if !isAnyProtectedResource(s) {
 		targets = s.ResourceOwners.Targets
@@ -296,6 +297,81 @@ func (s Selector) PathCategories() (selectorPathCategories, error) {
 	return ro.PathCategories(), nil
 }
 
+// LimitPathCategories returns a clone of the selector which only keeps the
+// scopes within the provided path categories.
+func (s Selector) LimitPathCategories(cats ...path.CategoryType) (Selector, error) {
+	ro, err := selectorAsIface[pathCategoryLimiter](s)
+	if err != nil {
+		return Selector{}, err
+	}
+
+	return ro.LimitPathCategories(cats), nil
+}
+
+// SelectsPartialFolders is true if the selector includes only some of the
+// drive files within a folder, such as by file name, or by the time files
+// were created or modified.  Excluding drive files has the same effect.
+func (s Selector) SelectsPartialFolders() bool {
+	switch s.Service {
+	case ServiceOneDrive:
+		return selectsPartialFolders[OneDriveScope](
+			s,
+			OneDriveItem,
+			FileInfoCreatedAfter, FileInfoCreatedBefore,
+			FileInfoModifiedAfter, FileInfoModifiedBefore)
+	case ServiceSharePoint:
+		return selectsPartialFolders[SharePointScope](
+			s,
+			SharePointLibraryItem,
+			SharePointInfoCreatedAfter, SharePointInfoCreatedBefore,
+			SharePointInfoModifiedAfter, SharePointInfoModifiedBefore)
+	case ServiceGroups:
+		return selectsPartialFolders[GroupsScope](
+			s,
+			GroupsLibraryItem,
+			GroupsInfoLibraryItemCreatedAfter, GroupsInfoLibraryItemCreatedBefore,
+			GroupsInfoLibraryItemModifiedAfter, GroupsInfoLibraryItemModifiedBefore)
+	}
+
+	return false
+}
+
+// selectsPartialFolders is true if any scope of the item's category
+// restricts the item value, or filters items on one of the info categories.
+func selectsPartialFolders[T scopeT, C categoryT](s Selector, item C, infoCats ...C) bool {
+	isItemScope := func(sc scope) bool {
+		return T(sc).categorizer().leafCat().String() == item.String()
+	}
+
+	for _, sc := range s.Excludes {
+		if isItemScope(sc) {
+			return true
+		}
+	}
+
+	for _, sc := range append(slices.Clone(s.Includes), s.Filters...) {
+		if !isItemScope(sc) {
+			continue
+		}
+
+		t := T(sc)
+
+		if ic := getInfoCategory(t); len(ic) > 0 {
+			if slices.ContainsFunc(infoCats, func(c C) bool { return c.String() == ic }) {
+				return true
+			}
+
+			continue
+		}
+
+		if !IsAnyTarget(t, item) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // AllHumanPathCategories returns the sets of include and filter path categories
 // across all scope sets. This is good for logging because it returns the
 // string version of the categories and sorts the slice so the category set is
@@ -447,6 +523,29 @@ func (ls loggableSelector) marshal() string {
 // helpers
 // ---------------------------------------------------------------------------
 
+// limitPathCategories clones the selector, keeping only the scopes whose
+// leaf category matches one of the path categories.
+func limitPathCategories[T scopeT, C categoryT](s Selector, cats []path.CategoryType) Selector {
+	inCats := func(ss []scope) []scope {
+		res := []scope{}
+
+		for _, sc := range ss {
+			if slices.Contains(cats, T(sc).categorizer().leafCat().PathType()) {
+				res = append(res, sc)
+			}
+		}
+
+		return res
+	}
+
+	r := s
+	r.Excludes = inCats(s.Excludes)
+	r.Filters = inCats(s.Filters)
+	r.Includes = inCats(s.Includes)
+
+	return r
+}
+
 // produces the discrete set of path categories in the slice of scopes.
 func pathCategoriesIn[T scopeT, C categoryT](ss []scope) []path.CategoryType {
 	m := map[path.CategoryType]struct{}{}
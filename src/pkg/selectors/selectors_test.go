@@ -6,6 +6,7 @@ import (
 
 	"github.com/alcionai/clues"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/alcionai/corso/src/internal/tester"
@@ -174,7 +175,7 @@ func (suite *SelectorSuite) TestSplitByResourceOnwer() {
 			t := suite.T()
 
 			s := newSelector(ServiceUnknown, test.input)
-			result := splitByProtectedResource[mockScope](s, allOwners, rootCatStub)
+			result := s.SplitByProtectedResource(allOwners)
 
 			assert.Len(t, result, test.expectLen)
 
@@ -367,6 +368,149 @@ func (suite *SelectorSuite) TestPathCategories_includes() {
 	}
 }
 
+func (suite *SelectorSuite) TestLimitPathCategories() {
+	t := suite.T()
+
+	sel := NewExchangeRestore(Any())
+	sel.Include(sel.AllData())
+	sel.Filter(sel.MailSubject("subject"))
+	sel.Exclude(sel.EventCalendars([]string{"July"}))
+
+	limited, err := sel.Selector.LimitPathCategories(path.EventsCategory)
+	require.NoError(t, err, clues.ToCore(err))
+
+	cats, err := limited.PathCategories()
+	require.NoError(t, err, clues.ToCore(err))
+
+	assert.ElementsMatch(t, []path.CategoryType{path.EventsCategory}, cats.Includes)
+	assert.Empty(t, cats.Filters)
+	assert.ElementsMatch(t, []path.CategoryType{path.EventsCategory}, cats.Excludes)
+
+	// the original selector is unchanged.
+	assert.Len(t, sel.PathCategories().Includes, 3)
+
+	_, err = Selector{}.LimitPathCategories(path.EventsCategory)
+	assert.Error(t, err, clues.ToCore(err))
+}
+
+func (suite *SelectorSuite) TestSelectsPartialFolders() {
+	table := []struct {
+		name   string
+		sel    func() Selector
+		expect assert.BoolAssertionFunc
+	}{
+		{
+			name: "onedrive all data",
+			sel: func() Selector {
+				sel := NewOneDriveRestore(Any())
+				sel.Include(sel.AllData(), sel.Notebooks(Any()))
+
+				return sel.Selector
+			},
+			expect: assert.False,
+		},
+		{
+			name: "onedrive folders",
+			sel: func() Selector {
+				sel := NewOneDriveRestore(Any())
+				sel.Include(sel.Folders([]string{"a"}))
+
+				return sel.Selector
+			},
+			expect: assert.False,
+		},
+		{
+			name: "onedrive file names",
+			sel: func() Selector {
+				sel := NewOneDriveRestore(Any())
+				sel.Include(sel.Items(Any(), []string{"file"}))
+
+				return sel.Selector
+			},
+			expect: assert.True,
+		},
+		{
+			name: "onedrive created after",
+			sel: func() Selector {
+				sel := NewOneDriveRestore(Any())
+				sel.Include(sel.AllData())
+				sel.Filter(sel.CreatedAfter("2023-01-01T00:00:00Z"))
+
+				return sel.Selector
+			},
+			expect: assert.True,
+		},
+		{
+			name: "onedrive excluded files",
+			sel: func() Selector {
+				sel := NewOneDriveRestore(Any())
+				sel.Include(sel.AllData())
+				sel.Exclude(sel.Items(Any(), []string{"file"}))
+
+				return sel.Selector
+			},
+			expect: assert.True,
+		},
+		{
+			name: "sharepoint library",
+			sel: func() Selector {
+				sel := NewSharePointRestore(Any())
+				sel.Include(sel.LibraryFolders(Any()))
+				sel.Filter(sel.Library("Documents"))
+
+				return sel.Selector
+			},
+			expect: assert.False,
+		},
+		{
+			name: "sharepoint file names",
+			sel: func() Selector {
+				sel := NewSharePointRestore(Any())
+				sel.Include(sel.LibraryItems(Any(), []string{"file"}))
+
+				return sel.Selector
+			},
+			expect: assert.True,
+		},
+		{
+			name: "sharepoint modified before",
+			sel: func() Selector {
+				sel := NewSharePointRestore(Any())
+				sel.Include(sel.LibraryFolders(Any()))
+				sel.Filter(sel.ModifiedBefore("2023-01-01T00:00:00Z"))
+
+				return sel.Selector
+			},
+			expect: assert.True,
+		},
+		{
+			name: "groups file names",
+			sel: func() Selector {
+				sel := NewGroupsRestore(Any())
+				sel.Include(sel.LibraryItems(Any(), []string{"file"}))
+
+				return sel.Selector
+			},
+			expect: assert.True,
+		},
+		{
+			name: "exchange",
+			sel: func() Selector {
+				sel := NewExchangeRestore(Any())
+				sel.Include(sel.Mails(Any(), []string{"mail"}))
+
+				return sel.Selector
+			},
+			expect: assert.False,
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			test.expect(suite.T(), test.sel().SelectsPartialFolders())
+		})
+	}
+}
+
 func (suite *SelectorSuite) TestSelector_pii() {
 	table := []struct {
 		name        string
@@ -41,9 +41,10 @@ type (
 )
 
 var (
-	_ Reducer        = &SharePointRestore{}
-	_ pathCategorier = &SharePointRestore{}
-	_ reasoner       = &SharePointRestore{}
+	_ Reducer             = &SharePointRestore{}
+	_ pathCategorier      = &SharePointRestore{}
+	_ reasoner            = &SharePointRestore{}
+	_ pathCategoryLimiter = &SharePointRestore{}
 )
 
 // NewSharePointBackup produces a new Selector with the service set to ServiceSharePoint.
@@ -70,7 +71,7 @@ func (s Selector) ToSharePointBackup() (*SharePointBackup, error) {
 }
 
 func (s SharePointBackup) SplitByResourceOwner(sites []string) []SharePointBackup {
-	sels := splitByProtectedResource[SharePointScope](s.Selector, sites, SharePointSite)
+	sels := s.Selector.SplitByProtectedResource(sites)
 
 	ss := make([]SharePointBackup, 0, len(sels))
 	for _, sel := range sels {
@@ -104,7 +105,7 @@ func (s Selector) ToSharePointRestore() (*SharePointRestore, error) {
 }
 
 func (s SharePointRestore) SplitByResourceOwner(sites []string) []SharePointRestore {
-	sels := splitByProtectedResource[SharePointScope](s.Selector, sites, SharePointSite)
+	sels := s.Selector.SplitByProtectedResource(sites)
 
 	ss := make([]SharePointRestore, 0, len(sels))
 	for _, sel := range sels {
@@ -123,6 +124,12 @@ func (s sharePoint) PathCategories() selectorPathCategories {
 	}
 }
 
+// LimitPathCategories produces a clone of the selector which only keeps the
+// scopes within the provided path categories.
+func (s sharePoint) LimitPathCategories(cats []path.CategoryType) Selector {
+	return limitPathCategories[SharePointScope, sharePointCategory](s.Selector, cats)
+}
+
 // Reasons returns a deduplicated set of the backup reasons produced
 // using the selector's discrete owner and each scopes' service and
 // category types.
@@ -243,6 +250,7 @@ func (s *SharePointRestore) WebURL(urls []string, opts ...option) []SharePointSc
 
 // Produces one or more SharePoint site scopes.
 // One scope is created per site entry.
+// Notebooks are not included; use Notebooks() to select them.
 // If any slice contains selectors.Any, that slice is reduced to [selectors.Any]
 // If any slice contains selectors.None, that slice is reduced to [selectors.None]
 // If any slice is empty, it defaults to [selectors.None]
@@ -367,6 +375,38 @@ func (s *sharePoint) PageItems(pages, items []string, opts ...option) []SharePoi
 	return scopes
 }
 
+// Notebooks produces one or more SharePoint OneNote notebook scopes.  Notebooks
+// are matched by their location: the notebook, section group, and section names.
+// If any slice contains selectors.Any, that slice is reduced to [selectors.Any]
+// If any slice contains selectors.None, that slice is reduced to [selectors.None]
+// If any slice is empty, it defaults to [selectors.None]
+func (s *sharePoint) Notebooks(notebooks []string, opts ...option) []SharePointScope {
+	var (
+		scopes = []SharePointScope{}
+		os     = append([]option{pathComparator()}, opts...)
+	)
+
+	scopes = append(scopes, makeScope[SharePointScope](SharePointNotebook, notebooks, os...))
+
+	return scopes
+}
+
+// NotebookPages produces one or more SharePoint OneNote page scopes.
+// If any slice contains selectors.Any, that slice is reduced to [selectors.Any]
+// If any slice contains selectors.None, that slice is reduced to [selectors.None]
+// If any slice is empty, it defaults to [selectors.None]
+// options are only applied to the notebook scopes.
+func (s *sharePoint) NotebookPages(notebooks, pages []string, opts ...option) []SharePointScope {
+	scopes := []SharePointScope{}
+
+	scopes = append(
+		scopes,
+		makeScope[SharePointScope](SharePointNotebookPage, pages, defaultItemOptions(s.Cfg)...).
+			set(SharePointNotebook, notebooks, opts...))
+
+	return scopes
+}
+
 // -------------------
 // ItemInfo Factories
 
@@ -473,6 +513,8 @@ const (
 	SharePointLibraryItem   sharePointCategory = "SharePointLibraryItem"
 	SharePointPageFolder    sharePointCategory = "SharePointPageFolder"
 	SharePointPage          sharePointCategory = "SharePointPage"
+	SharePointNotebook      sharePointCategory = "SharePointNotebook"
+	SharePointNotebookPage  sharePointCategory = "SharePointNotebookPage"
 
 	// details.itemInfo comparables
 	SharePointInfoCreatedAfter   sharePointCategory = "SharePointInfoCreatedAfter"
@@ -503,6 +545,10 @@ var sharePointLeafProperties = map[categorizer]leafProperty{
 		pathKeys: []categorizer{SharePointPageFolder, SharePointPage},
 		pathType: path.PagesCategory,
 	},
+	SharePointNotebookPage: {
+		pathKeys: []categorizer{SharePointNotebook, SharePointNotebookPage},
+		pathType: path.NotebooksCategory,
+	},
 	SharePointSite: { // the root category must be represented, even though it isn't a leaf
 		pathKeys: []categorizer{SharePointSite},
 		pathType: path.UnknownCategory,
@@ -530,6 +576,8 @@ func (c sharePointCategory) leafCat() categorizer {
 		return SharePointListItem
 	case SharePointPage, SharePointPageFolder:
 		return SharePointPage
+	case SharePointNotebook, SharePointNotebookPage:
+		return SharePointNotebookPage
 	}
 
 	return c
@@ -598,6 +646,15 @@ func (c sharePointCategory) pathValues(
 		rFld = ent.LocationRef
 		itemName = ent.ItemInfo.SharePoint.ItemName
 
+	case SharePointNotebook, SharePointNotebookPage:
+		if ent.SharePoint == nil {
+			return nil, clues.New("no SharePoint ItemInfo in details")
+		}
+
+		folderCat, itemCat = SharePointNotebook, SharePointNotebookPage
+		rFld = ent.LocationRef
+		itemName = ent.ItemInfo.SharePoint.ItemName
+
 	default:
 		return nil, clues.New("unrecognized sharePointCategory").With("category", c)
 	}
@@ -697,7 +754,7 @@ func (s SharePointScope) set(cat sharePointCategory, v []string, opts ...option)
 	// 1.there is no nested folders -> there cannot be lists within other lists
 	// 2. list itself is the item -> so container and item are the same
 	// since there is no path involved here, we do not need any path filters.
-	case SharePointLibraryFolder, SharePointPage:
+	case SharePointLibraryFolder, SharePointPage, SharePointNotebook:
 		os = append(os, pathComparator())
 	}
 
@@ -714,12 +771,16 @@ func (s SharePointScope) setDefaults() {
 		s[SharePointListItem.String()] = passAny
 		s[SharePointPageFolder.String()] = passAny
 		s[SharePointPage.String()] = passAny
+		s[SharePointNotebook.String()] = passAny
+		s[SharePointNotebookPage.String()] = passAny
 	case SharePointLibraryFolder:
 		s[SharePointLibraryItem.String()] = passAny
 	case SharePointList:
 		s[SharePointListItem.String()] = passAny
 	case SharePointPageFolder:
 		s[SharePointPage.String()] = passAny
+	case SharePointNotebook:
+		s[SharePointNotebookPage.String()] = passAny
 	}
 }
 
@@ -742,6 +803,7 @@ func (s sharePoint) Reduce(
 			path.LibrariesCategory: SharePointLibraryItem,
 			path.ListsCategory:     SharePointListItem,
 			path.PagesCategory:     SharePointPage,
+			path.NotebooksCategory: SharePointNotebookPage,
 		},
 		errs)
 }
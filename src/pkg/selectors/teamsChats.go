@@ -9,6 +9,7 @@ import (
 
 	"github.com/alcionai/corso/src/pkg/backup/details"
 	"github.com/alcionai/corso/src/pkg/backup/identity"
+	"github.com/alcionai/corso/src/pkg/dttm"
 	"github.com/alcionai/corso/src/pkg/fault"
 	"github.com/alcionai/corso/src/pkg/filters"
 	"github.com/alcionai/corso/src/pkg/path"
@@ -41,9 +42,10 @@ type (
 )
 
 var (
-	_ Reducer        = &TeamsChatsRestore{}
-	_ pathCategorier = &TeamsChatsRestore{}
-	_ reasoner       = &TeamsChatsRestore{}
+	_ Reducer             = &TeamsChatsRestore{}
+	_ pathCategorier      = &TeamsChatsRestore{}
+	_ reasoner            = &TeamsChatsRestore{}
+	_ pathCategoryLimiter = &TeamsChatsRestore{}
 )
 
 // NewTeamsChats produces a new Selector with the service set to ServiceTeamsChats.
@@ -70,7 +72,7 @@ func (s Selector) ToTeamsChatsBackup() (*TeamsChatsBackup, error) {
 }
 
 func (s TeamsChatsBackup) SplitByResourceOwner(users []string) []TeamsChatsBackup {
-	sels := splitByProtectedResource[TeamsChatsScope](s.Selector, users, TeamsChatsUser)
+	sels := s.Selector.SplitByProtectedResource(users)
 
 	ss := make([]TeamsChatsBackup, 0, len(sels))
 	for _, sel := range sels {
@@ -104,7 +106,7 @@ func (s Selector) ToTeamsChatsRestore() (*TeamsChatsRestore, error) {
 }
 
 func (sr TeamsChatsRestore) SplitByResourceOwner(users []string) []TeamsChatsRestore {
-	sels := splitByProtectedResource[TeamsChatsScope](sr.Selector, users, TeamsChatsUser)
+	sels := sr.Selector.SplitByProtectedResource(users)
 
 	ss := make([]TeamsChatsRestore, 0, len(sels))
 	for _, sel := range sels {
@@ -123,6 +125,12 @@ func (s teamsChats) PathCategories() selectorPathCategories {
 	}
 }
 
+// LimitPathCategories produces a clone of the selector which only keeps the
+// scopes within the provided path categories.
+func (s teamsChats) LimitPathCategories(cats []path.CategoryType) Selector {
+	return limitPathCategories[TeamsChatsScope, teamsChatsCategory](s.Selector, cats)
+}
+
 // Reasons returns a deduplicated set of the backup reasons produced
 // using the selector's discrete owner and each scopes' service and
 // category types.
@@ -269,6 +277,92 @@ func (sr *TeamsChatsRestore) ChatName(memberID string) []TeamsChatsScope {
 	}
 }
 
+// ChatCreatedAfter produces a chat created-after info scope.
+// Matches any chat where the creation time is after the timestring.
+// If the input equals selectors.Any, the scope will match all times.
+// If the input is empty or selectors.None, the scope will always fail comparisons.
+func (sr *TeamsChatsRestore) ChatCreatedAfter(timeStrings string) []TeamsChatsScope {
+	return []TeamsChatsScope{
+		makeInfoScope[TeamsChatsScope](
+			TeamsChatsChat,
+			TeamsChatsInfoChatCreatedAfter,
+			[]string{timeStrings},
+			filters.Less),
+	}
+}
+
+// ChatCreatedBefore produces a chat created-before info scope.
+// Matches any chat where the creation time is before the timestring.
+// If the input equals selectors.Any, the scope will match all times.
+// If the input is empty or selectors.None, the scope will always fail comparisons.
+func (sr *TeamsChatsRestore) ChatCreatedBefore(timeStrings string) []TeamsChatsScope {
+	return []TeamsChatsScope{
+		makeInfoScope[TeamsChatsScope](
+			TeamsChatsChat,
+			TeamsChatsInfoChatCreatedBefore,
+			[]string{timeStrings},
+			filters.Greater),
+	}
+}
+
+// ChatLastMessageAfter produces a chat last-message-after info scope.
+// Matches any chat where the most recent message was sent after the timestring.
+// If the input equals selectors.Any, the scope will match all times.
+// If the input is empty or selectors.None, the scope will always fail comparisons.
+func (sr *TeamsChatsRestore) ChatLastMessageAfter(timeStrings string) []TeamsChatsScope {
+	return []TeamsChatsScope{
+		makeInfoScope[TeamsChatsScope](
+			TeamsChatsChat,
+			TeamsChatsInfoChatLastMessageAfter,
+			[]string{timeStrings},
+			filters.Less),
+	}
+}
+
+// ChatLastMessageBefore produces a chat last-message-before info scope.
+// Matches any chat where the most recent message was sent before the timestring.
+// If the input equals selectors.Any, the scope will match all times.
+// If the input is empty or selectors.None, the scope will always fail comparisons.
+func (sr *TeamsChatsRestore) ChatLastMessageBefore(timeStrings string) []TeamsChatsScope {
+	return []TeamsChatsScope{
+		makeInfoScope[TeamsChatsScope](
+			TeamsChatsChat,
+			TeamsChatsInfoChatLastMessageBefore,
+			[]string{timeStrings},
+			filters.Greater),
+	}
+}
+
+// MessageCreatedAfter produces a chat message created-after info scope.
+// Matches any chat holding a message sent after the timestring.  Exports
+// also leave out the messages in each chat that were sent before it.
+// If the input equals selectors.Any, the scope will match all times.
+// If the input is empty or selectors.None, the scope will always fail comparisons.
+func (sr *TeamsChatsRestore) MessageCreatedAfter(timeStrings string) []TeamsChatsScope {
+	return []TeamsChatsScope{
+		makeInfoScope[TeamsChatsScope](
+			TeamsChatsChat,
+			TeamsChatsInfoMessageCreatedAfter,
+			[]string{timeStrings},
+			filters.Less),
+	}
+}
+
+// MessageCreatedBefore produces a chat message created-before info scope.
+// Matches any chat holding a message sent before the timestring.  Exports
+// also leave out the messages in each chat that were sent after it.
+// If the input equals selectors.Any, the scope will match all times.
+// If the input is empty or selectors.None, the scope will always fail comparisons.
+func (sr *TeamsChatsRestore) MessageCreatedBefore(timeStrings string) []TeamsChatsScope {
+	return []TeamsChatsScope{
+		makeInfoScope[TeamsChatsScope](
+			TeamsChatsChat,
+			TeamsChatsInfoMessageCreatedBefore,
+			[]string{timeStrings},
+			filters.Greater),
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Categories
 // ---------------------------------------------------------------------------
@@ -290,6 +384,14 @@ const (
 	// data contained within details.ItemInfo
 	TeamsChatsInfoChatMember teamsChatsCategory = "TeamsChatsInfoChatMember"
 	TeamsChatsInfoChatName   teamsChatsCategory = "TeamsChatsInfoChatName"
+
+	TeamsChatsInfoChatCreatedAfter      teamsChatsCategory = "TeamsChatsInfoChatCreatedAfter"
+	TeamsChatsInfoChatCreatedBefore     teamsChatsCategory = "TeamsChatsInfoChatCreatedBefore"
+	TeamsChatsInfoChatLastMessageAfter  teamsChatsCategory = "TeamsChatsInfoChatLastMessageAfter"
+	TeamsChatsInfoChatLastMessageBefore teamsChatsCategory = "TeamsChatsInfoChatLastMessageBefore"
+
+	TeamsChatsInfoMessageCreatedAfter  teamsChatsCategory = "TeamsChatsInfoMessageCreatedAfter"
+	TeamsChatsInfoMessageCreatedBefore teamsChatsCategory = "TeamsChatsInfoMessageCreatedBefore"
 )
 
 // teamsChatsLeafProperties describes common metadata of the leaf categories
@@ -317,7 +419,15 @@ func (ec teamsChatsCategory) String() string {
 // Ex: TeamsChatsUser.leafCat() => TeamsChatsUser
 func (ec teamsChatsCategory) leafCat() categorizer {
 	switch ec {
-	case TeamsChatsChat, TeamsChatsInfoChatMember, TeamsChatsInfoChatName:
+	case TeamsChatsChat,
+		TeamsChatsInfoChatMember,
+		TeamsChatsInfoChatName,
+		TeamsChatsInfoChatCreatedAfter,
+		TeamsChatsInfoChatCreatedBefore,
+		TeamsChatsInfoChatLastMessageAfter,
+		TeamsChatsInfoChatLastMessageBefore,
+		TeamsChatsInfoMessageCreatedAfter,
+		TeamsChatsInfoMessageCreatedBefore:
 		return TeamsChatsChat
 	}
 
@@ -507,6 +617,25 @@ func (s TeamsChatsScope) matchesInfo(dii details.ItemInfo) bool {
 		i = strings.Join(info.Chat.Members, ",")
 	case TeamsChatsInfoChatName:
 		i = info.Chat.Name
+	case TeamsChatsInfoChatCreatedAfter, TeamsChatsInfoChatCreatedBefore:
+		i = dttm.Format(info.Chat.CreatedAt)
+	// a chat holds messages sent after a time if its last message was.
+	case TeamsChatsInfoChatLastMessageAfter,
+		TeamsChatsInfoChatLastMessageBefore,
+		TeamsChatsInfoMessageCreatedAfter:
+		if info.Chat.LastMessageAt.IsZero() {
+			return false
+		}
+
+		i = dttm.Format(info.Chat.LastMessageAt)
+	// a chat holds messages sent before a time if it held any messages
+	// and was created before that time.
+	case TeamsChatsInfoMessageCreatedBefore:
+		if info.Chat.LastMessageAt.IsZero() {
+			return false
+		}
+
+		i = dttm.Format(info.Chat.CreatedAt)
 	}
 
 	return s.Matches(infoCat, i)
@@ -12,6 +12,7 @@ import (
 
 	"github.com/alcionai/corso/src/internal/tester"
 	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/dttm"
 	"github.com/alcionai/corso/src/pkg/fault"
 	"github.com/alcionai/corso/src/pkg/filters"
 	"github.com/alcionai/corso/src/pkg/path"
@@ -260,6 +261,7 @@ func (suite *TeamsChatsSelectorSuite) TestTeamsChatsScope_MatchesInfo() {
 	var (
 		now    = time.Now()
 		future = now.Add(1 * time.Minute)
+		past   = now.Add(-1 * time.Minute)
 	)
 
 	infoWith := func(itype details.ItemType) details.ItemInfo {
@@ -291,6 +293,17 @@ func (suite *TeamsChatsSelectorSuite) TestTeamsChatsScope_MatchesInfo() {
 		{"chat with a different name", details.TeamsChat, cs.ChatName("blarps"), assert.False},
 		{"chat with the same name", details.TeamsChat, cs.ChatName(name), assert.True},
 		{"chat with a subname search", details.TeamsChat, cs.ChatName(name[2:5]), assert.True},
+		{"chat created after the past", details.TeamsChat, cs.ChatCreatedAfter(dttm.Format(past)), assert.True},
+		{"chat created after the future", details.TeamsChat, cs.ChatCreatedAfter(dttm.Format(future)), assert.False},
+		{"chat created before the future", details.TeamsChat, cs.ChatCreatedBefore(dttm.Format(future)), assert.True},
+		{"chat created before the past", details.TeamsChat, cs.ChatCreatedBefore(dttm.Format(past)), assert.False},
+		{"chat last message after now", details.TeamsChat, cs.ChatLastMessageAfter(dttm.Format(now)), assert.True},
+		{"chat last message before now", details.TeamsChat, cs.ChatLastMessageBefore(dttm.Format(now)), assert.False},
+		{"chat last message before the far future", details.TeamsChat, cs.ChatLastMessageBefore(dttm.Format(future.Add(time.Hour))), assert.True},
+		{"message created after now", details.TeamsChat, cs.MessageCreatedAfter(dttm.Format(now)), assert.True},
+		{"message created after the far future", details.TeamsChat, cs.MessageCreatedAfter(dttm.Format(future.Add(time.Hour))), assert.False},
+		{"message created before the future", details.TeamsChat, cs.MessageCreatedBefore(dttm.Format(future)), assert.True},
+		{"message created before the past", details.TeamsChat, cs.MessageCreatedBefore(dttm.Format(past)), assert.False},
 	}
 	for _, test := range table {
 		suite.Run(test.name, func() {
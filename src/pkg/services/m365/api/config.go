@@ -22,6 +22,7 @@ const (
 	ccRecipients         = "ccRecipients"
 	createdDateTime      = "createdDateTime"
 	displayName          = "displayName"
+	dueDateTime          = "dueDateTime"
 	emailAddresses       = "emailAddresses"
 	givenName            = "givenName"
 	isCancelled          = "isCancelled"
@@ -9,6 +9,7 @@ import (
 const (
 	DefaultCalendar = "Calendar"
 	DefaultContacts = "Contacts"
+	DefaultTaskList = "Tasks"
 	MailInbox       = "Inbox"
 	MsgFolderRoot   = "msgfolderroot"
 
@@ -27,6 +28,8 @@ const (
 	AuthorLookupIDColumnName    = "AuthorLookupId"
 	EditorLookupIDColumnName    = "EditorLookupId"
 	AppAuthorLookupIDColumnName = "AppAuthorLookupId"
+	AuthorColumnName            = "Author"
+	EditorColumnName            = "Editor"
 	TitleColumnName             = "Title"
 
 	ContentTypeColumnDisplayName = "Content Type"
@@ -98,6 +98,145 @@ func (c Conversations) GetConversationPost(
 	return post, conversationPostInfo(post, contentLen, preview), clues.Stack(err).OrNil()
 }
 
+// PostConversation creates a new conversation in the group.  The
+// conversation is expected to hold a single thread containing the
+// first post.
+func (c Conversations) PostConversation(
+	ctx context.Context,
+	groupID string,
+	body models.Conversationable,
+) (models.Conversationable, error) {
+	conv, err := c.Stable.
+		Client().
+		Groups().
+		ByGroupId(groupID).
+		Conversations().
+		Post(ctx, body, nil)
+	if err != nil {
+		return nil, clues.Wrap(err, "creating conversation")
+	}
+
+	if conv == nil {
+		return nil, clues.NewWC(ctx, "nil response creating conversation")
+	}
+
+	return conv, nil
+}
+
+// PostConversationThread starts a new thread in the conversation.
+func (c Conversations) PostConversationThread(
+	ctx context.Context,
+	groupID, conversationID string,
+	body models.ConversationThreadable,
+) (models.ConversationThreadable, error) {
+	thread, err := c.Stable.
+		Client().
+		Groups().
+		ByGroupId(groupID).
+		Conversations().
+		ByConversationId(conversationID).
+		Threads().
+		Post(ctx, body, nil)
+	if err != nil {
+		return nil, clues.Wrap(err, "creating conversation thread")
+	}
+
+	if thread == nil {
+		return nil, clues.NewWC(ctx, "nil response creating conversation thread")
+	}
+
+	return thread, nil
+}
+
+// ReplyToConversationThread adds the post to the end of the thread.
+// Graph doesn't return the created post.
+func (c Conversations) ReplyToConversationThread(
+	ctx context.Context,
+	groupID, conversationID, threadID string,
+	post models.Postable,
+) error {
+	body := groups.NewItemConversationsItemThreadsItemReplyPostRequestBody()
+	body.SetPost(post)
+
+	err := c.Stable.
+		Client().
+		Groups().
+		ByGroupId(groupID).
+		Conversations().
+		ByConversationId(conversationID).
+		Threads().
+		ByConversationThreadId(threadID).
+		Reply().
+		Post(ctx, body, nil)
+
+	return clues.Wrap(err, "replying to conversation thread").OrNil()
+}
+
+// DeleteConversation removes the conversation, along with all of its
+// threads and posts, from the group.
+func (c Conversations) DeleteConversation(
+	ctx context.Context,
+	groupID, conversationID string,
+) error {
+	// deletes require unique http clients
+	// https://github.com/alcionai/corso/issues/2707
+	srv, err := NewService(c.Credentials, c.counter)
+	if err != nil {
+		return clues.StackWC(ctx, err)
+	}
+
+	err = srv.
+		Client().
+		Groups().
+		ByGroupId(groupID).
+		Conversations().
+		ByConversationId(conversationID).
+		Delete(ctx, nil)
+
+	return clues.Wrap(err, "deleting conversation").OrNil()
+}
+
+// GetConversationsByCollisionKey maps the collision key of each
+// conversation in the group to the conversation ID.
+func (c Conversations) GetConversationsByCollisionKey(
+	ctx context.Context,
+	groupID string,
+) (map[string]string, error) {
+	convs, err := c.GetConversations(ctx, groupID, CallConfig{Select: idAnd("topic")})
+	if err != nil {
+		return nil, clues.Wrap(err, "enumerating conversations")
+	}
+
+	m := map[string]string{}
+
+	for _, conv := range convs {
+		m[ConversationCollisionKey(conv)] = ptr.Val(conv.GetId())
+	}
+
+	return m, nil
+}
+
+// ConversationCollisionKey constructs a key from the conversation's topic.
+// collision keys are used to identify duplicate item conflicts for
+// handling advanced restoration config.
+func ConversationCollisionKey(conv models.Conversationable) string {
+	if conv == nil {
+		return ""
+	}
+
+	return ptr.Val(conv.GetTopic())
+}
+
+// ConversationPostInfo produces the details info for a restored post.
+func ConversationPostInfo(post models.Postable) *details.GroupsInfo {
+	preview, size, err := getConversationPostContentPreview(post)
+	if err != nil {
+		preview = "malformed or unparseable content body: " + preview
+	}
+
+	return conversationPostInfo(post, size, preview)
+}
+
 // ---------------------------------------------------------------------------
 // Helpers
 // ---------------------------------------------------------------------------
@@ -207,6 +207,22 @@ func (c Drives) PatchItem(
 	return clues.Wrap(err, "patching drive item").OrNil()
 }
 
+// MoveItem moves the item into the parent folder, keeping its name.
+func (c Drives) MoveItem(
+	ctx context.Context,
+	driveID, itemID, parentFolderID string,
+) error {
+	parent := models.NewItemReference()
+	parent.SetId(ptr.To(parentFolderID))
+
+	item := models.NewDriveItem()
+	item.SetParentReference(parent)
+
+	err := c.PatchItem(ctx, driveID, itemID, item)
+
+	return clues.Wrap(err, "moving drive item").With("item_id", itemID).OrNil()
+}
+
 func (c Drives) PutItemContent(
 	ctx context.Context,
 	driveID, itemID string,
@@ -248,6 +264,46 @@ func (c Drives) DeleteItem(
 	return clues.Wrap(err, "deleting item").With("item_id", itemID).OrNil()
 }
 
+// ---------------------------------------------------------------------------
+// Versions
+// ---------------------------------------------------------------------------
+
+// GetItemVersions retrieves the version history of the item, newest first.
+// The first version is the current content of the item.
+func (c Drives) GetItemVersions(
+	ctx context.Context,
+	driveID, itemID string,
+) ([]models.DriveItemVersionable, error) {
+	var (
+		results []models.DriveItemVersionable
+		builder = c.Stable.
+			Client().
+			Drives().
+			ByDriveId(driveID).
+			Items().
+			ByDriveItemId(itemID).
+			Versions()
+	)
+
+	for {
+		resp, err := builder.Get(ctx, nil)
+		if err != nil {
+			return nil, clues.Wrap(err, "getting item versions")
+		}
+
+		results = append(results, resp.GetValue()...)
+
+		nextLink := ptr.Val(resp.GetOdataNextLink())
+		if len(nextLink) == 0 {
+			break
+		}
+
+		builder = builder.WithUrl(nextLink)
+	}
+
+	return results, nil
+}
+
 // ---------------------------------------------------------------------------
 // Permissions
 // ---------------------------------------------------------------------------
@@ -232,6 +232,36 @@ func ResetLimiter(ctx context.Context) {
 	limiter.Reset()
 }
 
+var (
+	limiterUsersMu sync.Mutex
+	// limiterUsers counts the operations currently sharing the rate limiters.
+	limiterUsers int
+)
+
+// AcquireLimiter marks the start of an operation that uses the rate limiter
+// bound to the ctx.  The returned func marks the end of the operation.  Since
+// the limiters are shared by all operations within the process, the limiter
+// only gets reset after the last of any concurrent operations completes.
+// Otherwise, resetting it would refill the tokens of operations that are
+// still running.
+func AcquireLimiter(ctx context.Context) func() {
+	limiterUsersMu.Lock()
+	defer limiterUsersMu.Unlock()
+
+	limiterUsers++
+
+	return func() {
+		limiterUsersMu.Lock()
+		defer limiterUsersMu.Unlock()
+
+		limiterUsers--
+
+		if limiterUsers == 0 {
+			ResetLimiter(ctx)
+		}
+	}
+}
+
 // RateLimiterMiddleware is used to ensure we don't overstep per-min request limits.
 type RateLimiterMiddleware struct{}
 
@@ -32,6 +32,13 @@ const (
 	//nolint:lll
 	// https://learn.microsoft.com/en-us/graph/delta-query-overview?tabs=http#resource-representation-in-the-delta-query-response
 	AddtlDataRemoved = "@removed"
+	// AddtlDataDeleted is the key value in the AdditionalData map for
+	// deleted items on responses whose sdk model has no deleted facet,
+	// such as list item deltas.
+	AddtlDataDeleted = "deleted"
+	// AddtlDataDeltaLink is the key value in the AdditionalData map for
+	// the delta link on responses whose sdk model has no delta link.
+	AddtlDataDeltaLink = "@odata.deltaLink"
 )
 
 // ---------------------------------------------------------------------------
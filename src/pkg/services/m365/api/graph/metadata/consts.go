@@ -8,8 +8,13 @@ const (
 	MetaFileSuffix    = ".meta"
 	DirMetaFileSuffix = ".dirmeta"
 	DataFileSuffix    = ".data"
+	// VersionsFileSuffix marks the stream holding the content of the
+	// previous versions of a drive file.
+	VersionsFileSuffix = ".versions"
 )
 
 func HasMetaSuffix(name string) bool {
-	return strings.HasSuffix(name, MetaFileSuffix) || strings.HasSuffix(name, DirMetaFileSuffix)
+	return strings.HasSuffix(name, MetaFileSuffix) ||
+		strings.HasSuffix(name, DirMetaFileSuffix) ||
+		strings.HasSuffix(name, VersionsFileSuffix)
 }
@@ -34,6 +34,7 @@ var (
 	metaSuffixes = []string{
 		metadata.MetaFileSuffix,
 		metadata.DirMetaFileSuffix,
+		metadata.VersionsFileSuffix,
 	}
 
 	cases = []testCase{
@@ -2,8 +2,10 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/alcionai/clues"
@@ -13,7 +15,9 @@ import (
 	"github.com/alcionai/corso/src/internal/common/ptr"
 	"github.com/alcionai/corso/src/internal/common/str"
 	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/errs/core"
 	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
 )
 
 var ErrSkippableListTemplate = clues.New("unable to create lists with skippable templates")
@@ -91,6 +95,100 @@ func (c Lists) PostDrive(
 func (c Lists) GetListByID(ctx context.Context,
 	siteID, listID string,
 ) (models.Listable, *details.SharePointInfo, error) {
+	list, err := c.getList(ctx, siteID, listID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lItems, err := c.getListItems(ctx, siteID, listID)
+	if err != nil {
+		return nil, nil, clues.Wrap(err, "getting list contents")
+	}
+
+	list.SetItems(lItems)
+
+	return list, ListToSPInfo(list), nil
+}
+
+// GetListWithItemChanges populates the list in the same way as GetListByID,
+// but only fetches the list items in added.  The items in removed are
+// dropped, and all other items are carried over from prev.  Items keep
+// their position in prev, and new items are appended in the order of added.
+func (c Lists) GetListWithItemChanges(
+	ctx context.Context,
+	siteID, listID string,
+	prev models.Listable,
+	added, removed []string,
+) (models.Listable, *details.SharePointInfo, error) {
+	list, err := c.getList(ctx, siteID, listID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		dropped = make(map[string]struct{}, len(removed))
+		changed = make(map[string]models.ListItemable, len(added))
+		lItems  = make([]models.ListItemable, 0, len(prev.GetItems())+len(added))
+	)
+
+	for _, id := range removed {
+		dropped[id] = struct{}{}
+	}
+
+	for _, id := range added {
+		if _, ok := dropped[id]; ok {
+			continue
+		}
+
+		ictx := clues.Add(ctx, "list_item_id", id)
+
+		li, err := c.getListItem(ictx, siteID, listID, id)
+		if err != nil {
+			// the item was deleted after the delta was enumerated.
+			if errors.Is(err, core.ErrNotFound) {
+				dropped[id] = struct{}{}
+				continue
+			}
+
+			return nil, nil, clues.Wrap(err, "getting list item")
+		}
+
+		changed[id] = li
+	}
+
+	for _, li := range prev.GetItems() {
+		id := ptr.Val(li.GetId())
+
+		if _, ok := dropped[id]; ok {
+			continue
+		}
+
+		if cli, ok := changed[id]; ok {
+			li = cli
+			delete(changed, id)
+		}
+
+		lItems = append(lItems, li)
+	}
+
+	for _, id := range added {
+		if li, ok := changed[id]; ok {
+			lItems = append(lItems, li)
+			delete(changed, id)
+		}
+	}
+
+	list.SetItems(lItems)
+
+	return list, ListToSPInfo(list), nil
+}
+
+// getList fetches the list along with its columns and content types.
+// List items are not included.
+func (c Lists) getList(
+	ctx context.Context,
+	siteID, listID string,
+) (models.Listable, error) {
 	list, err := c.Stable.
 		Client().
 		Sites().
@@ -99,71 +197,77 @@ func (c Lists) GetListByID(ctx context.Context,
 		ByListId(listID).
 		Get(ctx, nil)
 	if err != nil {
-		return nil, nil, clues.Wrap(err, "fetching list")
+		return nil, clues.Wrap(err, "fetching list")
 	}
 
-	cols, cTypes, lItems, err := c.getListContents(ctx, siteID, listID)
+	cols, cTypes, err := c.getListContents(ctx, siteID, listID)
 	if err != nil {
-		return nil, nil, clues.Wrap(err, "getting list contents")
+		return nil, clues.Wrap(err, "getting list contents")
 	}
 
 	list.SetColumns(cols)
 	list.SetContentTypes(cTypes)
-	list.SetItems(lItems)
 
-	return list, ListToSPInfo(list), nil
+	return list, nil
 }
 
 // getListContents utility function to retrieve associated M365 relationships
 // which are not included with the standard List query:
-// - Columns, ContentTypes, ListItems
+// - Columns, ContentTypes
 func (c Lists) getListContents(ctx context.Context, siteID, listID string) (
 	[]models.ColumnDefinitionable,
 	[]models.ContentTypeable,
-	[]models.ListItemable,
 	error,
 ) {
 	cols, err := c.GetListColumns(ctx, siteID, listID, CallConfig{})
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
 	cTypes, err := c.GetContentTypes(ctx, siteID, listID, CallConfig{})
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
 	for i := 0; i < len(cTypes); i++ {
 		columnLinks, err := c.GetColumnLinks(ctx, siteID, listID, ptr.Val(cTypes[i].GetId()), CallConfig{})
 		if err != nil {
-			return nil, nil, nil, err
+			return nil, nil, err
 		}
 
 		cTypes[i].SetColumnLinks(columnLinks)
 
 		cTypeColumns, err := c.GetCTypesColumns(ctx, siteID, listID, ptr.Val(cTypes[i].GetId()), CallConfig{})
 		if err != nil {
-			return nil, nil, nil, err
+			return nil, nil, err
 		}
 
 		cTypes[i].SetColumns(cTypeColumns)
 	}
 
+	return cols, cTypes, nil
+}
+
+// getListItems fetches all items in the list, along with their fields.
+func (c Lists) getListItems(
+	ctx context.Context,
+	siteID, listID string,
+) ([]models.ListItemable, error) {
 	lItems, err := c.GetListItems(ctx, siteID, listID, CallConfig{})
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, err
 	}
 
 	for _, li := range lItems {
 		fields, err := c.getListItemFields(ctx, siteID, listID, ptr.Val(li.GetId()))
 		if err != nil {
-			return nil, nil, nil, err
+			return nil, err
 		}
 
 		li.SetFields(fields)
 	}
 
-	return cols, cTypes, lItems, nil
+	return lItems, nil
 }
 
 func (c Lists) PostList(
@@ -760,6 +864,34 @@ func addressKeyToVal(fields map[string]any, key string) string {
 	return ""
 }
 
+// getListItem fetches a single list item, along with its fields.
+func (c Lists) getListItem(
+	ctx context.Context,
+	siteID, listID, itemID string,
+) (models.ListItemable, error) {
+	li, err := c.Stable.
+		Client().
+		Sites().
+		BySiteId(siteID).
+		Lists().
+		ByListId(listID).
+		Items().
+		ByListItemId(itemID).
+		Get(ctx, nil)
+	if err != nil {
+		return nil, graph.Stack(ctx, err)
+	}
+
+	fields, err := c.getListItemFields(ctx, siteID, listID, itemID)
+	if err != nil {
+		return nil, graph.Stack(ctx, err)
+	}
+
+	li.SetFields(fields)
+
+	return li, nil
+}
+
 func (c Lists) getListItemFields(
 	ctx context.Context,
 	siteID, listID, itemID string,
@@ -775,7 +907,7 @@ func (c Lists) getListItemFields(
 
 	fields, err := prefix.Fields().Get(ctx, nil)
 	if err != nil {
-		return nil, err
+		return nil, graph.Stack(ctx, err)
 	}
 
 	return fields, nil
@@ -828,3 +960,174 @@ func ListCollisionKey(list models.Listable) string {
 func isSlice(val any) bool {
 	return reflect.TypeOf(val).Kind() == reflect.Slice
 }
+
+// ---------------------------------------------------------------------------
+// tabular representation
+// ---------------------------------------------------------------------------
+
+// ListIDColumnName is the header used for the list item id in the
+// tabular representation of a list.
+const ListIDColumnName = "ID"
+
+// tabular columns that are read-only but still useful to the reader.
+var readOnlyTableColumns = keys.Set{
+	CreatedColumnName:  {},
+	ModifiedColumnName: {},
+	AuthorColumnName:   {},
+	EditorColumnName:   {},
+}
+
+// ListToTable flattens the list into a header row and one row of values
+// per list item.  Columns are taken from the list's column definitions,
+// skipping hidden, system and legacy columns.  Values are converted
+// to strings based on the type of the column: lookups and persons use
+// their display value, multi-valued fields are joined with "; ".
+func ListToTable(list models.Listable) ([]string, [][]string) {
+	columns := tableColumns(list.GetColumns())
+	header := make([]string, 0, len(columns)+1)
+	header = append(header, ListIDColumnName)
+
+	for _, cd := range columns {
+		name := ptr.Val(cd.GetDisplayName())
+		if len(name) == 0 {
+			name = ptr.Val(cd.GetName())
+		}
+
+		header = append(header, name)
+	}
+
+	rows := make([][]string, 0, len(list.GetItems()))
+
+	for _, li := range list.GetItems() {
+		fields := map[string]any{}
+		if li.GetFields() != nil {
+			fields = li.GetFields().GetAdditionalData()
+		}
+
+		row := make([]string, 0, len(header))
+		row = append(row, ptr.Val(li.GetId()))
+
+		for _, cd := range columns {
+			row = append(row, tableValue(li, cd, fields))
+		}
+
+		rows = append(rows, row)
+	}
+
+	return header, rows
+}
+
+func tableColumns(cds []models.ColumnDefinitionable) []models.ColumnDefinitionable {
+	columns := make([]models.ColumnDefinitionable, 0, len(cds))
+
+	for _, cd := range cds {
+		name := ptr.Val(cd.GetName())
+
+		if ptr.Val(cd.GetHidden()) ||
+			len(name) == 0 ||
+			strings.HasPrefix(name, ReadOnlyOrHiddenFieldNamePrefix) ||
+			strings.Contains(name, LinkTitleFieldNamePart) ||
+			strings.Contains(name, ChildCountFieldNamePart) ||
+			legacyColumns.HasKey(name) ||
+			legacyColumns.HasKey(ptr.Val(cd.GetDisplayName())) {
+			continue
+		}
+
+		if ptr.Val(cd.GetReadOnly()) && !readOnlyTableColumns.HasKey(name) {
+			continue
+		}
+
+		columns = append(columns, cd)
+	}
+
+	return columns
+}
+
+func tableValue(
+	li models.ListItemable,
+	cd models.ColumnDefinitionable,
+	fields map[string]any,
+) string {
+	name := ptr.Val(cd.GetName())
+
+	// the author and editor fields only hold the lookup id of the user,
+	// the list item itself has their display name.
+	switch name {
+	case AuthorColumnName:
+		if u := userFromIdentitySet(li.GetCreatedBy()); len(u) > 0 {
+			return u
+		}
+	case EditorColumnName:
+		if u := userFromIdentitySet(li.GetLastModifiedBy()); len(u) > 0 {
+			return u
+		}
+	}
+
+	if cd.GetLookup() != nil || cd.GetPersonOrGroup() != nil {
+		// multi-valued lookups are stored as a slice of lookup values
+		// under the column name.  Single values only have the id.
+		if v, ok := fields[name]; ok {
+			return fieldValueToString(v)
+		}
+
+		if v, ok := fields[name+LookupIDFieldNamePart]; ok {
+			return fieldValueToString(v)
+		}
+
+		return ""
+	}
+
+	return fieldValueToString(fields[name])
+}
+
+func userFromIdentitySet(is models.IdentitySetable) string {
+	if is == nil || is.GetUser() == nil {
+		return ""
+	}
+
+	return ptr.Val(is.GetUser().GetDisplayName())
+}
+
+func fieldValueToString(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case *string:
+		return ptr.Val(val)
+	case string:
+		return val
+	case *bool:
+		return strconv.FormatBool(ptr.Val(val))
+	case *float64:
+		return strconv.FormatFloat(ptr.Val(val), 'f', -1, 64)
+	case *int32:
+		return strconv.FormatInt(int64(ptr.Val(val)), 10)
+	case *int64:
+		return strconv.FormatInt(ptr.Val(val), 10)
+	case []any:
+		values := make([]string, 0, len(val))
+
+		for _, elem := range val {
+			if s := fieldValueToString(elem); len(s) > 0 {
+				values = append(values, s)
+			}
+		}
+
+		return strings.Join(values, "; ")
+	case map[string]any:
+		switch {
+		case keys.HasKeys(val, LookupValueKey):
+			return fieldValueToString(val[LookupValueKey])
+		case keys.HasKeys(val, HyperlinkURLKey):
+			return concatenateHyperLinkFields(val)
+		case keys.HasKeys(val, MetadataLabelKey):
+			return fieldValueToString(val[MetadataLabelKey])
+		case keys.HasKeys(val, DisplayNameKey):
+			return concatenateAddressFields(val)
+		}
+
+		return ""
+	}
+
+	return fmt.Sprintf("%v", v)
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
@@ -19,8 +20,10 @@ import (
 	"github.com/alcionai/corso/src/internal/tester/tconfig"
 	"github.com/alcionai/corso/src/pkg/backup/details"
 	"github.com/alcionai/corso/src/pkg/control/testdata"
+	"github.com/alcionai/corso/src/pkg/count"
 	"github.com/alcionai/corso/src/pkg/errs/core"
 	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
 	graphTD "github.com/alcionai/corso/src/pkg/services/m365/api/graph/testdata"
 )
 
@@ -1124,6 +1127,244 @@ func (suite *ListsUnitSuite) TestCheckFields() {
 	}
 }
 
+func (suite *ListsUnitSuite) TestListToTable() {
+	t := suite.T()
+
+	makeColumn := func(name string, setter func(cd models.ColumnDefinitionable)) models.ColumnDefinitionable {
+		cd := models.NewColumnDefinition()
+		cd.SetName(ptr.To(name))
+		cd.SetDisplayName(ptr.To(name + " Display"))
+
+		if setter != nil {
+			setter(cd)
+		}
+
+		return cd
+	}
+
+	columns := []models.ColumnDefinitionable{
+		makeColumn(TitleColumnName, func(cd models.ColumnDefinitionable) {
+			cd.SetText(models.NewTextColumn())
+		}),
+		makeColumn("Count", func(cd models.ColumnDefinitionable) {
+			cd.SetNumber(models.NewNumberColumn())
+		}),
+		makeColumn("Done", func(cd models.ColumnDefinitionable) {
+			cd.SetBoolean(models.NewBooleanColumn())
+		}),
+		makeColumn("Tags", func(cd models.ColumnDefinitionable) {
+			cd.SetChoice(models.NewChoiceColumn())
+		}),
+		makeColumn("Country", func(cd models.ColumnDefinitionable) {
+			cd.SetLookup(models.NewLookupColumn())
+		}),
+		makeColumn("Owners", func(cd models.ColumnDefinitionable) {
+			cd.SetPersonOrGroup(models.NewPersonOrGroupColumn())
+		}),
+		makeColumn("Link", func(cd models.ColumnDefinitionable) {
+			cd.SetHyperlinkOrPicture(models.NewHyperlinkOrPictureColumn())
+		}),
+		makeColumn(AuthorColumnName, func(cd models.ColumnDefinitionable) {
+			cd.SetReadOnly(ptr.To(true))
+			cd.SetPersonOrGroup(models.NewPersonOrGroupColumn())
+		}),
+		// skipped columns
+		makeColumn("Hidden", func(cd models.ColumnDefinitionable) {
+			cd.SetHidden(ptr.To(true))
+		}),
+		makeColumn("ReadOnly", func(cd models.ColumnDefinitionable) {
+			cd.SetReadOnly(ptr.To(true))
+		}),
+		makeColumn("_UIVersionString", nil),
+		makeColumn(AttachmentsColumnName, nil),
+		makeColumn("LinkTitle", nil),
+	}
+
+	user := models.NewIdentity()
+	user.SetDisplayName(ptr.To("zim"))
+
+	createdBy := models.NewIdentitySet()
+	createdBy.SetUser(user)
+
+	fields := models.NewFieldValueSet()
+	fields.SetAdditionalData(map[string]any{
+		TitleColumnName:   ptr.To("first"),
+		"Count":           ptr.To(float64(42.5)),
+		"Done":            ptr.To(true),
+		"Tags":            []any{ptr.To("red"), ptr.To("blue")},
+		"CountryLookupId": ptr.To("3"),
+		"Owners": []any{
+			map[string]any{
+				LookupIDKey:    ptr.To(float64(10)),
+				LookupValueKey: ptr.To("gir"),
+				PersonEmailKey: ptr.To("gir@irk.com"),
+			},
+			map[string]any{
+				LookupIDKey:    ptr.To(float64(11)),
+				LookupValueKey: ptr.To("dib"),
+				PersonEmailKey: ptr.To("dib@earth.com"),
+			},
+		},
+		"Link": map[string]any{
+			HyperlinkURLKey:         ptr.To("https://example.com"),
+			HyperlinkDescriptionKey: ptr.To("example"),
+		},
+		AuthorColumnName + LookupIDFieldNamePart: ptr.To("7"),
+		"Hidden":                                 ptr.To("hidden"),
+	})
+
+	li := models.NewListItem()
+	li.SetId(ptr.To("1"))
+	li.SetFields(fields)
+	li.SetCreatedBy(createdBy)
+
+	empty := models.NewListItem()
+	empty.SetId(ptr.To("2"))
+
+	list := models.NewList()
+	list.SetColumns(columns)
+	list.SetItems([]models.ListItemable{li, empty})
+
+	header, rows := ListToTable(list)
+
+	assert.Equal(
+		t,
+		[]string{
+			ListIDColumnName,
+			"Title Display",
+			"Count Display",
+			"Done Display",
+			"Tags Display",
+			"Country Display",
+			"Owners Display",
+			"Link Display",
+			"Author Display",
+		},
+		header)
+
+	require.Len(t, rows, 2)
+	assert.Equal(
+		t,
+		[]string{
+			"1",
+			"first",
+			"42.5",
+			"true",
+			"red; blue",
+			"3",
+			"gir; dib",
+			"https://example.com,example",
+			"zim",
+		},
+		rows[0])
+	assert.Equal(t, []string{"2", "", "", "", "", "", "", "", ""}, rows[1])
+}
+
+func (suite *ListsUnitSuite) TestListItemsDeltaPage() {
+	t := suite.T()
+
+	body := `{
+		"value": [
+			{"id": "1", "lastModifiedDateTime": "2024-01-02T03:04:05Z"},
+			{"id": "2", "deleted": {"state": "deleted"}}
+		],
+		"@odata.deltaLink": "https://graph.microsoft.com/delta?token=next"
+	}`
+
+	pn, err := kjson.NewJsonParseNode([]byte(body))
+	require.NoError(t, err, clues.ToCore(err))
+
+	parsable, err := pn.GetObjectValue(models.CreateListItemCollectionResponseFromDiscriminatorValue)
+	require.NoError(t, err, clues.ToCore(err))
+
+	page := listItemsDeltaPage{parsable.(models.ListItemCollectionResponseable)}
+	assert.Equal(t, "https://graph.microsoft.com/delta?token=next", ptr.Val(page.GetOdataDeltaLink()))
+	assert.Empty(t, ptr.Val(page.GetOdataNextLink()))
+
+	added, removed, err := AddedAndRemovedListItems(page.GetValue())
+	require.NoError(t, err, clues.ToCore(err))
+
+	assert.Equal(
+		t,
+		map[string]time.Time{"1": time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		added)
+	assert.Equal(t, []string{"2"}, removed)
+}
+
+func (suite *ListsUnitSuite) TestGetListWithItemChanges_itemNotFound() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	a := tconfig.NewFakeM365Account(t)
+	creds, err := a.M365Config()
+	require.NoError(t, err, clues.ToCore(err))
+
+	client, err := gockClient(creds, count.New())
+	require.NoError(t, err, clues.ToCore(err))
+
+	defer gock.Off()
+
+	interceptV1Path("sites", "sid", "lists", "lid").
+		Reply(http.StatusOK).
+		JSON(map[string]any{"id": "lid", "displayName": "list"})
+	interceptV1Path("sites", "sid", "lists", "lid", "columns").
+		Reply(http.StatusOK).
+		JSON(map[string]any{"value": []any{}})
+	interceptV1Path("sites", "sid", "lists", "lid", "contentTypes").
+		Reply(http.StatusOK).
+		JSON(map[string]any{"value": []any{}})
+	// the item was deleted after the delta was enumerated.
+	interceptV1Path("sites", "sid", "lists", "lid", "items", "gone").
+		Reply(http.StatusNotFound).
+		JSON(map[string]any{
+			"error": map[string]any{
+				"code":    "itemNotFound",
+				"message": "The resource could not be found.",
+			},
+		})
+	interceptV1Path("sites", "sid", "lists", "lid", "items", "changed").
+		Reply(http.StatusOK).
+		JSON(map[string]any{"id": "changed"})
+	interceptV1Path("sites", "sid", "lists", "lid", "items", "changed", "fields").
+		Reply(http.StatusOK).
+		JSON(map[string]any{"Title": "new title"})
+
+	prev := models.NewList()
+	prev.SetItems([]models.ListItemable{
+		listItem("unchanged"),
+		listItem("changed"),
+		listItem("gone"),
+	})
+
+	list, _, err := client.Lists().GetListWithItemChanges(
+		ctx,
+		"sid",
+		"lid",
+		prev,
+		[]string{"changed", "gone"},
+		nil)
+	require.NoError(t, err, clues.ToCore(err))
+	assert.True(t, gock.IsDone(), "made all requests")
+
+	ids := []string{}
+
+	for _, li := range list.GetItems() {
+		ids = append(ids, ptr.Val(li.GetId()))
+	}
+
+	assert.Equal(t, []string{"unchanged", "changed"}, ids)
+	assert.NotNil(t, list.GetItems()[1].GetFields(), "changed item has its fields")
+}
+
+func listItem(id string) models.ListItemable {
+	li := models.NewListItem()
+	li.SetId(ptr.To(id))
+
+	return li
+}
+
 type ListsAPIIntgSuite struct {
 	tester.Suite
 	its intgTesterSetup
@@ -1339,6 +1580,102 @@ func (suite *ListsAPIIntgSuite) TestLists_GetListByID() {
 	}
 }
 
+func (suite *ListsAPIIntgSuite) TestLists_GetListWithItemChanges() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	defer gock.Off()
+
+	var (
+		listID = "fake-list-id"
+		siteID = suite.its.site.id
+	)
+
+	listItem := func(id, name string) models.ListItemable {
+		fields := models.NewFieldValueSet()
+		fields.SetAdditionalData(map[string]any{"itemName": name})
+
+		li := models.NewListItem()
+		li.SetId(ptr.To(id))
+		li.SetFields(fields)
+
+		return li
+	}
+
+	prev := models.NewList()
+	prev.SetId(ptr.To(listID))
+	prev.SetItems([]models.ListItemable{
+		listItem("kept", "kept"),
+		listItem("changed", "before"),
+		listItem("removed", "removed"),
+	})
+
+	list := models.NewList()
+	list.SetId(ptr.To(listID))
+	list.SetDisplayName(ptr.To("fake-list-name"))
+
+	interceptV1Path("sites", siteID, "lists", listID).
+		Reply(200).
+		JSON(graphTD.ParseableToMap(t, list))
+
+	interceptV1Path("sites", siteID, "lists", listID, "columns").
+		Reply(200).
+		JSON(graphTD.ParseableToMap(t, models.NewColumnDefinitionCollectionResponse()))
+
+	interceptV1Path("sites", siteID, "lists", listID, "contentTypes").
+		Reply(200).
+		JSON(graphTD.ParseableToMap(t, models.NewContentTypeCollectionResponse()))
+
+	for id, name := range map[string]string{"changed": "after", "new": "new"} {
+		item := models.NewListItem()
+		item.SetId(ptr.To(id))
+
+		fields := models.NewFieldValueSet()
+		fields.SetAdditionalData(map[string]any{"itemName": name})
+
+		interceptV1Path("sites", siteID, "lists", listID, "items", id).
+			Reply(200).
+			JSON(graphTD.ParseableToMap(t, item))
+
+		interceptV1Path("sites", siteID, "lists", listID, "items", id, "fields").
+			Reply(200).
+			JSON(graphTD.ParseableToMap(t, fields))
+	}
+
+	interceptV1Path("sites", siteID, "lists", listID, "items", "deleted-in-flight").
+		Reply(404).
+		JSON(graphTD.ParseableToMap(t, graphTD.ODataErr(string(graph.ItemNotFound))))
+
+	result, info, err := suite.its.gockAC.Lists().GetListWithItemChanges(
+		ctx,
+		siteID,
+		listID,
+		prev,
+		[]string{"changed", "deleted-in-flight", "new"},
+		[]string{"removed"})
+	require.NoError(t, err, clues.ToCore(err))
+
+	var ids, names []string
+
+	for _, li := range result.GetItems() {
+		ids = append(ids, ptr.Val(li.GetId()))
+
+		// carried over items keep their fields as they were set in prev.
+		switch name := li.GetFields().GetAdditionalData()["itemName"].(type) {
+		case *string:
+			names = append(names, ptr.Val(name))
+		case string:
+			names = append(names, name)
+		}
+	}
+
+	assert.Equal(t, []string{"kept", "changed", "new"}, ids)
+	assert.Equal(t, []string{"kept", "after", "new"}, names)
+	assert.Equal(t, int64(3), info.List.ItemCount)
+}
+
 func (suite *ListsAPIIntgSuite) TestLists_PostList() {
 	t := suite.T()
 
@@ -0,0 +1,267 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoft/kiota-abstractions-go/serialization"
+	kjson "github.com/microsoft/kiota-serialization-json-go"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+)
+
+// ---------------------------------------------------------------------------
+// controller
+// ---------------------------------------------------------------------------
+
+func (c Client) MailboxSettings() MailboxSettings {
+	return MailboxSettings{c}
+}
+
+// MailboxSettings is an interface-compliant provider of the client.
+type MailboxSettings struct {
+	Client
+}
+
+// ---------------------------------------------------------------------------
+// settings
+// ---------------------------------------------------------------------------
+
+// GetSettings retrieves the user's mailbox settings, such as their automatic
+// replies, working hours, and time zone.
+// Reference: https://learn.microsoft.com/en-us/graph/api/user-get-mailboxsettings?view=graph-rest-1.0
+func (c MailboxSettings) GetSettings(
+	ctx context.Context,
+	userID string,
+) (models.MailboxSettingsable, error) {
+	settings, err := c.Stable.
+		Client().
+		Users().
+		ByUserId(userID).
+		MailboxSettings().
+		Get(ctx, nil)
+
+	return settings, clues.Wrap(err, "getting mailbox settings").OrNil()
+}
+
+// PatchSettings updates the user's mailbox settings.  Only the properties
+// populated in the body are changed.
+// Reference: https://learn.microsoft.com/en-us/graph/api/user-update-mailboxsettings?view=graph-rest-1.0
+func (c MailboxSettings) PatchSettings(
+	ctx context.Context,
+	userID string,
+	body models.MailboxSettingsable,
+) error {
+	_, err := c.Stable.
+		Client().
+		Users().
+		ByUserId(userID).
+		MailboxSettings().
+		Patch(ctx, body, nil)
+
+	return clues.Wrap(err, "updating mailbox settings").OrNil()
+}
+
+// ---------------------------------------------------------------------------
+// inbox rules
+// ---------------------------------------------------------------------------
+
+// PostInboxRule creates a rule in the user's inbox.
+// Reference: https://learn.microsoft.com/en-us/graph/api/mailfolder-post-messagerules?view=graph-rest-1.0
+func (c MailboxSettings) PostInboxRule(
+	ctx context.Context,
+	userID string,
+	body models.MessageRuleable,
+) (models.MessageRuleable, error) {
+	rule, err := c.Stable.
+		Client().
+		Users().
+		ByUserId(userID).
+		MailFolders().
+		ByMailFolderId(MailInbox).
+		MessageRules().
+		Post(ctx, body, nil)
+
+	return rule, clues.Wrap(err, "creating inbox rule").OrNil()
+}
+
+// DeleteInboxRule removes a rule from the user's inbox.
+func (c MailboxSettings) DeleteInboxRule(
+	ctx context.Context,
+	userID, ruleID string,
+) error {
+	// deletes require unique http clients
+	// https://github.com/alcionai/corso/issues/2707
+	srv, err := c.Service(c.counter)
+	if err != nil {
+		return clues.StackWC(ctx, err)
+	}
+
+	err = srv.
+		Client().
+		Users().
+		ByUserId(userID).
+		MailFolders().
+		ByMailFolderId(MailInbox).
+		MessageRules().
+		ByMessageRuleId(ruleID).
+		Delete(ctx, nil)
+
+	return clues.Wrap(err, "deleting inbox rule").OrNil()
+}
+
+// ---------------------------------------------------------------------------
+// categories
+// ---------------------------------------------------------------------------
+
+// PostMasterCategory adds a category to the user's master category list.
+// Reference: https://learn.microsoft.com/en-us/graph/api/outlookuser-post-mastercategories?view=graph-rest-1.0
+func (c MailboxSettings) PostMasterCategory(
+	ctx context.Context,
+	userID string,
+	body models.OutlookCategoryable,
+) (models.OutlookCategoryable, error) {
+	cat, err := c.Stable.
+		Client().
+		Users().
+		ByUserId(userID).
+		Outlook().
+		MasterCategories().
+		Post(ctx, body, nil)
+
+	return cat, clues.Wrap(err, "creating category").OrNil()
+}
+
+// PatchMasterCategory updates a category in the user's master category list.
+// The display name of a category can't be changed.
+func (c MailboxSettings) PatchMasterCategory(
+	ctx context.Context,
+	userID, categoryID string,
+	body models.OutlookCategoryable,
+) error {
+	_, err := c.Stable.
+		Client().
+		Users().
+		ByUserId(userID).
+		Outlook().
+		MasterCategories().
+		ByOutlookCategoryId(categoryID).
+		Patch(ctx, body, nil)
+
+	return clues.Wrap(err, "updating category").OrNil()
+}
+
+// ---------------------------------------------------------------------------
+// Serialization
+// ---------------------------------------------------------------------------
+
+// Serialize writes any of the mailbox settings models to bytes.
+func (c MailboxSettings) Serialize(
+	ctx context.Context,
+	item serialization.Parsable,
+) ([]byte, error) {
+	writer := kjson.NewJsonSerializationWriter()
+
+	defer writer.Close()
+
+	if err := writer.WriteObjectValue("", item); err != nil {
+		return nil, clues.StackWC(ctx, err)
+	}
+
+	bs, err := writer.GetSerializedContent()
+
+	return bs, clues.WrapWC(ctx, err, "serializing mailbox setting").OrNil()
+}
+
+func BytesToMessageRuleable(bytes []byte) (models.MessageRuleable, error) {
+	v, err := CreateFromBytes(bytes, models.CreateMessageRuleFromDiscriminatorValue)
+	if err != nil {
+		return nil, clues.Wrap(err, "deserializing bytes to inbox rule")
+	}
+
+	return v.(models.MessageRuleable), nil
+}
+
+func BytesToMailboxSettingsable(bytes []byte) (models.MailboxSettingsable, error) {
+	v, err := CreateFromBytes(bytes, models.CreateMailboxSettingsFromDiscriminatorValue)
+	if err != nil {
+		return nil, clues.Wrap(err, "deserializing bytes to mailbox settings")
+	}
+
+	return v.(models.MailboxSettingsable), nil
+}
+
+// BytesToOutlookCategories deserializes the master category list, which is
+// stored as a collection response.
+func BytesToOutlookCategories(bytes []byte) ([]models.OutlookCategoryable, error) {
+	v, err := CreateFromBytes(bytes, models.CreateOutlookCategoryCollectionResponseFromDiscriminatorValue)
+	if err != nil {
+		return nil, clues.Wrap(err, "deserializing bytes to categories")
+	}
+
+	return v.(models.OutlookCategoryCollectionResponseable).GetValue(), nil
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// InboxRuleInfo produces the details of an inbox rule.  The content is the
+// serialized rule, and is hashed so that changes to the rule can be found
+// by comparing backups.
+func InboxRuleInfo(rule models.MessageRuleable, content []byte) *details.ExchangeInfo {
+	state := "disabled"
+	if ptr.Val(rule.GetIsEnabled()) {
+		state = "enabled"
+	}
+
+	var forwardsTo []string
+
+	if acts := rule.GetActions(); acts != nil {
+		recips := append([]models.Recipientable{}, acts.GetForwardTo()...)
+		recips = append(recips, acts.GetForwardAsAttachmentTo()...)
+		recips = append(recips, acts.GetRedirectTo()...)
+
+		for _, r := range recips {
+			if r.GetEmailAddress() == nil {
+				continue
+			}
+
+			forwardsTo = append(forwardsTo, ptr.Val(r.GetEmailAddress().GetAddress()))
+		}
+	}
+
+	info := MailboxSettingInfo(ptr.Val(rule.GetDisplayName()), state, content)
+	info.ForwardsTo = forwardsTo
+
+	return info
+}
+
+// MailboxSettingInfo produces the details of a mailbox setting.  The content
+// is the serialized setting, and is hashed so that changes to the setting
+// can be found by comparing backups.
+func MailboxSettingInfo(name, state string, content []byte) *details.ExchangeInfo {
+	sum := sha256.Sum256(content)
+
+	return &details.ExchangeInfo{
+		ItemType:     details.ExchangeMailboxSetting,
+		SettingName:  name,
+		SettingState: state,
+		SettingHash:  hex.EncodeToString(sum[:]),
+		Size:         int64(len(content)),
+	}
+}
+
+// InboxRuleCollisionKey constructs a key from the rule's display name.
+// collision keys are used to identify duplicate item conflicts for handling advanced restoration config.
+func InboxRuleCollisionKey(rule models.MessageRuleable) string {
+	if rule == nil {
+		return ""
+	}
+
+	return ptr.Val(rule.GetDisplayName())
+}
@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/pagers"
+)
+
+// ---------------------------------------------------------------------------
+// inbox rule pager
+// ---------------------------------------------------------------------------
+
+var _ pagers.NonDeltaHandler[models.MessageRuleable] = &inboxRulesPageCtrl{}
+
+type inboxRulesPageCtrl struct {
+	gs      graph.Servicer
+	builder *users.ItemMailFoldersItemMessageRulesRequestBuilder
+	options *users.ItemMailFoldersItemMessageRulesRequestBuilderGetRequestConfiguration
+}
+
+func (c MailboxSettings) NewInboxRulesPager(
+	userID string,
+) pagers.NonDeltaHandler[models.MessageRuleable] {
+	options := &users.ItemMailFoldersItemMessageRulesRequestBuilderGetRequestConfiguration{
+		Headers:         newPreferHeaders(preferPageSize(maxNonDeltaPageSize)),
+		QueryParameters: &users.ItemMailFoldersItemMessageRulesRequestBuilderGetQueryParameters{},
+		// do NOT set Top.  It limits the total items received.
+	}
+
+	builder := c.Stable.
+		Client().
+		Users().
+		ByUserId(userID).
+		MailFolders().
+		ByMailFolderId(MailInbox).
+		MessageRules()
+
+	return &inboxRulesPageCtrl{c.Stable, builder, options}
+}
+
+func (p *inboxRulesPageCtrl) GetPage(
+	ctx context.Context,
+) (pagers.NextLinkValuer[models.MessageRuleable], error) {
+	resp, err := p.builder.Get(ctx, p.options)
+	return resp, clues.Stack(err).OrNil()
+}
+
+func (p *inboxRulesPageCtrl) SetNextLink(nextLink string) {
+	p.builder = users.NewItemMailFoldersItemMessageRulesRequestBuilder(nextLink, p.gs.Adapter())
+}
+
+func (p *inboxRulesPageCtrl) ValidModTimes() bool {
+	return false
+}
+
+// GetInboxRules retrieves all of the rules in the user's inbox.
+func (c MailboxSettings) GetInboxRules(
+	ctx context.Context,
+	userID string,
+) ([]models.MessageRuleable, error) {
+	rules, err := pagers.BatchEnumerateItems(ctx, c.NewInboxRulesPager(userID))
+	return rules, clues.Wrap(err, "enumerating inbox rules").OrNil()
+}
+
+// ---------------------------------------------------------------------------
+// category pager
+// ---------------------------------------------------------------------------
+
+var _ pagers.NonDeltaHandler[models.OutlookCategoryable] = &masterCategoriesPageCtrl{}
+
+type masterCategoriesPageCtrl struct {
+	gs      graph.Servicer
+	builder *users.ItemOutlookMasterCategoriesRequestBuilder
+	options *users.ItemOutlookMasterCategoriesRequestBuilderGetRequestConfiguration
+}
+
+func (c MailboxSettings) NewMasterCategoriesPager(
+	userID string,
+) pagers.NonDeltaHandler[models.OutlookCategoryable] {
+	options := &users.ItemOutlookMasterCategoriesRequestBuilderGetRequestConfiguration{
+		Headers:         newPreferHeaders(preferPageSize(maxNonDeltaPageSize)),
+		QueryParameters: &users.ItemOutlookMasterCategoriesRequestBuilderGetQueryParameters{},
+		// do NOT set Top.  It limits the total items received.
+	}
+
+	builder := c.Stable.
+		Client().
+		Users().
+		ByUserId(userID).
+		Outlook().
+		MasterCategories()
+
+	return &masterCategoriesPageCtrl{c.Stable, builder, options}
+}
+
+func (p *masterCategoriesPageCtrl) GetPage(
+	ctx context.Context,
+) (pagers.NextLinkValuer[models.OutlookCategoryable], error) {
+	resp, err := p.builder.Get(ctx, p.options)
+	return resp, clues.Stack(err).OrNil()
+}
+
+func (p *masterCategoriesPageCtrl) SetNextLink(nextLink string) {
+	p.builder = users.NewItemOutlookMasterCategoriesRequestBuilder(nextLink, p.gs.Adapter())
+}
+
+func (p *masterCategoriesPageCtrl) ValidModTimes() bool {
+	return false
+}
+
+// GetMasterCategories retrieves the user's master category list.
+func (c MailboxSettings) GetMasterCategories(
+	ctx context.Context,
+	userID string,
+) ([]models.OutlookCategoryable, error) {
+	cats, err := pagers.BatchEnumerateItems(ctx, c.NewMasterCategoriesPager(userID))
+	return cats, clues.Wrap(err, "enumerating categories").OrNil()
+}
@@ -0,0 +1,133 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+)
+
+type MailboxSettingsAPIUnitSuite struct {
+	tester.Suite
+}
+
+func TestMailboxSettingsAPIUnitSuite(t *testing.T) {
+	suite.Run(t, &MailboxSettingsAPIUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func recipient(addr string) models.Recipientable {
+	ea := models.NewEmailAddress()
+	ea.SetAddress(ptr.To(addr))
+
+	r := models.NewRecipient()
+	r.SetEmailAddress(ea)
+
+	return r
+}
+
+func (suite *MailboxSettingsAPIUnitSuite) TestInboxRuleInfo() {
+	table := []struct {
+		name          string
+		rule          func() models.MessageRuleable
+		expectState   string
+		expectForward []string
+	}{
+		{
+			name: "disabled, no actions",
+			rule: func() models.MessageRuleable {
+				rule := models.NewMessageRule()
+				rule.SetDisplayName(ptr.To("rule"))
+
+				return rule
+			},
+			expectState: "disabled",
+		},
+		{
+			name: "enabled, forwards",
+			rule: func() models.MessageRuleable {
+				acts := models.NewMessageRuleActions()
+				acts.SetForwardTo([]models.Recipientable{recipient("a@example.com")})
+				acts.SetForwardAsAttachmentTo([]models.Recipientable{recipient("b@example.com")})
+				acts.SetRedirectTo([]models.Recipientable{recipient("c@example.com")})
+
+				rule := models.NewMessageRule()
+				rule.SetDisplayName(ptr.To("rule"))
+				rule.SetIsEnabled(ptr.To(true))
+				rule.SetActions(acts)
+
+				return rule
+			},
+			expectState:   "enabled",
+			expectForward: []string{"a@example.com", "b@example.com", "c@example.com"},
+		},
+	}
+	for _, test := range table {
+		suite.Run(test.name, func() {
+			t := suite.T()
+			content := []byte(test.name)
+
+			info := InboxRuleInfo(test.rule(), content)
+			assert.Equal(t, details.ExchangeMailboxSetting, info.ItemType)
+			assert.Equal(t, "rule", info.SettingName)
+			assert.Equal(t, test.expectState, info.SettingState)
+			assert.Equal(t, test.expectForward, info.ForwardsTo)
+			assert.Equal(t, int64(len(content)), info.Size)
+			assert.NotEmpty(t, info.SettingHash)
+		})
+	}
+}
+
+func (suite *MailboxSettingsAPIUnitSuite) TestMailboxSettingInfo_hash() {
+	t := suite.T()
+
+	a := MailboxSettingInfo("timeZone", "UTC", []byte("a"))
+	a2 := MailboxSettingInfo("timeZone", "UTC", []byte("a"))
+	b := MailboxSettingInfo("timeZone", "UTC", []byte("b"))
+
+	assert.Equal(t, a.SettingHash, a2.SettingHash)
+	assert.NotEqual(t, a.SettingHash, b.SettingHash)
+}
+
+func (suite *MailboxSettingsAPIUnitSuite) TestSerialize_roundTrip() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	ms := MailboxSettings{}
+
+	rule := models.NewMessageRule()
+	rule.SetId(ptr.To("rid"))
+	rule.SetDisplayName(ptr.To("Forward invoices"))
+	rule.SetSequence(ptr.To[int32](2))
+
+	bs, err := ms.Serialize(ctx, rule)
+	require.NoError(t, err, clues.ToCore(err))
+
+	result, err := BytesToMessageRuleable(bs)
+	require.NoError(t, err, clues.ToCore(err))
+	assert.Equal(t, "rid", ptr.Val(result.GetId()))
+	assert.Equal(t, "Forward invoices", InboxRuleCollisionKey(result))
+	assert.Equal(t, int32(2), ptr.Val(result.GetSequence()))
+
+	cat := models.NewOutlookCategory()
+	cat.SetDisplayName(ptr.To("Red category"))
+
+	cats := models.NewOutlookCategoryCollectionResponse()
+	cats.SetValue([]models.OutlookCategoryable{cat})
+
+	bs, err = ms.Serialize(ctx, cats)
+	require.NoError(t, err, clues.ToCore(err))
+
+	catResult, err := BytesToOutlookCategories(bs)
+	require.NoError(t, err, clues.ToCore(err))
+	require.Len(t, catResult, 1)
+	assert.Equal(t, "Red category", ptr.Val(catResult[0].GetDisplayName()))
+}
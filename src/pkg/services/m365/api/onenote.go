@@ -0,0 +1,323 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/pkg/logger"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
+)
+
+const (
+	userOneNoteRawURLFmt = "https://graph.microsoft.com/v1.0/users/%s/onenote"
+	siteOneNoteRawURLFmt = "https://graph.microsoft.com/v1.0/sites/%s/onenote"
+
+	// OneNotePresentationPart is the name of the multipart section holding
+	// the html body when creating a page.
+	OneNotePresentationPart = "Presentation"
+)
+
+// ---------------------------------------------------------------------------
+// controller
+// ---------------------------------------------------------------------------
+
+func (c Client) OneNote() OneNote {
+	return OneNote{c}
+}
+
+// OneNote is an interface-compliant provider of the client.
+type OneNote struct {
+	Client
+}
+
+// OneNoteOwner identifies the user or site that owns a set of notebooks.
+type OneNoteOwner struct {
+	// Service is path.OneDriveService for user notebooks and
+	// path.SharePointService for site notebooks.
+	Service    path.ServiceType
+	ResourceID string
+}
+
+// rootURL produces the url of the onenote api for the owner.  Graph's
+// onenote endpoints are identical for users and sites below this root,
+// so all calls are made against raw urls built from it.
+func (o OneNoteOwner) rootURL() (string, error) {
+	switch o.Service {
+	case path.OneDriveService:
+		return fmt.Sprintf(userOneNoteRawURLFmt, o.ResourceID), nil
+	case path.SharePointService:
+		return fmt.Sprintf(siteOneNoteRawURLFmt, o.ResourceID), nil
+	}
+
+	return "", clues.New("unsupported onenote owner service").With("service", o.Service)
+}
+
+func (o OneNoteOwner) url(elems ...string) (string, error) {
+	root, err := o.rootURL()
+	if err != nil {
+		return "", err
+	}
+
+	for _, e := range elems {
+		root += "/" + url.PathEscape(e)
+	}
+
+	return root, nil
+}
+
+// ---------------------------------------------------------------------------
+// containers
+// ---------------------------------------------------------------------------
+
+// CreateNotebook makes a notebook with the given display name.
+// Reference: https://learn.microsoft.com/en-us/graph/api/onenote-post-notebooks?view=graph-rest-1.0
+func (c OneNote) CreateNotebook(
+	ctx context.Context,
+	owner OneNoteOwner,
+	name string,
+) (models.Notebookable, error) {
+	rawURL, err := owner.url("notebooks")
+	if err != nil {
+		return nil, clues.StackWC(ctx, err)
+	}
+
+	body := models.NewNotebook()
+	body.SetDisplayName(ptr.To(name))
+
+	resp, err := users.
+		NewItemOnenoteNotebooksRequestBuilder(rawURL, c.Stable.Adapter()).
+		Post(ctx, body, nil)
+
+	return resp, clues.Wrap(err, "creating notebook").OrNil()
+}
+
+// CreateSectionGroup makes a section group with the given display name.  The
+// group is created within parentGroupID, if provided, or else at the root of
+// the notebook.
+// Reference: https://learn.microsoft.com/en-us/graph/api/notebook-post-sectiongroups?view=graph-rest-1.0
+func (c OneNote) CreateSectionGroup(
+	ctx context.Context,
+	owner OneNoteOwner,
+	notebookID, parentGroupID, name string,
+) (models.SectionGroupable, error) {
+	rawURL, err := owner.url("notebooks", notebookID, "sectionGroups")
+	if len(parentGroupID) > 0 {
+		rawURL, err = owner.url("sectionGroups", parentGroupID, "sectionGroups")
+	}
+
+	if err != nil {
+		return nil, clues.StackWC(ctx, err)
+	}
+
+	body := models.NewSectionGroup()
+	body.SetDisplayName(ptr.To(name))
+
+	resp, err := users.
+		NewItemOnenoteSectionGroupsRequestBuilder(rawURL, c.Stable.Adapter()).
+		Post(ctx, body, nil)
+
+	return resp, clues.Wrap(err, "creating section group").OrNil()
+}
+
+// CreateSection makes a section with the given display name.  The section is
+// created within parentGroupID, if provided, or else at the root of the notebook.
+// Reference: https://learn.microsoft.com/en-us/graph/api/notebook-post-sections?view=graph-rest-1.0
+func (c OneNote) CreateSection(
+	ctx context.Context,
+	owner OneNoteOwner,
+	notebookID, parentGroupID, name string,
+) (models.OnenoteSectionable, error) {
+	rawURL, err := owner.url("notebooks", notebookID, "sections")
+	if len(parentGroupID) > 0 {
+		rawURL, err = owner.url("sectionGroups", parentGroupID, "sections")
+	}
+
+	if err != nil {
+		return nil, clues.StackWC(ctx, err)
+	}
+
+	body := models.NewOnenoteSection()
+	body.SetDisplayName(ptr.To(name))
+
+	resp, err := users.
+		NewItemOnenoteSectionsRequestBuilder(rawURL, c.Stable.Adapter()).
+		Post(ctx, body, nil)
+
+	return resp, clues.Wrap(err, "creating section").OrNil()
+}
+
+// ---------------------------------------------------------------------------
+// pages
+// ---------------------------------------------------------------------------
+
+// GetPageContent retrieves the html body of the page.
+func (c OneNote) GetPageContent(
+	ctx context.Context,
+	owner OneNoteOwner,
+	pageID string,
+) ([]byte, error) {
+	rawURL, err := owner.url("pages", pageID, "content")
+	if err != nil {
+		return nil, clues.StackWC(ctx, err)
+	}
+
+	resp, err := users.
+		NewItemOnenotePagesItemContentRequestBuilder(rawURL, c.Stable.Adapter()).
+		Get(ctx, nil)
+
+	return resp, clues.Wrap(err, "getting page content").OrNil()
+}
+
+// DeletePage removes the page from its section.
+func (c OneNote) DeletePage(
+	ctx context.Context,
+	owner OneNoteOwner,
+	pageID string,
+) error {
+	rawURL, err := owner.url("pages", pageID)
+	if err != nil {
+		return clues.StackWC(ctx, err)
+	}
+
+	err = users.
+		NewItemOnenotePagesOnenotePageItemRequestBuilder(rawURL, c.Stable.Adapter()).
+		Delete(ctx, nil)
+
+	return clues.Wrap(err, "deleting page").OrNil()
+}
+
+// GetResourceContent retrieves the binary content of an image or file
+// embedded in a page.
+func (c OneNote) GetResourceContent(
+	ctx context.Context,
+	owner OneNoteOwner,
+	resourceID string,
+) ([]byte, error) {
+	rawURL, err := owner.url("resources", resourceID, "content")
+	if err != nil {
+		return nil, clues.StackWC(ctx, err)
+	}
+
+	resp, err := users.
+		NewItemOnenoteResourcesItemContentRequestBuilder(rawURL, c.LargeItem.Adapter()).
+		Get(ctx, nil)
+
+	return resp, clues.Wrap(err, "getting page resource content").OrNil()
+}
+
+// OneNotePagePart is an additional part of a multipart page creation
+// request, such as an image referenced by the html as "name:<Name>".
+type OneNotePagePart struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
+
+// CreatePage posts a new page into the section.  The html is sent as the
+// presentation part of a multipart request alongside the provided parts.
+// Reference: https://learn.microsoft.com/en-us/graph/onenote-images-files
+func (c OneNote) CreatePage(
+	ctx context.Context,
+	owner OneNoteOwner,
+	sectionID, html string,
+	parts []OneNotePagePart,
+) (models.OnenotePageable, error) {
+	rawURL, err := owner.url("sections", sectionID, "pages")
+	if err != nil {
+		return nil, clues.StackWC(ctx, err)
+	}
+
+	body, contentType, err := multipartPageBody(html, parts)
+	if err != nil {
+		return nil, clues.WrapWC(ctx, err, "building page body")
+	}
+
+	resp, err := c.Requester.Request(
+		ctx,
+		http.MethodPost,
+		rawURL,
+		body,
+		map[string]string{"Content-Type": contentType},
+		true)
+	if err != nil {
+		return nil, clues.WrapWC(ctx, err, "creating page")
+	}
+
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, clues.WrapWC(ctx, err, "reading page creation response")
+	}
+
+	if (resp.StatusCode / 100) != 2 {
+		logger.Ctx(ctx).Debugw("page creation failed", "response_body", string(respBody))
+
+		return nil, clues.
+			Wrap(clues.NewWC(ctx, resp.Status), "creating page").
+			Label(graph.LabelStatus(resp.StatusCode))
+	}
+
+	page, err := CreateFromBytes(respBody, models.CreateOnenotePageFromDiscriminatorValue)
+	if err != nil {
+		return nil, clues.WrapWC(ctx, err, "deserializing created page")
+	}
+
+	return page.(models.OnenotePageable), nil
+}
+
+func multipartPageBody(
+	html string,
+	parts []OneNotePagePart,
+) (io.Reader, string, error) {
+	var (
+		buf = &bytes.Buffer{}
+		mw  = multipart.NewWriter(buf)
+	)
+
+	pw, err := mw.CreatePart(partHeader(OneNotePresentationPart, "text/html"))
+	if err != nil {
+		return nil, "", clues.Wrap(err, "creating presentation part")
+	}
+
+	if _, err := pw.Write([]byte(html)); err != nil {
+		return nil, "", clues.Wrap(err, "writing presentation part")
+	}
+
+	for _, p := range parts {
+		pw, err := mw.CreatePart(partHeader(p.Name, p.ContentType))
+		if err != nil {
+			return nil, "", clues.Wrap(err, "creating resource part").With("part_name", p.Name)
+		}
+
+		if _, err := pw.Write(p.Content); err != nil {
+			return nil, "", clues.Wrap(err, "writing resource part").With("part_name", p.Name)
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, "", clues.Wrap(err, "closing multipart body")
+	}
+
+	return buf, mw.FormDataContentType(), nil
+}
+
+func partHeader(name, contentType string) textproto.MIMEHeader {
+	h := textproto.MIMEHeader{}
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"`, name))
+	h.Set("Content-Type", contentType)
+
+	return h
+}
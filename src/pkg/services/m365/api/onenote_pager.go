@@ -0,0 +1,259 @@
+package api
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/pagers"
+)
+
+// withQuery appends the query to the url.  Request builders made from a
+// raw url ignore the query parameters in their request configuration.
+func withQuery(rawURL string, query url.Values) string {
+	if len(query) == 0 {
+		return rawURL
+	}
+
+	return rawURL + "?" + query.Encode()
+}
+
+// expand the parents of section groups and sections so that the
+// notebook hierarchy can be assembled from the flat listings.
+var onenoteParentsExpand = strings.Join([]string{"parentNotebook", "parentSectionGroup"}, ",")
+
+// ---------------------------------------------------------------------------
+// notebooks pager
+// ---------------------------------------------------------------------------
+
+var _ pagers.NonDeltaHandler[models.Notebookable] = &notebooksPageCtrl{}
+
+type notebooksPageCtrl struct {
+	gs      graph.Servicer
+	builder *users.ItemOnenoteNotebooksRequestBuilder
+}
+
+func (c OneNote) NewNotebooksPager(
+	owner OneNoteOwner,
+) (pagers.NonDeltaHandler[models.Notebookable], error) {
+	rawURL, err := owner.url("notebooks")
+	if err != nil {
+		return nil, clues.Stack(err)
+	}
+
+	builder := users.NewItemOnenoteNotebooksRequestBuilder(rawURL, c.Stable.Adapter())
+
+	return &notebooksPageCtrl{c.Stable, builder}, nil
+}
+
+func (p *notebooksPageCtrl) GetPage(
+	ctx context.Context,
+) (pagers.NextLinkValuer[models.Notebookable], error) {
+	resp, err := p.builder.Get(ctx, nil)
+	return resp, clues.Stack(err).OrNil()
+}
+
+func (p *notebooksPageCtrl) SetNextLink(nextLink string) {
+	p.builder = users.NewItemOnenoteNotebooksRequestBuilder(nextLink, p.gs.Adapter())
+}
+
+func (p *notebooksPageCtrl) ValidModTimes() bool {
+	return true
+}
+
+// EnumerateNotebooks retrieves all of the owner's notebooks.
+func (c OneNote) EnumerateNotebooks(
+	ctx context.Context,
+	owner OneNoteOwner,
+) ([]models.Notebookable, error) {
+	pager, err := c.NewNotebooksPager(owner)
+	if err != nil {
+		return nil, clues.StackWC(ctx, err)
+	}
+
+	items, err := pagers.BatchEnumerateItems(ctx, pager)
+
+	return items, clues.Wrap(err, "enumerating notebooks").OrNil()
+}
+
+// ---------------------------------------------------------------------------
+// section groups pager
+// ---------------------------------------------------------------------------
+
+var _ pagers.NonDeltaHandler[models.SectionGroupable] = &sectionGroupsPageCtrl{}
+
+type sectionGroupsPageCtrl struct {
+	gs      graph.Servicer
+	builder *users.ItemOnenoteSectionGroupsRequestBuilder
+}
+
+// NewSectionGroupsPager pages through every section group in all of the
+// owner's notebooks, regardless of nesting.
+func (c OneNote) NewSectionGroupsPager(
+	owner OneNoteOwner,
+) (pagers.NonDeltaHandler[models.SectionGroupable], error) {
+	rawURL, err := owner.url("sectionGroups")
+	if err != nil {
+		return nil, clues.Stack(err)
+	}
+
+	rawURL = withQuery(rawURL, url.Values{"$expand": []string{onenoteParentsExpand}})
+	builder := users.NewItemOnenoteSectionGroupsRequestBuilder(rawURL, c.Stable.Adapter())
+
+	return &sectionGroupsPageCtrl{c.Stable, builder}, nil
+}
+
+func (p *sectionGroupsPageCtrl) GetPage(
+	ctx context.Context,
+) (pagers.NextLinkValuer[models.SectionGroupable], error) {
+	resp, err := p.builder.Get(ctx, nil)
+	return resp, clues.Stack(err).OrNil()
+}
+
+func (p *sectionGroupsPageCtrl) SetNextLink(nextLink string) {
+	p.builder = users.NewItemOnenoteSectionGroupsRequestBuilder(nextLink, p.gs.Adapter())
+}
+
+func (p *sectionGroupsPageCtrl) ValidModTimes() bool {
+	return true
+}
+
+// EnumerateSectionGroups retrieves all of the owner's section groups, with
+// their parent notebook and parent section group expanded.
+func (c OneNote) EnumerateSectionGroups(
+	ctx context.Context,
+	owner OneNoteOwner,
+) ([]models.SectionGroupable, error) {
+	pager, err := c.NewSectionGroupsPager(owner)
+	if err != nil {
+		return nil, clues.StackWC(ctx, err)
+	}
+
+	items, err := pagers.BatchEnumerateItems(ctx, pager)
+
+	return items, clues.Wrap(err, "enumerating section groups").OrNil()
+}
+
+// ---------------------------------------------------------------------------
+// sections pager
+// ---------------------------------------------------------------------------
+
+var _ pagers.NonDeltaHandler[models.OnenoteSectionable] = &sectionsPageCtrl{}
+
+type sectionsPageCtrl struct {
+	gs      graph.Servicer
+	builder *users.ItemOnenoteSectionsRequestBuilder
+}
+
+// NewSectionsPager pages through every section in all of the owner's
+// notebooks, regardless of nesting.
+func (c OneNote) NewSectionsPager(
+	owner OneNoteOwner,
+) (pagers.NonDeltaHandler[models.OnenoteSectionable], error) {
+	rawURL, err := owner.url("sections")
+	if err != nil {
+		return nil, clues.Stack(err)
+	}
+
+	rawURL = withQuery(rawURL, url.Values{"$expand": []string{onenoteParentsExpand}})
+	builder := users.NewItemOnenoteSectionsRequestBuilder(rawURL, c.Stable.Adapter())
+
+	return &sectionsPageCtrl{c.Stable, builder}, nil
+}
+
+func (p *sectionsPageCtrl) GetPage(
+	ctx context.Context,
+) (pagers.NextLinkValuer[models.OnenoteSectionable], error) {
+	resp, err := p.builder.Get(ctx, nil)
+	return resp, clues.Stack(err).OrNil()
+}
+
+func (p *sectionsPageCtrl) SetNextLink(nextLink string) {
+	p.builder = users.NewItemOnenoteSectionsRequestBuilder(nextLink, p.gs.Adapter())
+}
+
+func (p *sectionsPageCtrl) ValidModTimes() bool {
+	return true
+}
+
+// EnumerateSections retrieves all of the owner's sections, with their
+// parent notebook and parent section group expanded.
+func (c OneNote) EnumerateSections(
+	ctx context.Context,
+	owner OneNoteOwner,
+) ([]models.OnenoteSectionable, error) {
+	pager, err := c.NewSectionsPager(owner)
+	if err != nil {
+		return nil, clues.StackWC(ctx, err)
+	}
+
+	items, err := pagers.BatchEnumerateItems(ctx, pager)
+
+	return items, clues.Wrap(err, "enumerating sections").OrNil()
+}
+
+// ---------------------------------------------------------------------------
+// pages pager
+// ---------------------------------------------------------------------------
+
+var _ pagers.NonDeltaHandler[models.OnenotePageable] = &pagesPageCtrl{}
+
+type pagesPageCtrl struct {
+	gs      graph.Servicer
+	builder *users.ItemOnenoteSectionsItemPagesRequestBuilder
+}
+
+// NewPagesPager pages through the pages in a section.  Pages are returned
+// with their level and order so that indentation survives a restore.
+func (c OneNote) NewPagesPager(
+	owner OneNoteOwner,
+	sectionID string,
+) (pagers.NonDeltaHandler[models.OnenotePageable], error) {
+	rawURL, err := owner.url("sections", sectionID, "pages")
+	if err != nil {
+		return nil, clues.Stack(err)
+	}
+
+	rawURL = withQuery(rawURL, url.Values{"pagelevel": []string{"true"}})
+	builder := users.NewItemOnenoteSectionsItemPagesRequestBuilder(rawURL, c.Stable.Adapter())
+
+	return &pagesPageCtrl{c.Stable, builder}, nil
+}
+
+func (p *pagesPageCtrl) GetPage(
+	ctx context.Context,
+) (pagers.NextLinkValuer[models.OnenotePageable], error) {
+	resp, err := p.builder.Get(ctx, nil)
+	return resp, clues.Stack(err).OrNil()
+}
+
+func (p *pagesPageCtrl) SetNextLink(nextLink string) {
+	p.builder = users.NewItemOnenoteSectionsItemPagesRequestBuilder(nextLink, p.gs.Adapter())
+}
+
+func (p *pagesPageCtrl) ValidModTimes() bool {
+	return true
+}
+
+// GetPagesInSection retrieves the metadata of all pages in the section.
+func (c OneNote) GetPagesInSection(
+	ctx context.Context,
+	owner OneNoteOwner,
+	sectionID string,
+) ([]models.OnenotePageable, error) {
+	ctx = clues.Add(ctx, "section_id", sectionID)
+
+	pager, err := c.NewPagesPager(owner, sectionID)
+	if err != nil {
+		return nil, clues.StackWC(ctx, err)
+	}
+
+	items, err := pagers.BatchEnumerateItems(ctx, pager)
+
+	return items, clues.Wrap(err, "enumerating pages").OrNil()
+}
@@ -0,0 +1,373 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoft/kiota-abstractions-go/serialization"
+	kjson "github.com/microsoft/kiota-serialization-json-go"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/common/sanitize"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/dttm"
+	"github.com/alcionai/corso/src/pkg/fault"
+	"github.com/alcionai/corso/src/pkg/logger"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
+)
+
+// ---------------------------------------------------------------------------
+// controller
+// ---------------------------------------------------------------------------
+
+func (c Client) Tasks() Tasks {
+	return Tasks{c}
+}
+
+// Tasks is an interface-compliant provider of the client.
+type Tasks struct {
+	Client
+}
+
+// ---------------------------------------------------------------------------
+// containers
+// ---------------------------------------------------------------------------
+
+// CreateContainer makes a To Do task list with the displayName of containerName.
+// If successful, returns the created list.
+// Reference: https://learn.microsoft.com/en-us/graph/api/todo-post-lists?view=graph-rest-1.0
+func (c Tasks) CreateContainer(
+	ctx context.Context,
+	// parentContainerID needed for iface, doesn't apply to task lists
+	userID, _, containerName string,
+) (graph.Container, error) {
+	body := models.NewTodoTaskList()
+	body.SetDisplayName(ptr.To(containerName))
+
+	mdl, err := c.Stable.
+		Client().
+		Users().
+		ByUserId(userID).
+		Todo().
+		Lists().
+		Post(ctx, body, nil)
+
+	return TaskListDisplayable{TodoTaskListable: mdl}, clues.Wrap(err, "creating task list").OrNil()
+}
+
+// DeleteContainer removes a task list from the user's M365 account.
+func (c Tasks) DeleteContainer(
+	ctx context.Context,
+	userID, containerID string,
+) error {
+	// deletes require unique http clients
+	// https://github.com/alcionai/corso/issues/2707
+	srv, err := NewService(c.Credentials, c.counter)
+	if err != nil {
+		return clues.StackWC(ctx, err)
+	}
+
+	err = srv.
+		Client().
+		Users().
+		ByUserId(userID).
+		Todo().
+		Lists().
+		ByTodoTaskListId(containerID).
+		Delete(ctx, nil)
+
+	return clues.Stack(err).OrNil()
+}
+
+func (c Tasks) GetContainerByID(
+	ctx context.Context,
+	userID, containerID string,
+) (graph.Container, error) {
+	resp, err := c.Stable.
+		Client().
+		Users().
+		ByUserId(userID).
+		Todo().
+		Lists().
+		ByTodoTaskListId(containerID).
+		Get(ctx, nil)
+
+	return TaskListDisplayable{TodoTaskListable: resp}, clues.Stack(err).OrNil()
+}
+
+// GetContainerByName fetches a task list by name
+func (c Tasks) GetContainerByName(
+	ctx context.Context,
+	// parentContainerID needed for iface, doesn't apply to task lists
+	userID, _, containerName string,
+) (graph.Container, error) {
+	filter := fmt.Sprintf("displayName eq '%s'", containerName)
+	options := &users.ItemTodoListsRequestBuilderGetRequestConfiguration{
+		QueryParameters: &users.ItemTodoListsRequestBuilderGetQueryParameters{
+			Filter: &filter,
+		},
+	}
+
+	ctx = clues.Add(ctx, "container_name", containerName)
+
+	resp, err := c.Stable.
+		Client().
+		Users().
+		ByUserId(userID).
+		Todo().
+		Lists().
+		Get(ctx, options)
+	if err != nil {
+		return nil, clues.Stack(err)
+	}
+
+	gv := resp.GetValue()
+
+	if len(gv) == 0 {
+		return nil, clues.NewWC(ctx, "container not found")
+	}
+
+	// Task list names aren't unique.  If we match multiples, we'll
+	// eagerly return the first one.
+	logger.Ctx(ctx).Debugw("task lists matched the name search", "task_list_count", len(gv))
+
+	// Sanity check ID and name
+	container := TaskListDisplayable{TodoTaskListable: gv[0]}
+
+	if err := graph.CheckIDAndName(container); err != nil {
+		return nil, clues.StackWC(ctx, err)
+	}
+
+	return container, nil
+}
+
+// ---------------------------------------------------------------------------
+// items
+// ---------------------------------------------------------------------------
+
+// GetItem retrieves a TodoTaskable item, including its checklist items and
+// linked resources.  Tasks are only addressable within their list, so the
+// list ID is required alongside the task ID.
+func (c Tasks) GetItem(
+	ctx context.Context,
+	userID, containerID, itemID string,
+	_ *fault.Bus, // attachments aren't backed up, so this goes unused
+) (serialization.Parsable, *details.ExchangeInfo, error) {
+	options := &users.ItemTodoListsItemTasksTodoTaskItemRequestBuilderGetRequestConfiguration{
+		QueryParameters: &users.ItemTodoListsItemTasksTodoTaskItemRequestBuilderGetQueryParameters{
+			Expand: []string{"checklistItems", "linkedResources"},
+		},
+	}
+
+	task, err := c.Stable.
+		Client().
+		Users().
+		ByUserId(userID).
+		Todo().
+		Lists().
+		ByTodoTaskListId(containerID).
+		Tasks().
+		ByTodoTaskId(itemID).
+		Get(ctx, options)
+	if err != nil {
+		return nil, nil, clues.Stack(err)
+	}
+
+	return task, TaskInfo(task), nil
+}
+
+func (c Tasks) PostItem(
+	ctx context.Context,
+	userID, containerID string,
+	body models.TodoTaskable,
+) (models.TodoTaskable, error) {
+	itm, err := c.Stable.
+		Client().
+		Users().
+		ByUserId(userID).
+		Todo().
+		Lists().
+		ByTodoTaskListId(containerID).
+		Tasks().
+		Post(ctx, body, nil)
+
+	return itm, clues.Wrap(err, "creating task").OrNil()
+}
+
+// PostChecklistItem adds a checklist item (aka: a step) to the task.
+func (c Tasks) PostChecklistItem(
+	ctx context.Context,
+	userID, containerID, itemID string,
+	body models.ChecklistItemable,
+) (models.ChecklistItemable, error) {
+	itm, err := c.Stable.
+		Client().
+		Users().
+		ByUserId(userID).
+		Todo().
+		Lists().
+		ByTodoTaskListId(containerID).
+		Tasks().
+		ByTodoTaskId(itemID).
+		ChecklistItems().
+		Post(ctx, body, nil)
+
+	return itm, clues.Wrap(err, "creating task checklist item").OrNil()
+}
+
+func (c Tasks) DeleteItem(
+	ctx context.Context,
+	userID, containerID, itemID string,
+) error {
+	// deletes require unique http clients
+	// https://github.com/alcionai/corso/issues/2707
+	srv, err := c.Service(c.counter)
+	if err != nil {
+		return clues.StackWC(ctx, err)
+	}
+
+	err = srv.
+		Client().
+		Users().
+		ByUserId(userID).
+		Todo().
+		Lists().
+		ByTodoTaskListId(containerID).
+		Tasks().
+		ByTodoTaskId(itemID).
+		Delete(ctx, nil)
+
+	return clues.Wrap(err, "deleting task").OrNil()
+}
+
+// ---------------------------------------------------------------------------
+// Serialization
+// ---------------------------------------------------------------------------
+
+func bytesToTodoTaskable(bytes []byte) (serialization.Parsable, error) {
+	v, err := CreateFromBytes(bytes, models.CreateTodoTaskFromDiscriminatorValue)
+	if err != nil {
+		if !strings.Contains(err.Error(), invalidJSON) {
+			return nil, clues.Wrap(err, "deserializing bytes to task")
+		}
+
+		// If the JSON was invalid try sanitizing and deserializing again.
+		// Sanitizing should transform characters < 0x20 according to the spec where
+		// possible. The resulting JSON may still be invalid though.
+		bytes = sanitize.JSONBytes(bytes)
+		v, err = CreateFromBytes(bytes, models.CreateTodoTaskFromDiscriminatorValue)
+	}
+
+	return v, clues.Stack(err).OrNil()
+}
+
+func BytesToTodoTaskable(bytes []byte) (models.TodoTaskable, error) {
+	v, err := bytesToTodoTaskable(bytes)
+	if err != nil {
+		return nil, clues.Stack(err)
+	}
+
+	return v.(models.TodoTaskable), nil
+}
+
+func (c Tasks) Serialize(
+	ctx context.Context,
+	item serialization.Parsable,
+	userID, itemID string,
+) ([]byte, error) {
+	task, ok := item.(models.TodoTaskable)
+	if !ok {
+		return nil, clues.NewWC(ctx, fmt.Sprintf("item is not a TodoTaskable: %T", item))
+	}
+
+	ctx = clues.Add(ctx, "item_id", ptr.Val(task.GetId()))
+	writer := kjson.NewJsonSerializationWriter()
+
+	defer writer.Close()
+
+	if err := writer.WriteObjectValue("", task); err != nil {
+		return nil, clues.StackWC(ctx, err)
+	}
+
+	bs, err := writer.GetSerializedContent()
+
+	return bs, clues.WrapWC(ctx, err, "serializing task").OrNil()
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// TaskListDisplayable is a wrapper that complies with the
+// models.TodoTaskListable interface with the graph.Container
+// interfaces. Task lists do not have a parentFolderID.
+// Therefore, that value will always return nil.
+type TaskListDisplayable struct {
+	models.TodoTaskListable
+}
+
+// GetParentFolderId returns nil.  Task lists have a flat hierarchy.
+//
+//nolint:revive
+func (c TaskListDisplayable) GetParentFolderId() *string {
+	return nil
+}
+
+func TaskInfo(task models.TodoTaskable) *details.ExchangeInfo {
+	var (
+		status string
+		due    time.Time
+	)
+
+	if task.GetStatus() != nil {
+		status = task.GetStatus().String()
+	}
+
+	if task.GetDueDateTime() != nil && len(ptr.Val(task.GetDueDateTime().GetDateTime())) > 0 {
+		// timeString has 'Z' literal added to ensure the stored
+		// DateTime is not: time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)
+		dueTime := ptr.Val(task.GetDueDateTime().GetDateTime()) + "Z"
+
+		output, err := dttm.ParseTime(dueTime)
+		if err == nil {
+			due = output
+		}
+	}
+
+	return &details.ExchangeInfo{
+		ItemType:   details.ExchangeTask,
+		Subject:    ptr.Val(task.GetTitle()),
+		TaskStatus: status,
+		TaskDue:    due,
+		Created:    ptr.Val(task.GetCreatedDateTime()),
+		Modified:   ptr.OrNow(task.GetLastModifiedDateTime()),
+	}
+}
+
+func taskCollisionKeyProps() []string {
+	return idAnd("title", dueDateTime)
+}
+
+// TaskCollisionKey constructs a key from the task's title and due date.
+// collision keys are used to identify duplicate item conflicts for handling advanced restoration config.
+func TaskCollisionKey(item models.TodoTaskable) string {
+	if item == nil {
+		return ""
+	}
+
+	var (
+		title = ptr.Val(item.GetTitle())
+		due   string
+	)
+
+	if item.GetDueDateTime() != nil {
+		due = ptr.Val(item.GetDueDateTime().GetDateTime())
+	}
+
+	return title + due
+}
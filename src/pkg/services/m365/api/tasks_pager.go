@@ -0,0 +1,276 @@
+package api
+
+import (
+	"context"
+
+	"github.com/alcionai/clues"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/pkg/path"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/graph"
+	"github.com/alcionai/corso/src/pkg/services/m365/api/pagers"
+)
+
+// ---------------------------------------------------------------------------
+// container pager
+// ---------------------------------------------------------------------------
+
+var _ pagers.NonDeltaHandler[models.TodoTaskListable] = &taskListsPageCtrl{}
+
+type taskListsPageCtrl struct {
+	gs      graph.Servicer
+	builder *users.ItemTodoListsRequestBuilder
+	options *users.ItemTodoListsRequestBuilderGetRequestConfiguration
+}
+
+func (c Tasks) NewTaskListsPager(
+	userID string,
+	selectProps ...string,
+) pagers.NonDeltaHandler[models.TodoTaskListable] {
+	options := &users.ItemTodoListsRequestBuilderGetRequestConfiguration{
+		Headers:         newPreferHeaders(preferPageSize(maxNonDeltaPageSize)),
+		QueryParameters: &users.ItemTodoListsRequestBuilderGetQueryParameters{},
+		// do NOT set Top.  It limits the total items received.
+	}
+
+	if len(selectProps) > 0 {
+		options.QueryParameters.Select = selectProps
+	}
+
+	builder := c.Stable.
+		Client().
+		Users().
+		ByUserId(userID).
+		Todo().
+		Lists()
+
+	return &taskListsPageCtrl{c.Stable, builder, options}
+}
+
+func (p *taskListsPageCtrl) GetPage(
+	ctx context.Context,
+) (pagers.NextLinkValuer[models.TodoTaskListable], error) {
+	resp, err := p.builder.Get(ctx, p.options)
+	return resp, clues.Stack(err).OrNil()
+}
+
+func (p *taskListsPageCtrl) SetNextLink(nextLink string) {
+	p.builder = users.NewItemTodoListsRequestBuilder(nextLink, p.gs.Adapter())
+}
+
+func (p *taskListsPageCtrl) ValidModTimes() bool {
+	return false
+}
+
+// EnumerateContainers retrieves all of the user's current task lists.
+func (c Tasks) EnumerateContainers(
+	ctx context.Context,
+	// baseContainerID needed for iface, doesn't apply to task lists
+	userID, _ string,
+) ([]models.TodoTaskListable, error) {
+	containers, err := pagers.BatchEnumerateItems(ctx, c.NewTaskListsPager(userID))
+	return containers, clues.Stack(err).OrNil()
+}
+
+// ---------------------------------------------------------------------------
+// item pager
+// ---------------------------------------------------------------------------
+
+var _ pagers.NonDeltaHandler[models.TodoTaskable] = &tasksPageCtrl{}
+
+type tasksPageCtrl struct {
+	gs      graph.Servicer
+	builder *users.ItemTodoListsItemTasksRequestBuilder
+	options *users.ItemTodoListsItemTasksRequestBuilderGetRequestConfiguration
+}
+
+func (c Tasks) NewTasksPager(
+	userID, containerID string,
+	selectProps ...string,
+) pagers.NonDeltaHandler[models.TodoTaskable] {
+	options := &users.ItemTodoListsItemTasksRequestBuilderGetRequestConfiguration{
+		Headers:         newPreferHeaders(preferPageSize(maxNonDeltaPageSize)),
+		QueryParameters: &users.ItemTodoListsItemTasksRequestBuilderGetQueryParameters{},
+		// do NOT set Top.  It limits the total items received.
+	}
+
+	if len(selectProps) > 0 {
+		options.QueryParameters.Select = selectProps
+	}
+
+	builder := c.Stable.
+		Client().
+		Users().
+		ByUserId(userID).
+		Todo().
+		Lists().
+		ByTodoTaskListId(containerID).
+		Tasks()
+
+	return &tasksPageCtrl{c.Stable, builder, options}
+}
+
+func (p *tasksPageCtrl) GetPage(
+	ctx context.Context,
+) (pagers.NextLinkValuer[models.TodoTaskable], error) {
+	resp, err := p.builder.Get(ctx, p.options)
+	return resp, clues.Stack(err).OrNil()
+}
+
+func (p *tasksPageCtrl) SetNextLink(nextLink string) {
+	p.builder = users.NewItemTodoListsItemTasksRequestBuilder(nextLink, p.gs.Adapter())
+}
+
+func (p *tasksPageCtrl) ValidModTimes() bool {
+	return true
+}
+
+func (c Tasks) GetItemsInContainerByCollisionKey(
+	ctx context.Context,
+	userID, containerID string,
+) (map[string]string, error) {
+	ctx = clues.Add(ctx, "container_id", containerID)
+	pager := c.NewTasksPager(userID, containerID, taskCollisionKeyProps()...)
+
+	items, err := pagers.BatchEnumerateItems(ctx, pager)
+	if err != nil {
+		return nil, clues.Wrap(err, "enumerating tasks")
+	}
+
+	m := map[string]string{}
+
+	for _, item := range items {
+		m[TaskCollisionKey(item)] = ptr.Val(item.GetId())
+	}
+
+	return m, nil
+}
+
+func (c Tasks) GetItemIDsInContainer(
+	ctx context.Context,
+	userID, containerID string,
+) (map[string]struct{}, error) {
+	ctx = clues.Add(ctx, "container_id", containerID)
+	pager := c.NewTasksPager(userID, containerID, idAnd()...)
+
+	items, err := pagers.BatchEnumerateItems(ctx, pager)
+	if err != nil {
+		return nil, clues.Wrap(err, "enumerating tasks")
+	}
+
+	m := map[string]struct{}{}
+
+	for _, item := range items {
+		m[ptr.Val(item.GetId())] = struct{}{}
+	}
+
+	return m, nil
+}
+
+// ---------------------------------------------------------------------------
+// delta item ID pager
+// ---------------------------------------------------------------------------
+
+var _ pagers.DeltaHandler[models.TodoTaskable] = &taskDeltaPager{}
+
+type taskDeltaPager struct {
+	gs          graph.Servicer
+	userID      string
+	containerID string
+	builder     *users.ItemTodoListsItemTasksDeltaRequestBuilder
+	options     *users.ItemTodoListsItemTasksDeltaRequestBuilderGetRequestConfiguration
+}
+
+func getTaskDeltaBuilder(
+	ctx context.Context,
+	gs graph.Servicer,
+	userID, containerID string,
+) *users.ItemTodoListsItemTasksDeltaRequestBuilder {
+	builder := gs.Client().
+		Users().
+		ByUserId(userID).
+		Todo().
+		Lists().
+		ByTodoTaskListId(containerID).
+		Tasks().
+		Delta()
+
+	return builder
+}
+
+func (c Tasks) NewTasksDeltaPager(
+	ctx context.Context,
+	userID, containerID, prevDeltaLink string,
+	selectProps ...string,
+) pagers.DeltaHandler[models.TodoTaskable] {
+	options := &users.ItemTodoListsItemTasksDeltaRequestBuilderGetRequestConfiguration{
+		// do NOT set Top.  It limits the total items received.
+		QueryParameters: &users.ItemTodoListsItemTasksDeltaRequestBuilderGetQueryParameters{},
+		Headers:         newPreferHeaders(preferPageSize(c.options.DeltaPageSize)),
+	}
+
+	if len(selectProps) > 0 {
+		options.QueryParameters.Select = selectProps
+	}
+
+	var builder *users.ItemTodoListsItemTasksDeltaRequestBuilder
+	if len(prevDeltaLink) > 0 {
+		builder = users.NewItemTodoListsItemTasksDeltaRequestBuilder(prevDeltaLink, c.Stable.Adapter())
+	} else {
+		builder = getTaskDeltaBuilder(ctx, c.Stable, userID, containerID)
+	}
+
+	return &taskDeltaPager{c.Stable, userID, containerID, builder, options}
+}
+
+func (p *taskDeltaPager) GetPage(
+	ctx context.Context,
+) (pagers.DeltaLinkValuer[models.TodoTaskable], error) {
+	resp, err := p.builder.Get(ctx, p.options)
+	return resp, clues.Stack(err).OrNil()
+}
+
+func (p *taskDeltaPager) SetNextLink(nextLink string) {
+	p.builder = users.NewItemTodoListsItemTasksDeltaRequestBuilder(nextLink, p.gs.Adapter())
+}
+
+func (p *taskDeltaPager) Reset(ctx context.Context) {
+	p.builder = getTaskDeltaBuilder(ctx, p.gs, p.userID, p.containerID)
+}
+
+func (p *taskDeltaPager) ValidModTimes() bool {
+	return true
+}
+
+func (c Tasks) GetAddedAndRemovedItemIDs(
+	ctx context.Context,
+	userID, containerID, prevDeltaLink string,
+	config CallConfig,
+) (pagers.AddedAndRemoved, error) {
+	ctx = clues.Add(
+		ctx,
+		"data_category", path.TasksCategory,
+		"container_id", containerID)
+
+	deltaPager := c.NewTasksDeltaPager(
+		ctx,
+		userID,
+		containerID,
+		prevDeltaLink,
+		idAnd(lastModifiedDateTime)...)
+	pager := c.NewTasksPager(
+		userID,
+		containerID,
+		idAnd(lastModifiedDateTime)...)
+
+	return pagers.GetAddedAndRemovedItemIDs[models.TodoTaskable](
+		ctx,
+		pager,
+		deltaPager,
+		prevDeltaLink,
+		config.CanMakeDeltaQueries,
+		config.LimitResults,
+		pagers.AddedAndRemovedByAddtlData[models.TodoTaskable])
+}
@@ -0,0 +1,200 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/alcionai/clues"
+	"github.com/h2non/gock"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/alcionai/corso/src/internal/common/ptr"
+	"github.com/alcionai/corso/src/internal/tester"
+	"github.com/alcionai/corso/src/internal/tester/tconfig"
+	"github.com/alcionai/corso/src/pkg/backup/details"
+	"github.com/alcionai/corso/src/pkg/count"
+	"github.com/alcionai/corso/src/pkg/dttm"
+	"github.com/alcionai/corso/src/pkg/fault"
+)
+
+type TasksAPIUnitSuite struct {
+	tester.Suite
+}
+
+func TestTasksAPIUnitSuite(t *testing.T) {
+	suite.Run(t, &TasksAPIUnitSuite{Suite: tester.NewUnitSuite(t)})
+}
+
+func (suite *TasksAPIUnitSuite) TestTaskInfo() {
+	initial := time.Now().UTC().Truncate(time.Second)
+	now := dttm.FormatTo(initial, dttm.M365DateTimeTimeZone)
+
+	tests := []struct {
+		name      string
+		taskAndRP func() (models.TodoTaskable, *details.ExchangeInfo)
+	}{
+		{
+			name: "empty task",
+			taskAndRP: func() (models.TodoTaskable, *details.ExchangeInfo) {
+				task := models.NewTodoTask()
+
+				task.SetCreatedDateTime(&initial)
+				task.SetLastModifiedDateTime(&initial)
+
+				return task, &details.ExchangeInfo{
+					ItemType: details.ExchangeTask,
+					Created:  initial,
+					Modified: initial,
+				}
+			},
+		},
+		{
+			name: "title, status, and due date",
+			taskAndRP: func() (models.TodoTaskable, *details.ExchangeInfo) {
+				var (
+					task   = models.NewTodoTask()
+					due    = models.NewDateTimeTimeZone()
+					status = models.INPROGRESS_TASKSTATUS
+				)
+
+				task.SetCreatedDateTime(&initial)
+				task.SetLastModifiedDateTime(&initial)
+				task.SetTitle(ptr.To("water the plants"))
+				task.SetStatus(&status)
+				due.SetDateTime(&now)
+				due.SetTimeZone(ptr.To("UTC"))
+				task.SetDueDateTime(due)
+
+				return task, &details.ExchangeInfo{
+					ItemType:   details.ExchangeTask,
+					Subject:    "water the plants",
+					TaskStatus: "inProgress",
+					TaskDue:    initial,
+					Created:    initial,
+					Modified:   initial,
+				}
+			},
+		},
+	}
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			t := suite.T()
+			task, expected := test.taskAndRP()
+			result := TaskInfo(task)
+
+			assert.Equal(t, expected.ItemType, result.ItemType)
+			assert.Equal(t, expected.Subject, result.Subject)
+			assert.Equal(t, expected.TaskStatus, result.TaskStatus)
+			assert.Equal(t, expected.TaskDue, result.TaskDue)
+			assert.Equal(t, expected.Created, result.Created)
+			assert.Equal(t, expected.Modified, result.Modified)
+		})
+	}
+}
+
+func (suite *TasksAPIUnitSuite) TestBytesToTodoTaskable() {
+	tests := []struct {
+		name       string
+		byteArray  []byte
+		checkError assert.ErrorAssertionFunc
+		isNil      assert.ValueAssertionFunc
+	}{
+		{
+			name:       "empty bytes",
+			byteArray:  make([]byte, 0),
+			checkError: assert.Error,
+			isNil:      assert.Nil,
+		},
+		{
+			name:       "invalid bytes",
+			byteArray:  []byte("Invalid byte stream \"title:\" Not going to work"),
+			checkError: assert.Error,
+			isNil:      assert.Nil,
+		},
+		{
+			name:       "valid task",
+			byteArray:  []byte(`{"id":"task-id","title":"water the plants","status":"notStarted"}`),
+			checkError: assert.NoError,
+			isNil:      assert.NotNil,
+		},
+	}
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			t := suite.T()
+
+			result, err := BytesToTodoTaskable(test.byteArray)
+			test.checkError(t, err, clues.ToCore(err))
+			test.isNil(t, result)
+		})
+	}
+}
+
+func (suite *TasksAPIUnitSuite) TestTaskCollisionKey() {
+	t := suite.T()
+
+	task, err := BytesToTodoTaskable(
+		[]byte(`{"id":"task-id","title":"water the plants","dueDateTime":{"dateTime":"2024-01-01T00:00:00.0000000","timeZone":"UTC"}}`))
+	require.NoError(t, err, clues.ToCore(err))
+
+	other, err := BytesToTodoTaskable(
+		[]byte(`{"id":"other-id","title":"water the plants","dueDateTime":{"dateTime":"2024-01-01T00:00:00.0000000","timeZone":"UTC"}}`))
+	require.NoError(t, err, clues.ToCore(err))
+
+	assert.Equal(t, TaskCollisionKey(task), TaskCollisionKey(other), "ids don't affect the key")
+
+	other.SetTitle(ptr.To("feed the cat"))
+	assert.NotEqual(t, TaskCollisionKey(task), TaskCollisionKey(other), "different titles")
+	assert.Empty(t, TaskCollisionKey(nil))
+}
+
+func (suite *TasksAPIUnitSuite) TestGetItem_expandsLinkedResources() {
+	t := suite.T()
+
+	ctx, flush := tester.NewContext(t)
+	defer flush()
+
+	a := tconfig.NewFakeM365Account(t)
+	creds, err := a.M365Config()
+	require.NoError(t, err, clues.ToCore(err))
+
+	client, err := gockClient(creds, count.New())
+	require.NoError(t, err, clues.ToCore(err))
+
+	defer gock.Off()
+
+	interceptV1Path("users", "uid", "todo", "lists", "lid", "tasks", "tid").
+		MatchParam("$expand", "checklistItems,linkedResources").
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"id":    "tid",
+			"title": "water the plants",
+			"checklistItems": []map[string]any{
+				{"id": "ci1", "displayName": "fetch the can"},
+			},
+			"linkedResources": []map[string]any{
+				{
+					"id":              "lr1",
+					"applicationName": "Outlook",
+					"displayName":     "plants email",
+					"webUrl":          "https://outlook.office.com/mail/id",
+				},
+			},
+		})
+
+	item, _, err := client.Tasks().GetItem(ctx, "uid", "lid", "tid", fault.New(true))
+	require.NoError(t, err, clues.ToCore(err))
+	assert.True(t, gock.IsDone(), "made all requests")
+
+	task, ok := item.(models.TodoTaskable)
+	require.True(t, ok, "item is a task")
+	assert.Len(t, task.GetChecklistItems(), 1)
+
+	lrs := task.GetLinkedResources()
+	require.Len(t, lrs, 1)
+	assert.Equal(t, "plants email", ptr.Val(lrs[0].GetDisplayName()))
+	assert.Equal(t, "https://outlook.office.com/mail/id", ptr.Val(lrs[0].GetWebUrl()))
+}